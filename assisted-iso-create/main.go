@@ -3,10 +3,14 @@ package main
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
@@ -16,6 +20,7 @@ import (
 const (
 	ignitionFileName       string = "ignition.config"
 	coreosInstallerCommand string = "coreos-installer"
+	baseISOFileName        string = "base.iso"
 )
 
 var Options struct {
@@ -37,6 +42,35 @@ func setIgnitionConfigToFile(workDir, ignitionConfig string, log *logrus.Logger)
 	return fullFileName, nil
 }
 
+// getBaseISOFile returns the path of the base ISO, an ISO that is given as a URL is downloaded into the work directory
+func getBaseISOFile(workDir, baseISO string, log *logrus.Logger) (string, error) {
+	if !strings.HasPrefix(baseISO, "http://") && !strings.HasPrefix(baseISO, "https://") {
+		return baseISO, nil
+	}
+	log.Infof("Downloading base ISO %s", baseISO)
+	resp, err := http.Get(baseISO)
+	if err != nil {
+		log.Errorf("failed to download base ISO %s", baseISO)
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download base ISO %s: %s", baseISO, resp.Status)
+	}
+	fullFileName := filepath.Join(workDir, baseISOFileName)
+	out, err := os.Create(fullFileName)
+	if err != nil {
+		log.Errorf("failed to create base ISO file %s", fullFileName)
+		return "", err
+	}
+	defer out.Close()
+	if _, err = io.Copy(out, resp.Body); err != nil {
+		log.Errorf("failed to write base ISO into file %s", fullFileName)
+		return "", err
+	}
+	return fullFileName, nil
+}
+
 func embedIgnitionIntoISO(workDir, ignitionFile, imageName, baseISOFile string, log *logrus.Logger) (string, error) {
 	var out bytes.Buffer
 	resultFile := filepath.Join(workDir, imageName)
@@ -84,7 +118,12 @@ func main() {
 		log.Fatal(err.Error())
 	}
 
-	createImageFile, err := embedIgnitionIntoISO(Options.WorkDir, ignitionFilePath, Options.ImageName, Options.BaseISOFile, log)
+	baseISOFile, err := getBaseISOFile(Options.WorkDir, Options.BaseISOFile, log)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	createImageFile, err := embedIgnitionIntoISO(Options.WorkDir, ignitionFilePath, Options.ImageName, baseISOFile, log)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
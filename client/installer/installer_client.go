@@ -24,6 +24,15 @@ type API interface {
 	/*
 	   CompleteInstallation agents API to mark a finalizing installation as complete*/
 	CompleteInstallation(ctx context.Context, params *CompleteInstallationParams) (*CompleteInstallationAccepted, error)
+	/*
+	   CreateClusterManifest creates a manifest that is added to the installation files of the cluster*/
+	CreateClusterManifest(ctx context.Context, params *CreateClusterManifestParams) (*CreateClusterManifestCreated, error)
+	/*
+	   DeleteClusterManifest deletes a manifest of the cluster*/
+	DeleteClusterManifest(ctx context.Context, params *DeleteClusterManifestParams) (*DeleteClusterManifestNoContent, error)
+	/*
+	   DeleteHostBmcCredentials deletes the b m c credentials of the open shift bare metal host*/
+	DeleteHostBmcCredentials(ctx context.Context, params *DeleteHostBmcCredentialsParams) (*DeleteHostBmcCredentialsNoContent, error)
 	/*
 	   DeregisterCluster deletes an open shift bare metal cluster definition*/
 	DeregisterCluster(ctx context.Context, params *DeregisterClusterParams) (*DeregisterClusterNoContent, error)
@@ -33,6 +42,12 @@ type API interface {
 	/*
 	   DisableHost disables a host for inclusion in the cluster*/
 	DisableHost(ctx context.Context, params *DisableHostParams) (*DisableHostOK, error)
+	/*
+	   DownloadBaseBootArtifact downloads a network boot artifact of the r h c o s live i s o of the open shift version the kernel and the rootfs do not hold any data of a cluster so they are served without auth and their u r ls do not expire*/
+	DownloadBaseBootArtifact(ctx context.Context, params *DownloadBaseBootArtifactParams, writer io.Writer) (*DownloadBaseBootArtifactOK, error)
+	/*
+	   DownloadClusterBootArtifact downloads a network boot artifact of the discovery image of the cluster the i p x e script boots the kernel and the rootfs of the open shift version and the initrd with the ignition config of the cluster the minimal discovery i s o fetches the rootfs over HTTP*/
+	DownloadClusterBootArtifact(ctx context.Context, params *DownloadClusterBootArtifactParams, writer io.Writer) (*DownloadClusterBootArtifactOK, error)
 	/*
 	   DownloadClusterFiles downloads files relating to the installed installing cluster*/
 	DownloadClusterFiles(ctx context.Context, params *DownloadClusterFilesParams, writer io.Writer) (*DownloadClusterFilesOK, error)
@@ -53,10 +68,13 @@ type API interface {
 	EnableHost(ctx context.Context, params *EnableHostParams) (*EnableHostOK, error)
 	/*
 	   GenerateClusterISO creates a new open shift per cluster discovery i s o*/
-	GenerateClusterISO(ctx context.Context, params *GenerateClusterISOParams) (*GenerateClusterISOCreated, error)
+	GenerateClusterISO(ctx context.Context, params *GenerateClusterISOParams) (*GenerateClusterISOAccepted, error)
 	/*
 	   GetCluster retrieves the details of the open shift bare metal cluster*/
 	GetCluster(ctx context.Context, params *GetClusterParams) (*GetClusterOK, error)
+	/*
+	   GetClusterConnectivity retrieves the l2 and l3 connectivity between every pair of hosts of the open shift bare metal cluster*/
+	GetClusterConnectivity(ctx context.Context, params *GetClusterConnectivityParams) (*GetClusterConnectivityOK, error)
 	/*
 	   GetClusterInstallConfig gets the cluster install config yaml*/
 	GetClusterInstallConfig(ctx context.Context, params *GetClusterInstallConfigParams) (*GetClusterInstallConfigOK, error)
@@ -69,6 +87,9 @@ type API interface {
 	/*
 	   GetHost retrieves the details of the open shift bare metal host*/
 	GetHost(ctx context.Context, params *GetHostParams) (*GetHostOK, error)
+	/*
+	   GetHostBmcCredentials retrieves the b m c credentials of the open shift bare metal host without the password*/
+	GetHostBmcCredentials(ctx context.Context, params *GetHostBmcCredentialsParams) (*GetHostBmcCredentialsOK, error)
 	/*
 	   GetHostRequirements gets minimum host requirements*/
 	GetHostRequirements(ctx context.Context, params *GetHostRequirementsParams) (*GetHostRequirementsOK, error)
@@ -81,15 +102,36 @@ type API interface {
 	/*
 	   InstallCluster installs the open shift bare metal cluster*/
 	InstallCluster(ctx context.Context, params *InstallClusterParams) (*InstallClusterAccepted, error)
+	/*
+	   InstallHosts installs the hosts of an add hosts cluster into the existing open shift cluster*/
+	InstallHosts(ctx context.Context, params *InstallHostsParams) (*InstallHostsAccepted, error)
+	/*
+	   ListClusterHistory lists the state transitions of the open shift bare metal cluster*/
+	ListClusterHistory(ctx context.Context, params *ListClusterHistoryParams) (*ListClusterHistoryOK, error)
+	/*
+	   ListClusterManifests lists the manifests that are added to the installation files of the cluster*/
+	ListClusterManifests(ctx context.Context, params *ListClusterManifestsParams) (*ListClusterManifestsOK, error)
+	/*
+	   ListClusterValidations lists the validation results of the open shift bare metal cluster*/
+	ListClusterValidations(ctx context.Context, params *ListClusterValidationsParams) (*ListClusterValidationsOK, error)
 	/*
 	   ListClusters retrieves the list of open shift bare metal clusters*/
 	ListClusters(ctx context.Context, params *ListClustersParams) (*ListClustersOK, error)
+	/*
+	   ListHostHistory lists the state transitions of the open shift bare metal host*/
+	ListHostHistory(ctx context.Context, params *ListHostHistoryParams) (*ListHostHistoryOK, error)
+	/*
+	   ListHostValidations lists the validation results of the open shift bare metal host*/
+	ListHostValidations(ctx context.Context, params *ListHostValidationsParams) (*ListHostValidationsOK, error)
 	/*
 	   ListHosts retrieves the list of open shift bare metal hosts*/
 	ListHosts(ctx context.Context, params *ListHostsParams) (*ListHostsOK, error)
 	/*
 	   PostStepReply posts the result of the operations from the host agent*/
 	PostStepReply(ctx context.Context, params *PostStepReplyParams) (*PostStepReplyNoContent, error)
+	/*
+	   RegisterAddHostsCluster creates a new open shift bare metal cluster definition for adding nodes to an existing o c p cluster*/
+	RegisterAddHostsCluster(ctx context.Context, params *RegisterAddHostsClusterParams) (*RegisterAddHostsClusterCreated, error)
 	/*
 	   RegisterCluster creates a new open shift bare metal cluster definition*/
 	RegisterCluster(ctx context.Context, params *RegisterClusterParams) (*RegisterClusterCreated, error)
@@ -99,12 +141,21 @@ type API interface {
 	/*
 	   ResetCluster resets a failed installation*/
 	ResetCluster(ctx context.Context, params *ResetClusterParams) (*ResetClusterAccepted, error)
+	/*
+	   RetryInstallation retries a failed installation by re installing only the hosts that failed*/
+	RetryInstallation(ctx context.Context, params *RetryInstallationParams) (*RetryInstallationAccepted, error)
+	/*
+	   SuggestVips suggests an API v IP and an ingress v IP that all the hosts of the cluster report as free*/
+	SuggestVips(ctx context.Context, params *SuggestVipsParams) (*SuggestVipsOK, error)
 	/*
 	   UpdateCluster updates an open shift bare metal cluster definition*/
 	UpdateCluster(ctx context.Context, params *UpdateClusterParams) (*UpdateClusterCreated, error)
 	/*
 	   UpdateClusterInstallConfig overrides values in the install config*/
 	UpdateClusterInstallConfig(ctx context.Context, params *UpdateClusterInstallConfigParams) (*UpdateClusterInstallConfigCreated, error)
+	/*
+	   UpdateHostBmcCredentials sets the b m c credentials that are used to manage the power and boot of the open shift bare metal host*/
+	UpdateHostBmcCredentials(ctx context.Context, params *UpdateHostBmcCredentialsParams) (*UpdateHostBmcCredentialsCreated, error)
 	/*
 	   UpdateHostInstallProgress updates installation progress*/
 	UpdateHostInstallProgress(ctx context.Context, params *UpdateHostInstallProgressParams) (*UpdateHostInstallProgressOK, error)
@@ -184,6 +235,81 @@ func (a *Client) CompleteInstallation(ctx context.Context, params *CompleteInsta
 
 }
 
+/*
+CreateClusterManifest creates a manifest that is added to the installation files of the cluster
+*/
+func (a *Client) CreateClusterManifest(ctx context.Context, params *CreateClusterManifestParams) (*CreateClusterManifestCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateClusterManifest",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/manifests",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateClusterManifestReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateClusterManifestCreated), nil
+
+}
+
+/*
+DeleteClusterManifest deletes a manifest of the cluster
+*/
+func (a *Client) DeleteClusterManifest(ctx context.Context, params *DeleteClusterManifestParams) (*DeleteClusterManifestNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterManifest",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/manifests",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteClusterManifestReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteClusterManifestNoContent), nil
+
+}
+
+/*
+DeleteHostBmcCredentials deletes the b m c credentials of the open shift bare metal host
+*/
+func (a *Client) DeleteHostBmcCredentials(ctx context.Context, params *DeleteHostBmcCredentialsParams) (*DeleteHostBmcCredentialsNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteHostBmcCredentials",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/bmc-credentials",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteHostBmcCredentialsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteHostBmcCredentialsNoContent), nil
+
+}
+
 /*
 DeregisterCluster deletes an open shift bare metal cluster definition
 */
@@ -259,6 +385,55 @@ func (a *Client) DisableHost(ctx context.Context, params *DisableHostParams) (*D
 
 }
 
+/*
+DownloadBaseBootArtifact downloads a network boot artifact of the r h c o s live i s o of the open shift version the kernel and the rootfs do not hold any data of a cluster so they are served without auth and their u r ls do not expire
+*/
+func (a *Client) DownloadBaseBootArtifact(ctx context.Context, params *DownloadBaseBootArtifactParams, writer io.Writer) (*DownloadBaseBootArtifactOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadBaseBootArtifact",
+		Method:             "GET",
+		PathPattern:        "/boot-artifacts/{openshift_version}",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DownloadBaseBootArtifactReader{formats: a.formats, writer: writer},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadBaseBootArtifactOK), nil
+
+}
+
+/*
+DownloadClusterBootArtifact downloads a network boot artifact of the discovery image of the cluster the i p x e script boots the kernel and the rootfs of the open shift version and the initrd with the ignition config of the cluster the minimal discovery i s o fetches the rootfs over HTTP
+*/
+func (a *Client) DownloadClusterBootArtifact(ctx context.Context, params *DownloadClusterBootArtifactParams, writer io.Writer) (*DownloadClusterBootArtifactOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadClusterBootArtifact",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/downloads/boot-artifacts",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DownloadClusterBootArtifactReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadClusterBootArtifactOK), nil
+
+}
+
 /*
 DownloadClusterFiles downloads files relating to the installed installing cluster
 */
@@ -412,7 +587,7 @@ func (a *Client) EnableHost(ctx context.Context, params *EnableHostParams) (*Ena
 /*
 GenerateClusterISO creates a new open shift per cluster discovery i s o
 */
-func (a *Client) GenerateClusterISO(ctx context.Context, params *GenerateClusterISOParams) (*GenerateClusterISOCreated, error) {
+func (a *Client) GenerateClusterISO(ctx context.Context, params *GenerateClusterISOParams) (*GenerateClusterISOAccepted, error) {
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
 		ID:                 "GenerateClusterISO",
@@ -430,7 +605,7 @@ func (a *Client) GenerateClusterISO(ctx context.Context, params *GenerateCluster
 	if err != nil {
 		return nil, err
 	}
-	return result.(*GenerateClusterISOCreated), nil
+	return result.(*GenerateClusterISOAccepted), nil
 
 }
 
@@ -459,6 +634,31 @@ func (a *Client) GetCluster(ctx context.Context, params *GetClusterParams) (*Get
 
 }
 
+/*
+GetClusterConnectivity retrieves the l2 and l3 connectivity between every pair of hosts of the open shift bare metal cluster
+*/
+func (a *Client) GetClusterConnectivity(ctx context.Context, params *GetClusterConnectivityParams) (*GetClusterConnectivityOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterConnectivity",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/connectivity",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterConnectivityReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterConnectivityOK), nil
+
+}
+
 /*
 GetClusterInstallConfig gets the cluster install config yaml
 */
@@ -559,6 +759,31 @@ func (a *Client) GetHost(ctx context.Context, params *GetHostParams) (*GetHostOK
 
 }
 
+/*
+GetHostBmcCredentials retrieves the b m c credentials of the open shift bare metal host without the password
+*/
+func (a *Client) GetHostBmcCredentials(ctx context.Context, params *GetHostBmcCredentialsParams) (*GetHostBmcCredentialsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetHostBmcCredentials",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/bmc-credentials",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetHostBmcCredentialsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetHostBmcCredentialsOK), nil
+
+}
+
 /*
 GetHostRequirements gets minimum host requirements
 */
@@ -659,6 +884,106 @@ func (a *Client) InstallCluster(ctx context.Context, params *InstallClusterParam
 
 }
 
+/*
+InstallHosts installs the hosts of an add hosts cluster into the existing open shift cluster
+*/
+func (a *Client) InstallHosts(ctx context.Context, params *InstallHostsParams) (*InstallHostsAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "InstallHosts",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/actions/install_hosts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &InstallHostsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*InstallHostsAccepted), nil
+
+}
+
+/*
+ListClusterHistory lists the state transitions of the open shift bare metal cluster
+*/
+func (a *Client) ListClusterHistory(ctx context.Context, params *ListClusterHistoryParams) (*ListClusterHistoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterHistory",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListClusterHistoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterHistoryOK), nil
+
+}
+
+/*
+ListClusterManifests lists the manifests that are added to the installation files of the cluster
+*/
+func (a *Client) ListClusterManifests(ctx context.Context, params *ListClusterManifestsParams) (*ListClusterManifestsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterManifests",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/manifests",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListClusterManifestsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterManifestsOK), nil
+
+}
+
+/*
+ListClusterValidations lists the validation results of the open shift bare metal cluster
+*/
+func (a *Client) ListClusterValidations(ctx context.Context, params *ListClusterValidationsParams) (*ListClusterValidationsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterValidations",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/validations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListClusterValidationsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterValidationsOK), nil
+
+}
+
 /*
 ListClusters retrieves the list of open shift bare metal clusters
 */
@@ -684,6 +1009,56 @@ func (a *Client) ListClusters(ctx context.Context, params *ListClustersParams) (
 
 }
 
+/*
+ListHostHistory lists the state transitions of the open shift bare metal host
+*/
+func (a *Client) ListHostHistory(ctx context.Context, params *ListHostHistoryParams) (*ListHostHistoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListHostHistory",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListHostHistoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListHostHistoryOK), nil
+
+}
+
+/*
+ListHostValidations lists the validation results of the open shift bare metal host
+*/
+func (a *Client) ListHostValidations(ctx context.Context, params *ListHostValidationsParams) (*ListHostValidationsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListHostValidations",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/validations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListHostValidationsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListHostValidationsOK), nil
+
+}
+
 /*
 ListHosts retrieves the list of open shift bare metal hosts
 */
@@ -734,6 +1109,31 @@ func (a *Client) PostStepReply(ctx context.Context, params *PostStepReplyParams)
 
 }
 
+/*
+RegisterAddHostsCluster creates a new open shift bare metal cluster definition for adding nodes to an existing o c p cluster
+*/
+func (a *Client) RegisterAddHostsCluster(ctx context.Context, params *RegisterAddHostsClusterParams) (*RegisterAddHostsClusterCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RegisterAddHostsCluster",
+		Method:             "POST",
+		PathPattern:        "/add_hosts_clusters",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RegisterAddHostsClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegisterAddHostsClusterCreated), nil
+
+}
+
 /*
 RegisterCluster creates a new open shift bare metal cluster definition
 */
@@ -809,6 +1209,56 @@ func (a *Client) ResetCluster(ctx context.Context, params *ResetClusterParams) (
 
 }
 
+/*
+RetryInstallation retries a failed installation by re installing only the hosts that failed
+*/
+func (a *Client) RetryInstallation(ctx context.Context, params *RetryInstallationParams) (*RetryInstallationAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RetryInstallation",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/actions/retry_install",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RetryInstallationReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RetryInstallationAccepted), nil
+
+}
+
+/*
+SuggestVips suggests an API v IP and an ingress v IP that all the hosts of the cluster report as free
+*/
+func (a *Client) SuggestVips(ctx context.Context, params *SuggestVipsParams) (*SuggestVipsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SuggestVips",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/actions/suggest-vips",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SuggestVipsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SuggestVipsOK), nil
+
+}
+
 /*
 UpdateCluster updates an open shift bare metal cluster definition
 */
@@ -859,6 +1309,31 @@ func (a *Client) UpdateClusterInstallConfig(ctx context.Context, params *UpdateC
 
 }
 
+/*
+UpdateHostBmcCredentials sets the b m c credentials that are used to manage the power and boot of the open shift bare metal host
+*/
+func (a *Client) UpdateHostBmcCredentials(ctx context.Context, params *UpdateHostBmcCredentialsParams) (*UpdateHostBmcCredentialsCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateHostBmcCredentials",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/bmc-credentials",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateHostBmcCredentialsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateHostBmcCredentialsCreated), nil
+
+}
+
 /*
 UpdateHostInstallProgress updates installation progress
 */
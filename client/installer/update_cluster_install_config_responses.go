@@ -102,20 +102,20 @@ func NewUpdateClusterInstallConfigBadRequest() *UpdateClusterInstallConfigBadReq
 Error.
 */
 type UpdateClusterInstallConfigBadRequest struct {
-	Payload *models.Error
+	Payload *models.InstallConfigOverridesError
 }
 
 func (o *UpdateClusterInstallConfigBadRequest) Error() string {
 	return fmt.Sprintf("[PATCH /clusters/{cluster_id}/install-config][%d] updateClusterInstallConfigBadRequest  %+v", 400, o.Payload)
 }
 
-func (o *UpdateClusterInstallConfigBadRequest) GetPayload() *models.Error {
+func (o *UpdateClusterInstallConfigBadRequest) GetPayload() *models.InstallConfigOverridesError {
 	return o.Payload
 }
 
 func (o *UpdateClusterInstallConfigBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
-	o.Payload = new(models.Error)
+	o.Payload = new(models.InstallConfigOverridesError)
 
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
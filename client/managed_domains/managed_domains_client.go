@@ -17,9 +17,21 @@ import (
 
 // API is the interface of the managed domains client
 type API interface {
+	/*
+	   DeregisterManagedDomain deletes a managed DNS domain admin only*/
+	DeregisterManagedDomain(ctx context.Context, params *DeregisterManagedDomainParams) (*DeregisterManagedDomainNoContent, error)
+	/*
+	   GetManagedDomain retrieves the details of a managed DNS domain admin only*/
+	GetManagedDomain(ctx context.Context, params *GetManagedDomainParams) (*GetManagedDomainOK, error)
 	/*
 	   ListManagedDomains lists of managed DNS domains*/
 	ListManagedDomains(ctx context.Context, params *ListManagedDomainsParams) (*ListManagedDomainsOK, error)
+	/*
+	   RegisterManagedDomain adds a managed DNS domain admin only*/
+	RegisterManagedDomain(ctx context.Context, params *RegisterManagedDomainParams) (*RegisterManagedDomainCreated, error)
+	/*
+	   UpdateManagedDomain updates a managed DNS domain admin only*/
+	UpdateManagedDomain(ctx context.Context, params *UpdateManagedDomainParams) (*UpdateManagedDomainOK, error)
 }
 
 // New creates a new managed domains API client.
@@ -40,6 +52,56 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+DeregisterManagedDomain deletes a managed DNS domain admin only
+*/
+func (a *Client) DeregisterManagedDomain(ctx context.Context, params *DeregisterManagedDomainParams) (*DeregisterManagedDomainNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeregisterManagedDomain",
+		Method:             "DELETE",
+		PathPattern:        "/domains/{domain_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeregisterManagedDomainReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeregisterManagedDomainNoContent), nil
+
+}
+
+/*
+GetManagedDomain retrieves the details of a managed DNS domain admin only
+*/
+func (a *Client) GetManagedDomain(ctx context.Context, params *GetManagedDomainParams) (*GetManagedDomainOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetManagedDomain",
+		Method:             "GET",
+		PathPattern:        "/domains/{domain_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetManagedDomainReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetManagedDomainOK), nil
+
+}
+
 /*
 ListManagedDomains lists of managed DNS domains
 */
@@ -64,3 +126,53 @@ func (a *Client) ListManagedDomains(ctx context.Context, params *ListManagedDoma
 	return result.(*ListManagedDomainsOK), nil
 
 }
+
+/*
+RegisterManagedDomain adds a managed DNS domain admin only
+*/
+func (a *Client) RegisterManagedDomain(ctx context.Context, params *RegisterManagedDomainParams) (*RegisterManagedDomainCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RegisterManagedDomain",
+		Method:             "POST",
+		PathPattern:        "/domains",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RegisterManagedDomainReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegisterManagedDomainCreated), nil
+
+}
+
+/*
+UpdateManagedDomain updates a managed DNS domain admin only
+*/
+func (a *Client) UpdateManagedDomain(ctx context.Context, params *UpdateManagedDomainParams) (*UpdateManagedDomainOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateManagedDomain",
+		Method:             "PATCH",
+		PathPattern:        "/domains/{domain_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateManagedDomainReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateManagedDomainOK), nil
+
+}
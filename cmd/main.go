@@ -28,12 +28,14 @@ import (
 	"github.com/openshift/assisted-service/internal/domains"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/app"
 	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/bmc"
 	"github.com/openshift/assisted-service/pkg/db"
 	"github.com/openshift/assisted-service/pkg/generator"
 	"github.com/openshift/assisted-service/pkg/job"
@@ -70,12 +72,19 @@ var Options struct {
 	Versions                    versions.Versions
 	CreateS3Bucket              bool          `envconfig:"CREATE_S3_BUCKET" default:"false"`
 	ImageExpirationInterval     time.Duration `envconfig:"IMAGE_EXPIRATION_INTERVAL" default:"30m"`
+	MinimalImageExpirationTime  time.Duration `envconfig:"MINIMAL_IMAGE_EXPIRATION_TIME" default:"4h"`
+	InitrdExpirationTime        time.Duration `envconfig:"INITRD_EXPIRATION_TIME" default:"4h"`
+	IPXEScriptExpirationTime    time.Duration `envconfig:"IPXE_SCRIPT_EXPIRATION_TIME" default:"4h"`
 	ClusterConfig               cluster.Config
 	DeployTarget                string `envconfig:"DEPLOY_TARGET" default:"k8s"`
 	OCMConfig                   ocm.Config
 	HostConfig                  host.Config
 	LogConfig                   logconfig.Config
 	LeaderConfig                leader.Config
+	BmcConfig                   bmc.Config
+	BmcManagerConfig            host.BmcConfig
+	BmcMonitorInterval          time.Duration `envconfig:"BMC_MONITOR_INTERVAL" default:"10s"`
+	ImageBuildQueueInterval     time.Duration `envconfig:"IMAGE_BUILD_QUEUE_INTERVAL" default:"5s"`
 }
 
 func InitLogs() *logrus.Entry {
@@ -115,6 +124,12 @@ func main() {
 
 	log.Println("Starting bm service")
 
+	if Options.BMConfig.BmcCredentialsKey != "" {
+		if err = common.ValidateBmcCredentialsKey(Options.BMConfig.BmcCredentialsKey); err != nil {
+			log.WithError(err).Fatal("invalid BMC credentials key")
+		}
+	}
+
 	// Connect to db
 	dbConnectionStr := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
 		Options.DBConfig.Host, Options.DBConfig.Port, Options.DBConfig.User, Options.DBConfig.Name, Options.DBConfig.Pass)
@@ -127,7 +142,7 @@ func main() {
 	db.DB().SetMaxOpenConns(0)
 	db.DB().SetConnMaxLifetime(0)
 
-	if err = db.AutoMigrate(&models.Host{}, &common.Cluster{}, &events.Event{}).Error; err != nil {
+	if err = db.AutoMigrate(&models.Host{}, &common.Cluster{}, &events.Event{}, &history.Transition{}, &models.Validation{}, &common.BmcCredentials{}, &models.ManagedDomain{}).Error; err != nil {
 		log.Fatal("failed to auto migrate, ", err)
 	}
 
@@ -142,12 +157,17 @@ func main() {
 
 	var lead leader.ElectorInterface
 	var autoMigrationLeader leader.ElectorInterface
+	var baseISOsUploadLeader leader.ElectorInterface
 	authHandler := auth.NewAuthHandler(Options.Auth, ocmClient, log.WithField("pkg", "auth"))
 	authzHandler := auth.NewAuthzHandler(Options.Auth, ocmClient, log.WithField("pkg", "authz"))
-	versionHandler := versions.NewHandler(Options.Versions)
-	domainHandler := domains.NewHandler(Options.BMConfig.BaseDNSDomains)
+	openshiftVersions, err := versions.ParseOpenshiftVersions(Options.Versions.OpenshiftVersions, Options.Versions.ReleaseImage)
+	if err != nil {
+		log.Fatal("Failed to load the OpenShift versions, ", err)
+	}
+	versionHandler := versions.NewHandler(Options.Versions, openshiftVersions)
+	domainHandler := domains.NewHandler(db, log.WithField("pkg", "domains"))
 	eventsHandler := events.New(db, log.WithField("pkg", "events"))
-	hwValidator := hardware.NewValidator(log.WithField("pkg", "validators"), Options.HWValidatorConfig)
+	hwValidator := hardware.NewValidator(log.WithField("pkg", "validators"), Options.HWValidatorConfig, openshiftVersions)
 	connectivityValidator := connectivity.NewValidator(log.WithField("pkg", "validators"))
 	instructionApi := host.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator, Options.InstructionConfig, connectivityValidator)
 	prometheusRegistry := prometheus.DefaultRegisterer
@@ -162,7 +182,7 @@ func main() {
 		Options.JobConfig.S3EndpointURL = newUrl
 	}
 
-	var generator generator.ISOInstallConfigGenerator
+	var generator generator.InstallConfigGenerator
 	var objectHandler s3wrapper.API
 
 	switch Options.DeployTarget {
@@ -197,6 +217,11 @@ func main() {
 			"assisted-service-migration-helper",
 			log.WithField("pkg", "migrationLeader"))
 
+		baseISOsUploadLeader = leader.NewElector(k8sClient, leader.Config{LeaseDuration: 5 * time.Second,
+			RetryInterval: 2 * time.Second, Namespace: Options.LeaderConfig.Namespace, RenewDeadline: 4 * time.Second},
+			"assisted-service-base-iso-helper",
+			log.WithField("pkg", "baseISOsUploadLeader"))
+
 		lead = leader.NewElector(k8sClient, Options.LeaderConfig, "assisted-service-leader-election-helper",
 			log.WithField("pkg", "monitor-runner"))
 
@@ -208,6 +233,7 @@ func main() {
 	case "onprem":
 		lead = &leader.DummyElector{}
 		autoMigrationLeader = lead
+		baseISOsUploadLeader = lead
 		// in on-prem mode, setup file system s3 driver and use localjob implementation
 		objectHandler = s3wrapper.NewFSClient("/data", log)
 		if objectHandler == nil {
@@ -224,10 +250,17 @@ func main() {
 		log.WithError(err).Fatal("Failed auto migration process")
 	}
 
+	err = autoMigrationLeader.RunWithLeader(context.Background(), func() error {
+		return domainHandler.ImportBaseDNSDomains(Options.BMConfig.BaseDNSDomains)
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to import the base DNS domains")
+	}
+
 	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, eventsHandler, hwValidator,
 		instructionApi, &Options.HWValidatorConfig, metricsManager, &Options.HostConfig, lead)
 	clusterApi := cluster.NewManager(Options.ClusterConfig, log.WithField("pkg", "cluster-state"), db,
-		eventsHandler, hostApi, metricsManager, lead)
+		eventsHandler, hostApi, metricsManager, lead, versionHandler)
 
 	clusterStateMonitor := thread.New(
 		log.WithField("pkg", "cluster-monitor"), "Cluster State Monitor", Options.ClusterStateMonitorInterval, clusterApi.ClusterMonitoring)
@@ -239,6 +272,14 @@ func main() {
 	hostStateMonitor.Start()
 	defer hostStateMonitor.Stop()
 
+	bmcManager := host.NewBmcManager(log.WithField("pkg", "bmc-manager"), db,
+		bmc.NewClient(log.WithField("pkg", "bmc"), Options.BmcConfig), eventsHandler,
+		Options.InstructionConfig.ServiceBaseURL, Options.BMConfig.BmcCredentialsKey, lead, Options.BmcManagerConfig)
+	bmcMonitor := thread.New(
+		log.WithField("pkg", "bmc-monitor"), "BMC Monitor", Options.BmcMonitorInterval, bmcManager.BmcMonitoring)
+	bmcMonitor.Start()
+	defer bmcMonitor.Stop()
+
 	if newUrl, err = s3wrapper.FixEndpointURL(Options.BMConfig.S3EndpointURL); err != nil {
 		log.WithError(err).Fatalf("failed to create valid bm config S3 endpoint URL from %s", Options.BMConfig.S3EndpointURL)
 	} else {
@@ -246,11 +287,21 @@ func main() {
 	}
 
 	bm := bminventory.NewBareMetalInventory(db, log.WithField("pkg", "Inventory"), hostApi, clusterApi, Options.BMConfig,
-		generator, eventsHandler, objectHandler, metricsManager, *authHandler)
+		generator, eventsHandler, objectHandler, metricsManager, *authHandler, versionHandler, hwValidator)
 
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
 
-	expirer := imgexpirer.NewManager(objectHandler, eventsHandler, Options.BMConfig.ImageExpirationTime, lead)
+	imageBuildQueueMonitor := thread.New(
+		log.WithField("pkg", "image-build-queue-monitor"), "Image Build Queue Monitor", Options.ImageBuildQueueInterval, bm.ImageBuildQueueMonitoring)
+	imageBuildQueueMonitor.Start()
+	defer imageBuildQueueMonitor.Stop()
+
+	expirer := imgexpirer.NewManager(objectHandler, eventsHandler, imgexpirer.Config{
+		ImageExpirationTime:        Options.BMConfig.ImageExpirationTime,
+		MinimalImageExpirationTime: Options.MinimalImageExpirationTime,
+		InitrdExpirationTime:       Options.InitrdExpirationTime,
+		IPXEScriptExpirationTime:   Options.IPXEScriptExpirationTime,
+	}, lead)
 	imageExpirationMonitor := thread.New(
 		log.WithField("pkg", "image-expiration-monitor"), "Image Expiration Monitor", Options.ImageExpirationInterval, expirer.ExpirationTask)
 	imageExpirationMonitor.Start()
@@ -259,6 +310,7 @@ func main() {
 	h, err := restapi.Handler(restapi.Config{
 		AuthAgentAuth:       authHandler.AuthAgentAuth,
 		AuthUserAuth:        authHandler.AuthUserAuth,
+		AuthURLAuth:         authHandler.AuthURLAuth,
 		APIKeyAuthenticator: authHandler.CreateAuthenticator(),
 		Authorizer:          authzHandler.CreateAuthorizer(),
 		InstallerAPI:        bm,
@@ -284,16 +336,12 @@ func main() {
 	h = app.WithHealthMiddleware(apiEnabler)
 	h = requestid.Middleware(h)
 
-	if Options.DeployTarget == deploymet_type_k8s {
-		go func() {
-			defer apiEnabler.Enable()
-			//Run first ISO dummy for image pull, this is done so that the image will be pulled and the api will take less time.
-			// blocking function that can take a long time.
-			bminventory.GenerateDummyISOImage(log, generator, eventsHandler)
-		}()
-	} else {
-		apiEnabler.Enable()
-	}
+	go func() {
+		defer apiEnabler.Enable()
+		// the discovery images and the boot artifacts are streamed from the base ISOs, blocking function that can take a long time.
+		bminventory.UploadBaseISOsWithLeader(log, baseISOsUploadLeader, objectHandler, versionHandler.GetRHCOSImages(),
+			versionHandler.GetRHCOSMinimalImages())
+	}()
 
 	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%s", swag.StringValue(port)), h))
 }
@@ -338,7 +386,10 @@ func (a *ApiEnabler) Enable() {
 func autoMigrationWithLeader(migrationLeader leader.ElectorInterface, db *gorm.DB, log logrus.FieldLogger) error {
 	return migrationLeader.RunWithLeader(context.Background(), func() error {
 		log.Infof("Start automigration")
-		err := db.AutoMigrate(&models.Host{}, &common.Cluster{}, &events.Event{}).Error
+		err := db.AutoMigrate(&models.Host{}, &common.Cluster{}, &events.Event{}, &history.Transition{}, &models.Validation{}, &common.BmcCredentials{}, &models.ManagedDomain{}).Error
+		if err == nil {
+			err = common.MigrateValidationsInfo(db)
+		}
 		log.Infof("Finish automigration")
 		return err
 	})
@@ -0,0 +1,227 @@
+package bminventory
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/openshift/assisted-service/pkg/isoeditor"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// getBaseObjectID identifies the objects of an RHCOS image by a hash of its full location, as the images of different
+// versions may have the same file name
+func getBaseObjectID(image string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(image)))
+}
+
+// getBaseISOName returns the name of the object that stores the RHCOS live ISO, the discovery images of the clusters
+// are streamed from it
+func getBaseISOName(rhcosImage string) string {
+	return fmt.Sprintf("base-iso-%s", getBaseObjectID(rhcosImage))
+}
+
+// getBaseKernelName returns the name of the object that stores the kernel of the RHCOS live ISO
+func getBaseKernelName(rhcosImage string) string {
+	return fmt.Sprintf("base-kernel-%s", getBaseObjectID(rhcosImage))
+}
+
+// getBaseInitrdName returns the name of the object that stores the initrd of the RHCOS live ISO, the initrds of the
+// clusters are streamed from it
+func getBaseInitrdName(rhcosImage string) string {
+	return fmt.Sprintf("base-initrd-%s", getBaseObjectID(rhcosImage))
+}
+
+// getBaseRootfsName returns the name of the object that stores the rootfs of the RHCOS live ISO
+func getBaseRootfsName(rhcosImage string) string {
+	return fmt.Sprintf("base-rootfs-%s", getBaseObjectID(rhcosImage))
+}
+
+// getBaseMinimalISOName returns the name of the object that stores the RHCOS minimal live ISO, the minimal discovery
+// images of the clusters are streamed from it
+func getBaseMinimalISOName(rhcosMinimalImage string) string {
+	return fmt.Sprintf("base-minimal-iso-%s", getBaseObjectID(rhcosMinimalImage))
+}
+
+// getBaseMinimalKargsName returns the name of the object that stores the kargs areas of the RHCOS minimal live ISO as
+// JSON, the rootfs URL of the cluster is written into them as the minimal ISO does not include the rootfs
+func getBaseMinimalKargsName(rhcosMinimalImage string) string {
+	return fmt.Sprintf("base-minimal-kargs-%s", getBaseObjectID(rhcosMinimalImage))
+}
+
+const (
+	baseISOsUploadRetryInterval    = 10 * time.Second
+	baseISOsUploadMaxRetryInterval = 5 * time.Minute
+)
+
+// UploadBaseISOsWithLeader uploads the base ISOs once the replica is the leader of the upload, so that the replicas of
+// the service don't upload the same ISOs at the same time. The replicas that wait for the leader find the objects that
+// it uploaded. A failed upload is retried with an exponential backoff until it succeeds.
+func UploadBaseISOsWithLeader(log logrus.FieldLogger, uploadLeader leader.ElectorInterface, objectHandler s3wrapper.API,
+	rhcosImages, rhcosMinimalImages []string) {
+	retryInterval := baseISOsUploadRetryInterval
+	for {
+		err := uploadLeader.RunWithLeader(context.Background(), func() error {
+			return UploadBaseISOs(log, objectHandler, rhcosImages, rhcosMinimalImages)
+		})
+		if err == nil {
+			return
+		}
+		log.WithError(err).Errorf("Failed to upload the base ISOs, retrying in %s", retryInterval)
+		time.Sleep(retryInterval)
+		retryInterval *= 2
+		if retryInterval > baseISOsUploadMaxRetryInterval {
+			retryInterval = baseISOsUploadMaxRetryInterval
+		}
+	}
+}
+
+// UploadBaseISOs uploads the RHCOS live ISOs together with their kernel, initrd and rootfs, and the RHCOS minimal live
+// ISOs together with their kargs areas to the storage backend, objects that were already uploaded are skipped. An ISO
+// is downloaded if it is a URL and read from the file system of the service otherwise. A minimal ISO without kargs
+// areas fails the upload, as it could not boot without the rootfs URL
+func UploadBaseISOs(l logrus.FieldLogger, objectHandler s3wrapper.API, rhcosImages, rhcosMinimalImages []string) error {
+	var (
+		requestID = requestid.NewID()
+		log       = requestid.RequestIDLogger(l, requestID)
+		ctx       = requestid.ToContext(context.Background(), requestID)
+	)
+	for _, rhcosImage := range rhcosImages {
+		if err := uploadBaseObjects(ctx, log, objectHandler, rhcosImage, map[string]baseObjectReader{
+			getBaseISOName(rhcosImage):    readISO,
+			getBaseKernelName(rhcosImage): readISOFile(isoeditor.KernelPath),
+			getBaseInitrdName(rhcosImage): readISOFile(isoeditor.InitrdPath),
+			getBaseRootfsName(rhcosImage): readISOFile(isoeditor.RootfsPath),
+		}); err != nil {
+			return err
+		}
+	}
+	for _, rhcosMinimalImage := range rhcosMinimalImages {
+		if err := uploadBaseObjects(ctx, log, objectHandler, rhcosMinimalImage, map[string]baseObjectReader{
+			getBaseMinimalISOName(rhcosMinimalImage):   readISO,
+			getBaseMinimalKargsName(rhcosMinimalImage): readKargsAreas,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// baseObjectReader returns a reader of the content of a base object from the ISO
+type baseObjectReader func(iso io.ReaderAt, size int64) (io.Reader, error)
+
+func readISO(iso io.ReaderAt, size int64) (io.Reader, error) {
+	return io.NewSectionReader(iso, 0, size), nil
+}
+
+func readISOFile(filePath string) baseObjectReader {
+	return func(iso io.ReaderAt, _ int64) (io.Reader, error) {
+		return isoeditor.OpenFile(iso, filePath)
+	}
+}
+
+func readKargsAreas(iso io.ReaderAt, _ int64) (io.Reader, error) {
+	kargsAreas, err := isoeditor.ReadKargsAreas(iso)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(kargsAreas)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// uploadBaseObjects uploads the objects of the ISO that were not uploaded yet, the objects map their names to the
+// readers of their contents
+func uploadBaseObjects(ctx context.Context, log logrus.FieldLogger, objectHandler s3wrapper.API, image string,
+	objects map[string]baseObjectReader) error {
+	var missing []string
+	for objectName := range objects {
+		exists, err := objectHandler.DoesObjectExist(ctx, objectName)
+		if err != nil {
+			return errors.Wrapf(err, "failed to check if the base object %s exists", objectName)
+		}
+		if exists {
+			log.Infof("Base object %s was already uploaded", objectName)
+			continue
+		}
+		missing = append(missing, objectName)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+
+	iso, closeISO, err := openBaseISO(image)
+	if err != nil {
+		return err
+	}
+	defer closeISO()
+	info, err := iso.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the size of %s", image)
+	}
+
+	// the readers of all the objects are created first, so an ISO that lacks a file uploads none of them
+	readers := make(map[string]io.Reader, len(missing))
+	for _, objectName := range missing {
+		if readers[objectName], err = objects[objectName](iso, info.Size()); err != nil {
+			return errors.Wrapf(err, "failed to get the base object %s from %s", objectName, image)
+		}
+	}
+	for _, objectName := range missing {
+		log.Infof("Uploading base object %s from %s", objectName, image)
+		if err = objectHandler.UploadStream(ctx, readers[objectName], objectName); err != nil {
+			return errors.Wrapf(err, "failed to upload the base object %s", objectName)
+		}
+	}
+	return nil
+}
+
+// openBaseISO opens the ISO at the location, an ISO at a URL is downloaded to a temporary file as the files in it are
+// read at random, the returned function closes the ISO and removes the temporary file
+func openBaseISO(image string) (*os.File, func(), error) {
+	if u, err := url.Parse(image); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		file, err := os.Open(image)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to open %s", image)
+		}
+		return file, func() { file.Close() }, nil
+	}
+
+	resp, err := http.Get(image) // #nosec
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to download %s", image)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, errors.Errorf("failed to download %s: %s", image, resp.Status)
+	}
+	file, err := ioutil.TempFile("", "base-iso")
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create a temporary file for %s", image)
+	}
+	closeFile := func() {
+		file.Close()
+		os.Remove(file.Name())
+	}
+	if _, err = io.Copy(file, resp.Body); err != nil {
+		closeFile()
+		return nil, nil, errors.Wrapf(err, "failed to download %s", image)
+	}
+	return file, closeFile, nil
+}
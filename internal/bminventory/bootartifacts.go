@@ -0,0 +1,352 @@
+package bminventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	"github.com/openshift/assisted-service/pkg/isoeditor"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+)
+
+const (
+	bootArtifactIPXEScript = "ipxe-script"
+	bootArtifactKernel     = "kernel"
+	bootArtifactInitrd     = "initrd"
+	bootArtifactRootfs     = "rootfs"
+	bootArtifactMinimalISO = "minimal-iso"
+)
+
+// the ignition config is appended to the initrd as an extra initrd, so the kernel arguments only locate the rootfs.
+// iPXE cannot send headers, so the initrd URL carries a token of the cluster when auth is enabled. The kernel and the
+// rootfs are the ones of the OpenShift version and are downloaded without auth, the initramfs fetches the rootfs late
+// in the boot and the minimal ISO has the rootfs URL embedded, so their URLs must not expire
+const ipxeScriptFormat = `#!ipxe
+initrd --name initrd %s
+kernel %s initrd=initrd coreos.live.rootfs_url=%s random.trust_cpu=on ignition.firstboot ignition.platform.id=metal
+boot
+`
+
+// getMinimalImageIgnitionName returns the name of the object that stores the ignition config of the minimal image of
+// the cluster
+func getMinimalImageIgnitionName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("discovery-minimal-image-%s.ign", clusterID.String())
+}
+
+// getInitrdIgnitionName returns the name of the object that stores the ignition config of the initrd of the cluster
+func getInitrdIgnitionName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("discovery-initrd-%s.ign", clusterID.String())
+}
+
+// getIPXEScriptName returns the name of the object that stores the iPXE script of the cluster
+func getIPXEScriptName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("discovery-ipxe-script-%s.ipxe", clusterID.String())
+}
+
+// getBootArtifactURL returns the URL of a boot artifact of the cluster, the token is added to the URL unless it is empty
+func (b *bareMetalInventory) getBootArtifactURL(clusterID strfmt.UUID, artifact, token string) string {
+	u := installer.DownloadClusterBootArtifactURL{ClusterID: clusterID, Artifact: artifact}
+	artifactURL := strings.TrimSpace(b.ServiceBaseURL) + u.String()
+	if token != "" {
+		artifactURL += "&" + auth.URLAuthParam + "=" + url.QueryEscape(token)
+	}
+	return artifactURL
+}
+
+// getBaseBootArtifactURL returns the URL of a boot artifact of the OpenShift version, it needs no token
+func (b *bareMetalInventory) getBaseBootArtifactURL(openshiftVersion, artifact string) string {
+	u := installer.DownloadBaseBootArtifactURL{OpenshiftVersion: openshiftVersion, Artifact: artifact}
+	return strings.TrimSpace(b.ServiceBaseURL) + u.String()
+}
+
+// createURLToken returns the token that authenticates the boot artifact URLs of the cluster as its owner, it is empty
+// when auth is disabled
+func (b *bareMetalInventory) createURLToken(cluster *common.Cluster) (string, error) {
+	return b.authHandler.CreateClusterURLToken(cluster.ID.String(), cluster.UserName, cluster.OrgID)
+}
+
+// formatIPXEScript returns the iPXE script that boots the kernel, the initrd and the rootfs of the cluster from the
+// service
+func (b *bareMetalInventory) formatIPXEScript(cluster *common.Cluster, token string) string {
+	return fmt.Sprintf(ipxeScriptFormat, b.getBootArtifactURL(*cluster.ID, bootArtifactInitrd, token),
+		b.getBaseBootArtifactURL(cluster.OpenshiftVersion, bootArtifactKernel),
+		b.getBaseBootArtifactURL(cluster.OpenshiftVersion, bootArtifactRootfs))
+}
+
+// formatRootfsKarg returns the kernel argument that locates the rootfs of the OpenShift version, the minimal ISO boots
+// with it. The rootfs is the one of the RHCOS live ISO of the OpenShift version, which is the build of its minimal ISO
+func (b *bareMetalInventory) formatRootfsKarg(openshiftVersion string) string {
+	return "coreos.live.rootfs_url=" + b.getBaseBootArtifactURL(openshiftVersion, bootArtifactRootfs)
+}
+
+// getImageObjects returns the contents of the objects that are stored per image of the cluster keyed by their names,
+// every boot artifact has its own object so that it expires on its own. The ignition config is embedded into the ISOs
+// and appended to the initrd while they are downloaded, the minimal ISO is only available for OpenShift versions with
+// an RHCOS minimal live ISO. The iPXE script object has no token, the script is formatted with a new token when it is
+// downloaded
+func (b *bareMetalInventory) getImageObjects(cluster *common.Cluster, ignitionConfig []byte) (map[string][]byte, error) {
+	objects := map[string][]byte{
+		getImageIgnitionName(*cluster.ID):  ignitionConfig,
+		getInitrdIgnitionName(*cluster.ID): ignitionConfig,
+		getIPXEScriptName(*cluster.ID):     []byte(b.formatIPXEScript(cluster, "")),
+	}
+	rhcosMinimalImage, err := b.versionsApi.GetRHCOSMinimalImage(cluster.OpenshiftVersion)
+	if err != nil {
+		return nil, err
+	}
+	if rhcosMinimalImage != "" {
+		objects[getMinimalImageIgnitionName(*cluster.ID)] = ignitionConfig
+	}
+	return objects, nil
+}
+
+// uploadImageObjects uploads the objects of the image of the cluster
+func (b *bareMetalInventory) uploadImageObjects(ctx context.Context, cluster *common.Cluster, ignitionConfig string) error {
+	objects, err := b.getImageObjects(cluster, []byte(ignitionConfig))
+	if err != nil {
+		return err
+	}
+	for _, objectName := range sortedObjectNames(objects) {
+		if err = b.objectHandler.Upload(ctx, objects[objectName], objectName); err != nil {
+			return errors.Wrapf(err, "failed to upload %s", objectName)
+		}
+	}
+	return nil
+}
+
+// refreshImageObjects refreshes the timestamps of the objects of the image of the cluster, it returns false if any of
+// them expired
+func (b *bareMetalInventory) refreshImageObjects(ctx context.Context, cluster *common.Cluster) (bool, error) {
+	objects, err := b.getImageObjects(cluster, nil)
+	if err != nil {
+		return false, err
+	}
+	for _, objectName := range sortedObjectNames(objects) {
+		exists, err := b.objectHandler.UpdateObjectTimestamp(ctx, objectName)
+		if err != nil || !exists {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func sortedObjectNames(objects map[string][]byte) []string {
+	names := make([]string, 0, len(objects))
+	for objectName := range objects {
+		names = append(names, objectName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DownloadBaseBootArtifact streams a network boot artifact of the RHCOS live ISO of the OpenShift version, it is the
+// same for all the clusters so it is downloaded without auth
+func (b *bareMetalInventory) DownloadBaseBootArtifact(ctx context.Context, params installer.DownloadBaseBootArtifactParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	rhcosImage, err := b.versionsApi.GetRHCOSImage(params.OpenshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the RHCOS image of OpenShift version %s", params.OpenshiftVersion)
+		return common.NewApiError(http.StatusNotFound, err)
+	}
+
+	var objectName, fileName string
+	switch params.Artifact {
+	case bootArtifactKernel:
+		objectName, fileName = getBaseKernelName(rhcosImage), fmt.Sprintf("openshift-%s-vmlinuz", params.OpenshiftVersion)
+	case bootArtifactRootfs:
+		objectName, fileName = getBaseRootfsName(rhcosImage), fmt.Sprintf("openshift-%s-rootfs.img", params.OpenshiftVersion)
+	default:
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("invalid boot artifact %s", params.Artifact))
+	}
+	reader, contentLength, err := b.downloadBaseObject(ctx, objectName)
+	if err != nil {
+		log.WithError(err).Errorf("failed to download the %s of OpenShift version %s", params.Artifact, params.OpenshiftVersion)
+		return common.GenerateErrorResponder(err)
+	}
+
+	return filemiddleware.NewResponder(installer.NewDownloadBaseBootArtifactOK().WithPayload(reader), fileName, contentLength)
+}
+
+// DownloadClusterBootArtifact streams a network boot artifact of the image of the cluster. The iPXE script, the initrd
+// and the minimal ISO have their own objects that expire
+func (b *bareMetalInventory) DownloadClusterBootArtifact(ctx context.Context, params installer.DownloadClusterBootArtifactParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+
+	// a URL token authenticates the boot artifacts of its cluster only
+	if payload := auth.PayloadFromContext(ctx); payload.ClusterID != "" && payload.ClusterID != params.ClusterID.String() {
+		return common.NewInfraError(http.StatusForbidden, errors.Errorf(
+			"The token of cluster %s cannot download the boot artifacts of cluster %s", payload.ClusterID, params.ClusterID))
+	}
+
+	if err := b.db.First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusNotFound, err)
+	}
+	if err := b.checkImageForDownload(&cluster); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	rhcosImage, err := b.versionsApi.GetRHCOSImage(cluster.OpenshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the RHCOS image of cluster %s", cluster.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var (
+		reader        io.ReadCloser
+		contentLength int64
+		fileName      string
+	)
+	switch params.Artifact {
+	case bootArtifactIPXEScript:
+		fileName = fmt.Sprintf("cluster-%s-discovery.ipxe", params.ClusterID)
+		reader, contentLength, err = b.downloadIPXEScript(ctx, &cluster)
+	case bootArtifactInitrd:
+		fileName = fmt.Sprintf("cluster-%s-initrd.img", params.ClusterID)
+		reader, contentLength, err = b.downloadInitrd(ctx, &cluster, rhcosImage)
+	case bootArtifactMinimalISO:
+		fileName = fmt.Sprintf("cluster-%s-discovery-minimal.iso", params.ClusterID)
+		reader, contentLength, err = b.downloadMinimalISO(ctx, &cluster)
+	default:
+		err = common.NewApiError(http.StatusBadRequest, errors.Errorf("invalid boot artifact %s", params.Artifact))
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to download the %s of cluster %s", params.Artifact, params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	return filemiddleware.NewResponder(installer.NewDownloadClusterBootArtifactOK().WithPayload(reader), fileName, contentLength)
+}
+
+// checkImageForDownload returns an error if the image of the cluster is being built or its build failed
+func (b *bareMetalInventory) checkImageForDownload(cluster *common.Cluster) error {
+	switch {
+	case b.isImageBuildInProgress(cluster, time.Now()):
+		return common.NewApiError(http.StatusConflict, errors.New("The image is being generated - please wait "+
+			"until its build status is uploaded and try again"))
+	case cluster.ImageInfo.BuildStatus == models.ImageInfoBuildStatusFailed:
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("The image generation failed (%s) - "+
+			"please generate the image and try again", cluster.ImageInfo.BuildStatusInfo))
+	}
+	return nil
+}
+
+// verifyImageObject returns a not found error if an object of the image of the cluster expired
+func (b *bareMetalInventory) verifyImageObject(ctx context.Context, cluster *common.Cluster, objectName, artifact string) error {
+	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		msg := fmt.Sprintf("The %s was not found (perhaps it expired) - please generate the image and try again", artifact)
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError,
+			fmt.Sprintf("Failed to download %s: %s", artifact, msg), time.Now())
+		return common.NewApiError(http.StatusNotFound, errors.New(msg))
+	}
+	return nil
+}
+
+// downloadImageObject downloads an object of the image of the cluster, a not found error is returned if the object
+// expired
+func (b *bareMetalInventory) downloadImageObject(ctx context.Context, cluster *common.Cluster, objectName, artifact string) ([]byte, error) {
+	if err := b.verifyImageObject(ctx, cluster, objectName, artifact); err != nil {
+		return nil, err
+	}
+	return b.downloadObject(ctx, objectName)
+}
+
+// downloadIPXEScript formats the iPXE script of the cluster with a new token, the object of the script only expires it
+func (b *bareMetalInventory) downloadIPXEScript(ctx context.Context, cluster *common.Cluster) (io.ReadCloser, int64, error) {
+	if err := b.verifyImageObject(ctx, cluster, getIPXEScriptName(*cluster.ID), "iPXE script"); err != nil {
+		return nil, 0, err
+	}
+	token, err := b.createURLToken(cluster)
+	if err != nil {
+		return nil, 0, err
+	}
+	script := b.formatIPXEScript(cluster, token)
+	return ioutil.NopCloser(strings.NewReader(script)), int64(len(script)), nil
+}
+
+func (b *bareMetalInventory) downloadBaseObject(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	reader, contentLength, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to download the base object %s", objectName)
+	}
+	return reader, contentLength, nil
+}
+
+func (b *bareMetalInventory) downloadInitrd(ctx context.Context, cluster *common.Cluster, rhcosImage string) (io.ReadCloser, int64, error) {
+	ignitionConfig, err := b.downloadImageObject(ctx, cluster, getInitrdIgnitionName(*cluster.ID), "initrd")
+	if err != nil {
+		return nil, 0, err
+	}
+	initrd, initrdLength, err := b.downloadBaseObject(ctx, getBaseInitrdName(rhcosImage))
+	if err != nil {
+		return nil, 0, err
+	}
+	reader, contentLength, err := isoeditor.NewInitrdReader(initrd, initrdLength, ignitionConfig)
+	if err != nil {
+		initrd.Close()
+		return nil, 0, err
+	}
+	return reader, contentLength, nil
+}
+
+func (b *bareMetalInventory) downloadMinimalISO(ctx context.Context, cluster *common.Cluster) (io.ReadCloser, int64, error) {
+	rhcosMinimalImage, err := b.versionsApi.GetRHCOSMinimalImage(cluster.OpenshiftVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+	if rhcosMinimalImage == "" {
+		return nil, 0, common.NewApiError(http.StatusNotFound, errors.Errorf(
+			"The minimal image is not available for OpenShift version %s", cluster.OpenshiftVersion))
+	}
+	ignitionConfig, err := b.downloadImageObject(ctx, cluster, getMinimalImageIgnitionName(*cluster.ID), "minimal image")
+	if err != nil {
+		return nil, 0, err
+	}
+	kargsAreas, err := b.downloadKargsAreas(ctx, getBaseMinimalKargsName(rhcosMinimalImage))
+	if err != nil {
+		return nil, 0, err
+	}
+	baseISO, contentLength, err := b.downloadBaseObject(ctx, getBaseMinimalISOName(rhcosMinimalImage))
+	if err != nil {
+		return nil, 0, err
+	}
+	reader, err := isoeditor.NewEmbedKargsReader(baseISO, ignitionConfig, kargsAreas, b.formatRootfsKarg(cluster.OpenshiftVersion))
+	if err != nil {
+		baseISO.Close()
+		return nil, 0, err
+	}
+	return reader, contentLength, nil
+}
+
+// downloadKargsAreas downloads the kargs areas of a base minimal ISO
+func (b *bareMetalInventory) downloadKargsAreas(ctx context.Context, objectName string) (*isoeditor.KargsAreas, error) {
+	data, err := b.downloadObject(ctx, objectName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download the base object %s", objectName)
+	}
+	var kargsAreas isoeditor.KargsAreas
+	if err = json.Unmarshal(data, &kargsAreas); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the base object %s", objectName)
+	}
+	return &kargsAreas, nil
+}
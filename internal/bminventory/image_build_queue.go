@@ -0,0 +1,179 @@
+package bminventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
+)
+
+/*
+The image builds are queued in the clusters table, a queued build is a cluster with the image build status queued.
+Every replica of the service claims queued builds as long as it has free build slots, the claims of all the replicas
+are serialized by a database lock so that the limit of concurrent builds of an organization holds across replicas.
+Among the queued builds the replica claims the builds of the organizations with the fewest running builds first, the
+oldest build first within an organization. Running builds that did not finish within the build timeout are lost (e.g.
+the replica that ran the build restarted), they are queued again before the queued builds are claimed.
+*/
+
+// imageBuildQueueLockID is the key of the advisory lock that serializes the claims of queued image builds
+const imageBuildQueueLockID = 5263819047
+
+type orgImageBuilds struct {
+	OrgID string
+	Count int
+}
+
+// ImageBuildQueueMonitoring claims queued image builds for the free build slots of this replica, it runs periodically
+// in every replica of the service
+func (b *bareMetalInventory) ImageBuildQueueMonitoring() {
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	log := logutil.FromContext(ctx, b.log)
+
+	var depth int
+	if err := b.db.Model(&common.Cluster{}).Where("image_build_status = ?", models.ImageInfoBuildStatusQueued).
+		Count(&depth).Error; err != nil {
+		log.WithError(err).Error("failed to count the queued image builds")
+	} else {
+		b.metricApi.ImageBuildQueueDepth(depth)
+	}
+
+	b.processImageBuildQueue(ctx)
+}
+
+// processImageBuildQueue claims queued image builds for the free build slots of this replica and starts them, a replica
+// runs at most MaxImageBuilds builds and an organization at most MaxImageBuildsPerOrg builds (no limit if 0) across
+// the replicas
+func (b *bareMetalInventory) processImageBuildQueue(ctx context.Context) {
+	log := logutil.FromContext(ctx, b.log)
+
+	b.imageBuildsLock.Lock()
+	defer b.imageBuildsLock.Unlock()
+
+	freeSlots := b.MaxImageBuilds - b.imageBuildsRunning
+	if freeSlots <= 0 {
+		return
+	}
+	clusters, err := b.claimImageBuilds(freeSlots, time.Now())
+	if err != nil {
+		log.WithError(err).Error("failed to claim queued image builds")
+		return
+	}
+	for _, cluster := range clusters {
+		b.metricApi.ImageBuildStarted(cluster.ImageInfo.CreatedAt)
+		b.imageBuildsRunning++
+		go func(cluster common.Cluster) {
+			buildCtx := requestid.ToContext(context.Background(), requestid.NewID())
+			b.buildClusterISO(buildCtx, cluster)
+
+			b.imageBuildsLock.Lock()
+			b.imageBuildsRunning--
+			b.imageBuildsLock.Unlock()
+			b.processImageBuildQueue(buildCtx)
+		}(*cluster)
+	}
+}
+
+// claimImageBuilds marks up to limit queued image builds as running and returns their clusters
+func (b *bareMetalInventory) claimImageBuilds(limit int, now time.Time) ([]*common.Cluster, error) {
+	txSuccess := false
+	tx := b.db.Begin()
+	defer func() {
+		if !txSuccess {
+			tx.Rollback()
+		}
+	}()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	// the lock is released when the transaction ends
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", imageBuildQueueLockID).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to lock the image build queue")
+	}
+
+	if err := tx.Model(&common.Cluster{}).
+		Where("image_build_status = ? and image_build_started_at <= ?", models.ImageInfoBuildStatusRunning, now.Add(-b.ImageBuildTimeout)).
+		Update("image_build_status", models.ImageInfoBuildStatusQueued).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to queue the lost image builds again")
+	}
+
+	var running []orgImageBuilds
+	if err := tx.Model(&common.Cluster{}).Select("org_id, count(*) as count").
+		Where("image_build_status = ?", models.ImageInfoBuildStatusRunning).
+		Group("org_id").Scan(&running).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to count the running image builds")
+	}
+	runningPerOrg := make(map[string]int, len(running))
+	for _, r := range running {
+		runningPerOrg[r.OrgID] = r.Count
+	}
+
+	// clusters that are locked by a request that updates their image are skipped
+	var queued []*common.Cluster
+	if err := tx.Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").Select("id, org_id, image_created_at").
+		Where("image_build_status = ?", models.ImageInfoBuildStatusQueued).Order("image_created_at").
+		Find(&queued).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to get the queued image builds")
+	}
+
+	selected := selectImageBuilds(queued, runningPerOrg, limit, b.MaxImageBuildsPerOrg)
+	if len(selected) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(selected))
+	for _, cluster := range selected {
+		ids = append(ids, cluster.ID.String())
+	}
+
+	var clusters []*common.Cluster
+	if err := tx.Where("id in (?)", ids).Find(&clusters).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to get the clusters of the claimed image builds")
+	}
+	if err := tx.Model(&common.Cluster{}).Where("id in (?)", ids).Updates(map[string]interface{}{
+		"image_build_status":     models.ImageInfoBuildStatusRunning,
+		"image_build_started_at": now,
+	}).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to update the claimed image builds")
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, errors.Wrap(err, "failed to commit the claimed image builds")
+	}
+	txSuccess = true
+
+	for _, cluster := range clusters {
+		cluster.ImageInfo.BuildStatus = models.ImageInfoBuildStatusRunning
+		cluster.ImageBuildStartedAt = now
+	}
+	return clusters, nil
+}
+
+// selectImageBuilds selects up to limit of the queued builds, which are ordered by their age, each time it selects the
+// oldest build of the organization with the fewest running builds, organizations that reached the limit of concurrent
+// builds are skipped (no limit if perOrgLimit is 0), runningPerOrg is updated with the selected builds
+func selectImageBuilds(queued []*common.Cluster, runningPerOrg map[string]int, limit, perOrgLimit int) []*common.Cluster {
+	var selected []*common.Cluster
+	taken := make([]bool, len(queued))
+	for len(selected) < limit {
+		next := -1
+		for i, cluster := range queued {
+			if taken[i] || (perOrgLimit > 0 && runningPerOrg[cluster.OrgID] >= perOrgLimit) {
+				continue
+			}
+			if next == -1 || runningPerOrg[cluster.OrgID] < runningPerOrg[queued[next].OrgID] {
+				next = i
+			}
+		}
+		if next == -1 {
+			break
+		}
+		taken[next] = true
+		runningPerOrg[queued[next].OrgID]++
+		selected = append(selected, queued[next])
+	}
+	return selected
+}
@@ -9,6 +9,7 @@ import (
 	// #nosec
 	"crypto/md5"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -16,16 +17,15 @@ import (
 	"net/http"
 	"net/url"
 	"path/filepath"
-	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
 	"github.com/openshift/assisted-service/internal/identity"
 
-	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -34,15 +34,23 @@ import (
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/domains"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/registries"
+	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/dnsprovider"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	"github.com/openshift/assisted-service/pkg/generator"
+	"github.com/openshift/assisted-service/pkg/isoeditor"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
@@ -69,6 +77,8 @@ var (
 	DefaultClusterNetworkCidr       = "10.128.0.0/14"
 	DefaultClusterNetworkHostPrefix = int64(23)
 	DefaultServiceNetworkCidr       = "172.30.0.0/16"
+	// DefaultIPv6ClusterNetworkHostPrefix is the host prefix of IPv6 cluster networks that are set without one
+	DefaultIPv6ClusterNetworkHostPrefix = int64(64)
 )
 
 type Config struct {
@@ -79,12 +89,19 @@ type Config struct {
 	S3EndpointURL        string            `envconfig:"S3_ENDPOINT_URL" default:"http://10.35.59.36:30925"`
 	S3Bucket             string            `envconfig:"S3_BUCKET" default:"test"`
 	ImageExpirationTime  time.Duration     `envconfig:"IMAGE_EXPIRATION_TIME" default:"4h"`
+	ImageBuildTimeout    time.Duration     `envconfig:"IMAGE_BUILD_TIMEOUT" default:"30m"`
+	MaxImageBuilds       int               `envconfig:"MAX_IMAGE_BUILDS" default:"5"`
+	MaxImageBuildsPerOrg int               `envconfig:"MAX_IMAGE_BUILDS_PER_ORG" default:"2"`
 	AwsAccessKeyID       string            `envconfig:"AWS_ACCESS_KEY_ID" default:"accessKey1"`
 	AwsSecretAccessKey   string            `envconfig:"AWS_SECRET_ACCESS_KEY" default:"verySecretKey1"`
 	BaseDNSDomains       map[string]string `envconfig:"BASE_DNS_DOMAINS" default:""`
 	SkipCertVerification bool              `envconfig:"SKIP_CERT_VERIFICATION" default:"false"`
 	InstallRHCa          bool              `envconfig:"INSTALL_RH_CA" default:"false"`
 	RhQaRegCred          string            `envconfig:"REGISTRY_CREDS" default:""`
+	DNSProviderConfig    dnsprovider.Config
+	// BmcCredentialsKey is the base64 encoded AES-256 key that the BMC passwords of hosts are encrypted with, BMC
+	// credentials can not be set when it is not configured
+	BmcCredentialsKey string `envconfig:"BMC_CREDENTIALS_KEY" default:""`
 }
 
 const agentMessageOfTheDay = `
@@ -167,10 +184,32 @@ const ignitionConfigFormat = `{
 	      "name": "root"
 	  },
 	  "contents": { "source": "data:,{{.RH_ROOT_CA}}" }
-	}{{end}}]
+	}{{end}}{{if .REGISTRIES_CONF}},
+	{
+	  "overwrite": true,
+	  "path": "{{.REGISTRIES_CONF_PATH}}",
+	  "mode": 420,
+	  "user": {
+	      "name": "root"
+	  },
+	  "contents": { "source": "data:,{{.REGISTRIES_CONF}}" }
+	}{{end}}{{if .ADDITIONAL_TRUST_BUNDLE}},
+	{
+	  "overwrite": true,
+	  "path": "/etc/pki/ca-trust/source/anchors/assisted-additional-ca.crt",
+	  "mode": 420,
+	  "user": {
+	      "name": "root"
+	  },
+	  "contents": { "source": "data:,{{.ADDITIONAL_TRUST_BUNDLE}}" }
+	}{{end}}{{.STATIC_NETWORK_CONFIG}}]
   }
 }`
 
+// the machine config server serves the worker config over HTTP as well, the service does not know the root CA of the
+// existing cluster that signs the certificate of its HTTPS port
+const day2WorkerIgnitionFormat = `{"ignition":{"version":"3.1.0","config":{"merge":[{"source":"http://%s:22624/config/worker"}]}}}`
+
 var clusterFileNames = []string{
 	"kubeconfig",
 	"bootstrap.ign",
@@ -191,8 +230,13 @@ type bareMetalInventory struct {
 	eventsHandler events.Handler
 	objectHandler s3wrapper.API
 	metricApi     metrics.API
-	generator     generator.ISOInstallConfigGenerator
+	generator     generator.InstallConfigGenerator
 	authHandler   auth.AuthHandler
+	versionsApi   versions.Handler
+	hwValidator   hardware.Validator
+
+	imageBuildsLock    sync.Mutex
+	imageBuildsRunning int
 }
 
 var _ restapi.InstallerAPI = &bareMetalInventory{}
@@ -203,11 +247,13 @@ func NewBareMetalInventory(
 	hostApi host.API,
 	clusterApi cluster.API,
 	cfg Config,
-	generator generator.ISOInstallConfigGenerator,
+	generator generator.InstallConfigGenerator,
 	eventsHandler events.Handler,
 	objectHandler s3wrapper.API,
 	metricApi metrics.API,
 	authHandler auth.AuthHandler,
+	versionsApi versions.Handler,
+	hwValidator hardware.Validator,
 ) *bareMetalInventory {
 	return &bareMetalInventory{
 		db:            db,
@@ -220,6 +266,8 @@ func NewBareMetalInventory(
 		objectHandler: objectHandler,
 		metricApi:     metricApi,
 		authHandler:   authHandler,
+		versionsApi:   versionsApi,
+		hwValidator:   hwValidator,
 	}
 }
 
@@ -252,20 +300,36 @@ func (b *bareMetalInventory) formatIgnitionFile(cluster *common.Cluster, params
 	if b.Config.InstallRHCa {
 		rhCa = url.PathEscape(redhatRootCA)
 	}
+	staticNetworkConfig, err := staticNetworkConfigForIgnition(params.ImageCreateParams.StaticNetworkConfig)
+	if err != nil {
+		return "", err
+	}
+	registriesConf, err := registriesConfForIgnition(cluster.ImageContentSources)
+	if err != nil {
+		return "", err
+	}
+	additionalTrustBundle := ""
+	if cluster.AdditionalTrustBundle != "" {
+		additionalTrustBundle = url.PathEscape(cluster.AdditionalTrustBundle)
+	}
 	var ignitionParams = map[string]string{
-		"userSshKey":           b.getUserSshKey(params),
-		"AgentDockerImg":       b.AgentDockerImg,
-		"ServiceBaseURL":       strings.TrimSpace(b.ServiceBaseURL),
-		"clusterId":            cluster.ID.String(),
-		"PullSecretToken":      r.AuthRaw,
-		"AGENT_MOTD":           url.PathEscape(agentMessageOfTheDay),
-		"PULL_SECRET":          url.PathEscape(cluster.PullSecret),
-		"RH_ROOT_CA":           rhCa,
-		"PROXY_SETTINGS":       proxySettings,
-		"HTTPProxy":            cluster.HTTPProxy,
-		"HTTPSProxy":           cluster.HTTPSProxy,
-		"NoProxy":              cluster.NoProxy,
-		"SkipCertVerification": strconv.FormatBool(b.SkipCertVerification),
+		"userSshKey":              b.getUserSshKey(params),
+		"AgentDockerImg":          b.AgentDockerImg,
+		"ServiceBaseURL":          strings.TrimSpace(b.ServiceBaseURL),
+		"clusterId":               cluster.ID.String(),
+		"PullSecretToken":         r.AuthRaw,
+		"AGENT_MOTD":              url.PathEscape(agentMessageOfTheDay),
+		"PULL_SECRET":             url.PathEscape(cluster.PullSecret),
+		"RH_ROOT_CA":              rhCa,
+		"REGISTRIES_CONF":         registriesConf,
+		"REGISTRIES_CONF_PATH":    registries.RegistriesConfPath,
+		"ADDITIONAL_TRUST_BUNDLE": additionalTrustBundle,
+		"STATIC_NETWORK_CONFIG":   staticNetworkConfig,
+		"PROXY_SETTINGS":          proxySettings,
+		"HTTPProxy":               cluster.HTTPProxy,
+		"HTTPSProxy":              cluster.HTTPSProxy,
+		"NoProxy":                 cluster.NoProxy,
+		"SkipCertVerification":    strconv.FormatBool(b.SkipCertVerification),
 	}
 	tmpl, err := template.New("ignitionConfig").Parse(ignitionConfigFormat)
 	if err != nil {
@@ -278,6 +342,47 @@ func (b *bareMetalInventory) formatIgnitionFile(cluster *common.Cluster, params
 	return buf.String(), nil
 }
 
+// registriesConfForIgnition returns the escaped content of the registries.conf drop-in of the discovery image, it
+// redirects the pulls of the hosts to the mirror registries of the cluster. An empty string is returned when the
+// cluster has no mirrors
+func registriesConfForIgnition(imageContentSources string) (string, error) {
+	sources, err := registries.ParseImageContentSources(imageContentSources)
+	if err != nil {
+		return "", err
+	}
+	if len(sources) == 0 {
+		return "", nil
+	}
+	return url.PathEscape(registries.GenerateRegistriesConf(sources)), nil
+}
+
+// staticNetworkConfigForIgnition returns the ignition storage files of the NetworkManager keyfiles of the static
+// network configurations, NetworkManager applies them when the hosts boot from the discovery image
+func staticNetworkConfigForIgnition(configs []*models.HostStaticNetworkConfig) (string, error) {
+	files, err := network.GenerateNMConnectionFiles(configs)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, f := range files {
+		path, err := json.Marshal(f.Path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, `,
+	{
+	  "overwrite": true,
+	  "path": %s,
+	  "mode": 384,
+	  "user": {
+	      "name": "root"
+	  },
+	  "contents": { "source": "data:,%s" }
+	}`, path, url.PathEscape(f.Contents))
+	}
+	return b.String(), nil
+}
+
 func (b *bareMetalInventory) getUserSshKey(params installer.GenerateClusterISOParams) string {
 	sshKey := params.ImageCreateParams.SSHPublicKey
 	if sshKey == "" {
@@ -297,12 +402,21 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 	url := installer.GetClusterURL{ClusterID: id}
 	log.Infof("Register cluster: %s with id %s", swag.StringValue(params.NewClusterParams.Name), id)
 
+	if err := b.validateOpenshiftVersion(swag.StringValue(params.NewClusterParams.OpenshiftVersion)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	if err := validateProxySettings(params.NewClusterParams.HTTPProxy,
 		params.NewClusterParams.HTTPSProxy,
 		params.NewClusterParams.NoProxy); err != nil {
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if err := validateDisconnectedSettings(&params.NewClusterParams.ImageContentSources,
+		&params.NewClusterParams.AdditionalTrustBundle); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	if params.NewClusterParams.ClusterNetworkCidr == nil {
 		params.NewClusterParams.ClusterNetworkCidr = &DefaultClusterNetworkCidr
 	}
@@ -312,9 +426,18 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 	if params.NewClusterParams.ServiceNetworkCidr == nil {
 		params.NewClusterParams.ServiceNetworkCidr = &DefaultServiceNetworkCidr
 	}
+	if params.NewClusterParams.SecondaryClusterNetworkCidr != "" && params.NewClusterParams.SecondaryClusterNetworkHostPrefix == 0 {
+		params.NewClusterParams.SecondaryClusterNetworkHostPrefix = DefaultIPv6ClusterNetworkHostPrefix
+	}
 	if params.NewClusterParams.VipDhcpAllocation == nil {
 		params.NewClusterParams.VipDhcpAllocation = swag.Bool(false)
 	}
+	if params.NewClusterParams.UserManagedNetworking == nil {
+		params.NewClusterParams.UserManagedNetworking = swag.Bool(false)
+	}
+	if params.NewClusterParams.HighAvailabilityMode == nil {
+		params.NewClusterParams.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeFull)
+	}
 
 	cluster := common.Cluster{Cluster: models.Cluster{
 		ID:                       &id,
@@ -334,16 +457,30 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 		HTTPProxy:                swag.StringValue(params.NewClusterParams.HTTPProxy),
 		HTTPSProxy:               swag.StringValue(params.NewClusterParams.HTTPSProxy),
 		NoProxy:                  swag.StringValue(params.NewClusterParams.NoProxy),
+		ImageContentSources:      params.NewClusterParams.ImageContentSources,
+		AdditionalTrustBundle:    params.NewClusterParams.AdditionalTrustBundle,
 		VipDhcpAllocation:        params.NewClusterParams.VipDhcpAllocation,
+		HighAvailabilityMode:     params.NewClusterParams.HighAvailabilityMode,
+		UserManagedNetworking:    params.NewClusterParams.UserManagedNetworking,
+
+		SecondaryMachineNetworkCidr:       params.NewClusterParams.SecondaryMachineNetworkCidr,
+		SecondaryClusterNetworkCidr:       params.NewClusterParams.SecondaryClusterNetworkCidr,
+		SecondaryClusterNetworkHostPrefix: params.NewClusterParams.SecondaryClusterNetworkHostPrefix,
+		SecondaryServiceNetworkCidr:       params.NewClusterParams.SecondaryServiceNetworkCidr,
+		NetworkType:                       params.NewClusterParams.NetworkType,
+		AutoAssignVips:                    params.NewClusterParams.AutoAssignVips,
+		MachineNetworkDhcpRange:           params.NewClusterParams.MachineNetworkDhcpRange,
 	}}
 
-	if proxyHash, err := computeClusterProxyHash(params.NewClusterParams.HTTPProxy,
+	if imageSettingsHash, err := computeImageSettingsHash(params.NewClusterParams.HTTPProxy,
 		params.NewClusterParams.HTTPSProxy,
-		params.NewClusterParams.NoProxy); err != nil {
-		log.Error("Failed to compute cluster proxy hash", err)
+		params.NewClusterParams.NoProxy,
+		&params.NewClusterParams.ImageContentSources,
+		&params.NewClusterParams.AdditionalTrustBundle); err != nil {
+		log.Error("Failed to compute cluster image settings hash", err)
 		return installer.NewGenerateClusterISOInternalServerError()
 	} else {
-		cluster.ProxyHash = proxyHash
+		cluster.ProxyHash = imageSettingsHash
 	}
 
 	if params.NewClusterParams.PullSecret != "" {
@@ -370,6 +507,30 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 		}
 	}
 
+	if err := network.VerifyDualStackNetworks("", cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr,
+		cluster.SecondaryMachineNetworkCidr, cluster.SecondaryClusterNetworkCidr, cluster.SecondaryServiceNetworkCidr); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err := network.VerifyNetworkType(&cluster, b.versionsApi); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err := verifyAutoAssignVips(cluster.AutoAssignVips, swag.BoolValue(cluster.VipDhcpAllocation),
+		cluster.MachineNetworkDhcpRange); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err := verifyUserManagedNetworking(common.IsUserManagedNetworking(&cluster), swag.BoolValue(cluster.VipDhcpAllocation),
+		cluster.AutoAssignVips); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if cluster.AutoAssignVips && cluster.IngressVip != "" {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.New("Setting Ingress VIP is forbidden when the VIPs are assigned automatically"))
+	}
+	if common.IsUserManagedNetworking(&cluster) && cluster.IngressVip != "" {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.New("Setting Ingress VIP is forbidden when the networking is managed by the user"))
+	}
+
 	err := b.clusterApi.RegisterCluster(ctx, &cluster)
 	if err != nil {
 		log.Errorf("failed to register cluster %s ", swag.StringValue(params.NewClusterParams.Name))
@@ -381,6 +542,55 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 	return installer.NewRegisterClusterCreated().WithPayload(&cluster.Cluster)
 }
 
+func (b *bareMetalInventory) RegisterAddHostsCluster(ctx context.Context, params installer.RegisterAddHostsClusterParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	id := params.NewAddHostsClusterParams.ID
+	url := installer.GetClusterURL{ClusterID: *id}
+	clusterName := swag.StringValue(params.NewAddHostsClusterParams.Name)
+	apivipDnsname := swag.StringValue(params.NewAddHostsClusterParams.APIVipDnsname)
+	openshiftVersion := swag.StringValue(params.NewAddHostsClusterParams.OpenshiftVersion)
+	log.Infof("Register add-hosts cluster: %s with id %s", clusterName, id)
+
+	if err := validations.ValidateClusterNameFormat(clusterName); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err := b.validateOpenshiftVersion(openshiftVersion); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	err := b.db.First(&common.Cluster{}, "id = ?", id.String()).Error
+	if err == nil {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("cluster %s already exists", id.String()))
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		log.WithError(err).Errorf("failed to get cluster %s", id.String())
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	cluster := common.Cluster{Cluster: models.Cluster{
+		ID:               id,
+		Href:             swag.String(url.String()),
+		Kind:             swag.String(models.ClusterKindAddHostsCluster),
+		Name:             clusterName,
+		OpenshiftVersion: openshiftVersion,
+		APIVipDnsname:    apivipDnsname,
+		UserName:         auth.UserNameFromContext(ctx),
+		OrgID:            auth.OrgIDFromContext(ctx),
+		UpdatedAt:        strfmt.DateTime{},
+	}}
+
+	if err = b.clusterApi.RegisterAddHostsCluster(ctx, &cluster); err != nil {
+		log.Errorf("failed to register add-hosts cluster %s ", clusterName)
+		return installer.NewRegisterAddHostsClusterInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	b.metricApi.ClusterRegistered(openshiftVersion)
+	return installer.NewRegisterAddHostsClusterCreated().WithPayload(&cluster.Cluster)
+}
+
 func (b *bareMetalInventory) DeregisterCluster(ctx context.Context, params installer.DeregisterClusterParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	var cluster common.Cluster
@@ -402,6 +612,10 @@ func (b *bareMetalInventory) DeregisterCluster(ctx context.Context, params insta
 			WithPayload(common.GenerateError(http.StatusNotFound, err))
 	}
 
+	if err = manifests.DeleteManifests(ctx, b.objectHandler, params.ClusterID.String()); err != nil {
+		log.WithError(err).Warnf("failed to delete the manifests of cluster %s", params.ClusterID)
+	}
+
 	return installer.NewDeregisterClusterNoContent()
 }
 
@@ -414,8 +628,26 @@ func (b *bareMetalInventory) DownloadClusterISO(ctx context.Context, params inst
 		return common.NewApiError(http.StatusNotFound, err)
 	}
 
-	imgName := getImageName(*cluster.ID)
-	exists, err := b.objectHandler.DoesObjectExist(ctx, imgName)
+	switch {
+	case b.isImageBuildInProgress(&cluster, time.Now()):
+		return installer.NewDownloadClusterISOConflict().
+			WithPayload(common.GenerateError(http.StatusConflict, errors.New("The image is being generated - please wait "+
+				"until its build status is uploaded and try again")))
+	case cluster.ImageInfo.BuildStatus == models.ImageInfoBuildStatusFailed:
+		return installer.NewDownloadClusterISONotFound().
+			WithPayload(common.GenerateError(http.StatusNotFound, errors.Errorf("The image generation failed (%s) - "+
+				"please generate the image and try again", cluster.ImageInfo.BuildStatusInfo)))
+	}
+
+	rhcosImage, err := b.versionsApi.GetRHCOSImage(cluster.OpenshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the RHCOS image of cluster %s", cluster.ID)
+		return installer.NewDownloadClusterISOInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	ignitionName := getImageIgnitionName(*cluster.ID)
+	exists, err := b.objectHandler.DoesObjectExist(ctx, ignitionName)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
 		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
@@ -430,7 +662,15 @@ func (b *bareMetalInventory) DownloadClusterISO(ctx context.Context, params inst
 			WithPayload(common.GenerateError(http.StatusNotFound, errors.New("The image was not found "+
 				"(perhaps it expired) - please generate the image and try again")))
 	}
-	reader, contentLength, err := b.objectHandler.Download(ctx, imgName)
+	ignitionConfig, err := b.downloadObject(ctx, ignitionName)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
+			"Failed to download image: error fetching from storage backend", time.Now())
+		return installer.NewDownloadClusterISOInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	baseISO, contentLength, err := b.objectHandler.Download(ctx, getBaseISOName(rhcosImage))
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
 		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
@@ -438,6 +678,16 @@ func (b *bareMetalInventory) DownloadClusterISO(ctx context.Context, params inst
 		return installer.NewDownloadClusterISOInternalServerError().
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
+	// the ignition config is written into the base ISO while the ISO is streamed, the image itself is not stored
+	reader, err := isoeditor.NewEmbedReader(baseISO, ignitionConfig)
+	if err != nil {
+		baseISO.Close()
+		log.WithError(err).Errorf("Failed to embed the ignition config into the ISO of cluster %s", cluster.ID.String())
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
+			"Failed to download image: error embedding the ignition config", time.Now())
+		return installer.NewDownloadClusterISOInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
 	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Started image download", time.Now())
 
 	return filemiddleware.NewResponder(installer.NewDownloadClusterISOOK().WithPayload(reader),
@@ -445,32 +695,30 @@ func (b *bareMetalInventory) DownloadClusterISO(ctx context.Context, params inst
 		contentLength)
 }
 
-func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, cluster *common.Cluster, clusterProxyHash string) error {
+func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, db *gorm.DB, cluster *common.Cluster, imageSettingsHash string) error {
 	updates := map[string]interface{}{}
-	imgName := getImageName(*cluster.ID)
-	imgSize, err := b.objectHandler.GetObjectSizeBytes(ctx, imgName)
+	rhcosImage, err := b.versionsApi.GetRHCOSImage(cluster.OpenshiftVersion)
+	if err != nil {
+		return errors.New("Failed to generate image: the OpenShift version of the cluster is not supported")
+	}
+	// the image is streamed from the base ISO, so it has the size of the base ISO and no URL of the storage backend
+	imgSize, err := b.objectHandler.GetObjectSizeBytes(ctx, getBaseISOName(rhcosImage))
 	if err != nil {
 		return errors.New("Failed to generate image: error fetching size")
 	}
 	updates["image_size_bytes"] = imgSize
 	cluster.ImageInfo.SizeBytes = &imgSize
 
-	// Presigned URL only works with AWS S3 because Scality is not exposed
-	if b.objectHandler.IsAwsS3() {
-		signedURL, err := b.objectHandler.GeneratePresignedDownloadURL(ctx, imgName, b.Config.ImageExpirationTime)
-		if err != nil {
-			return errors.New("Failed to generate image: error generating URL")
-		}
-		updates["image_download_url"] = signedURL
-		cluster.ImageInfo.DownloadURL = signedURL
-	}
-
-	if cluster.ProxyHash != clusterProxyHash {
-		updates["proxy_hash"] = clusterProxyHash
-		cluster.ProxyHash = clusterProxyHash
+	if cluster.ProxyHash != imageSettingsHash {
+		updates["proxy_hash"] = imageSettingsHash
+		cluster.ProxyHash = imageSettingsHash
 	}
+	updates["image_build_status"] = models.ImageInfoBuildStatusUploaded
+	cluster.ImageInfo.BuildStatus = models.ImageInfoBuildStatusUploaded
 
-	dbReply := b.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(updates)
+	// a newer build of the image owns the image info
+	dbReply := db.Model(&common.Cluster{}).
+		Where("id = ? and image_created_at = ?", cluster.ID.String(), cluster.ImageInfo.CreatedAt).Updates(updates)
 	if dbReply.Error != nil {
 		return errors.New("Failed to generate image: error updating image record")
 	}
@@ -478,6 +726,9 @@ func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, clus
 	return nil
 }
 
+// GenerateClusterISO records the parameters of the image of the cluster and queues its build, the build runs in the
+// background and the build status of the image info of the cluster tracks it. Requests with the parameters of a build
+// that is queued or running share that build
 func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params installer.GenerateClusterISOParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("prepare image for cluster %s", params.ClusterID)
@@ -504,26 +755,13 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 			WithPayload(common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to start transaction")))
 	}
 
-	if err := tx.First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+	// concurrent requests of the cluster are serialized so that only one of them queues a build of the same image
+	if err := transaction.AddForUpdateQueryOption(tx).First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
 		return installer.NewGenerateClusterISONotFound().
 			WithPayload(common.GenerateError(http.StatusNotFound, err))
 	}
 
-	/* We need to ensure that the metadata in the DB matches the image that will be uploaded to S3,
-	so we check that at least 10 seconds have past since the previous request to reduce the chance
-	of a race between two consecutive requests.
-	*/
-	now := time.Now()
-	previousCreatedAt := time.Time(cluster.ImageInfo.CreatedAt)
-	if previousCreatedAt.Add(10 * time.Second).After(now) {
-		log.Error("request came too soon after previous request")
-		msg := "Failed to generate image: another request to generate an image has been recently submitted - please wait a few seconds and try again"
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, msg, time.Now())
-		return installer.NewGenerateClusterISOConflict().WithPayload(common.GenerateError(http.StatusConflict,
-			errors.New("Another request to generate an image has been recently submitted. Please wait a few seconds and try again.")))
-	}
-
 	if !cluster.PullSecretSet {
 		errMsg := "Can't generate cluster ISO without pull secret"
 		log.Error(errMsg)
@@ -531,22 +769,55 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 			WithPayload(common.GenerateError(http.StatusBadRequest, errors.New(errMsg)))
 	}
 
-	/* If the request has the same parameters as the previous request and the image is still in S3,
-	just refresh the timestamp.
-	*/
-	clusterProxyHash, err := computeClusterProxyHash(&cluster.HTTPProxy, &cluster.HTTPSProxy, &cluster.NoProxy)
+	imageSettingsHash, err := computeImageSettingsHash(&cluster.HTTPProxy, &cluster.HTTPSProxy, &cluster.NoProxy,
+		&cluster.ImageContentSources, &cluster.AdditionalTrustBundle)
 	if err != nil {
-		log.Error("Failed to compute cluster proxy hash", err)
+		log.Error("Failed to compute cluster image settings hash", err)
 		return installer.NewGenerateClusterISOInternalServerError()
 	}
 
-	var imageExists bool
-	if cluster.ImageInfo.SSHPublicKey == params.ImageCreateParams.SSHPublicKey &&
+	if err = network.ValidateStaticNetworkConfig(params.ImageCreateParams.StaticNetworkConfig); err != nil {
+		log.WithError(err).Errorf("invalid static network configuration for cluster %s", params.ClusterID)
+		return installer.NewGenerateClusterISOBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+	staticNetworkConfig, err := network.FormatStaticNetworkConfig(params.ImageCreateParams.StaticNetworkConfig)
+	if err != nil {
+		log.WithError(err).Errorf("failed to format the static network configuration for cluster %s", params.ClusterID)
+		return installer.NewGenerateClusterISOInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	if _, err = b.versionsApi.GetRHCOSImage(cluster.OpenshiftVersion); err != nil {
+		log.WithError(err).Errorf("failed to get the RHCOS image of cluster %s", cluster.ID)
+		msg := "Failed to generate image: the OpenShift version of the cluster is not supported"
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, msg, time.Now())
+		return installer.NewGenerateClusterISOBadRequest().WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+
+	now := time.Now()
+	// the proxy hash covers the proxy settings, the mirror registries and the trust bundle of the image
+	sameImage := cluster.ImageInfo.SSHPublicKey == params.ImageCreateParams.SSHPublicKey &&
+		cluster.ImageInfo.StaticNetworkConfig == staticNetworkConfig &&
 		cluster.ImageInfo.GeneratorVersion == b.Config.ImageBuilder &&
-		cluster.ProxyHash == clusterProxyHash {
-		var err error
-		imgName := getImageName(params.ClusterID)
-		imageExists, err = b.objectHandler.UpdateObjectTimestamp(ctx, imgName)
+		cluster.ProxyHash == imageSettingsHash
+
+	if sameImage && b.isImageBuildInProgress(&cluster, now) {
+		if err = tx.Commit().Error; err != nil {
+			log.WithError(err).Errorf("failed to commit the transaction of cluster %s", params.ClusterID)
+			return installer.NewGenerateClusterISOInternalServerError()
+		}
+		txSuccess = true
+		log.Infof("The image of cluster %s is already being built", params.ClusterID)
+		return installer.NewGenerateClusterISOAccepted().WithPayload(&cluster.Cluster)
+	}
+
+	/* If the request has the same parameters as the previous build and the image is still in S3,
+	just refresh the timestamp.
+	*/
+	var imageExists bool
+	if sameImage && (cluster.ImageInfo.BuildStatus == models.ImageInfoBuildStatusUploaded || cluster.ImageInfo.BuildStatus == "") {
+		imageExists, err = b.refreshImageObjects(ctx, &cluster)
 		if err != nil {
 			log.WithError(err).Errorf("failed to contact storage backend")
 			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
@@ -556,12 +827,31 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 		}
 	}
 
+	// the ignition config has the pull secret, so the build renders it again and only its parameters are stored
+	if !imageExists {
+		if _, err = b.formatIgnitionFile(&cluster, params); err != nil {
+			log.WithError(err).Errorf("failed to format ignition config file for cluster %s", cluster.ID)
+			msg := "Failed to generate image: error formatting ignition file"
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, msg, time.Now())
+			return installer.NewGenerateClusterISOInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+	}
+
 	updates := map[string]interface{}{}
 	updates["image_ssh_public_key"] = params.ImageCreateParams.SSHPublicKey
+	updates["image_static_network_config"] = staticNetworkConfig
 	updates["image_created_at"] = strfmt.DateTime(now)
 	updates["image_expires_at"] = strfmt.DateTime(now.Add(b.Config.ImageExpirationTime))
 	updates["image_generator_version"] = b.Config.ImageBuilder
 	updates["image_download_url"] = ""
+	updates["image_build_status_info"] = ""
+	updates["proxy_hash"] = imageSettingsHash
+	if imageExists {
+		updates["image_build_status"] = models.ImageInfoBuildStatusUploaded
+	} else {
+		updates["image_build_status"] = models.ImageInfoBuildStatusQueued
+	}
 	dbReply := tx.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(updates)
 	if dbReply.Error != nil {
 		log.WithError(dbReply.Error).Errorf("failed to update cluster: %s", params.ClusterID)
@@ -570,14 +860,14 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 		return installer.NewGenerateClusterISOInternalServerError()
 	}
 
-	if err := tx.Commit().Error; err != nil {
+	if err = tx.Commit().Error; err != nil {
 		log.Error(err)
 		msg := "Failed to generate image: error committing the transaction"
 		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, msg, time.Now())
 		return installer.NewGenerateClusterISOInternalServerError()
 	}
 	txSuccess = true
-	if err := b.db.Preload("Hosts").First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+	if err = b.db.Preload("Hosts").First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to get cluster %s after update", params.ClusterID)
 		msg := "Failed to generate image: error fetching updated cluster metadata"
 		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, msg, time.Now())
@@ -586,52 +876,229 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 	}
 
 	if imageExists {
-		if err := b.updateImageInfoPostUpload(ctx, &cluster, clusterProxyHash); err != nil {
+		if err = b.updateImageInfoPostUpload(ctx, b.db, &cluster, imageSettingsHash); err != nil {
 			return installer.NewGenerateClusterISOInternalServerError().
 				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 		}
 
 		log.Infof("Re-used existing cluster <%s> image", params.ClusterID)
 		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Re-used existing image rather than generating a new one", time.Now())
-		return installer.NewGenerateClusterISOCreated().WithPayload(&cluster.Cluster)
+		return installer.NewGenerateClusterISOAccepted().WithPayload(&cluster.Cluster)
 	}
-	ignitionConfig, formatErr := b.formatIgnitionFile(&cluster, params)
-	if formatErr != nil {
-		log.WithError(formatErr).Errorf("failed to format ignition config file for cluster %s", cluster.ID)
-		msg := "Failed to generate image: error formatting ignition file"
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, msg, time.Now())
-		return installer.NewGenerateClusterISOInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, formatErr))
+
+	// the build is queued, start it now if this replica has a free build slot
+	go b.processImageBuildQueue(requestid.ToContext(context.Background(), requestid.FromContext(ctx)))
+
+	return installer.NewGenerateClusterISOAccepted().WithPayload(&cluster.Cluster)
+}
+
+// isImageBuildInProgress returns true if a build of the image of the cluster is queued or running, running builds that
+// did not finish within the build timeout are considered lost (e.g. the replica that ran the build restarted)
+func (b *bareMetalInventory) isImageBuildInProgress(cluster *common.Cluster, now time.Time) bool {
+	switch cluster.ImageInfo.BuildStatus {
+	case models.ImageInfoBuildStatusQueued:
+		return true
+	case models.ImageInfoBuildStatusRunning:
+		return cluster.ImageBuildStartedAt.Add(b.Config.ImageBuildTimeout).After(now)
+	default:
+		return false
+	}
+}
+
+// updateImageBuildStatus updates the build status of the image of the cluster, the build is identified by the creation
+// time of the image and no update is done if a newer build replaced it
+func (b *bareMetalInventory) updateImageBuildStatus(cluster *common.Cluster, status, statusInfo string) (bool, error) {
+	dbReply := b.db.Model(&common.Cluster{}).
+		Where("id = ? and image_created_at = ?", cluster.ID.String(), cluster.ImageInfo.CreatedAt).
+		Updates(map[string]interface{}{"image_build_status": status, "image_build_status_info": statusInfo})
+	if dbReply.Error != nil {
+		return false, dbReply.Error
+	}
+	if dbReply.RowsAffected == 0 {
+		return false, nil
 	}
+	cluster.ImageInfo.BuildStatus = status
+	cluster.ImageInfo.BuildStatusInfo = statusInfo
+	return true, nil
+}
 
-	jobName := fmt.Sprintf("createimage-%s-%s", cluster.ID, now.Format("20060102150405"))
-	imgName := getImageName(params.ClusterID)
+func (b *bareMetalInventory) failImageBuild(ctx context.Context, cluster *common.Cluster, msg string) {
+	log := logutil.FromContext(ctx, b.log)
+	b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
+	if _, err := b.updateImageBuildStatus(cluster, models.ImageInfoBuildStatusFailed, msg); err != nil {
+		log.WithError(err).Errorf("failed to update the image build status of cluster %s", cluster.ID)
+	}
+}
 
-	if err := b.generator.GenerateISO(ctx, cluster, jobName, imgName, ignitionConfig, b.eventsHandler); err != nil {
-		log.WithError(err).Errorf("GenerateISO failed for cluster %s", cluster.ID)
-		msg := "Failed to generate image: error in generator.GenerateISO"
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, msg, time.Now())
-		return installer.NewGenerateClusterISOInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+// formatImageIgnitionFile formats the ignition config of a build of the image of the cluster from the parameters of the
+// image that are kept with the image info
+func (b *bareMetalInventory) formatImageIgnitionFile(cluster *common.Cluster) (string, error) {
+	var staticNetworkConfig []*models.HostStaticNetworkConfig
+	if cluster.ImageInfo.StaticNetworkConfig != "" {
+		if err := json.Unmarshal([]byte(cluster.ImageInfo.StaticNetworkConfig), &staticNetworkConfig); err != nil {
+			return "", err
+		}
 	}
+	return b.formatIgnitionFile(cluster, installer.GenerateClusterISOParams{
+		ClusterID: *cluster.ID,
+		ImageCreateParams: &models.ImageCreateParams{
+			SSHPublicKey:        cluster.ImageInfo.SSHPublicKey,
+			StaticNetworkConfig: staticNetworkConfig,
+		},
+	})
+}
 
-	if err := b.updateImageInfoPostUpload(ctx, &cluster, clusterProxyHash); err != nil {
-		return installer.NewGenerateClusterISOInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+// buildClusterISO generates the image of a claimed build of the cluster, it formats the ignition config and verifies
+// that it can be embedded into the base ISOs, uploads the objects of the image and updates the image info of the
+// cluster unless a newer build of the image replaced this build
+func (b *bareMetalInventory) buildClusterISO(ctx context.Context, cluster common.Cluster) {
+	log := logutil.FromContext(ctx, b.log)
+
+	rhcosImage, err := b.versionsApi.GetRHCOSImage(cluster.OpenshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the RHCOS image of cluster %s", cluster.ID)
+		b.failImageBuild(ctx, &cluster, "Failed to generate image: the OpenShift version of the cluster is not supported")
+		return
+	}
+
+	ignitionConfig, err := b.formatImageIgnitionFile(&cluster)
+	if err != nil {
+		log.WithError(err).Errorf("failed to format ignition config file for cluster %s", cluster.ID)
+		b.failImageBuild(ctx, &cluster, "Failed to generate image: error formatting ignition file")
+		return
+	}
+
+	if err = b.verifyIgnitionFitsBaseISO(ctx, getBaseISOName(rhcosImage), ignitionConfig); err != nil {
+		log.WithError(err).Errorf("failed to verify the ignition config of cluster %s", cluster.ID)
+		b.failImageBuild(ctx, &cluster, "Failed to generate image: the ignition config cannot be embedded into the base ISO")
+		return
+	}
+
+	rhcosMinimalImage, err := b.versionsApi.GetRHCOSMinimalImage(cluster.OpenshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the RHCOS minimal image of cluster %s", cluster.ID)
+		b.failImageBuild(ctx, &cluster, "Failed to generate image: the OpenShift version of the cluster is not supported")
+		return
+	}
+	if rhcosMinimalImage != "" {
+		if err = b.verifyIgnitionFitsBaseISO(ctx, getBaseMinimalISOName(rhcosMinimalImage), ignitionConfig); err != nil {
+			log.WithError(err).Errorf("failed to verify the ignition config of cluster %s", cluster.ID)
+			b.failImageBuild(ctx, &cluster, "Failed to generate image: the ignition config cannot be embedded into the base minimal ISO")
+			return
+		}
+		if err = b.verifyRootfsKargFitsBaseISO(ctx, &cluster, getBaseMinimalKargsName(rhcosMinimalImage)); err != nil {
+			log.WithError(err).Errorf("failed to verify the rootfs URL of cluster %s", cluster.ID)
+			b.failImageBuild(ctx, &cluster, "Failed to generate image: the rootfs URL cannot be embedded into the base minimal ISO")
+			return
+		}
+	}
+
+	uploaded, err := b.uploadImageBuild(ctx, &cluster, ignitionConfig)
+	if err != nil {
+		log.WithError(err).Errorf("failed to upload the image of cluster %s", cluster.ID)
+		b.failImageBuild(ctx, &cluster, err.Error())
+		return
+	}
+	if !uploaded {
+		log.Infof("The image build of cluster %s was replaced by a newer build", cluster.ID)
+		return
 	}
 
-	log.Infof("Generated cluster <%s> image with ignition config %s", params.ClusterID, ignitionConfig)
+	log.Infof("Generated cluster <%s> image", cluster.ID)
 	msg := fmt.Sprintf("Generated image (proxy URL is \"%s\", ", cluster.HTTPProxy)
-	if params.ImageCreateParams.SSHPublicKey != "" {
+	if cluster.ImageInfo.SSHPublicKey != "" {
 		msg += "SSH public key is set)"
 	} else {
 		msg += "SSH public key is not set)"
 	}
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, msg, time.Now())
-	return installer.NewGenerateClusterISOCreated().WithPayload(&cluster.Cluster)
+	b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityInfo, msg, time.Now())
+}
+
+// uploadImageBuild uploads the objects of a build of the image of the cluster and updates the image info, it returns
+// false without uploading anything if a newer build replaced this build. The cluster stays locked until the image info
+// is updated, so a build that is replaced while it uploads never overwrites the objects of the newer build
+func (b *bareMetalInventory) uploadImageBuild(ctx context.Context, cluster *common.Cluster, ignitionConfig string) (bool, error) {
+	log := logutil.FromContext(ctx, b.log)
+	txSuccess := false
+	tx := b.db.Begin()
+	defer func() {
+		if !txSuccess {
+			tx.Rollback()
+		}
+	}()
+	if tx.Error != nil {
+		return false, errors.New("Failed to generate image: error starting DB transaction")
+	}
+
+	var current common.Cluster
+	if err := transaction.AddForUpdateQueryOption(tx).Select("id").
+		Where("id = ? and image_created_at = ?", cluster.ID.String(), cluster.ImageInfo.CreatedAt).
+		Take(&current).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.New("Failed to generate image: error locking the image record")
+	}
+
+	if err := b.uploadImageObjects(ctx, cluster, ignitionConfig); err != nil {
+		log.WithError(err).Errorf("failed to upload the image objects of cluster %s", cluster.ID)
+		return false, errors.New("Failed to generate image: error uploading the ignition config")
+	}
+	if err := b.updateImageInfoPostUpload(ctx, tx, cluster, cluster.ProxyHash); err != nil {
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, errors.New("Failed to generate image: error committing the image record")
+	}
+	txSuccess = true
+	return true, nil
+}
+
+// getImageIgnitionName returns the name of the object that stores the ignition config of the image of the cluster
+func getImageIgnitionName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("discovery-image-%s.ign", clusterID.String())
+}
+
+// verifyIgnitionFitsBaseISO verifies that the base ISO has an embed area that the ignition config fits in, only the
+// beginning of the base ISO is downloaded
+func (b *bareMetalInventory) verifyIgnitionFitsBaseISO(ctx context.Context, baseISOName, ignitionConfig string) error {
+	baseISO, _, err := b.objectHandler.Download(ctx, baseISOName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download the base ISO %s", baseISOName)
+	}
+	defer baseISO.Close()
+	area, err := isoeditor.ReadEmbedArea(baseISO)
+	if err != nil {
+		return err
+	}
+	archive, err := isoeditor.IgnitionArchive([]byte(ignitionConfig))
+	if err != nil {
+		return err
+	}
+	if int64(len(archive)) > area.Length {
+		return errors.Errorf("the ignition archive (%d bytes) does not fit the embed area of the base ISO (%d bytes)",
+			len(archive), area.Length)
+	}
+	return nil
+}
+
+// verifyRootfsKargFitsBaseISO verifies that the kernel argument of the rootfs URL of the OpenShift version of the
+// cluster fits the kargs areas of the base minimal ISO
+func (b *bareMetalInventory) verifyRootfsKargFitsBaseISO(ctx context.Context, cluster *common.Cluster, kargsName string) error {
+	kargsAreas, err := b.downloadKargsAreas(ctx, kargsName)
+	if err != nil {
+		return err
+	}
+	return kargsAreas.VerifyKargFits(b.formatRootfsKarg(cluster.OpenshiftVersion))
 }
 
-func getImageName(clusterID strfmt.UUID) string {
-	return fmt.Sprintf("discovery-image-%s.iso", clusterID.String())
+func (b *bareMetalInventory) downloadObject(ctx context.Context, objectName string) ([]byte, error) {
+	reader, _, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
 }
 
 type clusterInstaller struct {
@@ -799,24 +1266,90 @@ func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installe
 	return installer.NewInstallClusterAccepted().WithPayload(&cluster.Cluster)
 }
 
-func (b *bareMetalInventory) setBootstrapHost(ctx context.Context, cluster common.Cluster, db *gorm.DB) error {
+func (b *bareMetalInventory) InstallHosts(ctx context.Context, params installer.InstallHostsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	var err error
 
-	// check if cluster already has bootstrap
-	for _, h := range cluster.Hosts {
-		if h.Bootstrap {
-			log.Infof("Bootstrap ID is %s", h.ID)
-			return nil
-		}
+	if err = b.db.Preload("Hosts", "status = ?", models.HostStatusKnown).
+		First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+		return common.NewApiError(http.StatusNotFound, err)
 	}
 
-	masterNodesIds, err := b.clusterApi.GetMasterNodesIds(ctx, &cluster, db)
-	if err != nil {
-		log.WithError(err).Errorf("failed to get cluster %s master node id's", cluster.ID)
-		return errors.Wrapf(err, "Failed to get cluster %s master node id's", cluster.ID)
-	}
-	if len(masterNodesIds) == 0 {
-		return errors.Errorf("Cluster have no master hosts that can operate as bootstrap")
+	if !common.IsDay2Cluster(&cluster) {
+		return common.NewApiError(http.StatusConflict,
+			errors.Errorf("InstallHosts is supported only for %s clusters", models.ClusterKindAddHostsCluster))
+	}
+
+	// auto select hosts roles if not selected yet.
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		for i := range cluster.Hosts {
+			if err = b.hostApi.AutoAssignRole(ctx, cluster.Hosts[i], tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	if err = b.uploadDay2WorkerIgnition(ctx, &cluster); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// move known hosts to installing
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		for i := range cluster.Hosts {
+			if err = b.hostApi.Install(ctx, cluster.Hosts[i], tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	if err = b.db.Preload("Hosts").First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	log.Infof("Successfully started installing hosts of cluster <%s>", params.ClusterID.String())
+	return installer.NewInstallHostsAccepted().WithPayload(&cluster.Cluster)
+}
+
+// uploadDay2WorkerIgnition uploads a worker ignition that points at the machine config server of the existing
+// cluster, so the installer of a day2 host fetches the actual worker configuration from the cluster itself
+func (b *bareMetalInventory) uploadDay2WorkerIgnition(ctx context.Context, cluster *common.Cluster) error {
+	log := logutil.FromContext(ctx, b.log)
+	fileName := fmt.Sprintf("%s/worker.ign", cluster.ID)
+	ignition := fmt.Sprintf(day2WorkerIgnitionFormat, cluster.APIVipDnsname)
+	if err := b.objectHandler.Upload(ctx, []byte(ignition), fileName); err != nil {
+		log.WithError(err).Errorf("failed to upload %s", fileName)
+		return errors.Wrapf(err, "failed to upload %s", fileName)
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) setBootstrapHost(ctx context.Context, cluster common.Cluster, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, b.log)
+
+	// check if cluster already has bootstrap
+	for _, h := range cluster.Hosts {
+		if h.Bootstrap {
+			log.Infof("Bootstrap ID is %s", h.ID)
+			return nil
+		}
+	}
+
+	masterNodesIds, err := b.clusterApi.GetMasterNodesIds(ctx, &cluster, db)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s master node id's", cluster.ID)
+		return errors.Wrapf(err, "Failed to get cluster %s master node id's", cluster.ID)
+	}
+	if len(masterNodesIds) == 0 {
+		return errors.Errorf("Cluster have no master hosts that can operate as bootstrap")
 	}
 	bootstrapId := masterNodesIds[len(masterNodesIds)-1]
 	log.Infof("Bootstrap ID is %s", bootstrapId)
@@ -835,12 +1368,18 @@ func (b *bareMetalInventory) setBootstrapHost(ctx context.Context, cluster commo
 func (b *bareMetalInventory) GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
-	c, err := b.getCluster(ctx, params.ClusterID.String())
-	if err != nil {
-		return common.GenerateErrorResponder(err)
+	var c common.Cluster
+	if err := b.db.Preload("Hosts").First(&c, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	cfg, err := installcfg.GetInstallConfig(log, c, false, "")
+	// The preview is rendered the same way as the install config that the cluster is installed with
+	skipInvalidInstallConfigOverrides(log, &c)
+	cfg, err := installcfg.GetInstallConfig(log, &c, b.Config.InstallRHCa, redhatRootCA, b.hwValidator)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -853,7 +1392,7 @@ func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, par
 	var cluster common.Cluster
 	query := identity.AddUserFilter(ctx, "id = ?")
 
-	err := b.db.First(&cluster, query, params.ClusterID).Error
+	err := b.db.Preload("Hosts").First(&cluster, query, params.ClusterID).Error
 	if err != nil {
 		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
 		if gorm.IsRecordNotFoundError(err) {
@@ -864,7 +1403,13 @@ func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, par
 	}
 
 	if err = installcfg.ValidateInstallConfigJSON(params.InstallConfigParams); err != nil {
-		return installer.NewUpdateClusterInstallConfigBadRequest().WithPayload(common.GenerateError(http.StatusBadRequest, err))
+		return installer.NewUpdateClusterInstallConfigBadRequest().WithPayload(generateInstallConfigOverridesError(err))
+	}
+
+	// The overrides must also apply onto the install config of the cluster, as it is going to be generated
+	cluster.InstallConfigOverrides = params.InstallConfigParams
+	if _, err = installcfg.GetInstallConfig(log, &cluster, b.Config.InstallRHCa, redhatRootCA, b.hwValidator); err != nil {
+		return installer.NewUpdateClusterInstallConfigBadRequest().WithPayload(generateInstallConfigOverridesError(err))
 	}
 
 	err = b.db.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", params.InstallConfigParams).Error
@@ -875,16 +1420,146 @@ func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, par
 	return installer.NewUpdateClusterInstallConfigCreated()
 }
 
+// generateInstallConfigOverridesError returns the error of install config overrides that can't be applied, it lists
+// the invalid fields of overrides that set fields that they may not set
+func generateInstallConfigOverridesError(err error) *models.InstallConfigOverridesError {
+	apiErr := common.GenerateError(http.StatusBadRequest, err)
+	ret := &models.InstallConfigOverridesError{
+		Code:   apiErr.Code,
+		Href:   apiErr.Href,
+		ID:     apiErr.ID,
+		Kind:   apiErr.Kind,
+		Reason: apiErr.Reason,
+	}
+	if overridesErr, ok := err.(*installcfg.OverridesError); ok {
+		for _, fieldErr := range overridesErr.FieldErrors {
+			ret.FieldErrors = append(ret.FieldErrors, &models.InstallConfigFieldError{
+				Field:   swag.String(fieldErr.Field),
+				Message: swag.String(fieldErr.Message),
+			})
+		}
+	}
+	return ret
+}
+
+// skipInvalidInstallConfigOverrides drops the install config overrides of the cluster if they set fields that they may
+// not set, which is possible for overrides that were stored before they were validated against the allowed fields.
+// It returns the reason that the overrides were dropped, or an empty string if they are valid
+func skipInvalidInstallConfigOverrides(log logrus.FieldLogger, cluster *common.Cluster) string {
+	if cluster.InstallConfigOverrides == "" {
+		return ""
+	}
+	err := installcfg.ValidateInstallConfigJSON(cluster.InstallConfigOverrides)
+	if err == nil {
+		return ""
+	}
+	log.WithError(err).Warnf("ignoring the invalid install config overrides of cluster %s", cluster.ID)
+	cluster.InstallConfigOverrides = ""
+	return fmt.Sprintf("The install config overrides were ignored as they are invalid: %s", err)
+}
+
+func (b *bareMetalInventory) CreateClusterManifest(ctx context.Context, params installer.CreateClusterManifestParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = b.clusterApi.VerifyClusterUpdatability(cluster); err != nil {
+		log.WithError(err).Errorf("manifests of cluster %s can't be changed in current state", params.ClusterID)
+		return common.NewApiError(http.StatusConflict, err)
+	}
+
+	folder := models.ManifestFolderManifests
+	if params.CreateManifestParams.Folder != nil {
+		folder = *params.CreateManifestParams.Folder
+	}
+	fileName := swag.StringValue(params.CreateManifestParams.FileName)
+	content, err := base64.StdEncoding.DecodeString(swag.StringValue(params.CreateManifestParams.Content))
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "Manifest %s content is not base64 encoded", fileName))
+	}
+	if err = manifests.ValidateManifest(fileName, content); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	objectName := manifests.GetObjectName(params.ClusterID.String(), folder, fileName)
+	if err = b.objectHandler.Upload(ctx, content, objectName); err != nil {
+		log.WithError(err).Errorf("failed to upload manifest %s", objectName)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	return installer.NewCreateClusterManifestCreated().WithPayload(&models.Manifest{Folder: folder, FileName: fileName})
+}
+
+func (b *bareMetalInventory) ListClusterManifests(ctx context.Context, params installer.ListClusterManifestsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	if _, err := b.getCluster(ctx, params.ClusterID.String()); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	clusterManifests, err := manifests.ListManifests(ctx, b.objectHandler, params.ClusterID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to list the manifests of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	return installer.NewListClusterManifestsOK().WithPayload(clusterManifests)
+}
+
+func (b *bareMetalInventory) DeleteClusterManifest(ctx context.Context, params installer.DeleteClusterManifestParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = b.clusterApi.VerifyClusterUpdatability(cluster); err != nil {
+		log.WithError(err).Errorf("manifests of cluster %s can't be changed in current state", params.ClusterID)
+		return common.NewApiError(http.StatusConflict, err)
+	}
+
+	if err = manifests.ValidateFileName(params.FileName); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	objectName := manifests.GetObjectName(params.ClusterID.String(), swag.StringValue(params.Folder), params.FileName)
+	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		log.WithError(err).Errorf("failed to find manifest %s", objectName)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !exists {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("Manifest %s was not found", params.FileName))
+	}
+	if err = b.objectHandler.DeleteObject(ctx, objectName); err != nil {
+		log.WithError(err).Errorf("failed to delete manifest %s", objectName)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	return installer.NewDeleteClusterManifestNoContent()
+}
+
 func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster) error {
 	log := logutil.FromContext(ctx, b.log)
 
-	cfg, err := installcfg.GetInstallConfig(log, &cluster, b.Config.InstallRHCa, redhatRootCA)
+	if msg := skipInvalidInstallConfigOverrides(log, &cluster); msg != "" {
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityWarning, msg, time.Now())
+	}
+
+	cfg, err := installcfg.GetInstallConfig(log, &cluster, b.Config.InstallRHCa, redhatRootCA, b.hwValidator)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get install config for cluster %s", cluster.ID)
 		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
 	}
 
-	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg); err != nil {
+	releaseImage, err := b.versionsApi.GetReleaseImage(cluster.OpenshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the release image of cluster %s", cluster.ID)
+		return err
+	}
+
+	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, releaseImage); err != nil {
 		log.WithError(err).Errorf("Faled generating kubeconfig files for cluster %s", cluster.ID)
 		return err
 	}
@@ -948,6 +1623,11 @@ func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if err = validateDisconnectedSettings(params.ClusterUpdateParams.ImageContentSources,
+		params.ClusterUpdateParams.AdditionalTrustBundle); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	txSuccess := false
 	tx := b.db.Begin()
 	defer func() {
@@ -980,7 +1660,7 @@ func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer
 		return installer.NewUpdateClusterConflict().WithPayload(common.GenerateError(http.StatusConflict, err))
 	}
 
-	if updateClusterConflict := b.validateDNSDomain(params, log); updateClusterConflict != nil {
+	if updateClusterConflict := b.validateDNSDomain(cluster, params, log); updateClusterConflict != nil {
 		return updateClusterConflict
 	}
 
@@ -1017,7 +1697,7 @@ func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer
 
 	cluster.HostNetworks = calculateHostNetworks(log, &cluster)
 	for _, host := range cluster.Hosts {
-		if err := b.customizeHost(host); err != nil {
+		if err := b.customizeHost(&cluster, host); err != nil {
 			return common.GenerateErrorResponder(err)
 		}
 		// Clear this field as it is not needed to be sent via API
@@ -1076,6 +1756,11 @@ func (b *bareMetalInventory) updateDhcpNetworkParams(updates map[string]interfac
 		log.WithError(err).Warnf("Set Ingress VIP")
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
+	if params.ClusterUpdateParams.MachineNetworkCidr != nil && network.IsIPv6CIDR(*params.ClusterUpdateParams.MachineNetworkCidr) {
+		err := errors.New("VIP DHCP allocation is supported only on IPv4 machine networks")
+		log.WithError(err).Warnf("Set Machine Network CIDR")
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
 	if params.ClusterUpdateParams.MachineNetworkCidr != nil &&
 		*machineCidr != swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr) {
 		*machineCidr = swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr)
@@ -1087,6 +1772,158 @@ func (b *bareMetalInventory) updateDhcpNetworkParams(updates map[string]interfac
 	return nil
 }
 
+// updateUserManagedNetworkParams updates the machine network of a cluster whose load balancers are managed by the user,
+// the machine network can't be calculated from VIPs so it is set by the user
+func (b *bareMetalInventory) updateUserManagedNetworkParams(updates map[string]interface{}, cluster *common.Cluster, params installer.UpdateClusterParams, log logrus.FieldLogger, machineCidr *string) error {
+	if params.ClusterUpdateParams.APIVip != nil {
+		err := errors.New("Setting API VIP is forbidden when the networking is managed by the user")
+		log.WithError(err).Warnf("Set API VIP")
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if params.ClusterUpdateParams.IngressVip != nil {
+		err := errors.New("Setting Ingress VIP is forbidden when the networking is managed by the user")
+		log.WithError(err).Warnf("Set Ingress VIP")
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if params.ClusterUpdateParams.MachineNetworkCidr != nil &&
+		*machineCidr != swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr) {
+		*machineCidr = swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr)
+		setMachineNetworkCIDRForUpdate(updates, *machineCidr)
+		return network.VerifyMachineCIDR(swag.StringValue(params.ClusterUpdateParams.MachineNetworkCidr), cluster.Hosts, log)
+	}
+	return nil
+}
+
+// updateSecondaryNetworkParams updates the secondary networks of a dual-stack cluster, setting them to empty strings
+// turns the cluster back to a single-stack cluster
+func (b *bareMetalInventory) updateSecondaryNetworkParams(updates map[string]interface{}, cluster *common.Cluster, params installer.UpdateClusterParams, log logrus.FieldLogger) error {
+	var err error
+	secondaryClusterCidr := cluster.SecondaryClusterNetworkCidr
+	if params.ClusterUpdateParams.SecondaryClusterNetworkCidr != nil {
+		secondaryClusterCidr = *params.ClusterUpdateParams.SecondaryClusterNetworkCidr
+		if secondaryClusterCidr != "" {
+			if err = network.VerifySubnetCIDR(secondaryClusterCidr); err != nil {
+				return common.NewApiError(http.StatusBadRequest, err)
+			}
+		}
+		updates["secondary_cluster_network_cidr"] = secondaryClusterCidr
+	}
+	if params.ClusterUpdateParams.SecondaryClusterNetworkHostPrefix != nil {
+		if err = network.VerifyNetworkHostPrefix(*params.ClusterUpdateParams.SecondaryClusterNetworkHostPrefix, secondaryClusterCidr); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		updates["secondary_cluster_network_host_prefix"] = *params.ClusterUpdateParams.SecondaryClusterNetworkHostPrefix
+	} else if secondaryClusterCidr != "" && cluster.SecondaryClusterNetworkHostPrefix == 0 {
+		updates["secondary_cluster_network_host_prefix"] = DefaultIPv6ClusterNetworkHostPrefix
+	}
+	if params.ClusterUpdateParams.SecondaryServiceNetworkCidr != nil {
+		if *params.ClusterUpdateParams.SecondaryServiceNetworkCidr != "" {
+			if err = network.VerifySubnetCIDR(*params.ClusterUpdateParams.SecondaryServiceNetworkCidr); err != nil {
+				return common.NewApiError(http.StatusBadRequest, err)
+			}
+		}
+		updates["secondary_service_network_cidr"] = *params.ClusterUpdateParams.SecondaryServiceNetworkCidr
+	}
+	if params.ClusterUpdateParams.SecondaryMachineNetworkCidr != nil {
+		if *params.ClusterUpdateParams.SecondaryMachineNetworkCidr != "" {
+			if err = network.VerifyMachineCIDR(*params.ClusterUpdateParams.SecondaryMachineNetworkCidr, cluster.Hosts, log); err != nil {
+				return err
+			}
+		}
+		updates["secondary_machine_network_cidr"] = *params.ClusterUpdateParams.SecondaryMachineNetworkCidr
+	}
+	if err = network.VerifyClusterCIDRsNotOverlap(updatedString(updates, "secondary_machine_network_cidr", cluster.SecondaryMachineNetworkCidr),
+		secondaryClusterCidr, updatedString(updates, "secondary_service_network_cidr", cluster.SecondaryServiceNetworkCidr)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+// verifyAutoAssignVips verifies that automatic VIPs are not requested together with VIP DHCP allocation, and that the
+// DHCP range of the machine network is valid
+func verifyAutoAssignVips(autoAssignVips, vipDhcpAllocation bool, dhcpRange string) error {
+	if autoAssignVips && vipDhcpAllocation {
+		return errors.New("Automatic VIPs can't be assigned when the VIPs are allocated by DHCP")
+	}
+	if dhcpRange != "" {
+		if _, _, err := network.ParseIPRange(dhcpRange); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyUserManagedNetworking verifies that a cluster whose load balancers are managed by the user doesn't ask for VIPs
+// that are allocated by DHCP or assigned by the service
+func verifyUserManagedNetworking(userManagedNetworking, vipDhcpAllocation, autoAssignVips bool) error {
+	if !userManagedNetworking {
+		return nil
+	}
+	if vipDhcpAllocation {
+		return errors.New("VIP DHCP allocation is not supported when the networking is managed by the user")
+	}
+	if autoAssignVips {
+		return errors.New("Automatic VIPs can't be assigned when the networking is managed by the user")
+	}
+	return nil
+}
+
+func updateAutoAssignVipsParams(updates map[string]interface{}, cluster *common.Cluster, params installer.UpdateClusterParams,
+	vipDhcpAllocation, userManagedNetworking bool) error {
+	autoAssignVips := cluster.AutoAssignVips
+	if params.ClusterUpdateParams.AutoAssignVips != nil {
+		autoAssignVips = *params.ClusterUpdateParams.AutoAssignVips
+		updates["auto_assign_vips"] = autoAssignVips
+	}
+	dhcpRange := cluster.MachineNetworkDhcpRange
+	if params.ClusterUpdateParams.MachineNetworkDhcpRange != nil {
+		dhcpRange = *params.ClusterUpdateParams.MachineNetworkDhcpRange
+		updates["machine_network_dhcp_range"] = dhcpRange
+	}
+	if err := verifyAutoAssignVips(autoAssignVips, vipDhcpAllocation, dhcpRange); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err := verifyUserManagedNetworking(userManagedNetworking, vipDhcpAllocation, autoAssignVips); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if autoAssignVips && (params.ClusterUpdateParams.APIVip != nil || params.ClusterUpdateParams.IngressVip != nil) {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.New("Setting the VIPs is forbidden when they are assigned automatically, turn off auto_assign_vips to set them"))
+	}
+	return nil
+}
+
+// updateNetworkType updates the network type of the cluster, and verifies that it supports the updated networks of the
+// cluster
+func (b *bareMetalInventory) updateNetworkType(updates map[string]interface{}, cluster *common.Cluster, params installer.UpdateClusterParams,
+	machineCidr, clusterCidr, serviceCidr string) error {
+	if params.ClusterUpdateParams.NetworkType != nil {
+		updates["network_type"] = *params.ClusterUpdateParams.NetworkType
+	}
+	updated := common.Cluster{Cluster: models.Cluster{
+		OpenshiftVersion:            cluster.OpenshiftVersion,
+		NetworkType:                 updatedString(updates, "network_type", cluster.NetworkType),
+		MachineNetworkCidr:          machineCidr,
+		ClusterNetworkCidr:          clusterCidr,
+		ServiceNetworkCidr:          serviceCidr,
+		SecondaryMachineNetworkCidr: updatedString(updates, "secondary_machine_network_cidr", cluster.SecondaryMachineNetworkCidr),
+		SecondaryClusterNetworkCidr: updatedString(updates, "secondary_cluster_network_cidr", cluster.SecondaryClusterNetworkCidr),
+		SecondaryServiceNetworkCidr: updatedString(updates, "secondary_service_network_cidr", cluster.SecondaryServiceNetworkCidr),
+	}}
+	if err := network.VerifyNetworkType(&updated, b.versionsApi); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+// updatedString returns the updated value of a column, or its current value if it is not updated
+func updatedString(updates map[string]interface{}, column string, current string) string {
+	if value, ok := updates[column]; ok {
+		return value.(string)
+	}
+	return current
+}
+
 func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *common.Cluster, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
 	var err error
 	updates := map[string]interface{}{}
@@ -1094,6 +1931,7 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 	serviceCidr := cluster.ServiceNetworkCidr
 	clusterCidr := cluster.ClusterNetworkCidr
 	vipDhcpAllocation := swag.BoolValue(cluster.VipDhcpAllocation)
+	userManagedNetworking := common.IsUserManagedNetworking(cluster)
 	if params.ClusterUpdateParams.Name != nil {
 		updates["name"] = *params.ClusterUpdateParams.Name
 	}
@@ -1108,7 +1946,7 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 		updates["cluster_network_cidr"] = clusterCidr
 	}
 	if params.ClusterUpdateParams.ClusterNetworkHostPrefix != nil {
-		if err = network.VerifyNetworkHostPrefix(*params.ClusterUpdateParams.ClusterNetworkHostPrefix); err != nil {
+		if err = network.VerifyNetworkHostPrefix(*params.ClusterUpdateParams.ClusterNetworkHostPrefix, clusterCidr); err != nil {
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
 		updates["cluster_network_host_prefix"] = *params.ClusterUpdateParams.ClusterNetworkHostPrefix
@@ -1120,6 +1958,9 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 		serviceCidr = *params.ClusterUpdateParams.ServiceNetworkCidr
 		updates["service_network_cidr"] = serviceCidr
 	}
+	if err = b.updateSecondaryNetworkParams(updates, cluster, params, log); err != nil {
+		return err
+	}
 	if params.ClusterUpdateParams.HTTPProxy != nil {
 		updates["http_proxy"] = swag.StringValue(params.ClusterUpdateParams.HTTPProxy)
 	}
@@ -1129,6 +1970,12 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 	if params.ClusterUpdateParams.NoProxy != nil {
 		updates["no_proxy"] = swag.StringValue(params.ClusterUpdateParams.NoProxy)
 	}
+	if params.ClusterUpdateParams.ImageContentSources != nil {
+		updates["image_content_sources"] = swag.StringValue(params.ClusterUpdateParams.ImageContentSources)
+	}
+	if params.ClusterUpdateParams.AdditionalTrustBundle != nil {
+		updates["additional_trust_bundle"] = swag.StringValue(params.ClusterUpdateParams.AdditionalTrustBundle)
+	}
 	if params.ClusterUpdateParams.VipDhcpAllocation != nil && swag.BoolValue(params.ClusterUpdateParams.VipDhcpAllocation) != vipDhcpAllocation {
 		vipDhcpAllocation = swag.BoolValue(params.ClusterUpdateParams.VipDhcpAllocation)
 		updates["vip_dhcp_allocation"] = vipDhcpAllocation
@@ -1137,7 +1984,18 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 		machineCidr = ""
 		setMachineNetworkCIDRForUpdate(updates, machineCidr)
 	}
-	if vipDhcpAllocation {
+	if params.ClusterUpdateParams.UserManagedNetworking != nil && swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking) != userManagedNetworking {
+		userManagedNetworking = swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking)
+		updates["user_managed_networking"] = userManagedNetworking
+		updates["api_vip"] = ""
+		updates["ingress_vip"] = ""
+	}
+	if err = updateAutoAssignVipsParams(updates, cluster, params, vipDhcpAllocation, userManagedNetworking); err != nil {
+		return err
+	}
+	if userManagedNetworking {
+		err = b.updateUserManagedNetworkParams(updates, cluster, params, log, &machineCidr)
+	} else if vipDhcpAllocation {
 		err = b.updateDhcpNetworkParams(updates, cluster, params, log, &machineCidr)
 	} else {
 		err = b.updateNonDhcpNetworkParams(updates, cluster, params, log, &machineCidr)
@@ -1148,6 +2006,14 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 	if err = network.VerifyClusterCIDRsNotOverlap(machineCidr, clusterCidr, serviceCidr); err != nil {
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
+	if err = network.VerifyDualStackNetworks(machineCidr, clusterCidr, serviceCidr, updatedString(updates, "secondary_machine_network_cidr", cluster.SecondaryMachineNetworkCidr),
+		updatedString(updates, "secondary_cluster_network_cidr", cluster.SecondaryClusterNetworkCidr),
+		updatedString(updates, "secondary_service_network_cidr", cluster.SecondaryServiceNetworkCidr)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = b.updateNetworkType(updates, cluster, params, machineCidr, clusterCidr, serviceCidr); err != nil {
+		return err
+	}
 	if params.ClusterUpdateParams.SSHPublicKey != nil {
 		updates["ssh_public_key"] = *params.ClusterUpdateParams.SSHPublicKey
 	}
@@ -1213,6 +2079,26 @@ func (b *bareMetalInventory) updateHostsData(ctx context.Context, params install
 		}
 	}
 
+	for i := range params.ClusterUpdateParams.HostsInstallationDisks {
+		log.Infof("Update host %s to installation disk %s", params.ClusterUpdateParams.HostsInstallationDisks[i].ID,
+			params.ClusterUpdateParams.HostsInstallationDisks[i].InstallationDiskID)
+		var host models.Host
+		err := db.First(&host, "id = ? and cluster_id = ?",
+			params.ClusterUpdateParams.HostsInstallationDisks[i].ID, params.ClusterID).Error
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsInstallationDisks[i].ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		err = b.hostApi.UpdateInstallationDisk(ctx, &host, params.ClusterUpdateParams.HostsInstallationDisks[i].InstallationDiskID, db)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set installation disk <%s> host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsInstallationDisks[i].InstallationDiskID,
+				params.ClusterUpdateParams.HostsInstallationDisks[i].ID, params.ClusterID)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1243,10 +2129,10 @@ func calculateHostNetworks(log logrus.FieldLogger, cluster *common.Cluster) []*m
 			continue
 		}
 		for _, intf := range inventory.Interfaces {
-			for _, ipv4Address := range intf.IPV4Addresses {
-				_, ipnet, err := net.ParseCIDR(ipv4Address)
+			for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+				_, ipnet, err := net.ParseCIDR(address)
 				if err != nil {
-					log.WithError(err).Warnf("Could not parse CIDR %s", ipv4Address)
+					log.WithError(err).Warnf("Could not parse CIDR %s", address)
 					continue
 				}
 				cidr := ipnet.String()
@@ -1283,160 +2169,440 @@ func (b *bareMetalInventory) ListClusters(ctx context.Context, params installer.
 		}
 	}
 
-	return installer.NewListClustersOK().WithPayload(mClusters)
+	return installer.NewListClustersOK().WithPayload(mClusters)
+}
+
+func (b *bareMetalInventory) GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	if err := b.db.Preload("Hosts").First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+		// TODO: check for the right error
+		return installer.NewGetClusterNotFound().
+			WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+
+	cluster.HostNetworks = calculateHostNetworks(log, &cluster)
+	for _, host := range cluster.Hosts {
+		if err := b.customizeHost(&cluster, host); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+		// Clear this field as it is not needed to be sent via API
+		host.FreeAddresses = ""
+	}
+
+	return installer.NewGetClusterOK().WithPayload(&cluster.Cluster)
+}
+
+func (b *bareMetalInventory) GetHostRequirements(ctx context.Context, params installer.GetHostRequirementsParams) middleware.Responder {
+	openshiftVersion := swag.StringValue(params.OpenshiftVersion)
+	masterReqs := b.hostApi.GetHostRequirements(openshiftVersion, models.HostRoleMaster)
+	workerReqs := b.hostApi.GetHostRequirements(openshiftVersion, models.HostRoleWorker)
+	return installer.NewGetHostRequirementsOK().WithPayload(
+		&models.HostRequirements{
+			Master: &masterReqs,
+			Worker: &workerReqs,
+		})
+}
+
+func (b *bareMetalInventory) RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var host models.Host
+	var cluster common.Cluster
+	log.Infof("Register host: %+v", params)
+
+	if err := b.db.First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID.String())
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	err := b.db.First(&host, identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), *params.NewHostParams.HostID, params.ClusterID).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		log.WithError(err).Errorf("failed to get host %s in cluster: %s",
+			*params.NewHostParams.HostID, params.ClusterID.String())
+		return installer.NewRegisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	// In case host doesn't exists check if the cluster accept new hosts registration
+	if err != nil && gorm.IsRecordNotFoundError(err) {
+		if err := b.clusterApi.AcceptRegistration(&cluster); err != nil {
+			log.WithError(err).Errorf("failed to register host <%s> to cluster %s due to: %s",
+				params.NewHostParams.HostID, params.ClusterID.String(), err.Error())
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
+				"Failed to register host: cluster cannot accept new hosts in its current state", time.Now())
+			return installer.NewRegisterHostForbidden().
+				WithPayload(&models.InfraError{
+					Code:    swag.Int32(http.StatusForbidden),
+					Message: swag.String(err.Error()),
+				})
+		}
+	}
+
+	url := installer.GetHostURL{ClusterID: params.ClusterID, HostID: *params.NewHostParams.HostID}
+	host = models.Host{
+		ID:                    params.NewHostParams.HostID,
+		Href:                  swag.String(url.String()),
+		Kind:                  swag.String(ResourceKindHost),
+		ClusterID:             params.ClusterID,
+		CheckedInAt:           strfmt.DateTime(time.Now()),
+		DiscoveryAgentVersion: params.NewHostParams.DiscoveryAgentVersion,
+		UserName:              auth.UserNameFromContext(ctx),
+		Role:                  models.HostRoleAutoAssign,
+	}
+
+	if err := b.hostApi.RegisterHost(ctx, &host); err != nil {
+		log.WithError(err).Errorf("failed to register host <%s> cluster <%s>",
+			params.NewHostParams.HostID.String(), params.ClusterID.String())
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
+			"Failed to register host: error creating host metadata", time.Now())
+		return installer.NewRegisterHostBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+
+	if err := b.customizeHost(&cluster, &host); err != nil {
+		b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
+			"Failed to register host: error setting host properties", time.Now())
+		return common.GenerateErrorResponder(err)
+	}
+
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityInfo,
+		fmt.Sprintf("Host %s: registered to cluster", hostutil.GetHostnameForMsg(&host)), time.Now())
+	return installer.NewRegisterHostCreated().WithPayload(&host)
+}
+
+func (b *bareMetalInventory) DeregisterHost(ctx context.Context, params installer.DeregisterHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Deregister host: %s cluster %s", params.HostID, params.ClusterID)
+
+	txSuccess := false
+	tx := b.db.Begin()
+	defer func() {
+		if !txSuccess {
+			tx.Rollback()
+		}
+	}()
+	if tx.Error != nil {
+		log.WithError(tx.Error).Errorf("failed to start db transaction")
+		return installer.NewDeregisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to start transaction")))
+	}
+
+	if err := tx.Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
+		Delete(&models.Host{}).Error; err != nil {
+		// TODO: check error type
+		return installer.NewDeregisterHostBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+
+	// the records of the host are removed together with the host, so a deregistered host leaves none of them behind
+	if err := common.DeleteValidations(tx, params.ClusterID, &params.HostID); err != nil {
+		log.WithError(err).Errorf("failed to delete validations of host %s", params.HostID)
+		return installer.NewDeregisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	if err := common.DeleteBmcCredentials(tx, params.ClusterID, &params.HostID); err != nil {
+		log.WithError(err).Errorf("failed to delete BMC credentials of host %s", params.HostID)
+		return installer.NewDeregisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	if err := history.DeleteTransitions(tx, params.ClusterID, &params.HostID); err != nil {
+		log.WithError(err).Errorf("failed to delete the transition history of host %s", params.HostID)
+		return installer.NewDeregisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.WithError(err).Errorf("failed to commit the deregistration of host %s", params.HostID)
+		return installer.NewDeregisterHostInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction")))
+	}
+	txSuccess = true
+
+	// TODO: need to check that host can be deleted from the cluster
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
+		fmt.Sprintf("Host %s: deregistered from cluster", params.HostID.String()), time.Now())
+	return installer.NewDeregisterHostNoContent()
+}
+
+func (b *bareMetalInventory) GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder {
+	var host models.Host
+	// TODO: validate what is the error
+	if err := b.db.Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
+		First(&host).Error; err != nil {
+		return installer.NewGetHostNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	if err := b.customizeHost(cluster, &host); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	// Clear this field as it is not needed to be sent via API
+	host.FreeAddresses = ""
+	return installer.NewGetHostOK().WithPayload(&host)
+}
+
+func (b *bareMetalInventory) ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var hosts []*models.Host
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	if err = b.db.Find(&hosts, identity.AddUserFilter(ctx, "cluster_id = ?"), params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get list of hosts for cluster %s", params.ClusterID)
+		return installer.NewListHostsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	for _, host := range hosts {
+		if err := b.customizeHost(cluster, host); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+		// Clear this field as it is not needed to be sent via API
+		host.FreeAddresses = ""
+	}
+
+	return installer.NewListHostsOK().WithPayload(hosts)
+}
+
+func (b *bareMetalInventory) ListClusterHistory(ctx context.Context, params installer.ListClusterHistoryParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := b.getCluster(ctx, params.ClusterID.String()); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	transitions, err := history.GetTransitions(b.db, params.ClusterID, nil)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get history of cluster %s", params.ClusterID)
+		return installer.NewListClusterHistoryInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	return installer.NewListClusterHistoryOK().WithPayload(toStateTransitionList(transitions))
+}
+
+func (b *bareMetalInventory) ListHostHistory(ctx context.Context, params installer.ListHostHistoryParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var host models.Host
+	if err := b.db.Select("id").Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
+		Take(&host).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewListHostHistoryNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewListHostHistoryInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	transitions, err := history.GetTransitions(b.db, params.ClusterID, &params.HostID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get history of host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewListHostHistoryInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	return installer.NewListHostHistoryOK().WithPayload(toStateTransitionList(transitions))
+}
+
+func toStateTransitionList(transitions []*history.Transition) models.StateTransitionList {
+	ret := make(models.StateTransitionList, len(transitions))
+	for i := range transitions {
+		ret[i] = &transitions[i].StateTransition
+	}
+	return ret
+}
+
+func (b *bareMetalInventory) ListClusterValidations(ctx context.Context, params installer.ListClusterValidationsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := b.getCluster(ctx, params.ClusterID.String()); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	validations, err := common.GetValidations(b.db, params.ClusterID, nil)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get validations of cluster %s", params.ClusterID)
+		return installer.NewListClusterValidationsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	return installer.NewListClusterValidationsOK().WithPayload(validations)
+}
+
+func (b *bareMetalInventory) ListHostValidations(ctx context.Context, params installer.ListHostValidationsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var host models.Host
+	if err := b.db.Select("id").Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
+		Take(&host).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewListHostValidationsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewListHostValidationsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	validations, err := common.GetValidations(b.db, params.ClusterID, &params.HostID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get validations of host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewListHostValidationsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	return installer.NewListHostValidationsOK().WithPayload(validations)
 }
 
-func (b *bareMetalInventory) GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder {
+func (b *bareMetalInventory) GetHostBmcCredentials(ctx context.Context, params installer.GetHostBmcCredentialsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	var cluster common.Cluster
-	if err := b.db.Preload("Hosts").First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
-		// TODO: check for the right error
-		return installer.NewGetClusterNotFound().
-			WithPayload(common.GenerateError(http.StatusNotFound, err))
+	var host models.Host
+	if err := b.db.Select("id").Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
+		Take(&host).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetHostBmcCredentialsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewGetHostBmcCredentialsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	cluster.HostNetworks = calculateHostNetworks(log, &cluster)
-	for _, host := range cluster.Hosts {
-		if err := b.customizeHost(host); err != nil {
-			return common.GenerateErrorResponder(err)
+	creds, err := common.GetBmcCredentials(b.db, params.ClusterID, params.HostID)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetHostBmcCredentialsNotFound().WithPayload(common.GenerateError(http.StatusNotFound,
+				errors.Errorf("host %s has no BMC credentials", params.HostID)))
 		}
-		// Clear this field as it is not needed to be sent via API
-		host.FreeAddresses = ""
+		log.WithError(err).Errorf("failed to get BMC credentials of host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewGetHostBmcCredentialsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	return installer.NewGetClusterOK().WithPayload(&cluster.Cluster)
-}
-
-func (b *bareMetalInventory) GetHostRequirements(ctx context.Context, params installer.GetHostRequirementsParams) middleware.Responder {
-	masterReqs := b.hostApi.GetHostRequirements(models.HostRoleMaster)
-	workerReqs := b.hostApi.GetHostRequirements(models.HostRoleWorker)
-	return installer.NewGetHostRequirementsOK().WithPayload(
-		&models.HostRequirements{
-			Master: &masterReqs,
-			Worker: &workerReqs,
-		})
+	return installer.NewGetHostBmcCredentialsOK().WithPayload(&creds.BmcCredentials)
 }
 
-func (b *bareMetalInventory) RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder {
+func (b *bareMetalInventory) UpdateHostBmcCredentials(ctx context.Context, params installer.UpdateHostBmcCredentialsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	var host models.Host
-	var cluster common.Cluster
-	log.Infof("Register host: %+v", params)
-
-	if err := b.db.First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID.String()).Error; err != nil {
-		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID.String())
+	if err := b.db.Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
+		Take(&host).Error; err != nil {
 		if gorm.IsRecordNotFoundError(err) {
-			return common.NewApiError(http.StatusNotFound, err)
+			return installer.NewUpdateHostBmcCredentialsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
 		}
-		return common.NewApiError(http.StatusInternalServerError, err)
-	}
-	err := b.db.First(&host, identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), *params.NewHostParams.HostID, params.ClusterID).Error
-	if err != nil && !gorm.IsRecordNotFoundError(err) {
-		log.WithError(err).Errorf("failed to get host %s in cluster: %s",
-			*params.NewHostParams.HostID, params.ClusterID.String())
-		return installer.NewRegisterHostInternalServerError().
+		return installer.NewUpdateHostBmcCredentialsInternalServerError().
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	// In case host doesn't exists check if the cluster accept new hosts registration
-	if err != nil && gorm.IsRecordNotFoundError(err) {
-		if err := b.clusterApi.AcceptRegistration(&cluster); err != nil {
-			log.WithError(err).Errorf("failed to register host <%s> to cluster %s due to: %s",
-				params.NewHostParams.HostID, params.ClusterID.String(), err.Error())
-			b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
-				"Failed to register host: cluster cannot accept new hosts in its current state", time.Now())
-			return installer.NewRegisterHostForbidden().
-				WithPayload(&models.InfraError{
-					Code:    swag.Int32(http.StatusForbidden),
-					Message: swag.String(err.Error()),
-				})
+	address := params.BmcCredentialsParams.Address
+	if address == "" && host.Inventory != "" {
+		var inventory models.Inventory
+		if err := json.Unmarshal([]byte(host.Inventory), &inventory); err == nil {
+			address = inventory.BmcAddress
 		}
 	}
-
-	url := installer.GetHostURL{ClusterID: params.ClusterID, HostID: *params.NewHostParams.HostID}
-	host = models.Host{
-		ID:                    params.NewHostParams.HostID,
-		Href:                  swag.String(url.String()),
-		Kind:                  swag.String(ResourceKindHost),
-		ClusterID:             params.ClusterID,
-		CheckedInAt:           strfmt.DateTime(time.Now()),
-		DiscoveryAgentVersion: params.NewHostParams.DiscoveryAgentVersion,
-		UserName:              auth.UserNameFromContext(ctx),
-		Role:                  models.HostRoleAutoAssign,
+	if address == "" || address == "0.0.0.0" {
+		return installer.NewUpdateHostBmcCredentialsBadRequest().WithPayload(common.GenerateError(http.StatusBadRequest,
+			errors.Errorf("BMC address of host %s is not set and is missing from its inventory", params.HostID)))
 	}
 
-	if err := b.hostApi.RegisterHost(ctx, &host); err != nil {
-		log.WithError(err).Errorf("failed to register host <%s> cluster <%s>",
-			params.NewHostParams.HostID.String(), params.ClusterID.String())
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
-			"Failed to register host: error creating host metadata", time.Now())
-		return installer.NewRegisterHostBadRequest().
-			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	creds, err := common.GetBmcCredentials(b.db, params.ClusterID, params.HostID)
+	if err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			log.WithError(err).Errorf("failed to get BMC credentials of host %s in cluster %s", params.HostID, params.ClusterID)
+			return installer.NewUpdateHostBmcCredentialsInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+		creds = &common.BmcCredentials{BmcCredentials: models.BmcCredentials{ClusterID: params.ClusterID, HostID: params.HostID}}
 	}
-
-	if err := b.customizeHost(&host); err != nil {
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityError,
-			"Failed to register host: error setting host properties", time.Now())
-		return common.GenerateErrorResponder(err)
+	creds.Address = address
+	creds.Username = swag.StringValue(params.BmcCredentialsParams.Username)
+	if err = creds.SetPassword(b.Config.BmcCredentialsKey, params.BmcCredentialsParams.Password.String()); err != nil {
+		log.WithError(err).Errorf("failed to encrypt the BMC password of host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewUpdateHostBmcCredentialsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	creds.DisableCertificateVerification = params.BmcCredentialsParams.DisableCertificateVerification
+	if err = b.db.Save(creds).Error; err != nil {
+		log.WithError(err).Errorf("failed to save BMC credentials of host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewUpdateHostBmcCredentialsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, params.NewHostParams.HostID, models.EventSeverityInfo,
-		fmt.Sprintf("Host %s: registered to cluster", hostutil.GetHostnameForMsg(&host)), time.Now())
-	return installer.NewRegisterHostCreated().WithPayload(&host)
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
+		fmt.Sprintf("BMC credentials of host %s were set", hostutil.GetHostnameForMsg(&host)), time.Now())
+	return installer.NewUpdateHostBmcCredentialsCreated().WithPayload(&creds.BmcCredentials)
 }
 
-func (b *bareMetalInventory) DeregisterHost(ctx context.Context, params installer.DeregisterHostParams) middleware.Responder {
+func (b *bareMetalInventory) DeleteHostBmcCredentials(ctx context.Context, params installer.DeleteHostBmcCredentialsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	log.Infof("Deregister host: %s cluster %s", params.HostID, params.ClusterID)
+	var host models.Host
+	if err := b.db.Select("id").Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
+		Take(&host).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewDeleteHostBmcCredentialsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewDeleteHostBmcCredentialsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
 
-	if err := b.db.Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
-		Delete(&models.Host{}).Error; err != nil {
-		// TODO: check error type
-		return installer.NewDeregisterHostBadRequest().
-			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	if err := common.DeleteBmcCredentials(b.db, params.ClusterID, &params.HostID); err != nil {
+		log.WithError(err).Errorf("failed to delete BMC credentials of host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewDeleteHostBmcCredentialsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	// TODO: need to check that host can be deleted from the cluster
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityInfo,
-		fmt.Sprintf("Host %s: deregistered from cluster", params.HostID.String()), time.Now())
-	return installer.NewDeregisterHostNoContent()
+	return installer.NewDeleteHostBmcCredentialsNoContent()
 }
 
-func (b *bareMetalInventory) GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder {
-	var host models.Host
-	// TODO: validate what is the error
-	if err := b.db.Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), params.HostID, params.ClusterID).
-		First(&host).Error; err != nil {
-		return installer.NewGetHostNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+func (b *bareMetalInventory) GetClusterConnectivity(ctx context.Context, params installer.GetClusterConnectivityParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	if err := b.db.Preload("Hosts", "status <> ?", models.HostStatusDisabled).
+		First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetClusterConnectivityNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewGetClusterConnectivityInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	if err := b.customizeHost(&host); err != nil {
-		return common.GenerateErrorResponder(err)
+	matrix, err := network.CreateConnectivityMatrix(cluster.Hosts, cluster.MachineNetworkCidr)
+	if err != nil {
+		log.WithError(err).Errorf("failed to create the connectivity matrix of cluster %s", params.ClusterID)
+		return installer.NewGetClusterConnectivityInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	// Clear this field as it is not needed to be sent via API
-	host.FreeAddresses = ""
-	return installer.NewGetHostOK().WithPayload(&host)
+	return installer.NewGetClusterConnectivityOK().WithPayload(matrix)
 }
 
-func (b *bareMetalInventory) ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder {
+func (b *bareMetalInventory) SuggestVips(ctx context.Context, params installer.SuggestVipsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	var hosts []*models.Host
-	if err := b.db.Find(&hosts, identity.AddUserFilter(ctx, "cluster_id = ?"), params.ClusterID).Error; err != nil {
-		log.WithError(err).Errorf("failed to get list of hosts for cluster %s", params.ClusterID)
-		return installer.NewListHostsInternalServerError().
+	var cluster common.Cluster
+	if err := b.db.Preload("Hosts").First(&cluster, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewSuggestVipsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewSuggestVipsInternalServerError().
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
-
-	for _, host := range hosts {
-		if err := b.customizeHost(host); err != nil {
-			return common.GenerateErrorResponder(err)
-		}
-		// Clear this field as it is not needed to be sent via API
-		host.FreeAddresses = ""
+	if swag.BoolValue(cluster.VipDhcpAllocation) {
+		return installer.NewSuggestVipsBadRequest().WithPayload(common.GenerateError(http.StatusBadRequest,
+			errors.New("VIPs of a cluster with VIP DHCP allocation are allocated by the DHCP server")))
 	}
 
-	return installer.NewListHostsOK().WithPayload(hosts)
+	suggestion, err := network.SuggestVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.MachineNetworkDhcpRange, log)
+	if err != nil {
+		log.WithError(err).Infof("failed to suggest VIPs for cluster %s", params.ClusterID)
+		return installer.NewSuggestVipsConflict().WithPayload(common.GenerateError(http.StatusConflict, err))
+	}
+	return installer.NewSuggestVipsOK().WithPayload(suggestion)
 }
 
 func (b *bareMetalInventory) GetNextSteps(ctx context.Context, params installer.GetNextStepsParams) middleware.Responder {
@@ -1573,6 +2739,21 @@ func (b *bareMetalInventory) updateFreeAddressesReport(ctx context.Context, host
 	return nil
 }
 
+func (b *bareMetalInventory) updateDomainResolutionReport(ctx context.Context, host *models.Host, domainResolutionReport string) error {
+	log := logutil.FromContext(ctx, b.log)
+	var resolutions models.DomainResolutionResponse
+	if err := json.Unmarshal([]byte(domainResolutionReport), &resolutions); err != nil {
+		log.WithError(err).Warnf("Json unmarshal domain resolutions of host %s", host.ID.String())
+		return err
+	}
+	if err := b.db.Model(&models.Host{}).Where(identity.AddUserFilter(ctx, "id = ? and cluster_id = ?"), host.ID.String(),
+		host.ClusterID.String()).Updates(map[string]interface{}{"domain_resolutions": domainResolutionReport}).Error; err != nil {
+		log.WithError(err).Warnf("Update domain resolutions of host %s", host.ID.String())
+		return err
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) processDhcpAllocationResponse(ctx context.Context, host *models.Host, dhcpAllocationResponseStr string) error {
 	var (
 		err                   error
@@ -1626,6 +2807,8 @@ func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInvento
 		err = b.updateFreeAddressesReport(ctx, &host, stepReply)
 	case models.StepTypeDhcpLeaseAllocate:
 		err = b.processDhcpAllocationResponse(ctx, &host, stepReply)
+	case models.StepTypeDomainResolution:
+		err = b.updateDomainResolutionReport(ctx, &host, stepReply)
 	}
 	return err
 }
@@ -1644,6 +2827,8 @@ func filterReplyByType(params installer.PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.FreeNetworksAddresses{}, params.Reply.Output)
 	case models.StepTypeDhcpLeaseAllocate:
 		stepReply, err = filterReply(&models.DhcpAllocationResponse{}, params.Reply.Output)
+	case models.StepTypeDomainResolution:
+		stepReply, err = filterReply(&models.DomainResolutionResponse{}, params.Reply.Output)
 	}
 	return stepReply, err
 }
@@ -1708,7 +2893,7 @@ func (b *bareMetalInventory) DisableHost(ctx context.Context, params installer.D
 		return common.GenerateErrorResponderWithDefault(err, http.StatusConflict)
 	}
 
-	if err := b.customizeHost(&host); err != nil {
+	if err := b.customizeHost(&cluster, &host); err != nil {
 		msg := "Failed to disable host: error setting host properties"
 		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
 		return common.GenerateErrorResponder(err)
@@ -1783,7 +2968,7 @@ func (b *bareMetalInventory) EnableHost(ctx context.Context, params installer.En
 		return common.GenerateErrorResponderWithDefault(err, http.StatusConflict)
 	}
 
-	if err := b.customizeHost(&host); err != nil {
+	if err := b.customizeHost(&cluster, &host); err != nil {
 		msg := "Failed to enable host: error setting host properties"
 		b.eventsHandler.AddEvent(ctx, params.ClusterID, &params.HostID, models.EventSeverityError, msg, time.Now())
 		return common.GenerateErrorResponder(err)
@@ -2151,7 +3336,7 @@ func (b *bareMetalInventory) CancelInstallation(ctx context.Context, params inst
 		if err := b.hostApi.CancelInstallation(ctx, h, "Installation was canceled by user", tx); err != nil {
 			return common.GenerateErrorResponder(err)
 		}
-		if err := b.customizeHost(h); err != nil {
+		if err := b.customizeHost(&c, h); err != nil {
 			return installer.NewCancelInstallationInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 		}
 	}
@@ -2214,7 +3399,7 @@ func (b *bareMetalInventory) ResetCluster(ctx context.Context, params installer.
 		if err := b.hostApi.ResetHost(ctx, h, "cluster was reset by user", tx); err != nil {
 			return common.GenerateErrorResponder(err)
 		}
-		if err := b.customizeHost(h); err != nil {
+		if err := b.customizeHost(&c, h); err != nil {
 			return installer.NewResetClusterInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 		}
 	}
@@ -2236,6 +3421,64 @@ func (b *bareMetalInventory) ResetCluster(ctx context.Context, params installer.
 	return installer.NewResetClusterAccepted().WithPayload(&c.Cluster)
 }
 
+func (b *bareMetalInventory) RetryInstallation(ctx context.Context, params installer.RetryInstallationParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("retrying installation of cluster %s", params.ClusterID)
+
+	var c common.Cluster
+
+	txSuccess := false
+	tx := b.db.Begin()
+	defer func() {
+		if !txSuccess {
+			log.Error("retry installation failed")
+			tx.Rollback()
+		}
+		if r := recover(); r != nil {
+			log.Error("retry installation failed")
+			tx.Rollback()
+		}
+	}()
+
+	if tx.Error != nil {
+		log.WithError(tx.Error).Errorf("failed to start db transaction")
+		return installer.NewRetryInstallationInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to start transaction")))
+	}
+
+	if err := tx.Preload("Hosts").First(&c, identity.AddUserFilter(ctx, "id = ?"), params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewRetryInstallationNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewRetryInstallationInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	if err := b.clusterApi.RetryInstallation(ctx, &c, tx); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	// only hosts that failed are installed again, the rest keep their state
+	for _, h := range c.Hosts {
+		if err := b.hostApi.RetryInstallation(ctx, h, tx); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error(err)
+		return installer.NewRetryInstallationInternalServerError().WithPayload(
+			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction")))
+	}
+	txSuccess = true
+
+	if err := b.db.Preload("Hosts").First(&c, "id = ?", params.ClusterID).Error; err != nil {
+		return installer.NewRetryInstallationInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	return installer.NewRetryInstallationAccepted().WithPayload(&c.Cluster)
+}
+
 func (b *bareMetalInventory) CompleteInstallation(ctx context.Context, params installer.CompleteInstallationParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
@@ -2277,7 +3520,12 @@ func (b *bareMetalInventory) deleteDNSRecordSets(ctx context.Context, cluster co
 func (b *bareMetalInventory) changeDNSRecordSets(ctx context.Context, cluster common.Cluster, delete bool) error {
 	log := logutil.FromContext(ctx, b.log)
 
-	domain, err := b.getDNSDomain(cluster.Name, cluster.BaseDNSDomain)
+	if common.HasNoVips(&cluster) {
+		// The records of user managed load balancers are managed by the user, single node clusters have no VIPs
+		return nil
+	}
+
+	domain, err := b.getDNSDomain(cluster.OrgID, cluster.Name, cluster.BaseDNSDomain)
 	if err != nil {
 		return err
 	}
@@ -2286,38 +3534,30 @@ func (b *bareMetalInventory) changeDNSRecordSets(ctx context.Context, cluster co
 		return nil
 	}
 
-	switch domain.Provider {
-	case "route53":
-		var dnsProvider dnsproviders.Provider = dnsproviders.Route53{
-			RecordSet: dnsproviders.RecordSet{
-				RecordSetType: "A",
-				TTL:           60,
-			},
-			HostedZoneID: domain.ID,
-			SharedCreds:  true,
-		}
+	dnsProvider, err := b.getDNSProvider(domain)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the DNS provider of base domain %s", cluster.BaseDNSDomain)
+		return err
+	}
 
-		dnsRecordSetFunc := dnsProvider.CreateRecordSet
-		if delete {
-			dnsRecordSetFunc = dnsProvider.DeleteRecordSet
-		}
+	dnsRecordFunc := dnsProvider.CreateRecord
+	if delete {
+		dnsRecordFunc = dnsProvider.DeleteRecord
+	}
 
-		// Create/Delete A record for API Virtual IP
-		_, err := dnsRecordSetFunc(domain.APIDomainName, cluster.APIVip)
-		if err != nil {
-			log.WithError(err).Errorf("failed to update DNS record: (%s, %s)",
-				domain.APIDomainName, cluster.APIVip)
-			return err
-		}
-		// Create/Delete A record for Ingress Virtual IP
-		_, err = dnsRecordSetFunc(domain.IngressDomainName, cluster.IngressVip)
-		if err != nil {
-			log.WithError(err).Errorf("failed to update DNS record: (%s, %s)",
-				domain.IngressDomainName, cluster.IngressVip)
-			return err
-		}
-		log.Infof("Successfully created DNS records for base domain: %s", cluster.BaseDNSDomain)
+	// Create/Delete A record for API Virtual IP
+	if err = dnsRecordFunc(domain.APIDomainName, cluster.APIVip); err != nil {
+		log.WithError(err).Errorf("failed to update DNS record: (%s, %s)",
+			domain.APIDomainName, cluster.APIVip)
+		return err
+	}
+	// Create/Delete A record for Ingress Virtual IP
+	if err = dnsRecordFunc(domain.IngressDomainName, cluster.IngressVip); err != nil {
+		log.WithError(err).Errorf("failed to update DNS record: (%s, %s)",
+			domain.IngressDomainName, cluster.IngressVip)
+		return err
 	}
+	log.Infof("Successfully updated DNS records of %s provider for base domain: %s", domain.Provider, cluster.BaseDNSDomain)
 	return nil
 }
 
@@ -2325,46 +3565,48 @@ type dnsDomain struct {
 	Name              string
 	ID                string
 	Provider          string
+	Server            string
+	CredentialsRef    string
 	APIDomainName     string
 	IngressDomainName string
 }
 
-func (b *bareMetalInventory) getDNSDomain(clusterName, baseDNSDomainName string) (*dnsDomain, error) {
-	var dnsDomainID string
-	var dnsProvider string
-
-	// Parse base domains from config
-	if val, ok := b.Config.BaseDNSDomains[baseDNSDomainName]; ok {
-		re := regexp.MustCompile("/")
-		if !re.MatchString(val) {
-			return nil, errors.New(fmt.Sprintf("Invalid DNS domain: %s", val))
-		}
-		s := re.Split(val, 2)
-		dnsDomainID = s[0]
-		dnsProvider = s[1]
-	} else {
-		// No base domains defined in config
-		return nil, nil
+// getDNSDomain returns the managed domain of the base DNS domain that the organization can use, or nil if the base
+// DNS domain isn't managed
+func (b *bareMetalInventory) getDNSDomain(orgID, clusterName, baseDNSDomainName string) (*dnsDomain, error) {
+	domain, err := domains.GetManagedDomain(b.db, baseDNSDomainName, orgID)
+	if err != nil {
+		return nil, err
 	}
-
-	if dnsDomainID == "" || dnsProvider == "" {
-		// Specified domain is not defined in config
+	if domain == nil || domain.ZoneID == "" || !dnsprovider.IsSupported(domain.Provider) {
+		// Specified domain is not managed
 		return nil, nil
 	}
 
 	return &dnsDomain{
 		Name:              baseDNSDomainName,
-		ID:                dnsDomainID,
-		Provider:          dnsProvider,
+		ID:                domain.ZoneID,
+		Provider:          domain.Provider,
+		Server:            domain.Server,
+		CredentialsRef:    domain.CredentialsRef,
 		APIDomainName:     fmt.Sprintf("%s.%s.%s", "api", clusterName, baseDNSDomainName),
 		IngressDomainName: fmt.Sprintf("*.%s.%s.%s", "apps", clusterName, baseDNSDomainName),
 	}, nil
 }
 
-func (b *bareMetalInventory) validateDNSDomain(params installer.UpdateClusterParams, log logrus.FieldLogger) *installer.UpdateClusterConflict {
+func (b *bareMetalInventory) getDNSProvider(domain *dnsDomain) (dnsprovider.Provider, error) {
+	return dnsprovider.NewProvider(b.Config.DNSProviderConfig, dnsprovider.Domain{
+		Provider:       domain.Provider,
+		ZoneID:         domain.ID,
+		Server:         domain.Server,
+		CredentialsRef: domain.CredentialsRef,
+	})
+}
+
+func (b *bareMetalInventory) validateDNSDomain(cluster common.Cluster, params installer.UpdateClusterParams, log logrus.FieldLogger) *installer.UpdateClusterConflict {
 	clusterName := swag.StringValue(params.ClusterUpdateParams.Name)
 	clusterBaseDomain := swag.StringValue(params.ClusterUpdateParams.BaseDNSDomain)
-	dnsDomain, err := b.getDNSDomain(clusterName, clusterBaseDomain)
+	dnsDomain, err := b.getDNSDomain(cluster.OrgID, clusterName, clusterBaseDomain)
 	if err == nil && dnsDomain != nil {
 		// Cluster's baseDNSDomain is defined in config (BaseDNSDomains map)
 		if err = b.validateBaseDNS(dnsDomain); err != nil {
@@ -2384,31 +3626,30 @@ func (b *bareMetalInventory) validateDNSDomain(params installer.UpdateClusterPar
 }
 
 func (b *bareMetalInventory) validateBaseDNS(domain *dnsDomain) error {
-	return validations.ValidateBaseDNS(domain.Name, domain.ID, domain.Provider)
+	dnsProvider, err := b.getDNSProvider(domain)
+	if err != nil {
+		return err
+	}
+	return validations.ValidateBaseDNS(domain.Name, dnsProvider)
 }
 
 func (b *bareMetalInventory) validateDNSRecords(domain *dnsDomain) error {
+	dnsProvider, err := b.getDNSProvider(domain)
+	if err != nil {
+		return err
+	}
 	vipAddresses := []string{domain.APIDomainName, domain.IngressDomainName}
-	return validations.CheckDNSRecordsExistence(vipAddresses, domain.ID, domain.Provider)
+	return validations.CheckDNSRecordsExistence(vipAddresses, dnsProvider)
 }
 
-func ipAsUint(ipStr string, log logrus.FieldLogger) uint64 {
-	parts := strings.Split(ipStr, ".")
-	if len(parts) != 4 {
+// ipAsBytes returns the 16 bytes form of an IPv4 or IPv6 address, addresses are sorted by it
+func ipAsBytes(ipStr string, log logrus.FieldLogger) []byte {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
 		log.Warnf("Invalid ip %s", ipStr)
-		return 0
-	}
-	var result uint64 = 0
-	for _, p := range parts {
-		result = result << 8
-		converted, err := strconv.ParseUint(p, 10, 64)
-		if err != nil {
-			log.WithError(err).Warnf("Conversion of %s to uint", p)
-			return 0
-		}
-		result += converted
+		return nil
 	}
-	return result
+	return ip.To16()
 }
 
 func applyLimit(ret models.FreeAddressesList, limitParam *int64) models.FreeAddressesList {
@@ -2436,7 +3677,7 @@ func (b *bareMetalInventory) getFreeAddresses(ctx context.Context, params instal
 
 	// Sort addresses
 	sort.Slice(ret, func(i, j int) bool {
-		return ipAsUint(ret[i].String(), log) < ipAsUint(ret[j].String(), log)
+		return bytes.Compare(ipAsBytes(ret[i], log), ipAsBytes(ret[j], log)) < 0
 	})
 
 	ret = applyLimit(ret, params.Limit)
@@ -2587,14 +3828,14 @@ func (b *bareMetalInventory) getCluster(ctx context.Context, clusterID string) (
 	return &cluster, nil
 }
 
-func (b *bareMetalInventory) customizeHost(host *models.Host) error {
-	b.customizeHostStages(host)
+func (b *bareMetalInventory) customizeHost(cluster *common.Cluster, host *models.Host) error {
+	b.customizeHostStages(cluster, host)
 	b.customizeHostname(host)
 	return nil
 }
 
-func (b *bareMetalInventory) customizeHostStages(host *models.Host) {
-	host.ProgressStages = b.hostApi.GetStagesByRole(host.Role, host.Bootstrap)
+func (b *bareMetalInventory) customizeHostStages(cluster *common.Cluster, host *models.Host) {
+	host.ProgressStages = b.hostApi.GetStagesByRole(host.Role, host.Bootstrap, common.IsSingleNodeCluster(cluster))
 }
 
 func (b *bareMetalInventory) customizeHostname(host *models.Host) {
@@ -2610,22 +3851,19 @@ func proxySettingsChanged(params *models.ClusterUpdateParams, cluster *common.Cl
 	return false
 }
 
-// computes the cluster proxy hash in order to identify if proxy settings were changed which will indicated if
-// new ISO file should be generated to contain new proxy settings
-func computeClusterProxyHash(httpProxy, httpsProxy, noProxy *string) (string, error) {
-	var proxyHash string
-	if httpProxy != nil {
-		proxyHash += *httpProxy
-	}
-	if httpsProxy != nil {
-		proxyHash += *httpsProxy
-	}
-	if noProxy != nil {
-		proxyHash += *noProxy
+// computes the hash of the cluster settings that are embedded in the ISO, the proxy settings, the mirror registries
+// and the trust bundle, in order to identify if they were changed which will indicate if a new ISO file should be
+// generated. The settings are hashed as a JSON array, so that a value moving from one setting to the next changes the
+// hash, and a missing setting is hashed as an empty one. The hash is stored as the proxy hash of the cluster
+func computeImageSettingsHash(httpProxy, httpsProxy, noProxy, imageContentSources, additionalTrustBundle *string) (string, error) {
+	settings, err := json.Marshal([]string{swag.StringValue(httpProxy), swag.StringValue(httpsProxy),
+		swag.StringValue(noProxy), swag.StringValue(imageContentSources), swag.StringValue(additionalTrustBundle)})
+	if err != nil {
+		return "", err
 	}
 	// #nosec
 	h := md5.New()
-	_, err := h.Write([]byte(proxyHash))
+	_, err = h.Write(settings)
 	if err != nil {
 		return "", err
 	}
@@ -2652,6 +3890,29 @@ func validateProxySettings(httpProxy, httpsProxy, noProxy *string) error {
 	return nil
 }
 
+func (b *bareMetalInventory) validateOpenshiftVersion(openshiftVersion string) error {
+	if !b.versionsApi.IsOpenshiftVersionSupported(openshiftVersion) {
+		return errors.Errorf("OpenShift version %s is not supported", openshiftVersion)
+	}
+	return nil
+}
+
+// validateDisconnectedSettings validates the mirror registries and the additional trust bundle of disconnected
+// installations, empty settings are allowed as they clear the current ones
+func validateDisconnectedSettings(imageContentSources, additionalTrustBundle *string) error {
+	if imageContentSources != nil {
+		if err := validations.ValidateImageContentSources(*imageContentSources); err != nil {
+			return fmt.Errorf("Failed to validate image content sources: %s", err)
+		}
+	}
+	if additionalTrustBundle != nil && *additionalTrustBundle != "" {
+		if err := validations.ValidateAdditionalTrustBundle(*additionalTrustBundle); err != nil {
+			return fmt.Errorf("Failed to validate additional trust bundle: %s", err)
+		}
+	}
+	return nil
+}
+
 func proxySettingsForIgnition(httpProxy, httpsProxy, noProxy string) (string, error) {
 	if httpProxy == "" && httpsProxy == "" {
 		return "", nil
@@ -3,20 +3,28 @@ package bminventory
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/openshift/assisted-service/internal/hostutil"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -29,14 +37,20 @@ import (
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	"github.com/openshift/assisted-service/pkg/isoeditor"
 	"github.com/openshift/assisted-service/pkg/job"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/restapi"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 
 	"github.com/kelseyhightower/envconfig"
@@ -46,7 +60,10 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-const ClusterStatusInstalled = "installed"
+const (
+	ClusterStatusInstalled = "installed"
+	testRHCOSImage         = "https://example.com/rhcos-live.iso"
+)
 
 func TestValidator(t *testing.T) {
 	RegisterFailHandler(Fail)
@@ -70,35 +87,98 @@ func getTestAuthHandler() auth.AuthHandler {
 	return *auth.NewAuthHandler(fakeConfigDisabled, nil, getTestLog().WithField("pkg", "auth"))
 }
 
+func getTestVersionsHandler() versions.Handler {
+	openshiftVersions, err := versions.ParseOpenshiftVersions("", "")
+	Expect(err).ShouldNot(HaveOccurred())
+	return versions.NewHandler(versions.Versions{RHCOSImage: testRHCOSImage}, openshiftVersions)
+}
+
 func strToUUID(s string) *strfmt.UUID {
 	u := strfmt.UUID(s)
 	return &u
 }
 
-func mockGenerateISOSuccess(mockKubeJob *job.MockAPI, mockLocalJob *job.MockLocalJob, times int) {
-	if mockKubeJob != nil {
-		mockKubeJob.EXPECT().GenerateISO(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(times)
-	}
-	if mockLocalJob != nil {
-		mockLocalJob.EXPECT().GenerateISO(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(times)
+// testBaseISO returns a base ISO with an embed area for the ignition config
+func testBaseISO(withEmbedArea bool) []byte {
+	iso := make([]byte, 65536)
+	if withEmbedArea {
+		header := iso[isoeditor.SystemAreaLength-24 : isoeditor.SystemAreaLength]
+		copy(header, "coreiso+")
+		binary.LittleEndian.PutUint64(header[8:16], 40960)
+		binary.LittleEndian.PutUint64(header[16:24], 4096)
 	}
+	return iso
 }
 
-func mockGenerateISOFailure(mockKubeJob *job.MockAPI, mockLocalJob *job.MockLocalJob, times int) {
-	if mockKubeJob != nil {
-		mockKubeJob.EXPECT().GenerateISO(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("error")).Times(times)
+const testKargsDefault = "coreos.liveiso=rhcos"
+
+// testBaseISO9660 returns a base ISO with an embed area and the kernel, the initrd and the rootfs of the RHCOS live ISO
+// in an ISO 9660 file system, the files hold their names. The kargs area is in the isolinux config
+func testBaseISO9660() []byte {
+	const (
+		sectorLength    = 2048
+		kargsAreaLength = 1024
+	)
+	iso := append(testBaseISO(true), make([]byte, 4*sectorLength)...)
+	record := func(name string, sector, length int, isDir bool) []byte {
+		r := make([]byte, 34+len(name)-len(name)%2)
+		r[0] = byte(len(r))
+		binary.LittleEndian.PutUint32(r[2:6], uint32(sector))
+		binary.LittleEndian.PutUint32(r[10:14], uint32(length))
+		if isDir {
+			r[25] = 0x02
+		}
+		r[32] = byte(len(name))
+		copy(r[33:], name)
+		return r
 	}
-	if mockLocalJob != nil {
-		mockLocalJob.EXPECT().GenerateISO(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("error")).Times(times)
+	writeSector := func(sector int, data ...[]byte) {
+		offset := sector * sectorLength
+		for _, d := range data {
+			offset += copy(iso[offset:], d)
+		}
 	}
+	// the primary volume descriptor and the terminator, the directories and the files follow the embed area
+	writeSector(16, []byte{1}, []byte("CD001"))
+	copy(iso[16*sectorLength+156:], record("\x00", 24, sectorLength, true))
+	writeSector(17, []byte{255}, []byte("CD001"))
+	kargsJSON := fmt.Sprintf(`{"default": %q, "files": [{"path": "isolinux/isolinux.cfg", "offset": 7}], "size": %d}`,
+		testKargsDefault, kargsAreaLength)
+	isolinuxConfig := "append " + testKargsDefault + strings.Repeat("#", kargsAreaLength-len(testKargsDefault)) + "\n"
+	writeSector(24, record("\x00", 24, sectorLength, true), record("COREOS", 30, sectorLength, true),
+		record("IMAGES", 25, sectorLength, true), record("ISOLINUX", 32, sectorLength, true))
+	writeSector(25, record("\x00", 25, sectorLength, true), record("PXEBOOT", 26, sectorLength, true))
+	writeSector(26, record("\x00", 26, sectorLength, true), record("VMLINUZ.;1", 27, len("vmlinuz"), false),
+		record("INITRD.IMG;1", 28, len("initrd.img"), false), record("ROOTFS.IMG;1", 29, len("rootfs.img"), false))
+	writeSector(27, []byte("vmlinuz"))
+	writeSector(28, []byte("initrd.img"))
+	writeSector(29, []byte("rootfs.img"))
+	writeSector(30, record("\x00", 30, sectorLength, true), record("KARGS.JSON;1", 31, len(kargsJSON), false))
+	writeSector(31, []byte(kargsJSON))
+	writeSector(32, record("\x00", 32, sectorLength, true), record("ISOLINUX.CFG;1", 33, len(isolinuxConfig), false))
+	writeSector(33, []byte(isolinuxConfig))
+	return iso
+}
+
+func mockDownloadBaseISO(mockS3Client *s3wrapper.MockAPI, iso []byte, times int) {
+	mockS3Client.EXPECT().Download(gomock.Any(), getBaseISOName(testRHCOSImage)).DoAndReturn(
+		func(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+			return ioutil.NopCloser(bytes.NewReader(iso)), int64(len(iso)), nil
+		}).Times(times)
+}
+
+func mockBuildClusterISOSuccess(mockS3Client *s3wrapper.MockAPI, times int) {
+	mockDownloadBaseISO(mockS3Client, testBaseISO(true), times)
+	// the ignition configs of the ISO and the initrd and the iPXE script are uploaded per image
+	mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3 * times)
 }
 
 func mockGenerateInstallConfigSuccess(mockKubeJob *job.MockAPI, mockLocalJob *job.MockLocalJob, times int) {
 	if mockKubeJob != nil {
-		mockKubeJob.EXPECT().GenerateInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockKubeJob.EXPECT().GenerateInstallConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	}
 	if mockLocalJob != nil {
-		mockLocalJob.EXPECT().GenerateInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockLocalJob.EXPECT().GenerateInstallConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	}
 }
 
@@ -118,10 +198,9 @@ var _ = Describe("GenerateClusterISO", func() {
 		db           *gorm.DB
 		ctx          = context.Background()
 		ctrl         *gomock.Controller
-		mockKubeJob  *job.MockAPI
-		mockLocalJob *job.MockLocalJob
 		mockEvents   *events.MockHandler
 		mockS3Client *s3wrapper.MockAPI
+		mockMetric   *metrics.MockAPI
 		dbName       = "generate_cluster_iso"
 	)
 
@@ -131,6 +210,9 @@ var _ = Describe("GenerateClusterISO", func() {
 		db = common.PrepareTestDB(dbName)
 		mockEvents = events.NewMockHandler(ctrl)
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ImageBuildStarted(gomock.Any()).AnyTimes()
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, mockEvents, mockS3Client, mockMetric, getTestAuthHandler(), getTestVersionsHandler(), nil)
 	})
 
 	AfterEach(func() {
@@ -141,9 +223,10 @@ var _ = Describe("GenerateClusterISO", func() {
 	registerClusterWithHTTPProxy := func(pullSecretSet bool, httpProxy string) *common.Cluster {
 		clusterID := strfmt.UUID(uuid.New().String())
 		cluster := common.Cluster{Cluster: models.Cluster{
-			ID:            &clusterID,
-			PullSecretSet: pullSecretSet,
-			HTTPProxy:     httpProxy,
+			ID:               &clusterID,
+			OpenshiftVersion: "4.6",
+			PullSecretSet:    pullSecretSet,
+			HTTPProxy:        httpProxy,
 		}, PullSecret: "{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"}
 		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
 		return &cluster
@@ -153,169 +236,701 @@ var _ = Describe("GenerateClusterISO", func() {
 		return registerClusterWithHTTPProxy(pullSecretSet, "")
 	}
 
-	RunGenerateClusterISOTests := func() {
-		It("success", func() {
-			clusterId := registerCluster(true).ID
-			mockGenerateISOSuccess(mockKubeJob, mockLocalJob, 1)
-			mockS3Client.EXPECT().IsAwsS3().Return(false)
-			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
-			mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         *clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
-			getReply := bm.GetCluster(ctx, installer.GetClusterParams{ClusterID: *clusterId}).(*installer.GetClusterOK)
-			Expect(getReply.Payload.ImageInfo.GeneratorVersion).To(Equal("quay.io/ocpmetal/assisted-iso-create:latest"))
-		})
-
-		It("success with proxy", func() {
-			clusterId := registerClusterWithHTTPProxy(true, "http://1.1.1.1:1234").ID
-			mockGenerateISOSuccess(mockKubeJob, mockLocalJob, 1)
-			mockS3Client.EXPECT().IsAwsS3().Return(false)
-			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
-			mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"http://1.1.1.1:1234\", SSH public key "+
-				"is not set)", gomock.Any())
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         *clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
-
-		})
-
-		It("image already exists", func() {
-			clusterId := strfmt.UUID(uuid.New().String())
-			cluster := common.Cluster{Cluster: models.Cluster{
-				ID:            &clusterId,
-				PullSecretSet: true,
-			}, PullSecret: "{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"}
-			cluster.ProxyHash, _ = computeClusterProxyHash(nil, nil, nil)
-			cluster.ImageInfo = &models.ImageInfo{GeneratorVersion: bm.Config.ImageBuilder}
-			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
-
-			mockS3Client.EXPECT().IsAwsS3().Return(true)
-			mockS3Client.EXPECT().UpdateObjectTimestamp(gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
-			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
-			mockS3Client.EXPECT().GeneratePresignedDownloadURL(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil).Times(1)
-			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, nil, models.EventSeverityInfo, "Re-used existing image rather than generating a new one", gomock.Any())
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
-			getReply := bm.GetCluster(ctx, installer.GetClusterParams{ClusterID: clusterId}).(*installer.GetClusterOK)
-			Expect(getReply.Payload.ImageInfo.GeneratorVersion).To(Equal("quay.io/ocpmetal/assisted-iso-create:latest"))
-		})
-
-		It("image expired", func() {
-			clusterId := strfmt.UUID(uuid.New().String())
-			cluster := common.Cluster{Cluster: models.Cluster{
-				ID:            &clusterId,
-				PullSecretSet: true,
-			}, PullSecret: "{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"}
-			cluster.ProxyHash, _ = computeClusterProxyHash(nil, nil, nil)
-			cluster.ImageInfo = &models.ImageInfo{GeneratorVersion: bm.Config.ImageBuilder}
-			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
-
-			mockGenerateISOSuccess(mockKubeJob, mockLocalJob, 1)
-			mockS3Client.EXPECT().IsAwsS3().Return(true)
-			mockS3Client.EXPECT().UpdateObjectTimestamp(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
-			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
-			mockS3Client.EXPECT().GeneratePresignedDownloadURL(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil).Times(1)
-			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
-			getReply := bm.GetCluster(ctx, installer.GetClusterParams{ClusterID: clusterId}).(*installer.GetClusterOK)
-			Expect(getReply.Payload.ImageInfo.GeneratorVersion).To(Equal("quay.io/ocpmetal/assisted-iso-create:latest"))
-		})
-
-		It("success with AWS S3", func() {
-			clusterId := registerCluster(true).ID
-			mockGenerateISOSuccess(mockKubeJob, mockLocalJob, 1)
-			mockS3Client.EXPECT().IsAwsS3().Return(true)
-			mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
-			mockS3Client.EXPECT().GeneratePresignedDownloadURL(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil).Times(1)
-			mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         *clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOCreated()))
-			getReply := bm.GetCluster(ctx, installer.GetClusterParams{ClusterID: *clusterId}).(*installer.GetClusterOK)
-			Expect(getReply.Payload.ImageInfo.GeneratorVersion).To(Equal("quay.io/ocpmetal/assisted-iso-create:latest"))
+	getImageBuildStatus := func(clusterID strfmt.UUID) func() string {
+		return func() string {
+			var cluster common.Cluster
+			Expect(db.First(&cluster, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+			return cluster.ImageInfo.BuildStatus
+		}
+	}
+
+	registerClusterWithImageBuild := func(orgID, status string, createdAt time.Time) *common.Cluster {
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster := common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			OpenshiftVersion: "4.6",
+			OrgID:            orgID,
+			PullSecretSet:    true,
+		}, PullSecret: "{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"}
+		cluster.ProxyHash, _ = computeImageSettingsHash(nil, nil, nil, nil, nil)
+		cluster.ImageInfo = &models.ImageInfo{
+			GeneratorVersion: bm.Config.ImageBuilder,
+			BuildStatus:      status,
+			CreatedAt:        strfmt.DateTime(createdAt),
+		}
+		if status == models.ImageInfoBuildStatusRunning {
+			cluster.ImageBuildStartedAt = createdAt
+		}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		return &cluster
+	}
+
+	It("success", func() {
+		clusterId := registerCluster(true).ID
+		mockBuildClusterISOSuccess(mockS3Client, 1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
 		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusUploaded))
+		getReply := bm.GetCluster(ctx, installer.GetClusterParams{ClusterID: *clusterId}).(*installer.GetClusterOK)
+		Expect(getReply.Payload.ImageInfo.GeneratorVersion).To(Equal("quay.io/ocpmetal/assisted-iso-create:latest"))
+	})
 
-		It("cluster_not_exists", func() {
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         strfmt.UUID(uuid.New().String()),
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISONotFound()))
+	It("success with proxy", func() {
+		clusterId := registerClusterWithHTTPProxy(true, "http://1.1.1.1:1234").ID
+		mockBuildClusterISOSuccess(mockS3Client, 1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"http://1.1.1.1:1234\", SSH public key "+
+			"is not set)", gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
 		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusUploaded))
+	})
 
-		It("failed_to_create_job", func() {
-			clusterId := registerCluster(true).ID
-			mockGenerateISOFailure(mockKubeJob, mockLocalJob, 1)
-			mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityError, gomock.Any(), gomock.Any())
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         *clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOInternalServerError()))
+	It("image already exists", func() {
+		clusterId := strfmt.UUID(uuid.New().String())
+		cluster := common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterId,
+			OpenshiftVersion: "4.6",
+			PullSecretSet:    true,
+		}, PullSecret: "{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"}
+		cluster.ProxyHash, _ = computeImageSettingsHash(nil, nil, nil, nil, nil)
+		cluster.ImageInfo = &models.ImageInfo{GeneratorVersion: bm.Config.ImageBuilder}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+
+		for _, objectName := range []string{getImageIgnitionName(clusterId), getInitrdIgnitionName(clusterId), getIPXEScriptName(clusterId)} {
+			mockS3Client.EXPECT().UpdateObjectTimestamp(gomock.Any(), objectName).Return(true, nil).Times(1)
+		}
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, nil, models.EventSeverityInfo, "Re-used existing image rather than generating a new one", gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
 		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Expect(getImageBuildStatus(clusterId)()).Should(Equal(models.ImageInfoBuildStatusUploaded))
+		getReply := bm.GetCluster(ctx, installer.GetClusterParams{ClusterID: clusterId}).(*installer.GetClusterOK)
+		Expect(getReply.Payload.ImageInfo.GeneratorVersion).To(Equal("quay.io/ocpmetal/assisted-iso-create:latest"))
+	})
 
-		It("job_failed", func() {
-			clusterId := registerCluster(true).ID
-			mockGenerateISOFailure(mockKubeJob, mockLocalJob, 1)
-			mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityError, gomock.Any(), gomock.Any())
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         *clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOInternalServerError()))
+	It("image expired", func() {
+		clusterId := strfmt.UUID(uuid.New().String())
+		cluster := common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterId,
+			OpenshiftVersion: "4.6",
+			PullSecretSet:    true,
+		}, PullSecret: "{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"}
+		cluster.ProxyHash, _ = computeImageSettingsHash(nil, nil, nil, nil, nil)
+		cluster.ImageInfo = &models.ImageInfo{GeneratorVersion: bm.Config.ImageBuilder}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+
+		mockBuildClusterISOSuccess(mockS3Client, 1)
+		mockS3Client.EXPECT().UpdateObjectTimestamp(gomock.Any(), getImageIgnitionName(clusterId)).Return(false, nil).Times(1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
 		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(clusterId)).Should(Equal(models.ImageInfoBuildStatusUploaded))
+		getReply := bm.GetCluster(ctx, installer.GetClusterParams{ClusterID: clusterId}).(*installer.GetClusterOK)
+		Expect(getReply.Payload.ImageInfo.GeneratorVersion).To(Equal("quay.io/ocpmetal/assisted-iso-create:latest"))
+	})
 
-		It("failed_missing_pull_secret", func() {
-			clusterId := registerCluster(false).ID
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         *clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOBadRequest()))
+	It("invalid static network config", func() {
+		clusterId := registerCluster(true).ID
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID: *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{
+				StaticNetworkConfig: []*models.HostStaticNetworkConfig{{
+					MacAddress:  swag.String("52:54:00:aa:bb:01"),
+					NetworkYaml: swag.String("interfaces:\n- name: bond0\n  type: bond\n"),
+				}},
+			},
 		})
+		verifyApiError(generateReply, http.StatusBadRequest)
+	})
 
-		It("failed_missing_openshift_token", func() {
-			cluster := registerCluster(true)
-			cluster.PullSecret = "{\"auths\":{\"another.cloud.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"
-			clusterId := cluster.ID
-			mockGenerateISOFailure(mockKubeJob, mockLocalJob, 1)
-			mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityError, gomock.Any(), gomock.Any())
-			generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
-				ClusterID:         *clusterId,
-				ImageCreateParams: &models.ImageCreateParams{},
-			})
-			Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOInternalServerError()))
+	It("cluster_not_exists", func() {
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         strfmt.UUID(uuid.New().String()),
+			ImageCreateParams: &models.ImageCreateParams{},
 		})
-	}
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISONotFound()))
+	})
 
-	Context("when kube job is used as generator", func() {
-		BeforeEach(func() {
-			mockKubeJob = job.NewMockAPI(ctrl)
-			bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, mockKubeJob, mockEvents, mockS3Client, nil, getTestAuthHandler())
+	It("failed_to_download_base_iso", func() {
+		clusterId := registerCluster(true).ID
+		mockS3Client.EXPECT().Download(gomock.Any(), getBaseISOName(testRHCOSImage)).Return(nil, int64(0), errors.Errorf("error")).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityError, gomock.Any(), gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
 		})
-		RunGenerateClusterISOTests()
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusFailed))
 	})
 
-	Context("when local job is used as generator", func() {
-		BeforeEach(func() {
-			mockLocalJob = job.NewMockLocalJob(ctrl)
-			bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, mockLocalJob, mockEvents, mockS3Client, nil, getTestAuthHandler())
+	It("failed_to_upload_ignition_config", func() {
+		clusterId := registerCluster(true).ID
+		mockDownloadBaseISO(mockS3Client, testBaseISO(true), 1)
+		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), getImageIgnitionName(*clusterId)).Return(errors.Errorf("error")).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityError, gomock.Any(), gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
 		})
-		RunGenerateClusterISOTests()
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusFailed))
+	})
+
+	It("failed_missing_pull_secret", func() {
+		clusterId := registerCluster(false).ID
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
+		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOBadRequest()))
+	})
+
+	It("failed_base_iso_without_embed_area", func() {
+		clusterId := registerCluster(true).ID
+		mockDownloadBaseISO(mockS3Client, testBaseISO(false), 1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityError, gomock.Any(), gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
+		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusFailed))
+	})
+
+	It("requests share a build in progress", func() {
+		clusterId := registerClusterWithImageBuild("", models.ImageInfoBuildStatusRunning, time.Now()).ID
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
+		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Expect(getImageBuildStatus(*clusterId)()).Should(Equal(models.ImageInfoBuildStatusRunning))
+	})
+
+	It("build in progress with other parameters is replaced", func() {
+		clusterId := registerClusterWithImageBuild("", models.ImageInfoBuildStatusRunning, time.Now()).ID
+		mockBuildClusterISOSuccess(mockS3Client, 1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is set)", gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{SSHPublicKey: "ssh-rsa AAAA"},
+		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusUploaded))
+	})
+
+	It("replaced build does not upload its objects", func() {
+		cluster := registerClusterWithImageBuild("", models.ImageInfoBuildStatusRunning, time.Now())
+		staleCluster := *cluster
+		staleCluster.ImageInfo = &models.ImageInfo{
+			BuildStatus: models.ImageInfoBuildStatusRunning,
+			CreatedAt:   strfmt.DateTime(time.Now().Add(-time.Minute)),
+		}
+		mockDownloadBaseISO(mockS3Client, testBaseISO(true), 1)
+		bm.buildClusterISO(ctx, staleCluster)
+		Expect(getImageBuildStatus(*cluster.ID)()).Should(Equal(models.ImageInfoBuildStatusRunning))
+	})
+
+	It("lost build is built again", func() {
+		clusterId := registerClusterWithImageBuild("", models.ImageInfoBuildStatusRunning, time.Now().Add(-time.Hour)).ID
+		mockBuildClusterISOSuccess(mockS3Client, 1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
+		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusUploaded))
+	})
+
+	It("queued build is claimed by the image build queue", func() {
+		clusterId := registerClusterWithImageBuild("", models.ImageInfoBuildStatusQueued, time.Now()).ID
+		mockBuildClusterISOSuccess(mockS3Client, 1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
+		mockMetric.EXPECT().ImageBuildQueueDepth(1).Times(1)
+		bm.ImageBuildQueueMonitoring()
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusUploaded))
+	})
+
+	It("lost build is queued again by the image build queue", func() {
+		clusterId := registerClusterWithImageBuild("", models.ImageInfoBuildStatusRunning, time.Now().Add(-time.Hour)).ID
+		mockBuildClusterISOSuccess(mockS3Client, 1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
+		mockMetric.EXPECT().ImageBuildQueueDepth(0).Times(1)
+		bm.ImageBuildQueueMonitoring()
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusUploaded))
+	})
+
+	It("queued build waits while its organization runs the maximal number of builds", func() {
+		bm.MaxImageBuildsPerOrg = 1
+		registerClusterWithImageBuild("org1", models.ImageInfoBuildStatusRunning, time.Now())
+		clusterId := registerClusterWithImageBuild("org1", models.ImageInfoBuildStatusQueued, time.Now()).ID
+		mockMetric.EXPECT().ImageBuildQueueDepth(1).Times(1)
+		bm.ImageBuildQueueMonitoring()
+		Expect(getImageBuildStatus(*clusterId)()).Should(Equal(models.ImageInfoBuildStatusQueued))
+	})
+
+	It("queued build waits while the replica runs the maximal number of builds", func() {
+		bm.imageBuildsRunning = bm.MaxImageBuilds
+		clusterId := registerClusterWithImageBuild("", models.ImageInfoBuildStatusQueued, time.Now()).ID
+		mockMetric.EXPECT().ImageBuildQueueDepth(1).Times(1)
+		bm.ImageBuildQueueMonitoring()
+		Expect(getImageBuildStatus(*clusterId)()).Should(Equal(models.ImageInfoBuildStatusQueued))
+	})
+
+	It("failed build is built again", func() {
+		clusterId := registerClusterWithImageBuild("", models.ImageInfoBuildStatusFailed, time.Now()).ID
+		mockBuildClusterISOSuccess(mockS3Client, 1)
+		mockS3Client.EXPECT().GetObjectSizeBytes(gomock.Any(), gomock.Any()).Return(int64(100), nil).Times(1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), *clusterId, nil, models.EventSeverityInfo, "Generated image (proxy URL is \"\", SSH public key is not set)", gomock.Any())
+		generateReply := bm.GenerateClusterISO(ctx, installer.GenerateClusterISOParams{
+			ClusterID:         *clusterId,
+			ImageCreateParams: &models.ImageCreateParams{},
+		})
+		Expect(generateReply).Should(BeAssignableToTypeOf(installer.NewGenerateClusterISOAccepted()))
+		Eventually(getImageBuildStatus(*clusterId)).Should(Equal(models.ImageInfoBuildStatusUploaded))
+	})
+})
+
+var _ = Describe("DownloadClusterISO", func() {
+	var (
+		bm             *bareMetalInventory
+		cfg            Config
+		db             *gorm.DB
+		ctx            = context.Background()
+		ctrl           *gomock.Controller
+		mockEvents     *events.MockHandler
+		mockS3Client   *s3wrapper.MockAPI
+		clusterID      strfmt.UUID
+		ignitionConfig = `{"ignition":{"version":"3.1.0"}}`
+		dbName         = "download_cluster_iso"
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		ctrl = gomock.NewController(GinkgoT())
+		db = common.PrepareTestDB(dbName)
+		mockEvents = events.NewMockHandler(ctrl)
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, mockEvents, mockS3Client, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		clusterID = strfmt.UUID(uuid.New().String())
+		cluster := common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			OpenshiftVersion: "4.6",
+			ImageInfo:        &models.ImageInfo{BuildStatus: models.ImageInfoBuildStatusUploaded},
+		}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("streams the base ISO with the ignition config embedded", func() {
+		iso := testBaseISO(true)
+		ignitionName := getImageIgnitionName(clusterID)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), ignitionName).Return(true, nil)
+		mockS3Client.EXPECT().Download(gomock.Any(), ignitionName).
+			Return(ioutil.NopCloser(strings.NewReader(ignitionConfig)), int64(len(ignitionConfig)), nil)
+		mockDownloadBaseISO(mockS3Client, iso, 1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityInfo, "Started image download", gomock.Any())
+
+		reply := bm.DownloadClusterISO(ctx, installer.DownloadClusterISOParams{ClusterID: clusterID})
+		rec := httptest.NewRecorder()
+		reply.WriteResponse(rec, runtime.ByteStreamProducer())
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Content-Length")).To(Equal(strconv.Itoa(len(iso))))
+
+		expected, err := isoeditor.NewEmbedReader(ioutil.NopCloser(bytes.NewReader(iso)), []byte(ignitionConfig))
+		Expect(err).ShouldNot(HaveOccurred())
+		expectedISO, err := ioutil.ReadAll(expected)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rec.Body.Bytes()).To(Equal(expectedISO))
+	})
+
+	It("image not found", func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getImageIgnitionName(clusterID)).Return(false, nil)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityError, gomock.Any(), gomock.Any())
+		reply := bm.DownloadClusterISO(ctx, installer.DownloadClusterISOParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewDownloadClusterISONotFound()))
+	})
+
+	It("base ISO without embed area", func() {
+		ignitionName := getImageIgnitionName(clusterID)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), ignitionName).Return(true, nil)
+		mockS3Client.EXPECT().Download(gomock.Any(), ignitionName).
+			Return(ioutil.NopCloser(strings.NewReader(ignitionConfig)), int64(len(ignitionConfig)), nil)
+		mockDownloadBaseISO(mockS3Client, testBaseISO(false), 1)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityError,
+			"Failed to download image: error embedding the ignition config", gomock.Any())
+		reply := bm.DownloadClusterISO(ctx, installer.DownloadClusterISOParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewDownloadClusterISOInternalServerError()))
+	})
+})
+
+var _ = Describe("DownloadClusterBootArtifact", func() {
+	var (
+		bm             *bareMetalInventory
+		cfg            Config
+		db             *gorm.DB
+		ctx            = context.Background()
+		ctrl           *gomock.Controller
+		mockEvents     *events.MockHandler
+		mockS3Client   *s3wrapper.MockAPI
+		clusterID      strfmt.UUID
+		ignitionConfig = `{"ignition":{"version":"3.1.0"}}`
+		dbName         = "download_cluster_boot_artifact"
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		cfg.ServiceBaseURL = "https://assisted.example.com"
+		ctrl = gomock.NewController(GinkgoT())
+		db = common.PrepareTestDB(dbName)
+		mockEvents = events.NewMockHandler(ctrl)
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, mockEvents, mockS3Client, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		clusterID = strfmt.UUID(uuid.New().String())
+		cluster := common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			OpenshiftVersion: "4.6",
+			ImageInfo:        &models.ImageInfo{BuildStatus: models.ImageInfoBuildStatusUploaded},
+		}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	download := func(artifact string) *httptest.ResponseRecorder {
+		reply := bm.DownloadClusterBootArtifact(ctx, installer.DownloadClusterBootArtifactParams{ClusterID: clusterID, Artifact: artifact})
+		rec := httptest.NewRecorder()
+		reply.WriteResponse(rec, runtime.ByteStreamProducer())
+		return rec
+	}
+
+	mockDownload := func(objectName string, content []byte) {
+		mockS3Client.EXPECT().Download(gomock.Any(), objectName).
+			Return(ioutil.NopCloser(bytes.NewReader(content)), int64(len(content)), nil)
+	}
+
+	It("downloads the iPXE script", func() {
+		script := bm.formatIPXEScript(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OpenshiftVersion: "4.6"}}, "")
+		Expect(script).To(HavePrefix("#!ipxe\n"))
+		Expect(script).To(ContainSubstring(fmt.Sprintf(
+			"initrd --name initrd https://assisted.example.com/api/assisted-install/v1/clusters/%s/downloads/boot-artifacts?artifact=initrd\n",
+			clusterID)))
+		Expect(script).To(ContainSubstring(
+			"kernel https://assisted.example.com/api/assisted-install/v1/boot-artifacts/4.6?artifact=kernel "))
+		Expect(script).To(ContainSubstring(
+			"coreos.live.rootfs_url=https://assisted.example.com/api/assisted-install/v1/boot-artifacts/4.6?artifact=rootfs "))
+
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getIPXEScriptName(clusterID)).Return(true, nil)
+		rec := download("ipxe-script")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(Equal(script))
+	})
+
+	It("authenticates the initrd URL of the iPXE script with a token of the cluster when auth is enabled", func() {
+		_, jwkCert := auth.GetTokenAndCert()
+		authHandler := auth.NewAuthHandler(auth.Config{EnableAuth: true, JwkCert: string(jwkCert),
+			URLAuthSecret: "url_auth_secret", URLAuthExpiration: time.Hour}, nil, getTestLog())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, mockEvents, mockS3Client, nil, *authHandler, getTestVersionsHandler(), nil)
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Updates(map[string]interface{}{"user_name": "jdoe123@example.com", "org_id": "1010101"}).Error).ShouldNot(HaveOccurred())
+		authCtx := context.WithValue(ctx, restapi.AuthKey, &ocm.AuthPayload{Username: "jdoe123@example.com", IsAdmin: true})
+
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getIPXEScriptName(clusterID)).Return(true, nil)
+		reply := bm.DownloadClusterBootArtifact(authCtx, installer.DownloadClusterBootArtifactParams{ClusterID: clusterID, Artifact: "ipxe-script"})
+		rec := httptest.NewRecorder()
+		reply.WriteResponse(rec, runtime.ByteStreamProducer())
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		tokens := regexp.MustCompile(`artifact=(\w+)&api_key=([^ \n]+)`).FindAllStringSubmatch(rec.Body.String(), -1)
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0][1]).To(Equal("initrd"))
+		payload, err := authHandler.AuthURLAuth(tokens[0][2])
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(payload).To(Equal(&ocm.AuthPayload{ClusterID: clusterID.String(), Username: "jdoe123@example.com",
+			Organization: "1010101"}))
+	})
+
+	It("rejects the token of another cluster", func() {
+		tokenCtx := context.WithValue(ctx, restapi.AuthKey, &ocm.AuthPayload{ClusterID: uuid.New().String(), Username: "jdoe123@example.com"})
+		reply := bm.DownloadClusterBootArtifact(tokenCtx, installer.DownloadClusterBootArtifactParams{ClusterID: clusterID, Artifact: "initrd"})
+		rec := httptest.NewRecorder()
+		reply.WriteResponse(rec, runtime.ByteStreamProducer())
+		Expect(rec.Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("downloads the kernel and the rootfs of the base ISO of the OpenShift version", func() {
+		downloadBase := func(openshiftVersion, artifact string) *httptest.ResponseRecorder {
+			reply := bm.DownloadBaseBootArtifact(ctx, installer.DownloadBaseBootArtifactParams{OpenshiftVersion: openshiftVersion, Artifact: artifact})
+			rec := httptest.NewRecorder()
+			reply.WriteResponse(rec, runtime.ByteStreamProducer())
+			return rec
+		}
+		mockDownload(getBaseKernelName(testRHCOSImage), []byte("vmlinuz"))
+		mockDownload(getBaseRootfsName(testRHCOSImage), []byte("rootfs.img"))
+		Expect(downloadBase("4.6", "kernel").Body.String()).To(Equal("vmlinuz"))
+		Expect(downloadBase("4.6", "rootfs").Body.String()).To(Equal("rootfs.img"))
+		Expect(downloadBase("3.11", "kernel").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("downloads the initrd with the ignition config appended", func() {
+		initrd := []byte("initrd.img")
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getInitrdIgnitionName(clusterID)).Return(true, nil)
+		mockDownload(getInitrdIgnitionName(clusterID), []byte(ignitionConfig))
+		mockDownload(getBaseInitrdName(testRHCOSImage), initrd)
+		rec := download("initrd")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		expected, length, err := isoeditor.NewInitrdReader(ioutil.NopCloser(bytes.NewReader(initrd)), int64(len(initrd)), []byte(ignitionConfig))
+		Expect(err).ShouldNot(HaveOccurred())
+		expectedInitrd, err := ioutil.ReadAll(expected)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rec.Header().Get("Content-Length")).To(Equal(strconv.FormatInt(length, 10)))
+		Expect(rec.Body.Bytes()).To(Equal(expectedInitrd))
+	})
+
+	It("initrd expired", func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getInitrdIgnitionName(clusterID)).Return(false, nil)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, nil, models.EventSeverityError, gomock.Any(), gomock.Any())
+		Expect(download("initrd").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("image is being generated", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Update("image_build_status", models.ImageInfoBuildStatusQueued).Error).ShouldNot(HaveOccurred())
+		Expect(download("initrd").Code).To(Equal(http.StatusConflict))
+	})
+
+	It("minimal ISO is not available", func() {
+		Expect(download("minimal-iso").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("downloads the minimal ISO with the ignition config and the rootfs URL embedded", func() {
+		openshiftVersions, err := versions.ParseOpenshiftVersions("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		versionsHandler := versions.NewHandler(versions.Versions{RHCOSImage: testRHCOSImage,
+			RHCOSMinimalImage: "https://example.com/rhcos-minimal.iso"}, openshiftVersions)
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, mockEvents, mockS3Client, nil, getTestAuthHandler(), versionsHandler, nil)
+
+		iso := testBaseISO9660()
+		kargsAreas, err := isoeditor.ReadKargsAreas(bytes.NewReader(iso))
+		Expect(err).ShouldNot(HaveOccurred())
+		kargsJSON, err := json.Marshal(kargsAreas)
+		Expect(err).ShouldNot(HaveOccurred())
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getMinimalImageIgnitionName(clusterID)).Return(true, nil)
+		mockDownload(getMinimalImageIgnitionName(clusterID), []byte(ignitionConfig))
+		mockDownload(getBaseMinimalKargsName("https://example.com/rhcos-minimal.iso"), kargsJSON)
+		mockDownload(getBaseMinimalISOName("https://example.com/rhcos-minimal.iso"), iso)
+		rec := download("minimal-iso")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		rootfsKarg := "coreos.live.rootfs_url=https://assisted.example.com/api/assisted-install/v1/boot-artifacts/4.6?artifact=rootfs"
+		Expect(rec.Body.String()).To(ContainSubstring("append " + testKargsDefault + " " + rootfsKarg + "#"))
+		expected, err := isoeditor.NewEmbedKargsReader(ioutil.NopCloser(bytes.NewReader(iso)), []byte(ignitionConfig), kargsAreas, rootfsKarg)
+		Expect(err).ShouldNot(HaveOccurred())
+		expectedISO, err := ioutil.ReadAll(expected)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rec.Body.Bytes()).To(Equal(expectedISO))
+	})
+})
+
+var _ = Describe("UploadBaseISOs", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockS3Client *s3wrapper.MockAPI
+		server       *httptest.Server
+		iso          = testBaseISO9660()
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/rhcos-live.iso" && r.URL.Path != "/rhcos-minimal.iso" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(iso)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		ctrl.Finish()
+	})
+
+	expectUploadStream := func(objectName string, content []byte) {
+		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), objectName).DoAndReturn(
+			func(ctx context.Context, reader io.Reader, objectName string) error {
+				uploaded, err := ioutil.ReadAll(reader)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(uploaded).To(Equal(content))
+				return nil
+			})
+	}
+
+	expectUploadBaseObjects := func(rhcosImage string) {
+		for objectName, content := range map[string][]byte{
+			getBaseISOName(rhcosImage):    iso,
+			getBaseKernelName(rhcosImage): []byte("vmlinuz"),
+			getBaseInitrdName(rhcosImage): []byte("initrd.img"),
+			getBaseRootfsName(rhcosImage): []byte("rootfs.img"),
+		} {
+			mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), objectName).Return(false, nil)
+			expectUploadStream(objectName, content)
+		}
+	}
+
+	It("downloads and uploads a URL", func() {
+		expectUploadBaseObjects(server.URL + "/rhcos-live.iso")
+		Expect(UploadBaseISOs(getTestLog(), mockS3Client, []string{server.URL + "/rhcos-live.iso"}, nil)).To(Succeed())
+	})
+
+	It("uploads a file", func() {
+		file, err := ioutil.TempFile("", "rhcos-live")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.Remove(file.Name())
+		_, err = file.Write(iso)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+
+		expectUploadBaseObjects(file.Name())
+		Expect(UploadBaseISOs(getTestLog(), mockS3Client, []string{file.Name()}, nil)).To(Succeed())
+	})
+
+	It("uploads a minimal ISO with its kargs areas", func() {
+		rhcosMinimalImage := server.URL + "/rhcos-minimal.iso"
+		kargsAreas, err := isoeditor.ReadKargsAreas(bytes.NewReader(iso))
+		Expect(err).ShouldNot(HaveOccurred())
+		kargsJSON, err := json.Marshal(kargsAreas)
+		Expect(err).ShouldNot(HaveOccurred())
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getBaseMinimalISOName(rhcosMinimalImage)).Return(false, nil)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getBaseMinimalKargsName(rhcosMinimalImage)).Return(false, nil)
+		expectUploadStream(getBaseMinimalISOName(rhcosMinimalImage), iso)
+		expectUploadStream(getBaseMinimalKargsName(rhcosMinimalImage), kargsJSON)
+		Expect(UploadBaseISOs(getTestLog(), mockS3Client, nil, []string{rhcosMinimalImage})).To(Succeed())
+	})
+
+	It("fails to upload a minimal ISO without kargs areas", func() {
+		file, err := ioutil.TempFile("", "rhcos-minimal")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.Remove(file.Name())
+		_, err = file.Write(testBaseISO(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+
+		// none of the objects of the ISO is uploaded
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(2)
+		Expect(UploadBaseISOs(getTestLog(), mockS3Client, nil, []string{file.Name()})).ToNot(Succeed())
+	})
+
+	It("skips uploaded objects", func() {
+		rhcosImage := server.URL + "/rhcos-live.iso"
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getBaseISOName(rhcosImage)).Return(true, nil)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getBaseKernelName(rhcosImage)).Return(true, nil)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getBaseInitrdName(rhcosImage)).Return(false, nil)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), getBaseRootfsName(rhcosImage)).Return(true, nil)
+		expectUploadStream(getBaseInitrdName(rhcosImage), []byte("initrd.img"))
+		Expect(UploadBaseISOs(getTestLog(), mockS3Client, []string{rhcosImage}, nil)).To(Succeed())
+	})
+
+	It("names the objects of ISOs with the same file name differently", func() {
+		Expect(getBaseISOName("https://example.com/4.6/rhcos-live.iso")).
+			ToNot(Equal(getBaseISOName("https://example.com/4.7/rhcos-live.iso")))
+	})
+
+	It("uploads the ISOs with the leader", func() {
+		mockLeader := leader.NewMockElectorInterface(ctrl)
+		mockLeader.EXPECT().RunWithLeader(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, run func() error) error { return run() })
+		expectUploadBaseObjects(server.URL + "/rhcos-live.iso")
+		UploadBaseISOsWithLeader(getTestLog(), mockLeader, mockS3Client, []string{server.URL + "/rhcos-live.iso"}, nil)
+	})
+
+	It("fails to download a missing ISO", func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(4)
+		Expect(UploadBaseISOs(getTestLog(), mockS3Client, []string{server.URL + "/missing.iso"}, nil)).ToNot(Succeed())
+	})
+
+	It("fails to upload the files of an ISO without a file system", func() {
+		file, err := ioutil.TempFile("", "rhcos-live")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.Remove(file.Name())
+		_, err = file.Write(testBaseISO(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(4)
+		Expect(UploadBaseISOs(getTestLog(), mockS3Client, []string{file.Name()}, nil)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("selectImageBuilds", func() {
+	queuedBuild := func(orgID string) *common.Cluster {
+		clusterID := strfmt.UUID(uuid.New().String())
+		return &common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: orgID}}
+	}
+
+	It("selects the oldest builds of the organizations with the fewest running builds", func() {
+		a1, a2, b1, c1 := queuedBuild("a"), queuedBuild("a"), queuedBuild("b"), queuedBuild("c")
+		selected := selectImageBuilds([]*common.Cluster{a1, a2, b1, c1}, map[string]int{"b": 1}, 3, 0)
+		Expect(selected).To(Equal([]*common.Cluster{a1, c1, a2}))
+	})
+
+	It("skips the organizations that run the maximal number of builds", func() {
+		a1, a2, b1 := queuedBuild("a"), queuedBuild("a"), queuedBuild("b")
+		selected := selectImageBuilds([]*common.Cluster{a1, a2, b1}, map[string]int{"b": 2}, 5, 2)
+		Expect(selected).To(Equal([]*common.Cluster{a1, a2}))
+	})
+
+	It("selects nothing from an empty queue", func() {
+		Expect(selectImageBuilds(nil, map[string]int{}, 5, 2)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("computeImageSettingsHash", func() {
+	hash := func(httpProxy, httpsProxy, noProxy, imageContentSources, additionalTrustBundle *string) string {
+		h, err := computeImageSettingsHash(httpProxy, httpsProxy, noProxy, imageContentSources, additionalTrustBundle)
+		Expect(err).ShouldNot(HaveOccurred())
+		return h
+	}
+
+	It("changes when a value moves to another setting", func() {
+		Expect(hash(swag.String("http://proxy"), nil, swag.String(".example.com"), nil, nil)).
+			NotTo(Equal(hash(nil, swag.String("http://proxy"), swag.String(".example.com"), nil, nil)))
+		Expect(hash(swag.String("http://proxy"), nil, nil, nil, nil)).
+			NotTo(Equal(hash(swag.String("http://"), swag.String("proxy"), nil, nil, nil)))
+	})
+
+	It("hashes a missing setting as an empty one", func() {
+		Expect(hash(nil, nil, nil, nil, nil)).To(Equal(hash(swag.String(""), swag.String(""), swag.String(""), swag.String(""), swag.String(""))))
 	})
 })
 
@@ -383,6 +998,49 @@ var _ = Describe("IgnitionParameters", func() {
 			Expect(err).Should(BeNil())
 			Expect(text).Should(ContainSubstring(`"proxy": { "httpProxy": "http://10.10.1.1:3128", "noProxy": ["quay.io"] }`))
 		})
+
+		It("ignition_file_contains_registries_conf_and_trust_bundle", func() {
+			disconnectedCluster := cluster
+			disconnectedCluster.ImageContentSources = "- mirrors:\n  - mirror.example.com:5000/ocp4\n  source: quay.io/openshift-release-dev/ocp-release\n"
+			disconnectedCluster.AdditionalTrustBundle = redhatRootCA
+			text, err := bm.formatIgnitionFile(&disconnectedCluster, installer.GenerateClusterISOParams{
+				ImageCreateParams: &models.ImageCreateParams{},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(text).Should(ContainSubstring(`"path": "/etc/containers/registries.conf.d/99-assisted-mirrors.conf"`))
+			Expect(text).Should(ContainSubstring(url.PathEscape(`location = "mirror.example.com:5000/ocp4"`)))
+			Expect(text).Should(ContainSubstring(`"path": "/etc/pki/ca-trust/source/anchors/assisted-additional-ca.crt"`))
+			var ignition map[string]interface{}
+			Expect(json.Unmarshal([]byte(text), &ignition)).ShouldNot(HaveOccurred())
+		})
+
+		It("ignition_file_without_registries_conf_and_trust_bundle", func() {
+			text, err := bm.formatIgnitionFile(&cluster, installer.GenerateClusterISOParams{
+				ImageCreateParams: &models.ImageCreateParams{},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(text).ShouldNot(ContainSubstring("/etc/containers/registries.conf.d"))
+			Expect(text).ShouldNot(ContainSubstring("assisted-additional-ca.crt"))
+		})
+
+		It("ignition_file_contains_static_network_config", func() {
+			text, err := bm.formatIgnitionFile(&cluster, installer.GenerateClusterISOParams{
+				ImageCreateParams: &models.ImageCreateParams{
+					StaticNetworkConfig: []*models.HostStaticNetworkConfig{{
+						MacAddress:  swag.String("52:54:00:aa:bb:01"),
+						NetworkYaml: swag.String("interfaces:\n- name: eth0\n  type: ethernet\n  ipv4:\n    enabled: true\n    dhcp: true\n"),
+					}},
+				},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(text).Should(ContainSubstring(`"path": "/etc/NetworkManager/system-connections/525400aabb01.nmconnection"`))
+			Expect(text).Should(ContainSubstring("mac-address=52:54:00:aa:bb:01"))
+			var ignition map[string]interface{}
+			Expect(json.Unmarshal([]byte(text), &ignition)).ShouldNot(HaveOccurred())
+		})
 	}
 
 	Context("start with clean configuration", func() {
@@ -414,7 +1072,7 @@ var _ = Describe("RegisterHost", func() {
 		mockEventsHandler = events.NewMockHandler(ctrl)
 		hostID = strfmt.UUID(uuid.New().String())
 		db = common.PrepareTestDB(dbName)
-		bm = NewBareMetalInventory(db, getTestLog(), mockHostAPI, mockClusterAPI, cfg, nil, mockEventsHandler, nil, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), mockHostAPI, mockClusterAPI, cfg, nil, mockEventsHandler, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 	})
 
 	AfterEach(func() {
@@ -452,7 +1110,7 @@ var _ = Describe("RegisterHost", func() {
 				Expect(h.Role).Should(Equal(models.HostRoleAutoAssign))
 				return nil
 			}).Times(1)
-		mockHostAPI.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockHostAPI.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockEventsHandler.EXPECT().
 			AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any()).
 			Times(1)
@@ -491,7 +1149,7 @@ var _ = Describe("GetNextSteps", func() {
 		mockHostApi = host.NewMockAPI(ctrl)
 		mockEvents = events.NewMockHandler(ctrl)
 		mockJob = job.NewMockAPI(ctrl)
-		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, nil, cfg, mockJob, mockEvents, nil, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, nil, cfg, mockJob, mockEvents, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 	})
 
 	AfterEach(func() {
@@ -538,7 +1196,7 @@ var _ = Describe("GetNextSteps", func() {
 	})
 })
 
-func makeFreeAddresses(network string, ips ...strfmt.IPv4) *models.FreeNetworkAddresses {
+func makeFreeAddresses(network string, ips ...string) *models.FreeNetworkAddresses {
 	return &models.FreeNetworkAddresses{
 		FreeAddresses: ips,
 		Network:       network,
@@ -578,7 +1236,7 @@ var _ = Describe("PostStepReply", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockJob = job.NewMockAPI(ctrl)
 		mockClusterApi = cluster.NewMockAPI(ctrl)
-		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, mockClusterApi, cfg, mockJob, mockEvents, nil, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, mockClusterApi, cfg, mockJob, mockEvents, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 	})
 
 	AfterEach(func() {
@@ -773,7 +1431,7 @@ var _ = Describe("GetFreeAddresses", func() {
 		mockHostApi = host.NewMockAPI(ctrl)
 		mockEvents = events.NewMockHandler(ctrl)
 		mockJob = job.NewMockAPI(ctrl)
-		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, nil, cfg, mockJob, mockEvents, nil, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, nil, cfg, mockJob, mockEvents, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 	})
 
 	AfterEach(func() {
@@ -809,9 +1467,9 @@ var _ = Describe("GetFreeAddresses", func() {
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetFreeAddressesOK()))
 		actualReply := reply.(*installer.GetFreeAddressesOK)
 		Expect(len(actualReply.Payload)).To(Equal(3))
-		Expect(actualReply.Payload[0]).To(Equal(strfmt.IPv4("10.0.9.250")))
-		Expect(actualReply.Payload[1]).To(Equal(strfmt.IPv4("10.0.10.1")))
-		Expect(actualReply.Payload[2]).To(Equal(strfmt.IPv4("10.0.20.0")))
+		Expect(actualReply.Payload[0]).To(Equal("10.0.9.250"))
+		Expect(actualReply.Payload[1]).To(Equal("10.0.10.1"))
+		Expect(actualReply.Payload[2]).To(Equal("10.0.20.0"))
 	})
 
 	It("success with limit", func() {
@@ -824,8 +1482,8 @@ var _ = Describe("GetFreeAddresses", func() {
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetFreeAddressesOK()))
 		actualReply := reply.(*installer.GetFreeAddressesOK)
 		Expect(len(actualReply.Payload)).To(Equal(2))
-		Expect(actualReply.Payload[0]).To(Equal(strfmt.IPv4("10.0.9.250")))
-		Expect(actualReply.Payload[1]).To(Equal(strfmt.IPv4("10.0.10.1")))
+		Expect(actualReply.Payload[0]).To(Equal("10.0.9.250"))
+		Expect(actualReply.Payload[1]).To(Equal("10.0.10.1"))
 	})
 
 	It("success with limit and prefix", func() {
@@ -839,8 +1497,8 @@ var _ = Describe("GetFreeAddresses", func() {
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetFreeAddressesOK()))
 		actualReply := reply.(*installer.GetFreeAddressesOK)
 		Expect(len(actualReply.Payload)).To(Equal(2))
-		Expect(actualReply.Payload[0]).To(Equal(strfmt.IPv4("10.0.1.0")))
-		Expect(actualReply.Payload[1]).To(Equal(strfmt.IPv4("10.0.10.1")))
+		Expect(actualReply.Payload[0]).To(Equal("10.0.1.0"))
+		Expect(actualReply.Payload[1]).To(Equal("10.0.10.1"))
 	})
 
 	It("one disconnected", func() {
@@ -854,7 +1512,7 @@ var _ = Describe("GetFreeAddresses", func() {
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetFreeAddressesOK()))
 		actualReply := reply.(*installer.GetFreeAddressesOK)
 		Expect(len(actualReply.Payload)).To(Equal(1))
-		Expect(actualReply.Payload).To(ContainElement(strfmt.IPv4("10.0.0.0")))
+		Expect(actualReply.Payload).To(ContainElement("10.0.0.0"))
 	})
 
 	It("empty result", func() {
@@ -885,7 +1543,7 @@ var _ = Describe("GetFreeAddresses", func() {
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetFreeAddressesOK()))
 		actualReply := reply.(*installer.GetFreeAddressesOK)
 		Expect(len(actualReply.Payload)).To(Equal(1))
-		Expect(actualReply.Payload).To(ContainElement(strfmt.IPv4("10.0.0.0")))
+		Expect(actualReply.Payload).To(ContainElement("10.0.0.0"))
 	})
 
 	It("no matching  hosts", func() {
@@ -922,7 +1580,7 @@ var _ = Describe("UpdateHostInstallProgress", func() {
 		mockHostApi = host.NewMockAPI(ctrl)
 		mockEvents = events.NewMockHandler(ctrl)
 		mockJob = job.NewMockAPI(ctrl)
-		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, nil, cfg, mockJob, mockEvents, nil, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, nil, cfg, mockJob, mockEvents, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 		defaultProgressStage = "some progress"
 	})
 
@@ -1120,6 +1778,13 @@ var _ = Describe("cluster", func() {
 		mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		mockClusterApi.EXPECT().ResetCluster(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.NewApiError(http.StatusInternalServerError, nil)).Times(1)
 	}
+	setRetryInstallationSuccess := func() {
+		mockClusterApi.EXPECT().RetryInstallation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockHostApi.EXPECT().RetryInstallation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	}
+	setRetryInstallationConflict := func() {
+		mockClusterApi.EXPECT().RetryInstallation(gomock.Any(), gomock.Any(), gomock.Any()).Return(common.NewApiError(http.StatusConflict, nil)).Times(1)
+	}
 	mockAutoAssignFailed := func() {
 		mockHostApi.EXPECT().AutoAssignRole(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(errors.Errorf("")).Times(1)
@@ -1181,7 +1846,7 @@ var _ = Describe("cluster", func() {
 				})
 
 				It("GetCluster", func() {
-					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(3) // Number of hosts
+					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3) // Number of hosts
 					reply := bm.GetCluster(ctx, installer.GetClusterParams{
 						ClusterID: clusterID,
 					})
@@ -1308,6 +1973,52 @@ var _ = Describe("cluster", func() {
 				})
 			})
 
+			Context("Update disconnected settings", func() {
+				const imageContentSources = "- mirrors:\n  - mirror.example.com:5000/ocp4\n  source: quay.io/openshift-release-dev/ocp-release\n"
+				BeforeEach(func() {
+					clusterID = strfmt.UUID(uuid.New().String())
+					err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+
+				It("set valid mirrors and trust bundle", func() {
+					mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+					mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ImageContentSources:   swag.String(imageContentSources),
+							AdditionalTrustBundle: swag.String(redhatRootCA),
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+					var cluster common.Cluster
+					Expect(db.First(&cluster, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+					Expect(cluster.ImageContentSources).To(Equal(imageContentSources))
+					Expect(cluster.AdditionalTrustBundle).To(Equal(redhatRootCA))
+				})
+
+				It("invalid mirrors", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							ImageContentSources: swag.String("- mirrors: mirror.example.com"),
+						},
+					})
+					verifyApiError(reply, http.StatusBadRequest)
+				})
+
+				It("invalid trust bundle", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							AdditionalTrustBundle: swag.String("not a certificate"),
+						},
+					})
+					verifyApiError(reply, http.StatusBadRequest)
+				})
+			})
+
 			Context("Hostname", func() {
 				BeforeEach(func() {
 					clusterID = strfmt.UUID(uuid.New().String())
@@ -1324,7 +2035,7 @@ var _ = Describe("cluster", func() {
 				It("Valid hostname", func() {
 					mockHostApi.EXPECT().UpdateHostname(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 					mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 					mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Cluster{}, nil).Times(1)
 					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
 						ClusterID: clusterID,
@@ -1341,7 +2052,7 @@ var _ = Describe("cluster", func() {
 				It("Valid splitted hostname", func() {
 					mockHostApi.EXPECT().UpdateHostname(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 					mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 					mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Cluster{}, nil).Times(1)
 					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
 						ClusterID: clusterID,
@@ -1396,6 +2107,63 @@ var _ = Describe("cluster", func() {
 				})
 			})
 
+			Context("Installation disk", func() {
+				BeforeEach(func() {
+					clusterID = strfmt.UUID(uuid.New().String())
+					err := db.Create(&common.Cluster{Cluster: models.Cluster{
+						ID: &clusterID,
+					}}).Error
+					Expect(err).ShouldNot(HaveOccurred())
+					addHost(masterHostId1, models.HostRoleMaster, "known", clusterID, getInventoryStr("1.2.3.4/24", "10.11.50.90/16"), db)
+					mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				})
+				It("Valid installation disk", func() {
+					mockHostApi.EXPECT().UpdateInstallationDisk(gomock.Any(), gomock.Any(), "/dev/sdb", gomock.Any()).Return(nil).Times(1)
+					mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Cluster{}, nil).Times(1)
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							HostsInstallationDisks: []*models.ClusterUpdateParamsHostsInstallationDisksItems0{
+								{
+									InstallationDiskID: "/dev/sdb",
+									ID:                 masterHostId1,
+								},
+							},
+						}})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+				})
+				It("Invalid installation disk", func() {
+					mockHostApi.EXPECT().UpdateInstallationDisk(gomock.Any(), gomock.Any(), "/dev/sdz", gomock.Any()).
+						Return(common.NewApiError(http.StatusBadRequest, errors.New("invalid disk"))).Times(1)
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							HostsInstallationDisks: []*models.ClusterUpdateParamsHostsInstallationDisksItems0{
+								{
+									InstallationDiskID: "/dev/sdz",
+									ID:                 masterHostId1,
+								},
+							},
+						}})
+					verifyApiError(reply, http.StatusBadRequest)
+				})
+				It("Unknown host", func() {
+					reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.ClusterUpdateParams{
+							HostsInstallationDisks: []*models.ClusterUpdateParamsHostsInstallationDisksItems0{
+								{
+									InstallationDiskID: "/dev/sdb",
+									ID:                 strfmt.UUID(uuid.New().String()),
+								},
+							},
+						}})
+					verifyApiError(reply, http.StatusNotFound)
+				})
+			})
+
 			Context("Update Network", func() {
 				BeforeEach(func() {
 					clusterID = strfmt.UUID(uuid.New().String())
@@ -1453,7 +2221,7 @@ var _ = Describe("cluster", func() {
 					It("Update success", func() {
 						apiVip := "10.11.12.15"
 						ingressVip := "10.11.12.16"
-						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(3) // Number of hosts
+						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3) // Number of hosts
 						mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
 						mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
@@ -1518,7 +2286,7 @@ var _ = Describe("cluster", func() {
 					It("Update success", func() {
 						apiVip := "10.11.12.15"
 						ingressVip := "10.11.12.16"
-						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(3) // Number of hosts
+						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3) // Number of hosts
 						mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
 						mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
@@ -1619,7 +2387,7 @@ var _ = Describe("cluster", func() {
 					It("OK", func() {
 						apiVip := "10.11.12.15"
 						ingressVip := "10.11.12.16"
-						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(3) // Number of hosts
+						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3) // Number of hosts
 						mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
 						mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
@@ -1651,6 +2419,124 @@ var _ = Describe("cluster", func() {
 						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
 					})
 				})
+				Context("IPv6", func() {
+					BeforeEach(func() {
+						for i, hostID := range []strfmt.UUID{masterHostId1, masterHostId2, masterHostId3} {
+							inventory := models.Inventory{
+								Interfaces: []*models.Interface{
+									{
+										IPV4Addresses: []string{fmt.Sprintf("1.2.3.%d/24", i+4)},
+										IPV6Addresses: []string{fmt.Sprintf("fd2e:6f44:5dd8:c956::%d/120", i+4)},
+										MacAddress:    "some MAC address",
+									},
+								},
+								Hostname: fmt.Sprintf("hostname%d", i),
+							}
+							b, err := json.Marshal(&inventory)
+							Expect(err).ShouldNot(HaveOccurred())
+							Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).UpdateColumn("inventory", string(b)).Error).ShouldNot(HaveOccurred())
+						}
+					})
+
+					It("IPv6 only", func() {
+						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+						mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+						mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:                   swag.String("fd2e:6f44:5dd8:c956::16"),
+								IngressVip:               swag.String("fd2e:6f44:5dd8:c956::17"),
+								ClusterNetworkCidr:       swag.String("fd01::/48"),
+								ClusterNetworkHostPrefix: swag.Int64(64),
+								ServiceNetworkCidr:       swag.String("fd02::/112"),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+						actual := reply.(*installer.UpdateClusterCreated)
+						Expect(actual.Payload.MachineNetworkCidr).To(Equal("fd2e:6f44:5dd8:c956::/120"))
+					})
+
+					It("IPv6 VIPs with IPv4 cluster network", func() {
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:             swag.String("fd2e:6f44:5dd8:c956::16"),
+								IngressVip:         swag.String("fd2e:6f44:5dd8:c956::17"),
+								ClusterNetworkCidr: swag.String("10.128.0.0/14"),
+								ServiceNetworkCidr: swag.String("172.30.0.0/16"),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+
+					It("Dual stack", func() {
+						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+						mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+						mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:                      swag.String("1.2.3.100"),
+								IngressVip:                  swag.String("1.2.3.101"),
+								ClusterNetworkCidr:          swag.String("10.128.0.0/14"),
+								ServiceNetworkCidr:          swag.String("172.30.0.0/16"),
+								SecondaryMachineNetworkCidr: swag.String("fd2e:6f44:5dd8:c956::/120"),
+								SecondaryClusterNetworkCidr: swag.String("fd01::/48"),
+								SecondaryServiceNetworkCidr: swag.String("fd02::/112"),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+						actual := reply.(*installer.UpdateClusterCreated)
+						Expect(actual.Payload.MachineNetworkCidr).To(Equal("1.2.3.0/24"))
+						Expect(actual.Payload.SecondaryMachineNetworkCidr).To(Equal("fd2e:6f44:5dd8:c956::/120"))
+						Expect(actual.Payload.SecondaryClusterNetworkHostPrefix).To(Equal(DefaultIPv6ClusterNetworkHostPrefix))
+					})
+
+					It("Partial dual stack", func() {
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:                      swag.String("1.2.3.100"),
+								IngressVip:                  swag.String("1.2.3.101"),
+								ClusterNetworkCidr:          swag.String("10.128.0.0/14"),
+								ServiceNetworkCidr:          swag.String("172.30.0.0/16"),
+								SecondaryMachineNetworkCidr: swag.String("fd2e:6f44:5dd8:c956::/120"),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+
+					It("IPv6 with OpenShiftSDN network type", func() {
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:                   swag.String("fd2e:6f44:5dd8:c956::16"),
+								IngressVip:               swag.String("fd2e:6f44:5dd8:c956::17"),
+								ClusterNetworkCidr:       swag.String("fd01::/48"),
+								ClusterNetworkHostPrefix: swag.Int64(64),
+								ServiceNetworkCidr:       swag.String("fd02::/112"),
+								NetworkType:              swag.String(models.ClusterNetworkTypeOpenShiftSDN),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+
+					It("IPv6 machine network in DHCP", func() {
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								MachineNetworkCidr: swag.String("fd2e:6f44:5dd8:c956::/120"),
+								VipDhcpAllocation:  swag.Bool(true),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+				})
 				Context("DHCP", func() {
 					It("Vips in DHCP", func() {
 						apiVip := "10.11.12.15"
@@ -1671,7 +2557,7 @@ var _ = Describe("cluster", func() {
 					It("Success in DHCP", func() {
 						apiVip := "10.11.12.15"
 						ingressVip := "10.11.12.16"
-						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(9)
+						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(9)
 						mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(9)
 						mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
 						mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(2)
@@ -1755,6 +2641,111 @@ var _ = Describe("cluster", func() {
 					})
 
 				})
+				Context("Auto assign VIPs", func() {
+					It("Vips with auto assign VIPs", func() {
+						apiVip := "10.11.12.15"
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:         &apiVip,
+								AutoAssignVips: swag.Bool(true),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+					It("Vips of a cluster with auto assign VIPs", func() {
+						Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+							Update("auto_assign_vips", true).Error).ShouldNot(HaveOccurred())
+						ingressVip := "10.11.12.16"
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								IngressVip: &ingressVip,
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+				})
+				Context("User managed networking", func() {
+					It("Vips with user managed networking", func() {
+						apiVip := "10.11.12.15"
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:                &apiVip,
+								UserManagedNetworking: swag.Bool(true),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+					It("DHCP with user managed networking", func() {
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								VipDhcpAllocation:     swag.Bool(true),
+								UserManagedNetworking: swag.Bool(true),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+					It("Success with user managed networking", func() {
+						apiVip := "10.11.12.15"
+						ingressVip := "10.11.12.16"
+						mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(9)
+						mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(9)
+						mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+						mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(2)
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:     &apiVip,
+								IngressVip: &ingressVip,
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+						reply = bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								MachineNetworkCidr:    swag.String("1.2.3.0/24"),
+								UserManagedNetworking: swag.Bool(true),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+						actual := reply.(*installer.UpdateClusterCreated)
+						Expect(swag.BoolValue(actual.Payload.UserManagedNetworking)).To(BeTrue())
+						Expect(actual.Payload.APIVip).To(BeEmpty())
+						Expect(actual.Payload.IngressVip).To(BeEmpty())
+						Expect(actual.Payload.MachineNetworkCidr).To(Equal("1.2.3.0/24"))
+						reply = bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								APIVip:                &apiVip,
+								IngressVip:            &ingressVip,
+								UserManagedNetworking: swag.Bool(false),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateClusterCreated()))
+						actual = reply.(*installer.UpdateClusterCreated)
+						Expect(swag.BoolValue(actual.Payload.UserManagedNetworking)).To(BeFalse())
+						Expect(actual.Payload.APIVip).To(Equal(apiVip))
+						Expect(actual.Payload.MachineNetworkCidr).To(Equal("10.11.0.0/16"))
+					})
+					It("User managed networking non existent network", func() {
+						reply := bm.UpdateCluster(ctx, installer.UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.ClusterUpdateParams{
+								MachineNetworkCidr:    swag.String("10.13.0.0/16"),
+								UserManagedNetworking: swag.Bool(true),
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+						Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+					})
+				})
 			})
 		})
 
@@ -2003,33 +2994,73 @@ var _ = Describe("cluster", func() {
 				waitForDoneChannel()
 			})
 
+			addManagedDomain := func(name, zoneID, provider, orgID string) {
+				Expect(db.Create(&models.ManagedDomain{
+					ID:       strfmt.UUID(uuid.New().String()),
+					Domain:   name,
+					ZoneID:   zoneID,
+					Provider: provider,
+					OrgID:    orgID,
+				}).Error).ShouldNot(HaveOccurred())
+			}
 			It("get DNS domain success", func() {
-				bm.Config.BaseDNSDomains = map[string]string{
-					"dns.example.com": "abc/route53",
-				}
-				dnsDomain, err := bm.getDNSDomain("test-cluster", "dns.example.com")
+				addManagedDomain("dns.example.com", "abc", "route53", "")
+				dnsDomain, err := bm.getDNSDomain("", "test-cluster", "dns.example.com")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dnsDomain.ID).Should(Equal("abc"))
 				Expect(dnsDomain.Provider).Should(Equal("route53"))
 				Expect(dnsDomain.APIDomainName).Should(Equal("api.test-cluster.dns.example.com"))
 				Expect(dnsDomain.IngressDomainName).Should(Equal("*.apps.test-cluster.dns.example.com"))
 			})
-			It("get DNS domain invalid", func() {
-				bm.Config.BaseDNSDomains = map[string]string{
-					"dns.example.com": "abc",
-				}
-				_, err := bm.getDNSDomain("test-cluster", "dns.example.com")
-				Expect(err).To(HaveOccurred())
+			It("get DNS domain rfc2136", func() {
+				Expect(db.Create(&models.ManagedDomain{
+					ID:       strfmt.UUID(uuid.New().String()),
+					Domain:   "dns.example.com",
+					ZoneID:   "example.com",
+					Provider: "rfc2136",
+					Server:   "ns1.example.com:53",
+				}).Error).ShouldNot(HaveOccurred())
+				dnsDomain, err := bm.getDNSDomain("", "test-cluster", "dns.example.com")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dnsDomain.ID).Should(Equal("example.com"))
+				Expect(dnsDomain.Provider).Should(Equal("rfc2136"))
+				Expect(dnsDomain.Server).Should(Equal("ns1.example.com:53"))
+			})
+			It("get DNS domain of the organization", func() {
+				addManagedDomain("dns.example.com", "abc", "route53", "org1")
+				dnsDomain, err := bm.getDNSDomain("org1", "test-cluster", "dns.example.com")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dnsDomain.ID).Should(Equal("abc"))
+				dnsDomain, err = bm.getDNSDomain("org2", "test-cluster", "dns.example.com")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dnsDomain).Should(BeNil())
+			})
+			It("get DNS domain unsupported provider", func() {
+				addManagedDomain("dns.example.com", "abc", "unknown", "")
+				dnsDomain, err := bm.getDNSDomain("", "test-cluster", "dns.example.com")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dnsDomain).Should(BeNil())
 			})
 			It("get DNS domain undefined", func() {
-				dnsDomain, err := bm.getDNSDomain("test-cluster", "dns.example.com")
+				dnsDomain, err := bm.getDNSDomain("", "test-cluster", "dns.example.com")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(dnsDomain).Should(BeNil())
 			})
+			It("skips the DNS records of a single node cluster on a managed domain", func() {
+				addManagedDomain("dns.example.com", "abc", "route53", "")
+				cluster := common.Cluster{Cluster: models.Cluster{
+					ID:                   &clusterID,
+					Name:                 "test-cluster",
+					BaseDNSDomain:        "dns.example.com",
+					HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeNone),
+				}}
+				Expect(bm.changeDNSRecordSets(ctx, cluster, false)).To(Succeed())
+				Expect(bm.changeDNSRecordSets(ctx, cluster, true)).To(Succeed())
+			})
 
 			Context("CancelInstallation", func() {
 				BeforeEach(func() {
-					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				})
 				It("cancel installation success", func() {
 					setCancelInstallationSuccess()
@@ -2061,7 +3092,7 @@ var _ = Describe("cluster", func() {
 
 			Context("reset cluster", func() {
 				BeforeEach(func() {
-					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+					mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				})
 				It("reset installation success", func() {
 					setResetClusterSuccess()
@@ -2091,6 +3122,32 @@ var _ = Describe("cluster", func() {
 				})
 			})
 
+			Context("retry installation", func() {
+				It("retry installation success", func() {
+					setRetryInstallationSuccess()
+
+					retryReply := bm.RetryInstallation(ctx, installer.RetryInstallationParams{
+						ClusterID: clusterID,
+					})
+					Expect(retryReply).Should(BeAssignableToTypeOf(installer.NewRetryInstallationAccepted()))
+				})
+				It("retry installation conflict", func() {
+					setRetryInstallationConflict()
+
+					retryReply := bm.RetryInstallation(ctx, installer.RetryInstallationParams{
+						ClusterID: clusterID,
+					})
+
+					verifyApiError(retryReply, http.StatusConflict)
+				})
+				It("retry installation of unknown cluster", func() {
+					retryReply := bm.RetryInstallation(ctx, installer.RetryInstallationParams{
+						ClusterID: strfmt.UUID(uuid.New().String()),
+					})
+					Expect(retryReply).Should(BeAssignableToTypeOf(installer.NewRetryInstallationNotFound()))
+				})
+			})
+
 			Context("complete installation", func() {
 				success := true
 				errorInfo := "dummy"
@@ -2114,26 +3171,204 @@ var _ = Describe("cluster", func() {
 				})
 			})
 
-			AfterEach(func() {
-				close(DoneChannel)
-			})
+			AfterEach(func() {
+				close(DoneChannel)
+			})
+		})
+	}
+
+	Context("when kube job is used as generator", func() {
+		BeforeEach(func() {
+			mockKubeJob = job.NewMockAPI(ctrl)
+			bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, mockClusterApi, cfg, mockKubeJob, mockEvents, mockS3Client, mockMetric, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		})
+		RunClusterTests()
+	})
+
+	Context("when local job is used as generator", func() {
+		BeforeEach(func() {
+			mockLocalJob = job.NewMockLocalJob(ctrl)
+			bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, mockClusterApi, cfg, mockLocalJob, mockEvents, mockS3Client, mockMetric, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		})
+		RunClusterTests()
+	})
+})
+
+var _ = Describe("Cluster manifests", func() {
+
+	var (
+		bm           *bareMetalInventory
+		cfg          Config
+		db           *gorm.DB
+		ctx          = context.Background()
+		ctrl         *gomock.Controller
+		mockS3Client *s3wrapper.MockAPI
+		clusterID    strfmt.UUID
+		clusterApi   cluster.API
+		dbName       = "cluster_manifests"
+		fileName     = "99-test.yaml"
+		content      = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n"
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		ctrl = gomock.NewController(GinkgoT())
+		db = common.PrepareTestDB(dbName)
+		clusterID = strfmt.UUID(uuid.New().String())
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		clusterApi = cluster.NewManager(cluster.Config{}, getTestLog().WithField("pkg", "cluster-monitor"),
+			db, nil, nil, nil, nil, getTestVersionsHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, clusterApi, cfg, nil, nil, mockS3Client, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		err := db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:     &clusterID,
+			Status: swag.String(models.ClusterStatusInsufficient),
+		}}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createManifest := func(folder *string, name, encodedContent string) middleware.Responder {
+		return bm.CreateClusterManifest(ctx, installer.CreateClusterManifestParams{
+			ClusterID: clusterID,
+			CreateManifestParams: &models.CreateManifestParams{
+				Folder:   folder,
+				FileName: swag.String(name),
+				Content:  swag.String(encodedContent),
+			},
 		})
 	}
 
-	Context("when kube job is used as generator", func() {
-		BeforeEach(func() {
-			mockKubeJob = job.NewMockAPI(ctrl)
-			bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, mockClusterApi, cfg, mockKubeJob, mockEvents, mockS3Client, mockMetric, getTestAuthHandler())
+	It("create a manifest in the default folder", func() {
+		objectName := fmt.Sprintf("%s/manifests/manifests/%s", clusterID, fileName)
+		mockS3Client.EXPECT().Upload(ctx, []byte(content), objectName).Return(nil).Times(1)
+		reply := createManifest(nil, fileName, base64.StdEncoding.EncodeToString([]byte(content)))
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewCreateClusterManifestCreated()))
+		Expect(*reply.(*installer.CreateClusterManifestCreated).Payload).Should(Equal(
+			models.Manifest{Folder: models.ManifestFolderManifests, FileName: fileName}))
+	})
+
+	It("create a manifest that is not base64 encoded", func() {
+		reply := createManifest(swag.String(models.ManifestFolderOpenshift), fileName, content)
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("create a manifest with an invalid file name", func() {
+		reply := createManifest(swag.String(models.ManifestFolderOpenshift), "99-test.txt",
+			base64.StdEncoding.EncodeToString([]byte(content)))
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("create a manifest of an installing cluster", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Update("status", models.ClusterStatusInstalling).Error).ShouldNot(HaveOccurred())
+		reply := createManifest(nil, fileName, base64.StdEncoding.EncodeToString([]byte(content)))
+		verifyApiError(reply, http.StatusConflict)
+	})
+
+	It("list the manifests", func() {
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, fmt.Sprintf("%s/manifests/", clusterID)).
+			Return([]string{fmt.Sprintf("%s/manifests/openshift/%s", clusterID, fileName)}, nil).Times(1)
+		reply := bm.ListClusterManifests(ctx, installer.ListClusterManifestsParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewListClusterManifestsOK()))
+		Expect(reply.(*installer.ListClusterManifestsOK).Payload).Should(Equal(models.ListManifests{
+			{Folder: models.ManifestFolderOpenshift, FileName: fileName},
+		}))
+	})
+
+	It("delete a manifest", func() {
+		objectName := fmt.Sprintf("%s/manifests/openshift/%s", clusterID, fileName)
+		mockS3Client.EXPECT().DoesObjectExist(ctx, objectName).Return(true, nil).Times(1)
+		mockS3Client.EXPECT().DeleteObject(ctx, objectName).Return(nil).Times(1)
+		reply := bm.DeleteClusterManifest(ctx, installer.DeleteClusterManifestParams{
+			ClusterID: clusterID,
+			Folder:    swag.String(models.ManifestFolderOpenshift),
+			FileName:  fileName,
 		})
-		RunClusterTests()
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewDeleteClusterManifestNoContent()))
 	})
 
-	Context("when local job is used as generator", func() {
-		BeforeEach(func() {
-			mockLocalJob = job.NewMockLocalJob(ctrl)
-			bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, mockClusterApi, cfg, mockLocalJob, mockEvents, mockS3Client, mockMetric, getTestAuthHandler())
+	It("delete the manifests of a deregistered cluster", func() {
+		mockClusterApi := cluster.NewMockAPI(ctrl)
+		bm.clusterApi = mockClusterApi
+		mockClusterApi.EXPECT().DeregisterCluster(ctx, gomock.Any()).Return(nil).Times(1)
+		objectName := fmt.Sprintf("%s/manifests/openshift/%s", clusterID, fileName)
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, fmt.Sprintf("%s/manifests/", clusterID)).
+			Return([]string{objectName}, nil).Times(1)
+		mockS3Client.EXPECT().DeleteObject(ctx, objectName).Return(nil).Times(1)
+		reply := bm.DeregisterCluster(ctx, installer.DeregisterClusterParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewDeregisterClusterNoContent()))
+	})
+
+	It("delete a missing manifest", func() {
+		objectName := fmt.Sprintf("%s/manifests/manifests/%s", clusterID, fileName)
+		mockS3Client.EXPECT().DoesObjectExist(ctx, objectName).Return(false, nil).Times(1)
+		reply := bm.DeleteClusterManifest(ctx, installer.DeleteClusterManifestParams{
+			ClusterID: clusterID,
+			Folder:    swag.String(models.ManifestFolderManifests),
+			FileName:  fileName,
 		})
-		RunClusterTests()
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("delete a manifest with a file name out of the manifests folder", func() {
+		for _, name := range []string{"../../../" + uuid.New().String() + "/kubeconfig", "../" + fileName, "openshift/" + fileName} {
+			reply := bm.DeleteClusterManifest(ctx, installer.DeleteClusterManifestParams{
+				ClusterID: clusterID,
+				Folder:    swag.String(models.ManifestFolderManifests),
+				FileName:  name,
+			})
+			verifyApiError(reply, http.StatusBadRequest)
+		}
+	})
+})
+
+var _ = Describe("uploadDay2WorkerIgnition", func() {
+	var (
+		bm           *bareMetalInventory
+		cfg          Config
+		ctrl         *gomock.Controller
+		mockS3Client *s3wrapper.MockAPI
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		bm = NewBareMetalInventory(nil, getTestLog(), nil, nil, cfg, nil, nil, mockS3Client, nil, getTestAuthHandler(), nil, nil)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("merges the worker config from the machine config server of the cluster", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID, APIVipDnsname: "api.test-cluster.example.com"}}
+		var uploaded []byte
+		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), fmt.Sprintf("%s/worker.ign", clusterID)).DoAndReturn(
+			func(ctx context.Context, data []byte, objectName string) error {
+				uploaded = data
+				return nil
+			}).Times(1)
+		Expect(bm.uploadDay2WorkerIgnition(context.Background(), cluster)).To(Succeed())
+
+		var ignition struct {
+			Ignition struct {
+				Version string `json:"version"`
+				Config  struct {
+					Merge []struct {
+						Source string `json:"source"`
+					} `json:"merge"`
+				} `json:"config"`
+			} `json:"ignition"`
+		}
+		Expect(json.Unmarshal(uploaded, &ignition)).To(Succeed())
+		Expect(ignition.Ignition.Version).To(Equal("3.1.0"))
+		Expect(ignition.Ignition.Config.Merge).To(HaveLen(1))
+		Expect(ignition.Ignition.Config.Merge[0].Source).To(Equal("http://api.test-cluster.example.com:22624/config/worker"))
 	})
 })
 
@@ -2161,9 +3396,9 @@ var _ = Describe("KubeConfig download", func() {
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
 		mockJob = job.NewMockAPI(ctrl)
 		clusterApi = cluster.NewManager(cluster.Config{}, getTestLog().WithField("pkg", "cluster-monitor"),
-			db, nil, nil, nil, nil)
+			db, nil, nil, nil, nil, getTestVersionsHandler())
 
-		bm = NewBareMetalInventory(db, getTestLog(), nil, clusterApi, cfg, mockJob, nil, mockS3Client, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, clusterApi, cfg, mockJob, nil, mockS3Client, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:     &clusterID,
 			APIVip: "10.11.12.13",
@@ -2290,8 +3525,8 @@ var _ = Describe("UploadClusterIngressCert test", func() {
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
 		mockJob = job.NewMockAPI(ctrl)
 		clusterApi = cluster.NewManager(cluster.Config{}, getTestLog().WithField("pkg", "cluster-monitor"),
-			db, nil, nil, nil, nil)
-		bm = NewBareMetalInventory(db, getTestLog(), nil, clusterApi, cfg, mockJob, nil, mockS3Client, nil, getTestAuthHandler())
+			db, nil, nil, nil, nil, getTestVersionsHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, clusterApi, cfg, mockJob, nil, mockS3Client, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:     &clusterID,
 			APIVip: "10.11.12.13",
@@ -2465,7 +3700,7 @@ var _ = Describe("Upload and Download logs test", func() {
 		mockJob := job.NewMockAPI(ctrl)
 		mockHostApi = host.NewMockAPI(ctrl)
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
-		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, mockClusterAPI, cfg, mockJob, nil, mockS3Client, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), mockHostApi, mockClusterAPI, cfg, mockJob, nil, mockS3Client, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:     &clusterID,
 			APIVip: "10.11.12.13",
@@ -2708,7 +3943,7 @@ var _ = Describe("GetClusterInstallConfig", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName)
 		clusterID = strfmt.UUID(uuid.New().String())
-		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:                     &clusterID,
 			BaseDNSDomain:          "example.com",
@@ -2737,6 +3972,19 @@ var _ = Describe("GetClusterInstallConfig", func() {
 		Expect(config.BaseDomain).To(Equal("example.com"))
 	})
 
+	It("ignores invalid stored overrides", func() {
+		Expect(db.Model(&c).Update("install_config_overrides", `{"networking": {"servceNetwork": ["172.31.0.0/16"]}}`).Error).
+			ShouldNot(HaveOccurred())
+		params := installer.GetClusterInstallConfigParams{ClusterID: clusterID}
+		response := bm.GetClusterInstallConfig(ctx, params)
+		actual, ok := response.(*installer.GetClusterInstallConfigOK)
+		Expect(ok).To(BeTrue())
+
+		config := installcfg.InstallerConfigBaremetal{}
+		Expect(yaml.Unmarshal([]byte(actual.Payload), &config)).NotTo(HaveOccurred())
+		Expect(config.BaseDomain).To(Equal("example.com"))
+	})
+
 	It("returns not found with a non-existant cluster", func() {
 		params := installer.GetClusterInstallConfigParams{ClusterID: strfmt.UUID(uuid.New().String())}
 		response := bm.GetClusterInstallConfig(ctx, params)
@@ -2744,6 +3992,410 @@ var _ = Describe("GetClusterInstallConfig", func() {
 	})
 })
 
+var _ = Describe("transition history", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		dbName    = "transition_history"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		c := common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		h := models.Host{ID: &hostID, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown)}
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		Expect(history.AddTransition(ctx, db, clusterID, nil, "RefreshStatus",
+			models.ClusterStatusInsufficient, models.ClusterStatusReady, "ready")).ShouldNot(HaveOccurred())
+		Expect(history.AddTransition(ctx, db, clusterID, &hostID, "RefreshHost",
+			models.HostStatusDiscovering, models.HostStatusKnown, "known")).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("lists cluster transitions", func() {
+		response := bm.ListClusterHistory(ctx, installer.ListClusterHistoryParams{ClusterID: clusterID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewListClusterHistoryOK()))
+		transitions := response.(*installer.ListClusterHistoryOK).Payload
+		Expect(transitions).Should(HaveLen(1))
+		Expect(swag.StringValue(transitions[0].ToState)).Should(Equal(models.ClusterStatusReady))
+	})
+
+	It("lists host transitions", func() {
+		response := bm.ListHostHistory(ctx, installer.ListHostHistoryParams{ClusterID: clusterID, HostID: hostID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewListHostHistoryOK()))
+		transitions := response.(*installer.ListHostHistoryOK).Payload
+		Expect(transitions).Should(HaveLen(1))
+		Expect(transitions[0].HostID).Should(Equal(hostID))
+		Expect(swag.StringValue(transitions[0].ToState)).Should(Equal(models.HostStatusKnown))
+	})
+
+	It("cluster not found", func() {
+		response := bm.ListClusterHistory(ctx, installer.ListClusterHistoryParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("host not found", func() {
+		response := bm.ListHostHistory(ctx, installer.ListHostHistoryParams{ClusterID: clusterID, HostID: strfmt.UUID(uuid.New().String())})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewListHostHistoryNotFound()))
+	})
+})
+
+var _ = Describe("validations", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		dbName    = "validations"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		c := common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		h := models.Host{ID: &hostID, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown)}
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		Expect(common.UpdateValidations(db, clusterID, nil, []*models.Validation{
+			{ID: "pull-secret-set", Category: "configuration", Status: "failure", Message: "The pull secret is not set."},
+		})).ShouldNot(HaveOccurred())
+		Expect(common.UpdateValidations(db, clusterID, &hostID, []*models.Validation{
+			{ID: "connected", Category: "network", Status: "success", Message: "Host is connected"},
+			{ID: "has-inventory", Category: "hardware", Status: "success", Message: "Valid inventory exists for the host"},
+		})).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("lists cluster validations", func() {
+		response := bm.ListClusterValidations(ctx, installer.ListClusterValidationsParams{ClusterID: clusterID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewListClusterValidationsOK()))
+		validations := response.(*installer.ListClusterValidationsOK).Payload
+		Expect(validations).Should(HaveLen(1))
+		Expect(validations[0].ID).Should(Equal("pull-secret-set"))
+		Expect(validations[0].Category).Should(Equal("configuration"))
+		Expect(validations[0].Status).Should(Equal("failure"))
+		Expect(validations[0].Message).Should(Equal("The pull secret is not set."))
+	})
+
+	It("lists host validations", func() {
+		response := bm.ListHostValidations(ctx, installer.ListHostValidationsParams{ClusterID: clusterID, HostID: hostID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewListHostValidationsOK()))
+		validations := response.(*installer.ListHostValidationsOK).Payload
+		Expect(validations).Should(HaveLen(2))
+		Expect(validations[0].ID).Should(Equal("has-inventory"))
+		Expect(validations[1].ID).Should(Equal("connected"))
+	})
+
+	It("status update time changes only with the status", func() {
+		before, err := common.GetValidations(db, clusterID, &hostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(common.UpdateValidations(db, clusterID, &hostID, []*models.Validation{
+			{ID: "connected", Category: "network", Status: "failure", Message: "Host is disconnected"},
+			{ID: "has-inventory", Category: "hardware", Status: "success", Message: "Valid inventory exists for the host"},
+		})).ShouldNot(HaveOccurred())
+		after, err := common.GetValidations(db, clusterID, &hostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(after).Should(HaveLen(2))
+		Expect(after[0].ID).Should(Equal("has-inventory"))
+		Expect(after[0].StatusUpdatedAt.String()).Should(Equal(before[0].StatusUpdatedAt.String()))
+		Expect(after[1].Status).Should(Equal("failure"))
+		Expect(after[1].Message).Should(Equal("Host is disconnected"))
+		Expect(time.Time(after[1].StatusUpdatedAt).After(time.Time(before[1].StatusUpdatedAt))).Should(BeTrue())
+	})
+
+	It("validations that are not reported anymore are removed", func() {
+		Expect(common.UpdateValidations(db, clusterID, &hostID, []*models.Validation{
+			{ID: "connected", Category: "network", Status: "success", Message: "Host is connected"},
+		})).ShouldNot(HaveOccurred())
+		validations, err := common.GetValidations(db, clusterID, &hostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(validations).Should(HaveLen(1))
+		Expect(validations[0].ID).Should(Equal("connected"))
+	})
+
+	It("host not found", func() {
+		response := bm.ListHostValidations(ctx, installer.ListHostValidationsParams{ClusterID: clusterID, HostID: strfmt.UUID(uuid.New().String())})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewListHostValidationsNotFound()))
+	})
+})
+
+var _ = Describe("BMC credentials", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		ctrl       *gomock.Controller
+		mockEvents *events.MockHandler
+		clusterID  strfmt.UUID
+		hostID     strfmt.UUID
+		dbName     = "bmc_credentials"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		cfg.BmcCredentialsKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, mockEvents, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		c := common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		inventory, err := json.Marshal(&models.Inventory{BmcAddress: "10.0.0.1"})
+		Expect(err).ShouldNot(HaveOccurred())
+		h := models.Host{ID: &hostID, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown), Inventory: string(inventory)}
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	updateCreds := func(address string) middleware.Responder {
+		password := strfmt.Password("secret")
+		return bm.UpdateHostBmcCredentials(ctx, installer.UpdateHostBmcCredentialsParams{
+			ClusterID: clusterID,
+			HostID:    hostID,
+			BmcCredentialsParams: &models.BmcCredentialsParams{
+				Address:  address,
+				Username: swag.String("admin"),
+				Password: &password,
+			},
+		})
+	}
+
+	It("sets, gets and deletes credentials", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+		response := updateCreds("")
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewUpdateHostBmcCredentialsCreated()))
+		Expect(response.(*installer.UpdateHostBmcCredentialsCreated).Payload.Address).Should(Equal("10.0.0.1"))
+
+		response = bm.GetHostBmcCredentials(ctx, installer.GetHostBmcCredentialsParams{ClusterID: clusterID, HostID: hostID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewGetHostBmcCredentialsOK()))
+		creds := response.(*installer.GetHostBmcCredentialsOK).Payload
+		Expect(creds.Username).Should(Equal("admin"))
+		Expect(creds.Address).Should(Equal("10.0.0.1"))
+		stored, err := common.GetBmcCredentials(db, clusterID, hostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stored.EncryptedPassword).ShouldNot(ContainSubstring("secret"))
+		password, err := stored.GetPassword(cfg.BmcCredentialsKey)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(password).Should(Equal("secret"))
+
+		response = bm.DeleteHostBmcCredentials(ctx, installer.DeleteHostBmcCredentialsParams{ClusterID: clusterID, HostID: hostID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewDeleteHostBmcCredentialsNoContent()))
+		response = bm.GetHostBmcCredentials(ctx, installer.GetHostBmcCredentialsParams{ClusterID: clusterID, HostID: hostID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewGetHostBmcCredentialsNotFound()))
+	})
+
+	It("replaces existing credentials", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any()).Times(2)
+		Expect(updateCreds("")).Should(BeAssignableToTypeOf(installer.NewUpdateHostBmcCredentialsCreated()))
+		Expect(updateCreds("https://bmc.example.com:8443")).Should(BeAssignableToTypeOf(installer.NewUpdateHostBmcCredentialsCreated()))
+		stored, err := common.GetBmcCredentials(db, clusterID, hostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stored.Address).Should(Equal("https://bmc.example.com:8443"))
+	})
+
+	It("address is required when the inventory has no BMC address", func() {
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Update("inventory", "").Error).ShouldNot(HaveOccurred())
+		Expect(updateCreds("")).Should(BeAssignableToTypeOf(installer.NewUpdateHostBmcCredentialsBadRequest()))
+	})
+
+	It("credentials can not be set without a credentials key", func() {
+		bm.Config.BmcCredentialsKey = ""
+		Expect(updateCreds("")).Should(BeAssignableToTypeOf(installer.NewUpdateHostBmcCredentialsInternalServerError()))
+		_, err := common.GetBmcCredentials(db, clusterID, hostID)
+		Expect(gorm.IsRecordNotFoundError(err)).Should(BeTrue())
+	})
+
+	It("host not found", func() {
+		response := bm.GetHostBmcCredentials(ctx, installer.GetHostBmcCredentialsParams{ClusterID: clusterID, HostID: strfmt.UUID(uuid.New().String())})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewGetHostBmcCredentialsNotFound()))
+	})
+})
+
+var _ = Describe("GetClusterConnectivity", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID1   strfmt.UUID
+		hostID2   strfmt.UUID
+		dbName    = "get_cluster_connectivity"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID1 = strfmt.UUID(uuid.New().String())
+		hostID2 = strfmt.UUID(uuid.New().String())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		c := common.Cluster{Cluster: models.Cluster{ID: &clusterID, MachineNetworkCidr: "1.2.3.0/24"}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		report, err := json.Marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+			HostID:         hostID2,
+			L2Connectivity: []*models.L2Connectivity{{RemoteIPAddress: "1.2.3.5", Successful: true}},
+		}}})
+		Expect(err).ShouldNot(HaveOccurred())
+		h1 := models.Host{ID: &hostID1, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown), Connectivity: string(report)}
+		Expect(db.Create(&h1).Error).ShouldNot(HaveOccurred())
+		h2 := models.Host{ID: &hostID2, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown)}
+		Expect(db.Create(&h2).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("returns the connectivity matrix", func() {
+		response := bm.GetClusterConnectivity(ctx, installer.GetClusterConnectivityParams{ClusterID: clusterID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewGetClusterConnectivityOK()))
+		matrix := response.(*installer.GetClusterConnectivityOK).Payload
+		Expect(matrix).Should(HaveLen(2))
+		for _, entry := range matrix {
+			Expect(swag.BoolValue(entry.L2Connected)).Should(Equal(*entry.HostID == hostID1))
+			Expect(swag.BoolValue(entry.L3Connected)).Should(BeFalse())
+		}
+	})
+
+	It("cluster not found", func() {
+		response := bm.GetClusterConnectivity(ctx, installer.GetClusterConnectivityParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewGetClusterConnectivityNotFound()))
+	})
+})
+
+var _ = Describe("Register cluster with auto assign VIPs", func() {
+	It("rejects an ingress VIP", func() {
+		bm := NewBareMetalInventory(nil, getTestLog(), nil, nil, Config{}, nil, nil, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		reply := bm.RegisterCluster(context.Background(), installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("some-cluster-name"),
+				OpenshiftVersion: swag.String("4.6"),
+				AutoAssignVips:   true,
+				IngressVip:       "1.2.3.11",
+			},
+		})
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+})
+
+var _ = Describe("Register cluster OpenShift version", func() {
+	var (
+		bm  *bareMetalInventory
+		cfg Config
+		ctx = context.Background()
+	)
+
+	BeforeEach(func() {
+		bm = NewBareMetalInventory(nil, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+	})
+
+	It("rejects a cluster of an unsupported version", func() {
+		reply := bm.RegisterCluster(ctx, installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("some-cluster-name"),
+				OpenshiftVersion: swag.String("4.4"),
+			},
+		})
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("rejects an add-hosts cluster of an unsupported version", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		reply := bm.RegisterAddHostsCluster(ctx, installer.RegisterAddHostsClusterParams{
+			NewAddHostsClusterParams: &models.AddHostsClusterCreateParams{
+				ID:               &clusterID,
+				Name:             swag.String("some-cluster-name"),
+				APIVipDnsname:    swag.String("api.some-cluster-name.example.com"),
+				OpenshiftVersion: swag.String("4.4"),
+			},
+		})
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+})
+
+var _ = Describe("SuggestVips", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    = "suggest_vips"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
+		c := common.Cluster{Cluster: models.Cluster{ID: &clusterID, MachineNetworkDhcpRange: "1.2.3.100-1.2.3.200"}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		for _, freeAddresses := range []string{
+			`[{"network":"1.2.3.0/24","free_addresses":["1.2.3.10","1.2.3.11","1.2.3.100"]}]`,
+			`[{"network":"1.2.3.0/24","free_addresses":["1.2.3.10","1.2.3.11","1.2.3.100","1.2.3.101"]}]`,
+		} {
+			hostID := strfmt.UUID(uuid.New().String())
+			h := models.Host{ID: &hostID, ClusterID: clusterID, Status: swag.String(models.HostStatusKnown), FreeAddresses: freeAddresses}
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("suggests addresses outside the DHCP range", func() {
+		response := bm.SuggestVips(ctx, installer.SuggestVipsParams{ClusterID: clusterID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewSuggestVipsOK()))
+		Expect(response.(*installer.SuggestVipsOK).Payload).Should(Equal(&models.VipsSuggestion{
+			MachineNetworkCidr: "1.2.3.0/24",
+			APIVip:             "1.2.3.10",
+			IngressVip:         "1.2.3.11",
+		}))
+	})
+
+	It("no free addresses", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Update("machine_network_dhcp_range", "1.2.3.1-1.2.3.254").Error).ShouldNot(HaveOccurred())
+		response := bm.SuggestVips(ctx, installer.SuggestVipsParams{ClusterID: clusterID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewSuggestVipsConflict()))
+	})
+
+	It("VIP DHCP allocation", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Update("vip_dhcp_allocation", true).Error).ShouldNot(HaveOccurred())
+		response := bm.SuggestVips(ctx, installer.SuggestVipsParams{ClusterID: clusterID})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewSuggestVipsBadRequest()))
+	})
+
+	It("cluster not found", func() {
+		response := bm.SuggestVips(ctx, installer.SuggestVipsParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		Expect(response).Should(BeAssignableToTypeOf(installer.NewSuggestVipsNotFound()))
+	})
+})
+
 var _ = Describe("UpdateClusterInstallConfig", func() {
 	var (
 		bm        *bareMetalInventory
@@ -2758,7 +4410,7 @@ var _ = Describe("UpdateClusterInstallConfig", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName)
 		clusterID = strfmt.UUID(uuid.New().String())
-		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler())
+		bm = NewBareMetalInventory(db, getTestLog(), nil, nil, cfg, nil, nil, nil, nil, getTestAuthHandler(), getTestVersionsHandler(), nil)
 		c = common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
 		err := db.Create(&c).Error
 		Expect(err).ShouldNot(HaveOccurred())
@@ -2812,6 +4464,34 @@ var _ = Describe("UpdateClusterInstallConfig", func() {
 		response := bm.UpdateClusterInstallConfig(ctx, params)
 		Expect(response).To(BeAssignableToTypeOf(&installer.UpdateClusterInstallConfigBadRequest{}))
 	})
+
+	It("returns bad request when provided unknown fields", func() {
+		override := `{"networking": {"servceNetwork": ["172.31.0.0/16"]}}`
+		params := installer.UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: override,
+		}
+		response := bm.UpdateClusterInstallConfig(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.UpdateClusterInstallConfigBadRequest{}))
+		payload := response.(*installer.UpdateClusterInstallConfigBadRequest).Payload
+		Expect(*payload.Reason).To(ContainSubstring("networking.servceNetwork: unknown field"))
+		Expect(payload.FieldErrors).To(Equal([]*models.InstallConfigFieldError{
+			{Field: swag.String("networking.servceNetwork"), Message: swag.String("unknown field")},
+		}))
+	})
+
+	It("returns bad request when provided forbidden fields", func() {
+		override := `{"pullSecret": "{}", "platform": {"baremetal": {"hosts": []}}}`
+		params := installer.UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: override,
+		}
+		response := bm.UpdateClusterInstallConfig(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.UpdateClusterInstallConfigBadRequest{}))
+		reason := *response.(*installer.UpdateClusterInstallConfigBadRequest).Payload.Reason
+		Expect(reason).To(ContainSubstring("pullSecret: field cannot be overridden"))
+		Expect(reason).To(ContainSubstring("platform.baremetal.hosts: field cannot be overridden"))
+	})
 })
 
 func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
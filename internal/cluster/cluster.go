@@ -17,7 +17,10 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/requestid"
@@ -33,6 +36,8 @@ const DhcpLeaseTimeoutMinutes = 2
 type RegistrationAPI interface {
 	// Register a new cluster
 	RegisterCluster(ctx context.Context, c *common.Cluster) error
+	// Register a new add-host cluster
+	RegisterAddHostsCluster(ctx context.Context, c *common.Cluster) error
 	//deregister cluster
 	DeregisterCluster(ctx context.Context, c *common.Cluster) error
 }
@@ -59,6 +64,7 @@ type API interface {
 	SetGeneratorVersion(c *common.Cluster, version string, db *gorm.DB) error
 	CancelInstallation(ctx context.Context, c *common.Cluster, reason string, db *gorm.DB) *common.ApiErrorResponse
 	ResetCluster(ctx context.Context, c *common.Cluster, reason string, db *gorm.DB) *common.ApiErrorResponse
+	RetryInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse
 	PrepareForInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) error
 	HandlePreInstallError(ctx context.Context, c *common.Cluster, err error)
 	CompleteInstallation(ctx context.Context, c *common.Cluster, successfullyFinished bool, reason string) *common.ApiErrorResponse
@@ -91,7 +97,7 @@ type Manager struct {
 }
 
 func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler, hostAPI host.API, metricApi metrics.API,
-	leaderElector leader.Leader) *Manager {
+	leaderElector leader.Leader, versionsHandler versions.Handler) *Manager {
 	th := &transitionHandler{
 		log:           log,
 		db:            db,
@@ -105,7 +111,7 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler e
 		eventsHandler:        eventsHandler,
 		sm:                   NewClusterStateMachine(th),
 		metricAPI:            metricApi,
-		rp:                   newRefreshPreprocessor(log, hostAPI),
+		rp:                   newRefreshPreprocessor(log, hostAPI, versionsHandler),
 		hostAPI:              hostAPI,
 		leaderElector:        leaderElector,
 		prevMonitorInvokedAt: time.Now(),
@@ -124,6 +130,18 @@ func (m *Manager) RegisterCluster(ctx context.Context, c *common.Cluster) error
 	return err
 }
 
+func (m *Manager) RegisterAddHostsCluster(ctx context.Context, c *common.Cluster) error {
+	err := m.registrationAPI.RegisterAddHostsCluster(ctx, c)
+	if err != nil {
+		m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityError,
+			fmt.Sprintf("Failed to register add-hosts cluster with name \"%s\". Error: %s", c.Name, err.Error()), time.Now())
+	} else {
+		m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityInfo,
+			fmt.Sprintf("Registered add-hosts cluster \"%s\"", c.Name), time.Now())
+	}
+	return err
+}
+
 func (m *Manager) DeregisterCluster(ctx context.Context, c *common.Cluster) error {
 	err := m.registrationAPI.DeregisterCluster(ctx, c)
 	if err != nil {
@@ -141,6 +159,9 @@ func (m *Manager) RefreshStatus(ctx context.Context, c *common.Cluster, db *gorm
 	if db == nil {
 		db = m.db
 	}
+	if err := m.autoAssignVips(ctx, c, db); err != nil {
+		return c, err
+	}
 	vc, err := newClusterValidationContext(*c.ID, db)
 	if err != nil {
 		return c, err
@@ -240,6 +261,7 @@ func (m *Manager) DownloadFiles(c *common.Cluster) (err error) {
 		models.ClusterStatusFinalizing,
 		models.ClusterStatusInstalled,
 		models.ClusterStatusError,
+		models.ClusterStatusAddingHosts,
 	}
 	if !funk.ContainsString(allowedStatuses, clusterStatus) {
 		err = errors.Errorf("cluster %s is in %s state, files can be downloaded only when status is one of: %s",
@@ -277,7 +299,7 @@ func (m *Manager) UploadIngressCert(c *common.Cluster) (err error) {
 
 func (m *Manager) AcceptRegistration(c *common.Cluster) (err error) {
 	clusterStatus := swag.StringValue(c.Status)
-	allowedStatuses := []string{models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput}
+	allowedStatuses := []string{models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput, models.ClusterStatusAddingHosts}
 	if !funk.ContainsString(allowedStatuses, clusterStatus) {
 		err = errors.Errorf("Cluster %s is in %s state, host can register only in one of %s", c.ID, clusterStatus, allowedStatuses)
 	}
@@ -286,7 +308,7 @@ func (m *Manager) AcceptRegistration(c *common.Cluster) (err error) {
 
 func (m *Manager) VerifyClusterUpdatability(c *common.Cluster) (err error) {
 	clusterStatus := swag.StringValue(c.Status)
-	allowedStatuses := []string{models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput}
+	allowedStatuses := []string{models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput, models.ClusterStatusAddingHosts}
 	if !funk.ContainsString(allowedStatuses, clusterStatus) {
 		err = errors.Errorf("Cluster %s is in %s state, cluster can be updated only in one of %s", c.ID, clusterStatus, allowedStatuses)
 	}
@@ -342,6 +364,27 @@ func (m *Manager) ResetCluster(ctx context.Context, c *common.Cluster, reason st
 	return nil
 }
 
+func (m *Manager) RetryInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse {
+	eventSeverity := models.EventSeverityInfo
+	eventInfo := "Retrying installation of failed hosts"
+	defer func() {
+		m.eventsHandler.AddEvent(ctx, *c.ID, nil, eventSeverity, eventInfo, time.Now())
+	}()
+
+	err := m.sm.Run(TransitionTypeRetryInstallation, newStateCluster(c), &TransitionArgsRetryInstallation{
+		ctx: ctx,
+		db:  db,
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "installation of cluster %s can not be retried, only a failed installation that "+
+			"its hosts started can be retried, reset the cluster instead", c.ID)
+		eventSeverity = models.EventSeverityError
+		eventInfo = fmt.Sprintf("Failed to retry installation. Error: %s", err.Error())
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
+}
+
 func (m *Manager) CompleteInstallation(ctx context.Context, c *common.Cluster, successfullyFinished bool, reason string) *common.ApiErrorResponse {
 	log := logutil.FromContext(ctx, m.log)
 
@@ -423,6 +466,48 @@ func (m *Manager) SetVips(ctx context.Context, c *common.Cluster, apiVip, ingres
 	return nil
 }
 
+// autoAssignVips assigns free addresses to the VIPs of a cluster that asked for automatic VIPs, when the VIPs are not
+// set yet or are not free anymore. The current VIPs are kept when no other addresses are free on all the hosts
+func (m *Manager) autoAssignVips(ctx context.Context, c *common.Cluster, db *gorm.DB) error {
+	if !c.AutoAssignVips || swag.BoolValue(c.VipDhcpAllocation) || common.HasNoVips(c) {
+		return nil
+	}
+	switch swag.StringValue(c.Status) {
+	case models.ClusterStatusPendingForInput, models.ClusterStatusInsufficient, models.ClusterStatusReady:
+	default:
+		return nil
+	}
+	log := logutil.FromContext(ctx, m.log)
+	var cluster common.Cluster
+	if err := db.Preload("Hosts").Take(&cluster, "id = ?", c.ID.String()).Error; err != nil {
+		return errors.Wrapf(err, "failed to get cluster %s", c.ID.String())
+	}
+	if network.VerifySuggestedVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip,
+		cluster.MachineNetworkDhcpRange) == nil {
+		return nil
+	}
+	suggestion, err := network.SuggestVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.MachineNetworkDhcpRange, log)
+	if err != nil {
+		log.WithError(err).Debugf("No VIPs to assign to cluster %s", c.ID.String())
+		return nil
+	}
+	updates := map[string]interface{}{
+		"api_vip":     suggestion.APIVip,
+		"ingress_vip": suggestion.IngressVip,
+	}
+	if suggestion.MachineNetworkCidr != cluster.MachineNetworkCidr {
+		updates["machine_network_cidr"] = suggestion.MachineNetworkCidr
+		updates["machine_network_cidr_updated_at"] = time.Now()
+	}
+	if err = db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Updates(updates).Error; err != nil {
+		return errors.Wrapf(err, "failed to assign VIPs to cluster %s", c.ID.String())
+	}
+	m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityInfo,
+		fmt.Sprintf("Cluster was assigned api-vip %s and ingress-vip %s of machine network %s", suggestion.APIVip,
+			suggestion.IngressVip, suggestion.MachineNetworkCidr), time.Now())
+	return nil
+}
+
 func (m *Manager) CreateTarredClusterLogs(ctx context.Context, c *common.Cluster, objectHandler s3wrapper.API) (string, error) {
 	log := logutil.FromContext(ctx, m.log)
 	fileName := fmt.Sprintf("%s/logs/cluster_logs.tar", c.ID)
@@ -439,6 +524,13 @@ func (m *Manager) CreateTarredClusterLogs(ctx context.Context, c *common.Cluster
 			errors.Errorf("No log files were found"))
 	}
 
+	// The custom manifests of the cluster are part of the logs, they explain installation issues that they cause
+	manifestFiles, err := objectHandler.ListObjectsByPrefix(ctx, manifests.GetPrefix(c.ID.String()))
+	if err != nil {
+		return "", common.NewApiError(http.StatusInternalServerError, err)
+	}
+	files = append(files, manifestFiles...)
+
 	log.Debugf("List of files to include into %s is %s", fileName, files)
 	err = common.TarAwsFiles(ctx, fileName, files, objectHandler, log)
 	if err != nil {
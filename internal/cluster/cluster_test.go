@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 
 	"github.com/go-openapi/strfmt"
@@ -54,7 +56,7 @@ var _ = Describe("stateMachine", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		dummy := &leader.DummyElector{}
-		state = NewManager(defaultTestConfig, getTestLog(), db, nil, nil, nil, dummy)
+		state = NewManager(defaultTestConfig, getTestLog(), db, nil, nil, nil, dummy, getTestVersionsHandler())
 		id := strfmt.UUID(uuid.New().String())
 		cluster = &common.Cluster{Cluster: models.Cluster{
 			ID:     &id,
@@ -122,7 +124,7 @@ var _ = Describe("cluster monitor", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, mockMetric, dummy)
+			mockEvents, mockHostAPI, mockMetric, dummy, getTestVersionsHandler())
 		expectedState = ""
 		shouldHaveUpdated = false
 	})
@@ -287,6 +289,43 @@ var _ = Describe("cluster monitor", func() {
 		})
 	})
 
+	Context("single node cluster from installing state", func() {
+
+		BeforeEach(func() {
+			c = common.Cluster{Cluster: models.Cluster{
+				ID:                   &id,
+				Status:               swag.String("installing"),
+				StatusInfo:           swag.String(statusInfoInstalling),
+				MachineNetworkCidr:   "1.1.0.0/16",
+				BaseDNSDomain:        "test.com",
+				PullSecretSet:        true,
+				HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeNone),
+			}}
+
+			Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+			mockMetric.EXPECT().ClusterInstallationFinished(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		})
+
+		It("installing -> installing", func() {
+			createHost(id, "installing-in-progress", db)
+			mockHostAPIIsValidMasterCandidateTrue(1)
+			shouldHaveUpdated = false
+			expectedState = "installing"
+		})
+		It("installing -> finalizing", func() {
+			createHost(id, "installed", db)
+			mockHostAPIIsValidMasterCandidateTrue(1)
+			shouldHaveUpdated = true
+			expectedState = models.ClusterStatusFinalizing
+		})
+		It("installing -> error", func() {
+			createHost(id, "error", db)
+			mockHostAPIIsValidMasterCandidateTrue(1)
+			shouldHaveUpdated = true
+			expectedState = "error"
+		})
+	})
+
 	mockHostAPIIsRequireUserActionResetFalse := func() {
 		mockHostAPI.EXPECT().IsRequireUserActionReset(gomock.Any()).Return(false).AnyTimes()
 	}
@@ -454,6 +493,7 @@ var _ = Describe("cluster monitor", func() {
 		saveStatusInfo := c.StatusInfo
 		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockHostAPIIsRequireUserActionResetFalse()
+		connectHosts(id, db)
 		clusterApi.ClusterMonitoring()
 		after := time.Now().Truncate(10 * time.Millisecond)
 		c = geCluster(id, db)
@@ -498,7 +538,7 @@ var _ = Describe("lease timeout event", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, mockMetric, dummy)
+			mockEvents, mockHostAPI, mockMetric, dummy, getTestVersionsHandler())
 	})
 	tests := []struct {
 		name                string
@@ -583,7 +623,7 @@ var _ = Describe("VerifyRegisterHost", func() {
 		db          *gorm.DB
 		id          strfmt.UUID
 		clusterApi  *Manager
-		errTemplate = "Cluster %s is in %s state, host can register only in one of [insufficient ready pending-for-input]"
+		errTemplate = "Cluster %s is in %s state, host can register only in one of [insufficient ready pending-for-input adding-hosts]"
 		dbName      = "verify_register_host"
 	)
 
@@ -592,7 +632,7 @@ var _ = Describe("VerifyRegisterHost", func() {
 		id = strfmt.UUID(uuid.New().String())
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			nil, nil, nil, dummy)
+			nil, nil, nil, dummy, getTestVersionsHandler())
 	})
 
 	checkVerifyRegisterHost := func(clusterStatus string, expectErr bool) {
@@ -625,6 +665,10 @@ var _ = Describe("VerifyRegisterHost", func() {
 	It("Register host while cluster in installed state", func() {
 		checkVerifyRegisterHost(models.ClusterStatusInstalled, true)
 	})
+
+	It("Register host while cluster in adding-hosts state", func() {
+		checkVerifyRegisterHost(models.ClusterStatusAddingHosts, false)
+	})
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
 	})
@@ -644,7 +688,7 @@ var _ = Describe("VerifyClusterUpdatability", func() {
 		id = strfmt.UUID(uuid.New().String())
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			nil, nil, nil, dummy)
+			nil, nil, nil, dummy, getTestVersionsHandler())
 	})
 
 	checkVerifyClusterUpdatability := func(clusterStatus string, expectErr bool) {
@@ -692,7 +736,7 @@ var _ = Describe("SetGeneratorVersion", func() {
 		id = strfmt.UUID(uuid.New().String())
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			nil, nil, nil, dummy)
+			nil, nil, nil, dummy, getTestVersionsHandler())
 		cluster := common.Cluster{Cluster: models.Cluster{ID: &id, Status: swag.String(models.ClusterStatusReady)}}
 		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
 		cluster = geCluster(id, db)
@@ -723,7 +767,7 @@ var _ = Describe("CancelInstallation", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
-		state = NewManager(defaultTestConfig, getTestLog(), db, eventsHandler, nil, mockMetric, dummy)
+		state = NewManager(defaultTestConfig, getTestLog(), db, eventsHandler, nil, mockMetric, dummy, getTestVersionsHandler())
 		id := strfmt.UUID(uuid.New().String())
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:         &id,
@@ -795,7 +839,7 @@ var _ = Describe("ResetCluster", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		eventsHandler = events.New(db, logrus.New())
 		dummy := &leader.DummyElector{}
-		state = NewManager(defaultTestConfig, getTestLog(), db, eventsHandler, nil, nil, dummy)
+		state = NewManager(defaultTestConfig, getTestLog(), db, eventsHandler, nil, nil, dummy, getTestVersionsHandler())
 	})
 
 	It("reset_cluster", func() {
@@ -837,6 +881,79 @@ var _ = Describe("ResetCluster", func() {
 	})
 })
 
+var _ = Describe("RetryInstallation", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		state         API
+		c             common.Cluster
+		eventsHandler events.Handler
+		dbName        = "retry_installation"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		eventsHandler = events.New(db, logrus.New())
+		dummy := &leader.DummyElector{}
+		state = NewManager(defaultTestConfig, getTestLog(), db, eventsHandler, nil, nil, dummy, getTestVersionsHandler())
+	})
+
+	createCluster := func(status string, hostStage models.HostStage) {
+		id := strfmt.UUID(uuid.New().String())
+		hostId := strfmt.UUID(uuid.New().String())
+		c = common.Cluster{Cluster: models.Cluster{
+			ID:     &id,
+			Status: swag.String(status),
+		}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		h := models.Host{
+			ID:        &hostId,
+			ClusterID: id,
+			Status:    swag.String(models.HostStatusError),
+			Progress:  &models.HostProgressInfo{CurrentStage: hostStage},
+		}
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		Expect(db.Preload("Hosts").First(&c, "id = ?", id).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("retry installation", func() {
+		createCluster(models.ClusterStatusError, models.HostStageWritingImageToDisk)
+		Expect(state.RetryInstallation(ctx, &c, db)).ShouldNot(HaveOccurred())
+		db.First(&c, "id = ?", c.ID)
+		Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusInstalling))
+		Expect(swag.StringValue(c.StatusInfo)).Should(Equal(statusInfoRetryingInstallation))
+		events, err := eventsHandler.GetEvents(*c.ID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(len(events)).ShouldNot(Equal(0))
+		retryEvent := events[len(events)-1]
+		Expect(*retryEvent.Severity).Should(Equal(models.EventSeverityInfo))
+		Expect(*retryEvent.Message).Should(Equal("Retrying installation of failed hosts"))
+	})
+
+	It("retry installation that did not start", func() {
+		createCluster(models.ClusterStatusError, "")
+		reply := state.RetryInstallation(ctx, &c, db)
+		Expect(int(reply.StatusCode())).Should(Equal(http.StatusConflict))
+		db.First(&c, "id = ?", c.ID)
+		Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusError))
+	})
+
+	It("retry installation conflict", func() {
+		createCluster(models.ClusterStatusReady, models.HostStageWritingImageToDisk)
+		reply := state.RetryInstallation(ctx, &c, db)
+		Expect(int(reply.StatusCode())).Should(Equal(http.StatusConflict))
+		events, err := eventsHandler.GetEvents(*c.ID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(len(events)).ShouldNot(Equal(0))
+		retryEvent := events[len(events)-1]
+		Expect(*retryEvent.Severity).Should(Equal(models.EventSeverityError))
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+})
+
 func createHost(clusterId strfmt.UUID, state string, db *gorm.DB) {
 	hostId := strfmt.UUID(uuid.New().String())
 	host := models.Host{
@@ -867,6 +984,12 @@ func getTestLog() logrus.FieldLogger {
 	return l
 }
 
+func getTestVersionsHandler() versions.Handler {
+	openshiftVersions, err := versions.ParseOpenshiftVersions("", "")
+	Expect(err).ShouldNot(HaveOccurred())
+	return versions.NewHandler(versions.Versions{}, openshiftVersions)
+}
+
 func geCluster(clusterId strfmt.UUID, db *gorm.DB) common.Cluster {
 	var cluster common.Cluster
 	Expect(db.Preload("Hosts").First(&cluster, "id = ?", clusterId).Error).ShouldNot(HaveOccurred())
@@ -887,9 +1010,46 @@ func addInstallationRequirements(clusterId strfmt.UUID, db *gorm.DB) {
 		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
 
 	}
+	connectHosts(clusterId, db)
 	Expect(db.Model(&common.Cluster{Cluster: models.Cluster{ID: &clusterId}}).Updates(map[string]interface{}{"api_vip": "1.2.3.5", "ingress_vip": "1.2.3.6"}).Error).To(Not(HaveOccurred()))
 }
 
+// connectHosts sets connectivity reports in which every host of the cluster reached all the other hosts
+func connectHosts(clusterId strfmt.UUID, db *gorm.DB) {
+	var hosts []*models.Host
+	Expect(db.Find(&hosts, "cluster_id = ?", clusterId.String()).Error).ShouldNot(HaveOccurred())
+	for _, h := range hosts {
+		report := models.ConnectivityReport{}
+		for _, r := range hosts {
+			if r.ID.String() == h.ID.String() {
+				continue
+			}
+			report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+				HostID:         *r.ID,
+				L2Connectivity: []*models.L2Connectivity{{RemoteIPAddress: "1.2.3.4", Successful: true}},
+			})
+		}
+		b, err := json.Marshal(&report)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.Model(h).Update("connectivity", string(b)).Error).ShouldNot(HaveOccurred())
+	}
+}
+
+// domainResolutions returns a domain resolution report in which the domain names of the cluster test.test.com resolve
+// to the given addresses
+func domainResolutions(addresses ...strfmt.IPv4) string {
+	report := models.DomainResolutionResponse{}
+	for _, name := range []string{"api.test.test.com", "console-openshift-console.apps.test.test.com"} {
+		report.Resolutions = append(report.Resolutions, &models.DomainResolutionResponseDomain{
+			DomainName:    swag.String(name),
+			IPV4Addresses: addresses,
+		})
+	}
+	b, err := json.Marshal(&report)
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(b)
+}
+
 func defaultInventory() string {
 	inventory := models.Inventory{
 		Interfaces: []*models.Interface{
@@ -918,7 +1078,7 @@ var _ = Describe("PrepareForInstallation", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		dummy := &leader.DummyElector{}
-		capi = NewManager(defaultTestConfig, getTestLog(), db, nil, nil, nil, dummy)
+		capi = NewManager(defaultTestConfig, getTestLog(), db, nil, nil, nil, dummy, getTestVersionsHandler())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
 
@@ -1000,7 +1160,7 @@ var _ = Describe("HandlePreInstallationError", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		dummy := &leader.DummyElector{}
-		capi = NewManager(defaultTestConfig, getTestLog(), db, nil, nil, nil, dummy)
+		capi = NewManager(defaultTestConfig, getTestLog(), db, nil, nil, nil, dummy, getTestVersionsHandler())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
 
@@ -1086,7 +1246,7 @@ var _ = Describe("SetVips", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		capi = NewManager(defaultTestConfig, getTestLog(), db, mockEvents, nil, nil, dummy)
+		capi = NewManager(defaultTestConfig, getTestLog(), db, mockEvents, nil, nil, dummy, getTestVersionsHandler())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
 	AfterEach(func() {
@@ -1203,6 +1363,81 @@ var _ = Describe("SetVips", func() {
 	})
 })
 
+var _ = Describe("auto assign VIPs", func() {
+	var (
+		ctx        = context.Background()
+		capi       *Manager
+		mockEvents *events.MockHandler
+		ctrl       *gomock.Controller
+		db         *gorm.DB
+		clusterId  strfmt.UUID
+		cluster    common.Cluster
+		dbName     = "auto_assign_vips"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		capi = NewManager(defaultTestConfig, getTestLog(), db, mockEvents, nil, nil, &leader.DummyElector{}, getTestVersionsHandler())
+		clusterId = strfmt.UUID(uuid.New().String())
+		cluster = common.Cluster{Cluster: models.Cluster{
+			ID:             &clusterId,
+			Status:         swag.String(models.ClusterStatusInsufficient),
+			AutoAssignVips: true,
+		}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		for _, freeAddresses := range []string{
+			`[{"network":"1.2.3.0/24","free_addresses":["1.2.3.10","1.2.3.11","1.2.3.12"]}]`,
+			`[{"network":"1.2.3.0/24","free_addresses":["1.2.3.11","1.2.3.12"]}]`,
+		} {
+			hostId := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&models.Host{ID: &hostId, ClusterID: clusterId, Status: swag.String(models.HostStatusKnown),
+				FreeAddresses: freeAddresses}).Error).ShouldNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getVips := func() (string, string, string) {
+		c := getCluster(clusterId, db)
+		return c.MachineNetworkCidr, c.APIVip, c.IngressVip
+	}
+
+	It("assigns free addresses", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, nil, models.EventSeverityInfo,
+			"Cluster was assigned api-vip 1.2.3.11 and ingress-vip 1.2.3.12 of machine network 1.2.3.0/24", gomock.Any())
+		Expect(capi.autoAssignVips(ctx, &cluster, db)).ShouldNot(HaveOccurred())
+		machineCidr, apiVip, ingressVip := getVips()
+		Expect(machineCidr).Should(Equal("1.2.3.0/24"))
+		Expect(apiVip).Should(Equal("1.2.3.11"))
+		Expect(ingressVip).Should(Equal("1.2.3.12"))
+
+		By("keeps valid VIPs")
+		Expect(capi.autoAssignVips(ctx, &cluster, db)).ShouldNot(HaveOccurred())
+	})
+
+	It("replaces VIPs that are not free anymore", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterId.String()).Updates(map[string]interface{}{
+			"machine_network_cidr": "1.2.3.0/24", "api_vip": "1.2.3.10", "ingress_vip": "1.2.3.11"}).Error).ShouldNot(HaveOccurred())
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+		Expect(capi.autoAssignVips(ctx, &cluster, db)).ShouldNot(HaveOccurred())
+		_, apiVip, ingressVip := getVips()
+		Expect(apiVip).Should(Equal("1.2.3.11"))
+		Expect(ingressVip).Should(Equal("1.2.3.12"))
+	})
+
+	It("does nothing when automatic VIPs are not requested", func() {
+		cluster.AutoAssignVips = false
+		Expect(capi.autoAssignVips(ctx, &cluster, db)).ShouldNot(HaveOccurred())
+		_, apiVip, _ := getVips()
+		Expect(apiVip).Should(BeEmpty())
+	})
+})
+
 var _ = Describe("ready_state", func() {
 	var (
 		ctx        = context.Background()
@@ -1221,7 +1456,7 @@ var _ = Describe("ready_state", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, nil, nil, dummy)
+			mockEvents, nil, nil, dummy, getTestVersionsHandler())
 
 		id = strfmt.UUID(uuid.New().String())
 		cluster = common.Cluster{Cluster: models.Cluster{
@@ -1286,7 +1521,7 @@ var _ = Describe("insufficient_state", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, nil, dummy)
+			mockEvents, mockHostAPI, nil, dummy, getTestVersionsHandler())
 
 		id = strfmt.UUID(uuid.New().String())
 		cluster = common.Cluster{Cluster: models.Cluster{
@@ -1327,7 +1562,7 @@ var _ = Describe("prepare-for-installation refresh status", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockEvents := events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		capi = NewManager(cfg, getTestLog(), db, mockEvents, mockHostAPI, nil, dummy)
+		capi = NewManager(cfg, getTestLog(), db, mockEvents, mockHostAPI, nil, dummy, getTestVersionsHandler())
 		clusterId = strfmt.UUID(uuid.New().String())
 		cl = common.Cluster{
 			Cluster: models.Cluster{
@@ -1366,18 +1601,19 @@ var _ = Describe("prepare-for-installation refresh status", func() {
 
 var _ = Describe("Cluster tarred files", func() {
 	var (
-		ctx          = context.Background()
-		capi         API
-		db           *gorm.DB
-		clusterId    strfmt.UUID
-		cl           common.Cluster
-		dbName       = "cluster_tar"
-		ctrl         *gomock.Controller
-		mockHostAPI  *host.MockAPI
-		mockS3Client *s3wrapper.MockAPI
-		prefix       string
-		files        []string
-		tarFile      string
+		ctx             = context.Background()
+		capi            API
+		db              *gorm.DB
+		clusterId       strfmt.UUID
+		cl              common.Cluster
+		dbName          = "cluster_tar"
+		ctrl            *gomock.Controller
+		mockHostAPI     *host.MockAPI
+		mockS3Client    *s3wrapper.MockAPI
+		prefix          string
+		manifestsPrefix string
+		files           []string
+		tarFile         string
 	)
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName)
@@ -1388,7 +1624,7 @@ var _ = Describe("Cluster tarred files", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockEvents := events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		capi = NewManager(cfg, getTestLog(), db, mockEvents, mockHostAPI, nil, dummy)
+		capi = NewManager(cfg, getTestLog(), db, mockEvents, mockHostAPI, nil, dummy, getTestVersionsHandler())
 		clusterId = strfmt.UUID(uuid.New().String())
 		cl = common.Cluster{
 			Cluster: models.Cluster{
@@ -1400,6 +1636,7 @@ var _ = Describe("Cluster tarred files", func() {
 		tarFile = fmt.Sprintf("%s/logs/cluster_logs.tar", clusterId)
 		Expect(db.Create(&cl).Error).NotTo(HaveOccurred())
 		prefix = fmt.Sprintf("%s/logs/", cl.ID)
+		manifestsPrefix = fmt.Sprintf("%s/manifests/", cl.ID)
 		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	})
 
@@ -1426,8 +1663,16 @@ var _ = Describe("Cluster tarred files", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("list manifests failed", func() {
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, prefix).Return(files, nil).Times(1)
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, manifestsPrefix).Return(nil, errors.Errorf("dummy")).Times(1)
+		_, err := capi.CreateTarredClusterLogs(ctx, &cl, mockS3Client)
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("download failed", func() {
 		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, prefix).Return(files, nil).Times(1)
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, manifestsPrefix).Return([]string{}, nil).Times(1)
 		mockS3Client.EXPECT().Download(ctx, files[0]).Return(nil, int64(0), errors.Errorf("Dummy")).Times(1)
 		mockS3Client.EXPECT().UploadStream(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		_, err := capi.CreateTarredClusterLogs(ctx, &cl, mockS3Client)
@@ -1437,9 +1682,28 @@ var _ = Describe("Cluster tarred files", func() {
 	It("upload failed", func() {
 		r := ioutil.NopCloser(bytes.NewReader([]byte("test")))
 		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, prefix).Return(files, nil).Times(1)
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, manifestsPrefix).Return([]string{}, nil).Times(1)
 		mockS3Client.EXPECT().Download(ctx, gomock.Any()).Return(r, int64(4), nil).AnyTimes()
 		mockS3Client.EXPECT().UploadStream(ctx, gomock.Any(), tarFile).Return(errors.Errorf("Dummy")).Times(1)
 		_, err := capi.CreateTarredClusterLogs(ctx, &cl, mockS3Client)
 		Expect(err).To(HaveOccurred())
 	})
+
+	It("manifests are included", func() {
+		manifest := fmt.Sprintf("%s/manifests/openshift/99-test.yaml", clusterId)
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, prefix).Return(files, nil).Times(1)
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, manifestsPrefix).Return([]string{manifest}, nil).Times(1)
+		for _, f := range append(files, manifest) {
+			r := ioutil.NopCloser(bytes.NewReader([]byte("test")))
+			mockS3Client.EXPECT().Download(ctx, f).Return(r, int64(4), nil).Times(1)
+		}
+		mockS3Client.EXPECT().UploadStream(ctx, gomock.Any(), tarFile).DoAndReturn(
+			func(ctx context.Context, reader io.Reader, objectName string) error {
+				_, err := ioutil.ReadAll(reader)
+				return err
+			}).Times(1)
+		fileName, err := capi.CreateTarredClusterLogs(ctx, &cl, mockS3Client)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileName).To(Equal(tarFile))
+	})
 })
@@ -1,12 +1,14 @@
 package cluster
 
 import (
+	"context"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -22,16 +24,22 @@ const (
 	statusInfoReady                           = "Cluster ready to be installed"
 	statusInfoInsufficient                    = "Cluster is not ready for install"
 	statusInfoInstalling                      = "Installation in progress"
+	statusInfoRetryingInstallation            = "Retrying installation of failed hosts"
 	statusInfoFinalizing                      = "Finalizing cluster installation"
 	statusInfoInstalled                       = "installed"
 	statusInfoPreparingForInstallation        = "Preparing cluster for installation"
 	statusInfoPreparingForInstallationTimeout = "Preparing cluster for installation timeout"
 	statusInfoPendingForInput                 = "User input required"
 	statusInfoError                           = "cluster has hosts in error"
+	statusInfoAddingHosts                     = "cluster is adding hosts to existing OCP cluster"
 )
 
-func updateClusterStatus(log logrus.FieldLogger, db *gorm.DB, clusterId strfmt.UUID, srcStatus string,
-	newStatus string, statusInfo string, extra ...interface{}) (*common.Cluster, error) {
+// Starting the installation moves the cluster to installing without running the state machine,
+// such status change is recorded in the transition history with this transition type
+const transitionTypeInstall = "Install"
+
+func updateClusterStatus(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, clusterId strfmt.UUID, transitionType string,
+	srcStatus string, newStatus string, statusInfo string, extra ...interface{}) (*common.Cluster, error) {
 	var cluster *common.Cluster
 	var err error
 
@@ -48,6 +56,9 @@ func updateClusterStatus(log logrus.FieldLogger, db *gorm.DB, clusterId strfmt.U
 	}
 
 	if newStatus != srcStatus {
+		if err = history.AddTransition(ctx, db, clusterId, nil, transitionType, srcStatus, newStatus, statusInfo); err != nil {
+			return nil, err
+		}
 		log.Infof("cluster %s has been updated with the following updates %+v", clusterId, extra)
 	}
 
@@ -109,6 +120,16 @@ func NumberOfWorkers(c *common.Cluster) int {
 	return num
 }
 
+func numberOfEnabledHosts(c *common.Cluster) int {
+	num := 0
+	for _, host := range c.Hosts {
+		if swag.StringValue(host.Status) != models.HostStatusDisabled {
+			num += 1
+		}
+	}
+	return num
+}
+
 func MapMasterHostsByStatus(c *common.Cluster) map[string][]*models.Host {
 	return mapHostsByStatus(c, models.HostRoleMaster)
 }
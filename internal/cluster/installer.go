@@ -43,7 +43,7 @@ func (i *installer) Install(ctx context.Context, c *common.Cluster, db *gorm.DB)
 			return err
 		}
 		return errors.Errorf("cluster %s is expected to have exactly %d known master to be installed, got %d",
-			c.ID, common.MinMasterHostsNeededForInstallation, len(masterKnownHosts))
+			c.ID, common.GetMasterHostsNeededForInstallation(c), len(masterKnownHosts))
 	case models.ClusterStatusReady:
 		return errors.Errorf("cluster %s is ready expected %s", c.ID, models.ClusterStatusPreparingForInstallation)
 	case models.ClusterStatusInstalling:
@@ -58,7 +58,7 @@ func (i *installer) Install(ctx context.Context, c *common.Cluster, db *gorm.DB)
 		return errors.Errorf("cluster %s state is unclear - cluster state: %s", c.ID, swag.StringValue(c.Status))
 	}
 
-	if _, err := updateClusterStatus(i.log, db, *c.ID, swag.StringValue(c.Status),
+	if _, err := updateClusterStatus(ctx, i.log, db, *c.ID, transitionTypeInstall, swag.StringValue(c.Status),
 		models.ClusterStatusInstalling, statusInfoInstalling); err != nil {
 		return err
 	}
@@ -51,6 +51,20 @@ func (mr *MockRegistrationAPIMockRecorder) RegisterCluster(ctx, c interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockRegistrationAPI)(nil).RegisterCluster), ctx, c)
 }
 
+// RegisterAddHostsCluster mocks base method
+func (m *MockRegistrationAPI) RegisterAddHostsCluster(ctx context.Context, c *common.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterAddHostsCluster", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterAddHostsCluster indicates an expected call of RegisterAddHostsCluster
+func (mr *MockRegistrationAPIMockRecorder) RegisterAddHostsCluster(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAddHostsCluster", reflect.TypeOf((*MockRegistrationAPI)(nil).RegisterAddHostsCluster), ctx, c)
+}
+
 // DeregisterCluster mocks base method
 func (m *MockRegistrationAPI) DeregisterCluster(ctx context.Context, c *common.Cluster) error {
 	m.ctrl.T.Helper()
@@ -154,6 +168,20 @@ func (mr *MockAPIMockRecorder) RegisterCluster(ctx, c interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockAPI)(nil).RegisterCluster), ctx, c)
 }
 
+// RegisterAddHostsCluster mocks base method
+func (m *MockAPI) RegisterAddHostsCluster(ctx context.Context, c *common.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterAddHostsCluster", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterAddHostsCluster indicates an expected call of RegisterAddHostsCluster
+func (mr *MockAPIMockRecorder) RegisterAddHostsCluster(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAddHostsCluster", reflect.TypeOf((*MockAPI)(nil).RegisterAddHostsCluster), ctx, c)
+}
+
 // DeregisterCluster mocks base method
 func (m *MockAPI) DeregisterCluster(ctx context.Context, c *common.Cluster) error {
 	m.ctrl.T.Helper()
@@ -350,6 +378,20 @@ func (mr *MockAPIMockRecorder) ResetCluster(ctx, c, reason, db interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetCluster", reflect.TypeOf((*MockAPI)(nil).ResetCluster), ctx, c, reason, db)
 }
 
+// RetryInstallation mocks base method
+func (m *MockAPI) RetryInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryInstallation", ctx, c, db)
+	ret0, _ := ret[0].(*common.ApiErrorResponse)
+	return ret0
+}
+
+// RetryInstallation indicates an expected call of RetryInstallation
+func (mr *MockAPIMockRecorder) RetryInstallation(ctx, c, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryInstallation", reflect.TypeOf((*MockAPI)(nil).RetryInstallation), ctx, c, db)
+}
+
 // PrepareForInstallation mocks base method
 func (m *MockAPI) PrepareForInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -1,16 +1,12 @@
 package cluster
 
 import (
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/sirupsen/logrus"
 )
 
-type validationResult struct {
-	ID      validationID     `json:"id"`
-	Status  validationStatus `json:"status"`
-	Message string           `json:"message"`
-}
-
 type stringer interface {
 	String() string
 }
@@ -21,17 +17,17 @@ type refreshPreprocessor struct {
 	conditions  []condition
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, versionsHandler versions.Handler) *refreshPreprocessor {
 	return &refreshPreprocessor{
 		log:         log,
-		validations: newValidations(log, hostAPI),
+		validations: newValidations(log, hostAPI, versionsHandler),
 		conditions:  newConditions(),
 	}
 }
 
-func (r *refreshPreprocessor) preprocess(c *clusterPreprocessContext) (map[string]bool, map[string][]validationResult, error) {
+func (r *refreshPreprocessor) preprocess(c *clusterPreprocessContext) (map[string]bool, map[string][]common.ValidationResult, error) {
 	stateMachineInput := make(map[string]bool)
-	validationsOutput := make(map[string][]validationResult)
+	validationsOutput := make(map[string][]common.ValidationResult)
 	for _, v := range r.validations {
 		st := v.condition(c)
 		stateMachineInput[v.id.String()] = st == ValidationSuccess
@@ -41,9 +37,9 @@ func (r *refreshPreprocessor) preprocess(c *clusterPreprocessContext) (map[strin
 			logrus.WithError(err).Warn("id.category()")
 			return nil, nil, err
 		}
-		validationsOutput[category] = append(validationsOutput[category], validationResult{
-			ID:      v.id,
-			Status:  st,
+		validationsOutput[category] = append(validationsOutput[category], common.ValidationResult{
+			ID:      v.id.String(),
+			Status:  string(st),
 			Message: message,
 		})
 	}
@@ -53,10 +49,11 @@ func (r *refreshPreprocessor) preprocess(c *clusterPreprocessContext) (map[strin
 	return stateMachineInput, validationsOutput, nil
 }
 
-func newValidations(log logrus.FieldLogger, api host.API) []validation {
+func newValidations(log logrus.FieldLogger, api host.API, versionsHandler versions.Handler) []validation {
 	v := clusterValidator{
-		log:     log,
-		hostAPI: api,
+		log:             log,
+		hostAPI:         api,
+		versionsHandler: versionsHandler,
 	}
 	ret := []validation{
 		{
@@ -129,6 +126,26 @@ func newValidations(log logrus.FieldLogger, api host.API) []validation {
 			condition: v.networkPrefixValid,
 			formatter: v.printNetworkPrefixValid,
 		},
+		{
+			id:        AllHostsAreConnected,
+			condition: v.allHostsAreConnected,
+			formatter: v.printAllHostsAreConnected,
+		},
+		{
+			id:        isNetworkTypeValid,
+			condition: v.isNetworkTypeValid,
+			formatter: v.printIsNetworkTypeValid,
+		},
+		{
+			id:        isApiDomainNameResolved,
+			condition: v.isApiDomainNameResolved,
+			formatter: v.printIsApiDomainNameResolved,
+		},
+		{
+			id:        isAppsDomainNameResolved,
+			condition: v.isAppsDomainNameResolved,
+			formatter: v.printIsAppsDomainNameResolved,
+		},
 	}
 	return ret
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 )
@@ -28,8 +29,16 @@ type registrar struct {
 }
 
 func (r *registrar) RegisterCluster(ctx context.Context, cluster *common.Cluster) error {
-	cluster.Status = swag.String(models.ClusterStatusInsufficient)
-	cluster.StatusInfo = swag.String(statusInfoInsufficient)
+	return r.registerCluster(ctx, cluster, models.ClusterStatusInsufficient, statusInfoInsufficient)
+}
+
+func (r *registrar) RegisterAddHostsCluster(ctx context.Context, cluster *common.Cluster) error {
+	return r.registerCluster(ctx, cluster, models.ClusterStatusAddingHosts, statusInfoAddingHosts)
+}
+
+func (r *registrar) registerCluster(ctx context.Context, cluster *common.Cluster, status, statusInfo string) error {
+	cluster.Status = swag.String(status)
+	cluster.StatusInfo = swag.String(statusInfo)
 	cluster.StatusUpdatedAt = strfmt.DateTime(time.Now())
 	tx := r.db.Begin()
 	defer func() {
@@ -76,6 +85,21 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Clust
 		return errors.Errorf("failed to deregister host while unregistering cluster %s", cluster.ID)
 	}
 
+	if txErr = common.DeleteValidations(tx, *cluster.ID, nil); txErr != nil {
+		tx.Rollback()
+		return errors.Errorf("failed to delete validations while unregistering cluster %s", cluster.ID)
+	}
+
+	if txErr = common.DeleteBmcCredentials(tx, *cluster.ID, nil); txErr != nil {
+		tx.Rollback()
+		return errors.Errorf("failed to delete BMC credentials while unregistering cluster %s", cluster.ID)
+	}
+
+	if txErr = history.DeleteTransitions(tx, *cluster.ID, nil); txErr != nil {
+		tx.Rollback()
+		return errors.Errorf("failed to delete transition history while unregistering cluster %s", cluster.ID)
+	}
+
 	if txErr = tx.Delete(cluster).Error; txErr != nil {
 		tx.Rollback()
 		return errors.Errorf("failed to delete cluster %s", cluster.ID)
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -53,6 +54,29 @@ var _ = Describe("registrar", func() {
 		})
 	})
 
+	Context("register add-hosts cluster", func() {
+		It("register a new add-hosts cluster", func() {
+			day2ID := strfmt.UUID(uuid.New().String())
+			day2Cluster := common.Cluster{Cluster: models.Cluster{
+				ID:            &day2ID,
+				Kind:          swag.String(models.ClusterKindAddHostsCluster),
+				APIVipDnsname: "api.test-cluster.example.com",
+			}}
+			updateErr = registerManager.RegisterAddHostsCluster(ctx, &day2Cluster)
+			Expect(updateErr).Should(BeNil())
+
+			day2Cluster = geCluster(day2ID, db)
+			Expect(swag.StringValue(day2Cluster.Status)).Should(Equal(models.ClusterStatusAddingHosts))
+			Expect(swag.StringValue(day2Cluster.StatusInfo)).Should(Equal(statusInfoAddingHosts))
+			Expect(day2Cluster.APIVipDnsname).Should(Equal("api.test-cluster.example.com"))
+		})
+
+		It("register an add-hosts cluster with a registered cluster id", func() {
+			updateErr = registerManager.RegisterAddHostsCluster(ctx, &cluster)
+			Expect(updateErr).Should(HaveOccurred())
+		})
+	})
+
 	Context("deregister", func() {
 		It("unregister a registered cluster", func() {
 			updateErr = registerManager.DeregisterCluster(ctx, &cluster)
@@ -64,6 +88,21 @@ var _ = Describe("registrar", func() {
 			Expect(db.First(&host, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
 
 		})
+		It("unregister a cluster with transition history", func() {
+			hostID := strfmt.UUID(uuid.New().String())
+			Expect(history.AddTransition(ctx, db, *cluster.ID, nil, "RefreshStatus",
+				models.ClusterStatusInsufficient, models.ClusterStatusReady, "")).ShouldNot(HaveOccurred())
+			Expect(history.AddTransition(ctx, db, *cluster.ID, &hostID, "RefreshHost",
+				models.HostStatusDiscovering, models.HostStatusKnown, "")).ShouldNot(HaveOccurred())
+
+			updateErr = registerManager.DeregisterCluster(ctx, &cluster)
+			Expect(updateErr).Should(BeNil())
+
+			var count int
+			Expect(db.Unscoped().Model(&history.Transition{}).Where("cluster_id = ?", cluster.ID.String()).
+				Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(0))
+		})
 		It("unregister a cluster in installing state", func() {
 			// cluster state to installing
 			cluster.Status = swag.String("installing")
@@ -7,8 +7,9 @@ import (
 )
 
 type stateCluster struct {
-	srcState string
-	cluster  *common.Cluster
+	srcState       string
+	transitionType string
+	cluster        *common.Cluster
 }
 
 func newStateCluster(c *common.Cluster) *stateCluster {
@@ -26,3 +27,17 @@ func (sh *stateCluster) SetState(state stateswitch.State) error {
 	sh.cluster.Status = swag.String(string(state))
 	return nil
 }
+
+// stateMachine keeps the type of the running transition on the cluster state, so the transition history
+// records which transition changed the cluster status
+type stateMachine struct {
+	stateswitch.StateMachine
+}
+
+func (sm *stateMachine) Run(transitionType stateswitch.TransitionType, stateSwitch stateswitch.StateSwitch,
+	args stateswitch.TransitionArgs) error {
+	if sCluster, ok := stateSwitch.(*stateCluster); ok {
+		sCluster.transitionType = string(transitionType)
+	}
+	return sm.StateMachine.Run(transitionType, stateSwitch, args)
+}
@@ -8,6 +8,7 @@ import (
 const (
 	TransitionTypeCancelInstallation         = "CancelInstallation"
 	TransitionTypeResetCluster               = "ResetCluster"
+	TransitionTypeRetryInstallation          = "RetryInstallation"
 	TransitionTypePrepareForInstallation     = "PrepareForInstallation"
 	TransitionTypeCompleteInstallation       = "CompleteInstallation"
 	TransitionTypeHandlePreInstallationError = "Handle pre-installation-error"
@@ -39,6 +40,16 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		PostTransition:   th.PostResetCluster,
 	})
 
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRetryInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.ClusterStatusError),
+		},
+		Condition:        th.IsInstallationStarted,
+		DestinationState: stateswitch.State(models.ClusterStatusInstalling),
+		PostTransition:   th.PostRetryInstallation,
+	})
+
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypePrepareForInstallation,
 		SourceStates: []stateswitch.State{
@@ -86,7 +97,8 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 	var pendingConditions = stateswitch.And(If(IsMachineCidrDefined), If(isClusterCidrDefined), If(isServiceCidrDefined), If(IsDNSDomainDefined), If(IsPullSecretSet))
 	var vipsDefinedConditions = stateswitch.And(If(isApiVipDefined), If(isIngressVipDefined))
 	var requiredForInstall = stateswitch.And(If(isMachineCidrEqualsToCalculatedCidr), If(isApiVipValid), If(isIngressVipValid), If(AllHostsAreReadyToInstall),
-		If(SufficientMastersCount), If(networkPrefixValid), If(noCidrOverlapping))
+		If(SufficientMastersCount), If(networkPrefixValid), If(noCidrOverlapping), If(AllHostsAreConnected), If(isNetworkTypeValid),
+		If(isApiDomainNameResolved), If(isAppsDomainNameResolved))
 
 	// Refresh cluster status conditions - Non DHCP
 	var requiredInputFieldsExistNonDhcp = stateswitch.And(vipsDefinedConditions, pendingConditions)
@@ -218,7 +230,8 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		stateswitch.State(models.ClusterStatusPreparingForInstallation),
 		stateswitch.State(models.ClusterStatusFinalizing),
 		stateswitch.State(models.ClusterStatusInstalled),
-		stateswitch.State(models.ClusterStatusError)} {
+		stateswitch.State(models.ClusterStatusError),
+		stateswitch.State(models.ClusterStatusAddingHosts)} {
 		sm.AddTransition(stateswitch.TransitionRule{
 			TransitionType:   TransitionTypeRefreshStatus,
 			SourceStates:     []stateswitch.State{state},
@@ -226,5 +239,5 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		})
 	}
 
-	return sm
+	return &stateMachine{StateMachine: sm}
 }
@@ -50,7 +50,7 @@ func (th *transitionHandler) PostCancelInstallation(sw stateswitch.StateSwitch,
 		return nil
 	}
 
-	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), params.db, sCluster,
+	return th.updateTransitionCluster(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sCluster,
 		params.reason)
 }
 
@@ -74,10 +74,47 @@ func (th *transitionHandler) PostResetCluster(sw stateswitch.StateSwitch, args s
 		return errors.New("PostResetCluster invalid argument")
 	}
 
-	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), params.db, sCluster,
+	return th.updateTransitionCluster(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sCluster,
 		params.reason)
 }
 
+////////////////////////////////////////////////////////////////////////////
+// RetryInstallation
+////////////////////////////////////////////////////////////////////////////
+
+type TransitionArgsRetryInstallation struct {
+	ctx context.Context
+	db  *gorm.DB
+}
+
+func (th *transitionHandler) PostRetryInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sCluster, ok := sw.(*stateCluster)
+	if !ok {
+		return errors.New("PostRetryInstallation incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsRetryInstallation)
+	if !ok {
+		return errors.New("PostRetryInstallation invalid argument")
+	}
+
+	return th.updateTransitionCluster(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sCluster,
+		statusInfoRetryingInstallation)
+}
+
+// An installation can be retried only if its hosts already started installing, otherwise the cluster has to be reset
+func (th *transitionHandler) IsInstallationStarted(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
+	sCluster, ok := sw.(*stateCluster)
+	if !ok {
+		return false, errors.New("IsInstallationStarted incompatible type of StateSwitch")
+	}
+	for _, h := range sCluster.cluster.Hosts {
+		if h.Progress != nil && h.Progress.CurrentStage != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////
 // Prepare for installation
 ////////////////////////////////////////////////////////////////////////////
@@ -97,7 +134,7 @@ func (th *transitionHandler) PostPrepareForInstallation(sw stateswitch.StateSwit
 		return errors.New("PostResetCluster invalid argument")
 	}
 
-	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), th.db, sCluster,
+	return th.updateTransitionCluster(params.ctx, logutil.FromContext(params.ctx, th.log), th.db, sCluster,
 		statusInfoPreparingForInstallation, "install_started_at", strfmt.DateTime(time.Now()))
 }
 
@@ -121,7 +158,7 @@ func (th *transitionHandler) PostCompleteInstallation(sw stateswitch.StateSwitch
 		return errors.New("PostCompleteInstallation invalid argument")
 	}
 
-	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), th.db, sCluster,
+	return th.updateTransitionCluster(params.ctx, logutil.FromContext(params.ctx, th.log), th.db, sCluster,
 		params.reason, "install_completed_at", strfmt.DateTime(time.Now()))
 }
 
@@ -147,14 +184,14 @@ type TransitionArgsHandlePreInstallationError struct {
 func (th *transitionHandler) PostHandlePreInstallationError(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
 	sCluster, _ := sw.(*stateCluster)
 	params, _ := args.(*TransitionArgsHandlePreInstallationError)
-	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), th.db, sCluster,
+	return th.updateTransitionCluster(params.ctx, logutil.FromContext(params.ctx, th.log), th.db, sCluster,
 		params.installErr.Error())
 }
 
-func (th *transitionHandler) updateTransitionCluster(log logrus.FieldLogger, db *gorm.DB, state *stateCluster,
+func (th *transitionHandler) updateTransitionCluster(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, state *stateCluster,
 	statusInfo string, extra ...interface{}) error {
 
-	if cluster, err := updateClusterStatus(log, db, *state.cluster.ID, state.srcState,
+	if cluster, err := updateClusterStatus(ctx, log, db, *state.cluster.ID, state.transitionType, state.srcState,
 		swag.StringValue(state.cluster.Status), statusInfo, extra...); err != nil {
 		return err
 	} else {
@@ -173,7 +210,7 @@ type TransitionArgsRefreshCluster struct {
 	metricApi         metrics.API
 	hostApi           host.API
 	conditions        map[string]bool
-	validationResults map[string][]validationResult
+	validationResults map[string][]common.ValidationResult
 	db                *gorm.DB
 }
 
@@ -228,9 +265,13 @@ func (th *transitionHandler) enoughMastersAndWorkers(sCluster *stateCluster, sta
 	}
 
 	numberOfExpectedWorkers := NumberOfWorkers(sCluster.cluster)
+	minMastersNeededForInstallation := MinMastersNeededForInstallation
+	if common.IsSingleNodeCluster(sCluster.cluster) {
+		minMastersNeededForInstallation = common.AllowedNumberOfMasterHostsInNoneHaMode
+	}
 
-	// to be installed cluster need 3 master and at least 1 worker(if workers were given)
-	if mastersInSomeInstallingStatus >= MinMastersNeededForInstallation &&
+	// to be installed cluster need 3 master (1 for single node cluster) and at least 1 worker(if workers were given)
+	if mastersInSomeInstallingStatus >= minMastersNeededForInstallation &&
 		(numberOfExpectedWorkers == 0 || workersInSomeInstallingStatus >= MinWorkersNeededForInstallation) {
 		return true
 	}
@@ -270,8 +311,11 @@ func (th *transitionHandler) PostRefreshCluster(reason string) stateswitch.PostT
 		if err != nil {
 			return err
 		}
-		updatedCluster, err = updateClusterStatus(logutil.FromContext(params.ctx, th.log), params.db, *sCluster.cluster.ID, sCluster.srcState, *sCluster.cluster.Status,
+		updatedCluster, err = updateClusterStatus(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, *sCluster.cluster.ID, sCluster.transitionType, sCluster.srcState, *sCluster.cluster.Status,
 			reason, "validations_info", string(b))
+		if err == nil {
+			err = common.UpdateValidations(params.db, *sCluster.cluster.ID, nil, common.ToValidationModels(params.validationResults))
+		}
 		//update hosts status to models.HostStatusResettingPendingUserAction if needed
 		cluster := sCluster.cluster
 		if updatedCluster != nil {
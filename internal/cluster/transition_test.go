@@ -40,7 +40,7 @@ var _ = Describe("Transition tests", func() {
 		eventsHandler = events.New(db, logrus.New())
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
-		capi = NewManager(defaultTestConfig, getTestLog(), db, eventsHandler, nil, mockMetric, nil)
+		capi = NewManager(defaultTestConfig, getTestLog(), db, eventsHandler, nil, mockMetric, nil, getTestVersionsHandler())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
 
@@ -163,7 +163,7 @@ var _ = Describe("Cancel cluster installation", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEventsHandler = events.NewMockHandler(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
-		capi = NewManager(defaultTestConfig, getTestLog(), db, mockEventsHandler, nil, mockMetric, nil)
+		capi = NewManager(defaultTestConfig, getTestLog(), db, mockEventsHandler, nil, mockMetric, nil, getTestVersionsHandler())
 	})
 
 	acceptNewEvents := func(times int) {
@@ -231,7 +231,7 @@ var _ = Describe("Reset cluster", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEventsHandler = events.NewMockHandler(ctrl)
-		capi = NewManager(defaultTestConfig, getTestLog(), db, mockEventsHandler, nil, nil, nil)
+		capi = NewManager(defaultTestConfig, getTestLog(), db, mockEventsHandler, nil, nil, nil, getTestVersionsHandler())
 	})
 
 	acceptNewEvents := func(times int) {
@@ -305,7 +305,7 @@ type validationsChecker struct {
 }
 
 func (j *validationsChecker) check(validationsStr string) {
-	validationMap := make(map[string][]validationResult)
+	validationMap := make(map[string][]common.ValidationResult)
 	Expect(json.Unmarshal([]byte(validationsStr), &validationMap)).ToNot(HaveOccurred())
 next:
 	for id, checkedResult := range j.expected {
@@ -314,8 +314,8 @@ next:
 		results, ok := validationMap[category]
 		Expect(ok).To(BeTrue())
 		for _, r := range results {
-			if r.ID == id {
-				Expect(r.Status).To(Equal(checkedResult.status), "id = %s", id.String())
+			if r.ID == id.String() {
+				Expect(r.Status).To(Equal(string(checkedResult.status)), "id = %s", id.String())
 				Expect(r.Message).To(MatchRegexp(checkedResult.messagePattern))
 				continue next
 			}
@@ -358,7 +358,7 @@ var _ = Describe("Refresh Cluster - No DHCP", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, mockMetric, nil)
+			mockEvents, mockHostAPI, mockMetric, nil, getTestVersionsHandler())
 
 		hid1 = strfmt.UUID(uuid.New().String())
 		hid2 = strfmt.UUID(uuid.New().String())
@@ -743,6 +743,19 @@ var _ = Describe("Refresh Cluster - No DHCP", func() {
 				validationsChecker: nil,
 				errorExpected:      false,
 			},
+			{
+				name:          "adding-hosts to adding-hosts",
+				srcState:      models.ClusterStatusAddingHosts,
+				srcStatusInfo: statusInfoAddingHosts,
+				dstState:      models.ClusterStatusAddingHosts,
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleWorker},
+					{ID: &hid2, Status: swag.String(models.HostStatusInstalling), Inventory: defaultInventory(), Role: models.HostRoleWorker},
+				},
+				statusInfoChecker:  makeValueChecker(statusInfoAddingHosts),
+				validationsChecker: nil,
+				errorExpected:      false,
+			},
 		}
 
 		for i := range tests {
@@ -768,6 +781,7 @@ var _ = Describe("Refresh Cluster - No DHCP", func() {
 					t.hosts[i].ClusterID = clusterId
 					Expect(db.Create(&t.hosts[i]).Error).ShouldNot(HaveOccurred())
 				}
+				connectHosts(clusterId, db)
 				cluster = getCluster(clusterId, db)
 				if srcState != t.dstState {
 					mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(),
@@ -820,7 +834,7 @@ var _ = Describe("Refresh Cluster - Advanced networking validations", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, mockMetric, nil)
+			mockEvents, mockHostAPI, mockMetric, nil, getTestVersionsHandler())
 
 		hid1 = strfmt.UUID(uuid.New().String())
 		hid2 = strfmt.UUID(uuid.New().String())
@@ -840,6 +854,10 @@ var _ = Describe("Refresh Cluster - Advanced networking validations", func() {
 			clusterNetworkHostPrefix int64
 			apiVip                   string
 			ingressVip               string
+			openshiftVersion         string
+			networkType              string
+			userManagedNetworking    bool
+			highAvailabilityMode     *string
 			dstState                 string
 			hosts                    []models.Host
 			statusInfoChecker        statusInfoChecker
@@ -938,6 +956,104 @@ var _ = Describe("Refresh Cluster - Advanced networking validations", func() {
 					isServiceCidrDefined:                {status: ValidationSuccess, messagePattern: "Service Network CIDR is defined"},
 					noCidrOverlapping:                   {status: ValidationSuccess, messagePattern: "No CIDRS overlapping"},
 					networkPrefixValid:                  {status: ValidationSuccess, messagePattern: "Cluster Network Prefix valid"},
+					isNetworkTypeValid:                  {status: ValidationSuccess, messagePattern: "The network type OpenShiftSDN is valid"},
+				}),
+				errorExpected: false,
+			},
+			{
+				name:                     "pending-for-input to insufficient - network type not supported by version",
+				srcState:                 models.ClusterStatusPendingForInput,
+				dstState:                 models.ClusterStatusInsufficient,
+				machineNetworkCidr:       "1.2.3.0/24",
+				apiVip:                   "1.2.3.4",
+				ingressVip:               "1.2.3.5",
+				serviceNetworkCidr:       "1.2.8.0/23",
+				clusterNetworkCidr:       "1.2.20.0/24",
+				clusterNetworkHostPrefix: 23,
+				openshiftVersion:         "4.5",
+				networkType:              models.ClusterNetworkTypeOVNKubernetes,
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid2, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid3, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid4, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleWorker},
+				},
+				statusInfoChecker: makeValueChecker(statusInfoInsufficient),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					noCidrOverlapping:  {status: ValidationSuccess, messagePattern: "No CIDRS overlapping"},
+					networkPrefixValid: {status: ValidationSuccess, messagePattern: "Cluster Network Prefix valid"},
+					isNetworkTypeValid: {status: ValidationFailure, messagePattern: "Invalid network type: Network type OVNKubernetes is not supported by OpenShift version 4.5"},
+				}),
+				errorExpected: false,
+			},
+			{
+				name:                     "pending-for-input to ready - user managed networking",
+				srcState:                 models.ClusterStatusPendingForInput,
+				dstState:                 models.ClusterStatusReady,
+				machineNetworkCidr:       "1.2.3.0/24",
+				serviceNetworkCidr:       "1.2.8.0/23",
+				clusterNetworkCidr:       "1.2.20.0/24",
+				clusterNetworkHostPrefix: 23,
+				userManagedNetworking:    true,
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster, DomainResolutions: domainResolutions("1.2.3.10")},
+					{ID: &hid2, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster, DomainResolutions: domainResolutions("1.2.3.10")},
+					{ID: &hid3, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster, DomainResolutions: domainResolutions("1.2.3.10")},
+					{ID: &hid4, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleWorker, DomainResolutions: domainResolutions("1.2.3.10")},
+				},
+				statusInfoChecker: makeValueChecker(statusInfoReady),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					isMachineCidrEqualsToCalculatedCidr: {status: ValidationSuccess, messagePattern: "No machine CIDR calculation needed: User managed networking"},
+					isApiVipDefined:                     {status: ValidationSuccess, messagePattern: "No API VIP needed: User managed networking"},
+					isApiVipValid:                       {status: ValidationSuccess, messagePattern: "No API VIP validation needed: User managed networking"},
+					isIngressVipDefined:                 {status: ValidationSuccess, messagePattern: "No Ingress VIP needed: User managed networking"},
+					isIngressVipValid:                   {status: ValidationSuccess, messagePattern: "No Ingress VIP validation needed: User managed networking"},
+					isApiDomainNameResolved:             {status: ValidationSuccess, messagePattern: "The API domain name api.test.test.com is resolved by all hosts in the cluster"},
+					isAppsDomainNameResolved:            {status: ValidationSuccess, messagePattern: "is resolved by all hosts in the cluster"},
+				}),
+				errorExpected: false,
+			},
+			{
+				name:                     "pending-for-input to ready - single node cluster",
+				srcState:                 models.ClusterStatusPendingForInput,
+				dstState:                 models.ClusterStatusReady,
+				machineNetworkCidr:       "1.2.3.0/24",
+				serviceNetworkCidr:       "1.2.8.0/23",
+				clusterNetworkCidr:       "1.2.20.0/24",
+				clusterNetworkHostPrefix: 23,
+				highAvailabilityMode:     swag.String(models.ClusterHighAvailabilityModeNone),
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster},
+				},
+				statusInfoChecker: makeValueChecker(statusInfoReady),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					isMachineCidrEqualsToCalculatedCidr: {status: ValidationSuccess, messagePattern: "No machine CIDR calculation needed: Single node cluster"},
+					isApiVipDefined:                     {status: ValidationSuccess, messagePattern: "No API VIP needed: Single node cluster"},
+					isApiVipValid:                       {status: ValidationSuccess, messagePattern: "No API VIP validation needed: Single node cluster"},
+					isIngressVipDefined:                 {status: ValidationSuccess, messagePattern: "No Ingress VIP needed: Single node cluster"},
+					isIngressVipValid:                   {status: ValidationSuccess, messagePattern: "No Ingress VIP validation needed: Single node cluster"},
+				}),
+				errorExpected: false,
+			},
+			{
+				name:                     "pending-for-input to insufficient - user managed networking domain names not resolved",
+				srcState:                 models.ClusterStatusPendingForInput,
+				dstState:                 models.ClusterStatusInsufficient,
+				machineNetworkCidr:       "1.2.3.0/24",
+				serviceNetworkCidr:       "1.2.8.0/23",
+				clusterNetworkCidr:       "1.2.20.0/24",
+				clusterNetworkHostPrefix: 23,
+				userManagedNetworking:    true,
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster, DomainResolutions: domainResolutions("1.2.3.10")},
+					{ID: &hid2, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster, DomainResolutions: domainResolutions()},
+					{ID: &hid3, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleMaster, DomainResolutions: domainResolutions("1.2.3.10")},
+					{ID: &hid4, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventory(), Role: models.HostRoleWorker},
+				},
+				statusInfoChecker: makeValueChecker(statusInfoInsufficient),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					isApiDomainNameResolved:  {status: ValidationFailure, messagePattern: "The API domain name api.test.test.com is not resolved by hosts"},
+					isAppsDomainNameResolved: {status: ValidationFailure, messagePattern: "is not resolved by hosts"},
 				}),
 				errorExpected: false,
 			},
@@ -957,7 +1073,12 @@ var _ = Describe("Refresh Cluster - Advanced networking validations", func() {
 						ClusterNetworkCidr:       t.clusterNetworkCidr,
 						ServiceNetworkCidr:       t.serviceNetworkCidr,
 						ClusterNetworkHostPrefix: t.clusterNetworkHostPrefix,
+						OpenshiftVersion:         t.openshiftVersion,
+						NetworkType:              t.networkType,
+						UserManagedNetworking:    swag.Bool(t.userManagedNetworking),
+						HighAvailabilityMode:     t.highAvailabilityMode,
 						PullSecretSet:            true,
+						Name:                     "test",
 						BaseDNSDomain:            "test.com",
 					},
 				}
@@ -966,6 +1087,7 @@ var _ = Describe("Refresh Cluster - Advanced networking validations", func() {
 					t.hosts[i].ClusterID = clusterId
 					Expect(db.Create(&t.hosts[i]).Error).ShouldNot(HaveOccurred())
 				}
+				connectHosts(clusterId, db)
 				cluster = getCluster(clusterId, db)
 				if srcState != t.dstState {
 					mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(),
@@ -1018,7 +1140,7 @@ var _ = Describe("Refresh Cluster - With DHCP", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, mockMetric, nil)
+			mockEvents, mockHostAPI, mockMetric, nil, getTestVersionsHandler())
 
 		hid1 = strfmt.UUID(uuid.New().String())
 		hid2 = strfmt.UUID(uuid.New().String())
@@ -1407,6 +1529,7 @@ var _ = Describe("Refresh Cluster - With DHCP", func() {
 					t.hosts[i].ClusterID = clusterId
 					Expect(db.Create(&t.hosts[i]).Error).ShouldNot(HaveOccurred())
 				}
+				connectHosts(clusterId, db)
 				cluster = getCluster(clusterId, db)
 				if srcState != t.dstState {
 					mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(),
@@ -1435,6 +1558,117 @@ var _ = Describe("Refresh Cluster - With DHCP", func() {
 	})
 })
 
+var _ = Describe("Refresh Cluster - Connectivity", func() {
+	var (
+		ctx              = context.Background()
+		db               *gorm.DB
+		clusterId        strfmt.UUID
+		hid1, hid2, hid3 strfmt.UUID
+		cluster          common.Cluster
+		clusterApi       *Manager
+		mockEvents       *events.MockHandler
+		mockHostAPI      *host.MockAPI
+		ctrl             *gomock.Controller
+		dbName           = "cluster_transition_test_refresh_connectivity"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		mockHostAPI = host.NewMockAPI(ctrl)
+		clusterApi = NewManager(defaultTestConfig, getTestLog().WithField("pkg", "cluster-monitor"), db,
+			mockEvents, mockHostAPI, nil, nil, getTestVersionsHandler())
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockHostAPI.EXPECT().IsRequireUserActionReset(gomock.Any()).Return(false).AnyTimes()
+
+		clusterId = strfmt.UUID(uuid.New().String())
+		hid1 = strfmt.UUID(uuid.New().String())
+		hid2 = strfmt.UUID(uuid.New().String())
+		hid3 = strfmt.UUID(uuid.New().String())
+		cluster = common.Cluster{Cluster: models.Cluster{
+			ID:                       &clusterId,
+			Status:                   swag.String(models.ClusterStatusInsufficient),
+			StatusInfo:               swag.String(statusInfoInsufficient),
+			MachineNetworkCidr:       "1.2.3.0/24",
+			APIVip:                   "1.2.3.5",
+			IngressVip:               "1.2.3.6",
+			BaseDNSDomain:            "test.com",
+			PullSecretSet:            true,
+			ClusterNetworkCidr:       "1.2.4.0/24",
+			ServiceNetworkCidr:       "1.2.5.0/24",
+			ClusterNetworkHostPrefix: 24,
+		}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		for _, id := range []strfmt.UUID{hid1, hid2, hid3} {
+			hostId := id
+			h := models.Host{ID: &hostId, ClusterID: clusterId, Status: swag.String(models.HostStatusKnown),
+				Inventory: defaultInventory(), Role: models.HostRoleMaster}
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		}
+		connectHosts(clusterId, db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	setConnectivity := func(hostId strfmt.UUID, connectivity string) {
+		Expect(db.Model(&models.Host{}).Where("id = ? and cluster_id = ?", hostId.String(), clusterId.String()).
+			Update("connectivity", connectivity).Error).ShouldNot(HaveOccurred())
+	}
+
+	refresh := func() *common.Cluster {
+		cluster = getCluster(clusterId, db)
+		clusterAfterRefresh, err := clusterApi.RefreshStatus(ctx, &cluster, db)
+		Expect(err).ShouldNot(HaveOccurred())
+		return clusterAfterRefresh
+	}
+
+	It("all hosts are connected", func() {
+		c := refresh()
+		Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusReady))
+		makeJsonChecker(map[validationID]validationCheckResult{
+			AllHostsAreConnected: {status: ValidationSuccess, messagePattern: "All hosts in the cluster are connected to each other"},
+		}).check(c.ValidationsInfo)
+	})
+
+	It("host is not reachable", func() {
+		setConnectivity(hid3, `{"remote_hosts":[]}`)
+		c := refresh()
+		Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusInsufficient))
+		makeJsonChecker(map[validationID]validationCheckResult{
+			AllHostsAreConnected: {status: ValidationFailure, messagePattern: "No connectivity on machine network CIDR 1.2.3.0/24 between hosts"},
+		}).check(c.ValidationsInfo)
+		Expect(c.ValidationsInfo).Should(ContainSubstring(hid3.String()))
+	})
+
+	It("host is reachable only outside of the machine network", func() {
+		report := models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{
+			{HostID: hid1, L2Connectivity: []*models.L2Connectivity{{RemoteIPAddress: "10.0.0.1", Successful: true}}},
+			{HostID: hid2, L3Connectivity: []*models.L3Connectivity{{RemoteIPAddress: "10.0.0.2", Successful: true}}},
+		}}
+		b, err := json.Marshal(&report)
+		Expect(err).ShouldNot(HaveOccurred())
+		setConnectivity(hid3, string(b))
+		c := refresh()
+		Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusInsufficient))
+		makeJsonChecker(map[validationID]validationCheckResult{
+			AllHostsAreConnected: {status: ValidationFailure, messagePattern: "No connectivity on machine network CIDR 1.2.3.0/24 between hosts"},
+		}).check(c.ValidationsInfo)
+	})
+
+	It("missing connectivity report", func() {
+		setConnectivity(hid3, "")
+		c := refresh()
+		Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusInsufficient))
+		makeJsonChecker(map[validationID]validationCheckResult{
+			AllHostsAreConnected: {status: ValidationPending, messagePattern: "Missing connectivity reports of hosts"},
+		}).check(c.ValidationsInfo)
+	})
+})
+
 func getCluster(clusterId strfmt.UUID, db *gorm.DB) common.Cluster {
 	var cluster common.Cluster
 	Expect(db.Preload("Hosts").First(&cluster, "id = ?", clusterId).Error).ShouldNot(HaveOccurred())
@@ -26,12 +26,17 @@ const (
 	SufficientMastersCount              = validationID(models.ClusterValidationIDSufficientMastersCount)
 	IsDNSDomainDefined                  = validationID(models.ClusterValidationIDDNSDomainDefined)
 	IsPullSecretSet                     = validationID(models.ClusterValidationIDPullSecretSet)
+	AllHostsAreConnected                = validationID(models.ClusterValidationIDAllHostsAreConnected)
+	isNetworkTypeValid                  = validationID(models.ClusterValidationIDNetworkTypeValid)
+	isApiDomainNameResolved             = validationID(models.ClusterValidationIDAPIDomainNameResolved)
+	isAppsDomainNameResolved            = validationID(models.ClusterValidationIDAppsDomainNameResolved)
 )
 
 func (v validationID) category() (string, error) {
 	switch v {
 	case IsMachineCidrDefined, isMachineCidrEqualsToCalculatedCidr, isApiVipDefined, isApiVipValid, isIngressVipDefined, isIngressVipValid,
-		isClusterCidrDefined, isServiceCidrDefined, noCidrOverlapping, networkPrefixValid, IsDNSDomainDefined:
+		isClusterCidrDefined, isServiceCidrDefined, noCidrOverlapping, networkPrefixValid, IsDNSDomainDefined, AllHostsAreConnected,
+		isNetworkTypeValid, isApiDomainNameResolved, isAppsDomainNameResolved:
 		return "network", nil
 	case AllHostsAreReadyToInstall, SufficientMastersCount:
 		return "hosts-data", nil
@@ -2,8 +2,10 @@ package validations
 
 import (
 	"bytes"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -11,15 +13,16 @@ import (
 	"strings"
 
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/registries"
 
 	"github.com/pkg/errors"
 
 	"golang.org/x/crypto/ssh"
 
 	"github.com/asaskevich/govalidator"
-	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
 
 	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/dnsprovider"
 	"github.com/openshift/assisted-service/pkg/ocm"
 )
 
@@ -142,7 +145,7 @@ func ValidatePullSecret(secret string, username string, authHandler auth.AuthHan
 	return nil
 }
 
-func validateDomainNameFormat(dnsDomainName string) error {
+func ValidateDomainNameFormat(dnsDomainName string) error {
 	matched, err := regexp.MatchString(dnsNameRegex, dnsDomainName)
 	if err != nil {
 		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "DNS name validation for %s", dnsDomainName))
@@ -153,25 +156,15 @@ func validateDomainNameFormat(dnsDomainName string) error {
 	return nil
 }
 
-// ValidateBaseDNS validates the specified base domain name
-func ValidateBaseDNS(dnsDomainName, dnsDomainID, dnsProviderType string) error {
-	if err := validateDomainNameFormat(dnsDomainName); err != nil {
+// ValidateBaseDNS validates the specified base domain name against the zone of its DNS provider
+func ValidateBaseDNS(dnsDomainName string, dnsProvider dnsprovider.Provider) error {
+	if err := ValidateDomainNameFormat(dnsDomainName); err != nil {
 		return err
 	}
-	var dnsProvider dnsproviders.Provider
-	switch dnsProviderType {
-	case "route53":
-		dnsProvider = dnsproviders.Route53{
-			HostedZoneID: dnsDomainID,
-			SharedCreds:  true,
-		}
-	default:
-		return nil
-	}
-	return validateBaseDNS(dnsDomainName, dnsDomainID, dnsProvider)
+	return validateBaseDNS(dnsDomainName, dnsProvider)
 }
 
-func validateBaseDNS(dnsDomainName, dnsDomainID string, dnsProvider dnsproviders.Provider) error {
+func validateBaseDNS(dnsDomainName string, dnsProvider dnsprovider.Provider) error {
 	dnsNameFromService, err := dnsProvider.GetDomainName()
 	if err != nil {
 		return fmt.Errorf("Can't validate base DNS domain: %v", err)
@@ -189,31 +182,14 @@ func validateBaseDNS(dnsDomainName, dnsDomainID string, dnsProvider dnsproviders
 	return nil
 }
 
-// CheckDNSRecordsExistence checks whether that specified record-set names already exist in the DNS service
-func CheckDNSRecordsExistence(names []string, dnsDomainID, dnsProviderType string) error {
-	var dnsProvider dnsproviders.Provider
-	switch dnsProviderType {
-	case "route53":
-		dnsProvider = dnsproviders.Route53{
-			RecordSet: dnsproviders.RecordSet{
-				RecordSetType: "A",
-			},
-			HostedZoneID: dnsDomainID,
-			SharedCreds:  true,
-		}
-	default:
-		return nil
-	}
-	return checkDNSRecordsExistence(names, dnsProvider)
-}
-
-func checkDNSRecordsExistence(names []string, dnsProvider dnsproviders.Provider) error {
+// CheckDNSRecordsExistence checks whether that specified record names already exist in the DNS service
+func CheckDNSRecordsExistence(names []string, dnsProvider dnsprovider.Provider) error {
 	for _, name := range names {
-		res, err := dnsProvider.GetRecordSet(name)
+		exists, err := dnsProvider.RecordExists(name)
 		if err != nil {
 			return fmt.Errorf("Can't verify DNS record set existence: %v", err)
 		}
-		if res != "" {
+		if exists {
 			return fmt.Errorf("DNS domain already exists")
 		}
 	}
@@ -279,3 +255,37 @@ func ValidateSSHPublicKey(sshPublicKey string) (err error) {
 	}
 	return
 }
+
+// ValidateImageContentSources validates that the image content sources are a YAML formatted list of sources that have
+// mirrors
+func ValidateImageContentSources(imageContentSources string) error {
+	_, err := registries.ParseImageContentSources(imageContentSources)
+	return err
+}
+
+// ValidateAdditionalTrustBundle validates that the trust bundle consists of PEM-encoded X.509 certificates
+func ValidateAdditionalTrustBundle(trustBundle string) error {
+	rest := []byte(trustBundle)
+	count := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return errors.Errorf("Additional trust bundle contains a PEM block of type %s, only certificates are allowed", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return errors.Wrap(err, "Additional trust bundle contains an invalid certificate")
+		}
+		count++
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return errors.New("Additional trust bundle contains data that isn't PEM-encoded")
+	}
+	if count == 0 {
+		return errors.New("Additional trust bundle contains no certificates")
+	}
+	return nil
+}
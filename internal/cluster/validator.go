@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -9,7 +10,9 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 )
@@ -81,8 +84,17 @@ func boolValue(b bool) validationStatus {
 }
 
 type clusterValidator struct {
-	log     logrus.FieldLogger
-	hostAPI host.API
+	log             logrus.FieldLogger
+	hostAPI         host.API
+	versionsHandler versions.Handler
+}
+
+// noVipsReason returns why the cluster has no VIPs
+func noVipsReason(cluster *common.Cluster) string {
+	if common.IsSingleNodeCluster(cluster) {
+		return "Single node cluster"
+	}
+	return "User managed networking"
 }
 
 func (v *clusterValidator) isMachineCidrDefined(c *clusterPreprocessContext) validationStatus {
@@ -92,7 +104,9 @@ func (v *clusterValidator) isMachineCidrDefined(c *clusterPreprocessContext) val
 func (v *clusterValidator) printIsMachineCidrDefined(context *clusterPreprocessContext, status validationStatus) string {
 	switch status {
 	case ValidationFailure:
-		if swag.BoolValue(context.cluster.VipDhcpAllocation) {
+		if common.HasNoVips(context.cluster) {
+			return "Machine network CIDR is undefined and must be provided"
+		} else if swag.BoolValue(context.cluster.VipDhcpAllocation) {
 			return "Machine network CIDR is undefined; setting the machine network CIDR initiates the VIPs DHCP lease allocation"
 		} else {
 			return "Machine network CIDR is undefined; the machine network CIDR can be defined by setting either the API VIP or the Ingress VIP"
@@ -135,6 +149,9 @@ func (v *clusterValidator) printIsServiceCidrDefined(context *clusterPreprocessC
 }
 
 func (v *clusterValidator) isMachineCidrEqualsToCalculatedCidr(c *clusterPreprocessContext) validationStatus {
+	if common.HasNoVips(c.cluster) {
+		return ValidationSuccess
+	}
 	if c.cluster.APIVip == "" && c.cluster.IngressVip == "" {
 		return ValidationPending
 	}
@@ -148,6 +165,9 @@ func (v *clusterValidator) printIsMachineCidrEqualsToCalculatedCidr(context *clu
 	case ValidationPending:
 		return "Machine network CIDR or API VIP or Ingress VIP is undefined"
 	case ValidationSuccess:
+		if common.HasNoVips(context.cluster) {
+			return fmt.Sprintf("No machine CIDR calculation needed: %s", noVipsReason(context.cluster))
+		}
 		return "Cluster machine CIDR equals to the calculated CIDR "
 	case ValidationFailure:
 		return fmt.Sprintf("Cluster machine CIDR %s is different than the calculated CIDR %s", context.cluster.MachineNetworkCidr, context.calculateCidr)
@@ -157,6 +177,9 @@ func (v *clusterValidator) printIsMachineCidrEqualsToCalculatedCidr(context *clu
 }
 
 func (v *clusterValidator) isApiVipDefined(c *clusterPreprocessContext) validationStatus {
+	if common.HasNoVips(c.cluster) {
+		return ValidationSuccess
+	}
 	if swag.BoolValue(c.cluster.VipDhcpAllocation) && c.cluster.MachineNetworkCidr == "" {
 		return ValidationPending
 	}
@@ -178,6 +201,9 @@ func (v *clusterValidator) printIsApiVipDefined(context *clusterPreprocessContex
 			return "API VIP is undefined and must be provided"
 		}
 	case ValidationSuccess:
+		if common.HasNoVips(context.cluster) {
+			return fmt.Sprintf("No API VIP needed: %s", noVipsReason(context.cluster))
+		}
 		return "API VIP is defined"
 	default:
 		return fmt.Sprintf("Unexpected status %s", status)
@@ -185,6 +211,9 @@ func (v *clusterValidator) printIsApiVipDefined(context *clusterPreprocessContex
 }
 
 func (v *clusterValidator) isApiVipValid(c *clusterPreprocessContext) validationStatus {
+	if common.HasNoVips(c.cluster) {
+		return ValidationSuccess
+	}
 	if c.cluster.APIVip == "" {
 		return ValidationPending
 	}
@@ -198,6 +227,9 @@ func (v *clusterValidator) printIsApiVipValid(context *clusterPreprocessContext,
 	case ValidationPending:
 		return "API VIP is undefined"
 	case ValidationSuccess:
+		if common.HasNoVips(context.cluster) {
+			return fmt.Sprintf("No API VIP validation needed: %s", noVipsReason(context.cluster))
+		}
 		return fmt.Sprintf("%s %s belongs to machine CIDR and not in use ", ApiVipName, context.cluster.APIVip)
 	case ValidationFailure:
 		return fmt.Sprintf("%s %s does not belong to machine CIDR or already in use ", ApiVipName, context.cluster.APIVip)
@@ -207,6 +239,9 @@ func (v *clusterValidator) printIsApiVipValid(context *clusterPreprocessContext,
 }
 
 func (v *clusterValidator) isIngressVipDefined(c *clusterPreprocessContext) validationStatus {
+	if common.HasNoVips(c.cluster) {
+		return ValidationSuccess
+	}
 	if swag.BoolValue(c.cluster.VipDhcpAllocation) && c.cluster.MachineNetworkCidr == "" {
 		return ValidationPending
 	}
@@ -228,12 +263,18 @@ func (v *clusterValidator) printIsIngressVipDefined(context *clusterPreprocessCo
 			return "Ingress VIP is undefined and must be provided"
 		}
 	case ValidationSuccess:
+		if common.HasNoVips(context.cluster) {
+			return fmt.Sprintf("No Ingress VIP needed: %s", noVipsReason(context.cluster))
+		}
 		return "Ingress VIP is defined"
 	default:
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
 func (v *clusterValidator) isIngressVipValid(c *clusterPreprocessContext) validationStatus {
+	if common.HasNoVips(c.cluster) {
+		return ValidationSuccess
+	}
 	if c.cluster.IngressVip == "" {
 		return ValidationPending
 	}
@@ -247,6 +288,9 @@ func (v *clusterValidator) printIsIngressVipValid(context *clusterPreprocessCont
 	case ValidationPending:
 		return "Ingress VIP is undefined"
 	case ValidationSuccess:
+		if common.HasNoVips(context.cluster) {
+			return fmt.Sprintf("No Ingress VIP validation needed: %s", noVipsReason(context.cluster))
+		}
 		return fmt.Sprintf("%s %s belongs to machine CIDR and not in use ", IngressVipName, context.cluster.IngressVip)
 	case ValidationFailure:
 		return fmt.Sprintf("%s %s does not belong to machine CIDR or already in use ", IngressVipName, context.cluster.IngressVip)
@@ -259,15 +303,21 @@ func (v *clusterValidator) printIsIngressVipValid(context *clusterPreprocessCont
 // 1. have exactly three masters
 // 2. have less then 3 master but enough auto-assign hosts that can become masters
 // having more then 3 known masters is failure
+// single node cluster (high availability mode None) must have exactly one enabled host that can be a master
 func (v *clusterValidator) sufficientMastersCount(c *clusterPreprocessContext) validationStatus {
+	mastersNeeded := common.GetMasterHostsNeededForInstallation(c.cluster)
+	if common.IsSingleNodeCluster(c.cluster) && numberOfEnabledHosts(c.cluster) != mastersNeeded {
+		return boolValue(false)
+	}
+
 	mappedMastersByRole := MapMasterHostsByStatus(c.cluster)
 	mastersInKnown, ok := mappedMastersByRole[models.HostStatusKnown]
 
-	if ok && len(mastersInKnown) == common.MinMasterHostsNeededForInstallation {
+	if ok && len(mastersInKnown) == mastersNeeded {
 		return boolValue(true)
 	}
 
-	if ok && len(mastersInKnown) > common.MinMasterHostsNeededForInstallation {
+	if ok && len(mastersInKnown) > mastersNeeded {
 		return boolValue(false)
 	}
 
@@ -278,7 +328,7 @@ func (v *clusterValidator) sufficientMastersCount(c *clusterPreprocessContext) v
 		}
 	}
 
-	return boolValue(candidates >= common.MinMasterHostsNeededForInstallation)
+	return boolValue(candidates >= mastersNeeded)
 }
 
 func (v *clusterValidator) printSufficientMastersCount(context *clusterPreprocessContext, status validationStatus) string {
@@ -286,6 +336,10 @@ func (v *clusterValidator) printSufficientMastersCount(context *clusterPreproces
 	case ValidationSuccess:
 		return "Cluster has sufficient number of master candidates"
 	case ValidationFailure:
+		if common.IsSingleNodeCluster(context.cluster) {
+			return fmt.Sprintf("Single node cluster is expected to have exactly %d master host and %d worker hosts",
+				common.AllowedNumberOfMasterHostsInNoneHaMode, common.AllowedNumberOfWorkersInNoneHaMode)
+		}
 		return fmt.Sprintf("no sufficient count of master hosts candidates expected %d",
 			common.MinMasterHostsNeededForInstallation)
 	default:
@@ -330,11 +384,25 @@ func (v *clusterValidator) printIsDNSDomainDefined(context *clusterPreprocessCon
 	}
 }
 
+// verifyClusterNetworks verifies that the networks of the cluster don't overlap, and that the networks of a
+// dual-stack cluster are of the right IP families
+func verifyClusterNetworks(cluster *common.Cluster) error {
+	if err := network.VerifyClusterCIDRsNotOverlap(cluster.MachineNetworkCidr, cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr); err != nil {
+		return err
+	}
+	if err := network.VerifyClusterCIDRsNotOverlap(cluster.SecondaryMachineNetworkCidr, cluster.SecondaryClusterNetworkCidr,
+		cluster.SecondaryServiceNetworkCidr); err != nil {
+		return err
+	}
+	return network.VerifyDualStackNetworks(cluster.MachineNetworkCidr, cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr,
+		cluster.SecondaryMachineNetworkCidr, cluster.SecondaryClusterNetworkCidr, cluster.SecondaryServiceNetworkCidr)
+}
+
 func (v *clusterValidator) noCidrsOverlapping(c *clusterPreprocessContext) validationStatus {
 	if c.cluster.MachineNetworkCidr == "" || c.cluster.ClusterNetworkCidr == "" || c.cluster.ServiceNetworkCidr == "" {
 		return ValidationPending
 	}
-	return boolValue(network.VerifyClusterCIDRsNotOverlap(c.cluster.MachineNetworkCidr, c.cluster.ClusterNetworkCidr, c.cluster.ServiceNetworkCidr) == nil)
+	return boolValue(verifyClusterNetworks(c.cluster) == nil)
 }
 
 func (v *clusterValidator) printNoCidrsOverlapping(c *clusterPreprocessContext, status validationStatus) string {
@@ -342,7 +410,7 @@ func (v *clusterValidator) printNoCidrsOverlapping(c *clusterPreprocessContext,
 	case ValidationSuccess:
 		return "No CIDRS overlapping"
 	case ValidationFailure:
-		if err := network.VerifyClusterCIDRsNotOverlap(c.cluster.MachineNetworkCidr, c.cluster.ClusterNetworkCidr, c.cluster.ServiceNetworkCidr); err != nil {
+		if err := verifyClusterNetworks(c.cluster); err != nil {
 			return fmt.Sprintf("CIDRS Overlapping: %s", err.Error())
 		}
 		return ""
@@ -368,8 +436,18 @@ func (v *clusterValidator) printIsPullSecretSet(context *clusterPreprocessContex
 	}
 }
 
+func verifyNetworkPrefixes(cluster *common.Cluster) error {
+	if err := network.VerifyNetworkHostPrefix(cluster.ClusterNetworkHostPrefix, cluster.ClusterNetworkCidr); err != nil {
+		return err
+	}
+	if cluster.SecondaryClusterNetworkCidr != "" {
+		return network.VerifyNetworkHostPrefix(cluster.SecondaryClusterNetworkHostPrefix, cluster.SecondaryClusterNetworkCidr)
+	}
+	return nil
+}
+
 func (v *clusterValidator) networkPrefixValid(c *clusterPreprocessContext) validationStatus {
-	return boolValue(network.VerifyNetworkHostPrefix(c.cluster.ClusterNetworkHostPrefix) == nil)
+	return boolValue(verifyNetworkPrefixes(c.cluster) == nil)
 }
 
 func (v *clusterValidator) printNetworkPrefixValid(c *clusterPreprocessContext, status validationStatus) string {
@@ -377,7 +455,7 @@ func (v *clusterValidator) printNetworkPrefixValid(c *clusterPreprocessContext,
 	case ValidationSuccess:
 		return "Cluster Network Prefix valid"
 	case ValidationFailure:
-		if err := network.VerifyNetworkHostPrefix(c.cluster.ClusterNetworkHostPrefix); err != nil {
+		if err := verifyNetworkPrefixes(c.cluster); err != nil {
 			return fmt.Sprintf("Invalid cluster network prefix: %s", err.Error())
 		}
 		return ""
@@ -385,3 +463,143 @@ func (v *clusterValidator) printNetworkPrefixValid(c *clusterPreprocessContext,
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
+
+func (v *clusterValidator) allHostsAreConnected(c *clusterPreprocessContext) validationStatus {
+	if common.IsDay2Cluster(c.cluster) || len(c.cluster.Hosts) < 2 {
+		return ValidationSuccess
+	}
+	if c.cluster.MachineNetworkCidr == "" {
+		return ValidationPending
+	}
+	disconnected, missingReports, err := network.GetDisconnectedHosts(c.cluster.Hosts, c.cluster.MachineNetworkCidr)
+	if err != nil {
+		v.log.WithError(err).Warnf("failed to check the connectivity of the hosts of cluster %s", c.clusterId.String())
+		return ValidationFailure
+	}
+	if len(disconnected) > 0 {
+		return ValidationFailure
+	}
+	if len(missingReports) > 0 {
+		return ValidationPending
+	}
+	return ValidationSuccess
+}
+
+func (v *clusterValidator) printAllHostsAreConnected(c *clusterPreprocessContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if common.IsDay2Cluster(c.cluster) {
+			return "No connectivity validation needed: Day2 cluster"
+		}
+		return "All hosts in the cluster are connected to each other"
+	case ValidationFailure:
+		disconnected, _, err := network.GetDisconnectedHosts(c.cluster.Hosts, c.cluster.MachineNetworkCidr)
+		if err != nil {
+			return "Failed to parse the connectivity reports of the cluster hosts"
+		}
+		pairs := make([]string, 0, len(disconnected))
+		for _, p := range disconnected {
+			pairs = append(pairs, fmt.Sprintf("%s and %s", hostutil.GetHostnameForMsg(p.Host), hostutil.GetHostnameForMsg(p.RemoteHost)))
+		}
+		return fmt.Sprintf("No connectivity on machine network CIDR %s between hosts %s", c.cluster.MachineNetworkCidr, strings.Join(pairs, ", "))
+	case ValidationPending:
+		if c.cluster.MachineNetworkCidr == "" {
+			return "Machine network CIDR is undefined"
+		}
+		_, missingReports, _ := network.GetDisconnectedHosts(c.cluster.Hosts, c.cluster.MachineNetworkCidr)
+		hosts := make([]string, 0, len(missingReports))
+		for _, h := range missingReports {
+			hosts = append(hosts, hostutil.GetHostnameForMsg(h))
+		}
+		return fmt.Sprintf("Missing connectivity reports of hosts %s", strings.Join(hosts, ", "))
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *clusterValidator) isNetworkTypeValid(c *clusterPreprocessContext) validationStatus {
+	return boolValue(network.VerifyNetworkType(c.cluster, v.versionsHandler) == nil)
+}
+
+func (v *clusterValidator) printIsNetworkTypeValid(c *clusterPreprocessContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return fmt.Sprintf("The network type %s is valid", network.GetNetworkType(c.cluster))
+	case ValidationFailure:
+		if err := network.VerifyNetworkType(c.cluster, v.versionsHandler); err != nil {
+			return fmt.Sprintf("Invalid network type: %s", err.Error())
+		}
+		return ""
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *clusterValidator) isDomainNameResolved(c *clusterPreprocessContext, domainName string) validationStatus {
+	if !common.IsUserManagedNetworking(c.cluster) {
+		return ValidationSuccess
+	}
+	if !network.IsDomainResolutionRequired(c.cluster) {
+		return ValidationPending
+	}
+	unresolved, missingReports, err := network.GetUnresolvedHosts(c.cluster.Hosts, domainName)
+	if err != nil {
+		v.log.WithError(err).Warnf("failed to check the domain resolutions of the hosts of cluster %s", c.clusterId.String())
+		return ValidationFailure
+	}
+	if len(unresolved) > 0 {
+		return ValidationFailure
+	}
+	if len(missingReports) > 0 {
+		return ValidationPending
+	}
+	return ValidationSuccess
+}
+
+func (v *clusterValidator) printIsDomainNameResolved(c *clusterPreprocessContext, status validationStatus, kind, domainName string) string {
+	switch status {
+	case ValidationSuccess:
+		if !common.IsUserManagedNetworking(c.cluster) {
+			return fmt.Sprintf("No %s domain name resolution needed: The service manages the VIPs", kind)
+		}
+		return fmt.Sprintf("The %s domain name %s is resolved by all hosts in the cluster", kind, domainName)
+	case ValidationFailure:
+		unresolved, _, err := network.GetUnresolvedHosts(c.cluster.Hosts, domainName)
+		if err != nil {
+			return "Failed to parse the domain resolutions of the cluster hosts"
+		}
+		hosts := make([]string, 0, len(unresolved))
+		for _, h := range unresolved {
+			hosts = append(hosts, hostutil.GetHostnameForMsg(h))
+		}
+		return fmt.Sprintf("The %s domain name %s is not resolved by hosts %s", kind, domainName, strings.Join(hosts, ", "))
+	case ValidationPending:
+		if !network.IsDomainResolutionRequired(c.cluster) {
+			return "Cluster name or base DNS domain is undefined"
+		}
+		_, missingReports, _ := network.GetUnresolvedHosts(c.cluster.Hosts, domainName)
+		hosts := make([]string, 0, len(missingReports))
+		for _, h := range missingReports {
+			hosts = append(hosts, hostutil.GetHostnameForMsg(h))
+		}
+		return fmt.Sprintf("Missing domain resolution reports of hosts %s", strings.Join(hosts, ", "))
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *clusterValidator) isApiDomainNameResolved(c *clusterPreprocessContext) validationStatus {
+	return v.isDomainNameResolved(c, network.GetAPIDomainName(c.cluster))
+}
+
+func (v *clusterValidator) printIsApiDomainNameResolved(c *clusterPreprocessContext, status validationStatus) string {
+	return v.printIsDomainNameResolved(c, status, "API", network.GetAPIDomainName(c.cluster))
+}
+
+func (v *clusterValidator) isAppsDomainNameResolved(c *clusterPreprocessContext) validationStatus {
+	return v.isDomainNameResolved(c, network.GetAppsDomainName(c.cluster))
+}
+
+func (v *clusterValidator) printIsAppsDomainNameResolved(c *clusterPreprocessContext, status validationStatus) string {
+	return v.printIsDomainNameResolved(c, status, "*.apps", network.GetAppsDomainName(c.cluster))
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// BmcCredentials are the credentials of the BMC of a host. The password is kept only in the database, encrypted with
+// the BMC credentials key of the service, it is never returned by the API
+type BmcCredentials struct {
+	models.BmcCredentials
+	EncryptedPassword string `gorm:"type:text"`
+	// HandledStatusUpdatedAt is the status update time of the host when a power management action was last done for
+	// it, every status of the host that waits for a reboot is handled once
+	HandledStatusUpdatedAt time.Time `gorm:"type:timestamp with time zone"`
+	// AttemptedStatusUpdatedAt is the status update time of the host that the failed attempts were counted for
+	AttemptedStatusUpdatedAt time.Time `gorm:"type:timestamp with time zone"`
+	FailedAttempts           int
+	NextAttemptAt            time.Time `gorm:"type:timestamp with time zone"`
+}
+
+// newBmcCredentialsCipher returns the AES-GCM cipher of a base64 encoded AES-256 key
+func newBmcCredentialsCipher(key string) (cipher.AEAD, error) {
+	if key == "" {
+		return nil, errors.New("the BMC credentials key is not configured")
+	}
+	rawKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "the BMC credentials key is not base64 encoded")
+	}
+	if len(rawKey) != 32 {
+		return nil, errors.Errorf("the BMC credentials key must be 32 bytes long, got %d", len(rawKey))
+	}
+	block, err := aes.NewCipher(rawKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ValidateBmcCredentialsKey returns an error if the key can not encrypt BMC passwords
+func ValidateBmcCredentialsKey(key string) error {
+	_, err := newBmcCredentialsCipher(key)
+	return err
+}
+
+// SetPassword encrypts the password with the key and stores it in the credentials
+func (c *BmcCredentials) SetPassword(key, password string) error {
+	aead, err := newBmcCredentialsCipher(key)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return errors.Wrap(err, "failed to generate a nonce")
+	}
+	c.EncryptedPassword = base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(password), nil))
+	return nil
+}
+
+// GetPassword decrypts the password of the credentials with the key
+func (c *BmcCredentials) GetPassword(key string) (string, error) {
+	aead, err := newBmcCredentialsCipher(key)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(c.EncryptedPassword)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("the encrypted BMC password is malformed")
+	}
+	password, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt the BMC password")
+	}
+	return string(password), nil
+}
+
+// GetBmcCredentials returns the BMC credentials of a host
+func GetBmcCredentials(db *gorm.DB, clusterID strfmt.UUID, hostID strfmt.UUID) (*BmcCredentials, error) {
+	var creds BmcCredentials
+	if err := db.Take(&creds, "cluster_id = ? and host_id = ?", clusterID.String(), hostID.String()).Error; err != nil {
+		return nil, err
+	}
+	return &creds, nil
+}
+
+// DeleteBmcCredentials removes the BMC credentials of all the hosts of a cluster, or only of one host when hostID is set
+func DeleteBmcCredentials(db *gorm.DB, clusterID strfmt.UUID, hostID *strfmt.UUID) error {
+	q := db.Where("cluster_id = ?", clusterID.String())
+	if hostID != nil {
+		q = q.Where("host_id = ?", hostID.String())
+	}
+	return q.Delete(&BmcCredentials{}).Error
+}
@@ -9,13 +9,50 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-openapi/swag"
 	"github.com/sirupsen/logrus"
 
+	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/pkg/errors"
 )
 
-const MinMasterHostsNeededForInstallation = 3
+const (
+	MinMasterHostsNeededForInstallation    = 3
+	AllowedNumberOfMasterHostsInNoneHaMode = 1
+	AllowedNumberOfWorkersInNoneHaMode     = 0
+)
+
+// IsSingleNodeCluster returns true when the cluster is installed over a single host (high availability mode None)
+func IsSingleNodeCluster(cluster *Cluster) bool {
+	return swag.StringValue(cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone
+}
+
+// IsDay2Cluster returns true when the cluster adds hosts to an already installed OpenShift cluster
+func IsDay2Cluster(cluster *Cluster) bool {
+	return swag.StringValue(cluster.Kind) == models.ClusterKindAddHostsCluster
+}
+
+// IsUserManagedNetworking returns true when the user provides the load balancers of the API and the ingress, such
+// clusters have no VIPs
+func IsUserManagedNetworking(cluster *Cluster) bool {
+	return swag.BoolValue(cluster.UserManagedNetworking)
+}
+
+// HasNoVips returns true when the cluster is installed without API and ingress VIPs, user managed networking clusters
+// and single node clusters are both installed with platform none
+func HasNoVips(cluster *Cluster) bool {
+	return IsUserManagedNetworking(cluster) || IsSingleNodeCluster(cluster)
+}
+
+// GetMasterHostsNeededForInstallation returns the number of masters the cluster has to have according to its
+// high availability mode
+func GetMasterHostsNeededForInstallation(cluster *Cluster) int {
+	if IsSingleNodeCluster(cluster) {
+		return AllowedNumberOfMasterHostsInNoneHaMode
+	}
+	return MinMasterHostsNeededForInstallation
+}
 
 // continueOnError is set when running as stream, error is doing nothing when it happens cause we in the middle of stream
 // and 200 was already returned
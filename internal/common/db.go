@@ -11,10 +11,15 @@ type Cluster struct {
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
 	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
 
-	// The compute hash value of the http-proxy, https-proxy and no-proxy attributes, used internally to indicate
-	// if the proxy settings were changed while downloading ISO
+	// The compute hash value of the settings that are embedded in the discovery image, the http-proxy, https-proxy
+	// and no-proxy attributes, the image content sources and the additional trust bundle. Used internally to indicate
+	// if the image settings were changed since the ISO was generated, the name is kept from when it hashed only the
+	// proxy settings so that the hashes of existing clusters stay valid
 	ProxyHash string `json:"proxy_hash"`
 
 	// Used to detect if DHCP allocation task is timed out
 	MachineNetworkCidrUpdatedAt time.Time
+
+	// The time a replica of the service started the build of the image, used to detect lost builds
+	ImageBuildStartedAt time.Time `json:"-"`
 }
@@ -0,0 +1,126 @@
+package common
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+func validationsFilter(db *gorm.DB, clusterID strfmt.UUID, hostID *strfmt.UUID) *gorm.DB {
+	if hostID == nil {
+		return db.Where("cluster_id = ? and host_id = ''", clusterID.String())
+	}
+	return db.Where("cluster_id = ? and host_id = ?", clusterID.String(), hostID.String())
+}
+
+// UpdateValidations stores the latest validation results of a cluster, or of one of its hosts when hostID is set.
+// The status update time of a validation changes only when its status changes, and validations that are not part
+// of the given results anymore are removed
+func UpdateValidations(db *gorm.DB, clusterID strfmt.UUID, hostID *strfmt.UUID, validations []*models.Validation) error {
+	var current []*models.Validation
+	if err := validationsFilter(db, clusterID, hostID).Find(&current).Error; err != nil {
+		return errors.Wrapf(err, "failed to get validations of cluster %s", clusterID)
+	}
+	currentByID := make(map[string]*models.Validation, len(current))
+	for _, v := range current {
+		currentByID[v.ID] = v
+	}
+
+	now := strfmt.DateTime(time.Now())
+	for _, v := range validations {
+		v.ClusterID = clusterID
+		v.HostID = ""
+		if hostID != nil {
+			v.HostID = *hostID
+		}
+		existing, ok := currentByID[v.ID]
+		if !ok {
+			v.StatusUpdatedAt = now
+			if err := db.Create(v).Error; err != nil {
+				return errors.Wrapf(err, "failed to create validation %s of cluster %s", v.ID, clusterID)
+			}
+			continue
+		}
+		delete(currentByID, v.ID)
+		v.StatusUpdatedAt = existing.StatusUpdatedAt
+		if existing.Status == v.Status && existing.Message == v.Message && existing.Category == v.Category {
+			continue
+		}
+		if existing.Status != v.Status {
+			v.StatusUpdatedAt = now
+		}
+		if err := validationsFilter(db, clusterID, hostID).Model(&models.Validation{}).Where("id = ?", v.ID).
+			Updates(map[string]interface{}{
+				"category":          v.Category,
+				"status":            v.Status,
+				"message":           v.Message,
+				"status_updated_at": v.StatusUpdatedAt,
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to update validation %s of cluster %s", v.ID, clusterID)
+		}
+	}
+
+	// what is left are validations that are not part of the results anymore
+	for id := range currentByID {
+		if err := validationsFilter(db, clusterID, hostID).Where("id = ?", id).Delete(&models.Validation{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete validation %s of cluster %s", id, clusterID)
+		}
+	}
+	return nil
+}
+
+// GetValidations returns the validations of a cluster, or of one of its hosts when hostID is set
+func GetValidations(db *gorm.DB, clusterID strfmt.UUID, hostID *strfmt.UUID) ([]*models.Validation, error) {
+	var validations []*models.Validation
+	if err := validationsFilter(db, clusterID, hostID).Order("category, id").Find(&validations).Error; err != nil {
+		return nil, err
+	}
+	return validations, nil
+}
+
+// DeleteValidations removes the validations of a cluster and of all its hosts, or only of one host when hostID is set
+func DeleteValidations(db *gorm.DB, clusterID strfmt.UUID, hostID *strfmt.UUID) error {
+	q := db.Where("cluster_id = ?", clusterID.String())
+	if hostID != nil {
+		q = q.Where("host_id = ?", hostID.String())
+	}
+	return q.Delete(&models.Validation{}).Error
+}
+
+// ValidationResult is the outcome of one validation of a cluster or a host
+type ValidationResult struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// ToValidationModels converts the validation results grouped by category to their API representation
+func ToValidationModels(validationResults map[string][]ValidationResult) []*models.Validation {
+	ret := make([]*models.Validation, 0)
+	for category, results := range validationResults {
+		for _, r := range results {
+			ret = append(ret, &models.Validation{
+				ID:       r.ID,
+				Category: category,
+				Status:   r.Status,
+				Message:  r.Message,
+			})
+		}
+	}
+	return ret
+}
+
+// MigrateValidationsInfo changes the validations_info columns of databases that were created when they were limited
+// to 2048 characters to text, the auto migration only adds missing columns and doesn't change existing ones
+func MigrateValidationsInfo(db *gorm.DB) error {
+	if err := db.Model(&models.Host{}).ModifyColumn("validations_info", "text").Error; err != nil {
+		return errors.Wrap(err, "failed to change the type of the validations info of hosts")
+	}
+	if err := db.Model(&Cluster{}).ModifyColumn("validations_info", "text").Error; err != nil {
+		return errors.Wrap(err, "failed to change the type of the validations info of clusters")
+	}
+	return nil
+}
@@ -2,49 +2,255 @@ package domains
 
 import (
 	"context"
-	"regexp"
+	"net/http"
+	"strings"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/dnsprovider"
+	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/restapi"
 	operations "github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 // NewHandler returns managed domains handler
-func NewHandler(baseDNSDomains map[string]string) *Handler {
-	return &Handler{baseDNSDomains: baseDNSDomains}
+func NewHandler(db *gorm.DB, log logrus.FieldLogger) *Handler {
+	return &Handler{db: db, log: log}
 }
 
 var _ restapi.ManagedDomainsAPI = (*Handler)(nil)
 
 // Handler represents managed domains handler
 type Handler struct {
-	baseDNSDomains map[string]string
+	db  *gorm.DB
+	log logrus.FieldLogger
 }
 
-func (h *Handler) parseDomainProvider(val string) (string, error) {
-	re := regexp.MustCompile("/")
-	if !re.MatchString(val) {
-		return "", errors.Errorf("Invalid format: %s", val)
+// visibilityFilter returns the condition of the domains that the organization can use, the domains of the
+// organization and the global domains
+func visibilityFilter(db *gorm.DB, orgID string) *gorm.DB {
+	return db.Where("org_id = '' or org_id is null or org_id = ?", orgID)
+}
+
+// GetManagedDomain returns the managed domain of the name that the organization can use, or nil if there is none
+func GetManagedDomain(db *gorm.DB, name, orgID string) (*models.ManagedDomain, error) {
+	var domain models.ManagedDomain
+	err := visibilityFilter(db, orgID).Take(&domain, "domain = ?", strings.TrimSuffix(name, ".")).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, nil
 	}
-	s := re.Split(val, 2)
-	return s[1], nil
+	if err != nil {
+		return nil, err
+	}
+	return &domain, nil
 }
 
-func (h *Handler) ListManagedDomains(ctx context.Context, params operations.ListManagedDomainsParams) middleware.Responder {
-	managedDomains := models.ListManagedDomains{}
-	for k, v := range h.baseDNSDomains {
-		provider, err := h.parseDomainProvider(v)
+// ImportBaseDNSDomains adds the base DNS domains of the service configuration, whose values are in id/provider format,
+// as global managed domains. The domains that are already managed are left as is
+func (h *Handler) ImportBaseDNSDomains(baseDNSDomains map[string]string) error {
+	for name, val := range baseDNSDomains {
+		config, err := dnsprovider.ParseDomainConfig(val)
 		if err != nil {
-			return operations.NewListManagedDomainsInternalServerError().
-				WithPayload(common.GenerateInternalFromError(err))
+			return err
+		}
+		if !dnsprovider.IsSupported(config.Provider) {
+			h.log.Warnf("Skipping base DNS domain %s of unsupported provider %s", name, config.Provider)
+			continue
 		}
-		managedDomains = append(managedDomains, &models.ManagedDomain{
-			Domain:   k,
-			Provider: provider,
+		domain := models.ManagedDomain{
+			ID:       strfmt.UUID(uuid.New().String()),
+			Domain:   name,
+			Provider: config.Provider,
+			ZoneID:   config.ZoneID,
+			Server:   config.Server,
+		}
+		if err = h.db.Where("domain = ?", name).Attrs(domain).FirstOrCreate(&domain).Error; err != nil {
+			return errors.Wrapf(err, "failed to import base DNS domain %s", name)
+		}
+	}
+	return nil
+}
+
+// validateDomainServer returns an error if the domain is managed by a DNS server that is missing
+func validateDomainServer(domain *models.ManagedDomain) error {
+	if domain.Provider == dnsprovider.ProviderRFC2136 && domain.Server == "" {
+		return errors.Errorf("The DNS server of rfc2136 domain %s is missing", domain.Domain)
+	}
+	return nil
+}
+
+// ListManagedDomains lists the domains that the user can use, their zones and credentials are only available to
+// admins through GetManagedDomain
+func (h *Handler) ListManagedDomains(ctx context.Context, params operations.ListManagedDomainsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	q := h.db
+	if !identity.IsAdmin(ctx) {
+		q = visibilityFilter(q, auth.OrgIDFromContext(ctx))
+	}
+	var domains []*models.ManagedDomain
+	if err := q.Order("domain").Find(&domains).Error; err != nil {
+		log.WithError(err).Error("failed to list managed domains")
+		return operations.NewListManagedDomainsInternalServerError().
+			WithPayload(common.GenerateInternalFromError(err))
+	}
+	managedDomains := models.ListManagedDomains{}
+	for _, domain := range domains {
+		managedDomains = append(managedDomains, &models.ManagedDomainSummary{
+			ID:       domain.ID,
+			Domain:   domain.Domain,
+			Provider: domain.Provider,
 		})
 	}
 	return operations.NewListManagedDomainsOK().WithPayload(managedDomains)
 }
+
+func (h *Handler) GetManagedDomain(ctx context.Context, params operations.GetManagedDomainParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if !identity.IsAdmin(ctx) {
+		return operations.NewGetManagedDomainForbidden().
+			WithPayload(common.GenerateInfraError(http.StatusForbidden, errors.New("Only admins can manage domains")))
+	}
+	var domain models.ManagedDomain
+	if err := h.db.Take(&domain, "id = ?", params.DomainID.String()).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return operations.NewGetManagedDomainNotFound().
+				WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		log.WithError(err).Errorf("failed to get managed domain %s", params.DomainID)
+		return operations.NewGetManagedDomainInternalServerError().
+			WithPayload(common.GenerateInternalFromError(err))
+	}
+	return operations.NewGetManagedDomainOK().WithPayload(&domain)
+}
+
+func (h *Handler) RegisterManagedDomain(ctx context.Context, params operations.RegisterManagedDomainParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if !identity.IsAdmin(ctx) {
+		return operations.NewRegisterManagedDomainForbidden().
+			WithPayload(common.GenerateInfraError(http.StatusForbidden, errors.New("Only admins can manage domains")))
+	}
+	p := params.NewManagedDomainParams
+	name := strings.TrimSuffix(swag.StringValue(p.Domain), ".")
+	if err := validations.ValidateDomainNameFormat(name); err != nil {
+		return operations.NewRegisterManagedDomainBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+
+	var existing models.ManagedDomain
+	err := h.db.Take(&existing, "domain = ?", name).Error
+	if err == nil {
+		return operations.NewRegisterManagedDomainConflict().
+			WithPayload(common.GenerateError(http.StatusConflict, errors.Errorf("Domain %s is already managed", name)))
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		log.WithError(err).Errorf("failed to get managed domain %s", name)
+		return operations.NewRegisterManagedDomainInternalServerError().
+			WithPayload(common.GenerateInternalFromError(err))
+	}
+
+	domain := models.ManagedDomain{
+		ID:             strfmt.UUID(uuid.New().String()),
+		Domain:         name,
+		Provider:       swag.StringValue(p.Provider),
+		ZoneID:         swag.StringValue(p.ZoneID),
+		Server:         p.Server,
+		CredentialsRef: p.CredentialsRef,
+		OrgID:          p.OrgID,
+	}
+	if err = validateDomainServer(&domain); err != nil {
+		return operations.NewRegisterManagedDomainBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+	if err = h.db.Create(&domain).Error; err != nil {
+		log.WithError(err).Errorf("failed to create managed domain %s", name)
+		return operations.NewRegisterManagedDomainInternalServerError().
+			WithPayload(common.GenerateInternalFromError(err))
+	}
+	log.Infof("Added managed domain %s of provider %s", name, domain.Provider)
+	return operations.NewRegisterManagedDomainCreated().WithPayload(&domain)
+}
+
+func (h *Handler) UpdateManagedDomain(ctx context.Context, params operations.UpdateManagedDomainParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if !identity.IsAdmin(ctx) {
+		return operations.NewUpdateManagedDomainForbidden().
+			WithPayload(common.GenerateInfraError(http.StatusForbidden, errors.New("Only admins can manage domains")))
+	}
+	var domain models.ManagedDomain
+	if err := h.db.Take(&domain, "id = ?", params.DomainID.String()).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return operations.NewUpdateManagedDomainNotFound().
+				WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		log.WithError(err).Errorf("failed to get managed domain %s", params.DomainID)
+		return operations.NewUpdateManagedDomainInternalServerError().
+			WithPayload(common.GenerateInternalFromError(err))
+	}
+
+	p := params.ManagedDomainUpdateParams
+	updates := map[string]interface{}{}
+	if p.Provider != nil {
+		updates["provider"] = *p.Provider
+		domain.Provider = *p.Provider
+	}
+	if p.ZoneID != nil {
+		updates["zone_id"] = *p.ZoneID
+	}
+	if p.Server != nil {
+		updates["server"] = *p.Server
+		domain.Server = *p.Server
+	}
+	if err := validateDomainServer(&domain); err != nil {
+		return operations.NewUpdateManagedDomainBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+	if p.CredentialsRef != nil {
+		updates["credentials_ref"] = *p.CredentialsRef
+	}
+	if p.OrgID != nil {
+		updates["org_id"] = *p.OrgID
+	}
+	if len(updates) > 0 {
+		if err := h.db.Model(&domain).Updates(updates).Error; err != nil {
+			log.WithError(err).Errorf("failed to update managed domain %s", params.DomainID)
+			return operations.NewUpdateManagedDomainInternalServerError().
+				WithPayload(common.GenerateInternalFromError(err))
+		}
+	}
+	if err := h.db.Take(&domain, "id = ?", params.DomainID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get managed domain %s", params.DomainID)
+		return operations.NewUpdateManagedDomainInternalServerError().
+			WithPayload(common.GenerateInternalFromError(err))
+	}
+	return operations.NewUpdateManagedDomainOK().WithPayload(&domain)
+}
+
+func (h *Handler) DeregisterManagedDomain(ctx context.Context, params operations.DeregisterManagedDomainParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if !identity.IsAdmin(ctx) {
+		return operations.NewDeregisterManagedDomainForbidden().
+			WithPayload(common.GenerateInfraError(http.StatusForbidden, errors.New("Only admins can manage domains")))
+	}
+	reply := h.db.Delete(&models.ManagedDomain{}, "id = ?", params.DomainID.String())
+	if reply.Error != nil {
+		log.WithError(reply.Error).Errorf("failed to delete managed domain %s", params.DomainID)
+		return operations.NewDeregisterManagedDomainInternalServerError().
+			WithPayload(common.GenerateInternalFromError(reply.Error))
+	}
+	if reply.RowsAffected == 0 {
+		return operations.NewDeregisterManagedDomainNotFound().
+			WithPayload(common.GenerateError(http.StatusNotFound, errors.Errorf("Managed domain %s not found", params.DomainID)))
+	}
+	log.Infof("Deleted managed domain %s", params.DomainID)
+	return operations.NewDeregisterManagedDomainNoContent()
+}
@@ -4,49 +4,190 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
 	operations "github.com/openshift/assisted-service/restapi/operations/managed_domains"
+	"github.com/sirupsen/logrus"
 )
 
 func TestHandler_ListManagedDomains(t *testing.T) {
 	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
 	RunSpecs(t, "managed domains")
 }
 
-var _ = Describe("list base domains", func() {
+var _ = Describe("managed domains", func() {
 	var (
-		h              *Handler
-		baseDNSDomains map[string]string
+		h        *Handler
+		db       *gorm.DB
+		dbName   = "managed_domains_test"
+		adminCtx = context.Background()
+		userCtx  = context.WithValue(context.Background(), restapi.AuthKey,
+			&ocm.AuthPayload{Username: "jdoe", Organization: "org1"})
 	)
-	It("valid", func() {
-		baseDNSDomains = map[string]string{
-			"example.com": "abc/route53",
-		}
-		h = NewHandler(baseDNSDomains)
-		reply := h.ListManagedDomains(context.Background(), operations.ListManagedDomainsParams{})
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		h = NewHandler(db, logrus.New())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	register := func(ctx context.Context, domain, orgID string) middleware.Responder {
+		return h.RegisterManagedDomain(ctx, operations.RegisterManagedDomainParams{
+			NewManagedDomainParams: &models.ManagedDomainCreateParams{
+				Domain:   swag.String(domain),
+				Provider: swag.String("route53"),
+				ZoneID:   swag.String("abc"),
+				OrgID:    orgID,
+			},
+		})
+	}
+
+	list := func(ctx context.Context) models.ListManagedDomains {
+		reply := h.ListManagedDomains(ctx, operations.ListManagedDomainsParams{})
 		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListManagedDomainsOK()))
-		val, _ := reply.(*operations.ListManagedDomainsOK)
-		domains := val.Payload
+		return reply.(*operations.ListManagedDomainsOK).Payload
+	}
+
+	get := func(domainID strfmt.UUID) *models.ManagedDomain {
+		reply := h.GetManagedDomain(adminCtx, operations.GetManagedDomainParams{DomainID: domainID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewGetManagedDomainOK()))
+		return reply.(*operations.GetManagedDomainOK).Payload
+	}
+
+	It("empty", func() {
+		Expect(list(adminCtx)).Should(BeEmpty())
+	})
+
+	It("imports the base DNS domains of the configuration", func() {
+		Expect(h.ImportBaseDNSDomains(map[string]string{
+			"example.com": "abc/route53",
+			"other.com":   "abc/unknown",
+		})).ShouldNot(HaveOccurred())
+		Expect(h.ImportBaseDNSDomains(map[string]string{
+			"example.com": "def/route53",
+		})).ShouldNot(HaveOccurred())
+		domains := list(userCtx)
 		Expect(len(domains)).Should(Equal(1))
 		Expect(domains[0].Domain).Should(Equal("example.com"))
 		Expect(domains[0].Provider).Should(Equal("route53"))
+		Expect(get(domains[0].ID).ZoneID).Should(Equal("abc"))
+
+		Expect(h.ImportBaseDNSDomains(map[string]string{
+			"example.com": "abcroute53",
+		})).Should(HaveOccurred())
 	})
-	It("empty", func() {
-		baseDNSDomains = map[string]string{}
-		h = NewHandler(baseDNSDomains)
-		reply := h.ListManagedDomains(context.Background(), operations.ListManagedDomainsParams{})
-		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListManagedDomainsOK()))
-		val, _ := reply.(*operations.ListManagedDomainsOK)
-		domains := val.Payload
-		Expect(len(domains)).Should(Equal(0))
+
+	It("imports the DNS server of rfc2136 base DNS domains", func() {
+		Expect(h.ImportBaseDNSDomains(map[string]string{
+			"example.com": "example.com/rfc2136/ns1.example.com:53",
+		})).ShouldNot(HaveOccurred())
+		domains := list(adminCtx)
+		Expect(len(domains)).Should(Equal(1))
+		Expect(domains[0].Provider).Should(Equal("rfc2136"))
+		Expect(get(domains[0].ID).Server).Should(Equal("ns1.example.com:53"))
 	})
-	It("invalid format", func() {
-		baseDNSDomains = map[string]string{
-			"example.com": "abcroute53",
+
+	It("gets the details of a domain", func() {
+		reply := register(adminCtx, "example.com", "org1")
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainCreated()))
+		id := reply.(*operations.RegisterManagedDomainCreated).Payload.ID
+
+		Expect(h.GetManagedDomain(userCtx, operations.GetManagedDomainParams{DomainID: id})).
+			Should(BeAssignableToTypeOf(operations.NewGetManagedDomainForbidden()))
+		Expect(h.GetManagedDomain(adminCtx, operations.GetManagedDomainParams{DomainID: strfmt.UUID("5a8ab6bd-2ae7-4e68-a4b8-1b3fbb0a2e4e")})).
+			Should(BeAssignableToTypeOf(operations.NewGetManagedDomainNotFound()))
+		domain := get(id)
+		Expect(domain.Domain).Should(Equal("example.com"))
+		Expect(domain.ZoneID).Should(Equal("abc"))
+		Expect(domain.OrgID).Should(Equal("org1"))
+
+		domains := list(userCtx)
+		Expect(len(domains)).Should(Equal(1))
+		Expect(*domains[0]).Should(Equal(models.ManagedDomainSummary{ID: id, Domain: "example.com", Provider: "route53"}))
+	})
+
+	It("lists the global domains and the domains of the organization", func() {
+		Expect(register(adminCtx, "example.com", "")).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainCreated()))
+		Expect(register(adminCtx, "org1.example.com", "org1")).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainCreated()))
+		Expect(register(adminCtx, "org2.example.com", "org2")).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainCreated()))
+
+		Expect(len(list(adminCtx))).Should(Equal(3))
+		domains := list(userCtx)
+		Expect(len(domains)).Should(Equal(2))
+		Expect(domains[0].Domain).Should(Equal("example.com"))
+		Expect(domains[1].Domain).Should(Equal("org1.example.com"))
+
+		domain, err := GetManagedDomain(db, "org2.example.com", "org1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(domain).Should(BeNil())
+		domain, err = GetManagedDomain(db, "org1.example.com.", "org1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(domain.OrgID).Should(Equal("org1"))
+	})
+
+	It("register validations", func() {
+		Expect(register(userCtx, "example.com", "")).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainForbidden()))
+		Expect(register(adminCtx, "example..com", "")).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainBadRequest()))
+		Expect(register(adminCtx, "example.com", "")).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainCreated()))
+		Expect(register(adminCtx, "example.com", "org1")).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainConflict()))
+		Expect(h.RegisterManagedDomain(adminCtx, operations.RegisterManagedDomainParams{
+			NewManagedDomainParams: &models.ManagedDomainCreateParams{
+				Domain:   swag.String("other.com"),
+				Provider: swag.String("rfc2136"),
+				ZoneID:   swag.String("other.com"),
+			},
+		})).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainBadRequest()))
+	})
+
+	It("update and delete", func() {
+		reply := register(adminCtx, "example.com", "")
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewRegisterManagedDomainCreated()))
+		id := reply.(*operations.RegisterManagedDomainCreated).Payload.ID
+
+		update := func(ctx context.Context, domainID strfmt.UUID) middleware.Responder {
+			return h.UpdateManagedDomain(ctx, operations.UpdateManagedDomainParams{
+				DomainID: domainID,
+				ManagedDomainUpdateParams: &models.ManagedDomainUpdateParams{
+					Provider:       swag.String("rfc2136"),
+					ZoneID:         swag.String("example.com"),
+					Server:         swag.String("ns1.example.com:53"),
+					CredentialsRef: swag.String("key1"),
+					OrgID:          swag.String("org2"),
+				},
+			})
 		}
-		h = NewHandler(baseDNSDomains)
-		reply := h.ListManagedDomains(context.Background(), operations.ListManagedDomainsParams{})
-		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListManagedDomainsInternalServerError()))
+		Expect(update(userCtx, id)).Should(BeAssignableToTypeOf(operations.NewUpdateManagedDomainForbidden()))
+		Expect(update(adminCtx, strfmt.UUID("5a8ab6bd-2ae7-4e68-a4b8-1b3fbb0a2e4e"))).
+			Should(BeAssignableToTypeOf(operations.NewUpdateManagedDomainNotFound()))
+		reply = update(adminCtx, id)
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewUpdateManagedDomainOK()))
+		domain := reply.(*operations.UpdateManagedDomainOK).Payload
+		Expect(domain.Provider).Should(Equal("rfc2136"))
+		Expect(domain.ZoneID).Should(Equal("example.com"))
+		Expect(domain.Server).Should(Equal("ns1.example.com:53"))
+		Expect(domain.CredentialsRef).Should(Equal("key1"))
+		Expect(domain.OrgID).Should(Equal("org2"))
+		Expect(list(userCtx)).Should(BeEmpty())
+
+		Expect(h.DeregisterManagedDomain(userCtx, operations.DeregisterManagedDomainParams{DomainID: id})).
+			Should(BeAssignableToTypeOf(operations.NewDeregisterManagedDomainForbidden()))
+		Expect(h.DeregisterManagedDomain(adminCtx, operations.DeregisterManagedDomainParams{DomainID: id})).
+			Should(BeAssignableToTypeOf(operations.NewDeregisterManagedDomainNoContent()))
+		Expect(h.DeregisterManagedDomain(adminCtx, operations.DeregisterManagedDomainParams{DomainID: id})).
+			Should(BeAssignableToTypeOf(operations.NewDeregisterManagedDomainNotFound()))
+		Expect(list(adminCtx)).Should(BeEmpty())
 	})
 })
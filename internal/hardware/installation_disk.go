@@ -0,0 +1,146 @@
+package hardware
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// InstallationDiskPolicySmallestSSD selects the smallest eligible SSD, or the smallest eligible disk when the
+	// host has no SSD
+	InstallationDiskPolicySmallestSSD = "smallest-ssd"
+	// InstallationDiskPolicyByPath selects the first eligible disk whose by-path link matches the policy pattern, the
+	// pattern is matched against both the link name and its full path
+	InstallationDiskPolicyByPath = "by-path"
+	// InstallationDiskPolicySerial selects the first eligible disk whose serial number matches the policy pattern
+	InstallationDiskPolicySerial = "serial"
+)
+
+const byPathDir = "/dev/disk/by-path/"
+
+// InstallationDiskPolicy determines the installation disk of hosts that the user did not choose a disk for.
+// It is configured as "smallest-ssd", "by-path:<pattern>" or "serial:<pattern>", where pattern is a shell glob.
+// The by-path and serial policies don't select any disk when no eligible disk matches the pattern.
+type InstallationDiskPolicy struct {
+	Type    string
+	Pattern string
+}
+
+// Decode implements envconfig.Decoder
+func (p *InstallationDiskPolicy) Decode(value string) error {
+	parts := strings.SplitN(strings.TrimSpace(value), ":", 2)
+	policy := InstallationDiskPolicy{Type: parts[0]}
+	if len(parts) == 2 {
+		policy.Pattern = parts[1]
+	}
+	switch policy.Type {
+	case "":
+		policy.Type = InstallationDiskPolicySmallestSSD
+	case InstallationDiskPolicySmallestSSD:
+		if policy.Pattern != "" {
+			return errors.Errorf("installation disk policy %s doesn't accept a pattern", policy.Type)
+		}
+	case InstallationDiskPolicyByPath, InstallationDiskPolicySerial:
+		if policy.Pattern == "" {
+			return errors.Errorf("installation disk policy %s requires a pattern", policy.Type)
+		}
+		if _, err := path.Match(policy.Pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid pattern of installation disk policy %s", policy.Type)
+		}
+	default:
+		return errors.Errorf("unsupported installation disk policy %s", policy.Type)
+	}
+	*p = policy
+	return nil
+}
+
+func (p InstallationDiskPolicy) String() string {
+	if p.Pattern == "" {
+		return p.Type
+	}
+	return fmt.Sprintf("%s:%s", p.Type, p.Pattern)
+}
+
+// GetDiskID returns the identifier of the disk, its by-path link does not change between boots as the device name may
+func GetDiskID(disk *models.Disk) string {
+	if disk.ByPath != "" {
+		if strings.HasPrefix(disk.ByPath, "/") {
+			return disk.ByPath
+		}
+		return byPathDir + disk.ByPath
+	}
+	return fmt.Sprintf("/dev/%s", disk.Name)
+}
+
+// SetDisksID sets the identifier of all the inventory disks
+func SetDisksID(inventory *models.Inventory) {
+	for _, disk := range inventory.Disks {
+		disk.ID = GetDiskID(disk)
+	}
+}
+
+func matches(pattern, value string) bool {
+	if value == "" {
+		return false
+	}
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
+
+// SelectInstallationDisk returns the eligible disk that the policy selects, or nil if the policy doesn't select any
+func SelectInstallationDisk(eligibleDisks []*models.Disk, policy InstallationDiskPolicy) *models.Disk {
+	switch policy.Type {
+	case InstallationDiskPolicyByPath:
+		for _, disk := range eligibleDisks {
+			if disk.ByPath != "" && (matches(policy.Pattern, path.Base(disk.ByPath)) || matches(policy.Pattern, GetDiskID(disk))) {
+				return disk
+			}
+		}
+		return nil
+	case InstallationDiskPolicySerial:
+		for _, disk := range eligibleDisks {
+			if matches(policy.Pattern, disk.Serial) {
+				return disk
+			}
+		}
+		return nil
+	default:
+		if len(eligibleDisks) == 0 {
+			return nil
+		}
+		disks := append([]*models.Disk{}, eligibleDisks...)
+		sort.SliceStable(disks, func(i, j int) bool {
+			isSSD1 := disks[i].DriveType == "SSD"
+			isSSD2 := disks[j].DriveType == "SSD"
+			if isSSD1 != isSSD2 {
+				return isSSD1
+			}
+			return disks[i].SizeBytes < disks[j].SizeBytes
+		})
+		return disks[0]
+	}
+}
+
+// GetInstallationDisk returns the disk that the host will be installed on. It is the disk with the selected id, or
+// nil when that disk is not eligible anymore, the policy selects the disk only when no disk was selected.
+func GetInstallationDisk(eligibleDisks []*models.Disk, selectedDiskID string, policy InstallationDiskPolicy) *models.Disk {
+	if selectedDiskID != "" {
+		return FindDisk(eligibleDisks, selectedDiskID)
+	}
+	return SelectInstallationDisk(eligibleDisks, policy)
+}
+
+// FindDisk returns the disk with the given id, or nil if there is none
+func FindDisk(disks []*models.Disk, diskID string) *models.Disk {
+	for _, disk := range disks {
+		if GetDiskID(disk) == diskID {
+			return disk
+		}
+	}
+	return nil
+}
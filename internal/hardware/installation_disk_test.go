@@ -0,0 +1,72 @@
+package hardware
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("installation disk", func() {
+	var disks []*models.Disk
+
+	BeforeEach(func() {
+		disks = []*models.Disk{
+			{Name: "sda", DriveType: "HDD", SizeBytes: 100, Serial: "hdd-1"},
+			{Name: "sdb", DriveType: "SSD", SizeBytes: 300, ByPath: "/dev/disk/by-path/pci-0000:00:1f.2-ata-2", Serial: "ssd-1"},
+			{Name: "nvme0n1", DriveType: "SSD", SizeBytes: 200, ByPath: "pci-0000:3b:00.0-nvme-1"},
+		}
+	})
+
+	decode := func(value string) InstallationDiskPolicy {
+		var policy InstallationDiskPolicy
+		Expect(policy.Decode(value)).ShouldNot(HaveOccurred())
+		return policy
+	}
+
+	It("disk id", func() {
+		Expect(GetDiskID(disks[0])).Should(Equal("/dev/sda"))
+		Expect(GetDiskID(disks[1])).Should(Equal("/dev/disk/by-path/pci-0000:00:1f.2-ata-2"))
+		Expect(GetDiskID(disks[2])).Should(Equal("/dev/disk/by-path/pci-0000:3b:00.0-nvme-1"))
+	})
+
+	It("policy defaults to the smallest SSD", func() {
+		policy := decode("")
+		Expect(policy.Type).Should(Equal(InstallationDiskPolicySmallestSSD))
+		Expect(SelectInstallationDisk(disks, policy).Name).Should(Equal("nvme0n1"))
+		Expect(SelectInstallationDisk(disks[:1], policy).Name).Should(Equal("sda"))
+		Expect(SelectInstallationDisk(nil, policy)).Should(BeNil())
+	})
+
+	It("by-path policy", func() {
+		policy := decode("by-path:*-ata-*")
+		Expect(SelectInstallationDisk(disks, policy).Name).Should(Equal("sdb"))
+		policy = decode("by-path:pci-0000:3b:00.0-*")
+		Expect(SelectInstallationDisk(disks, policy).Name).Should(Equal("nvme0n1"))
+		policy = decode("by-path:*-sas-*")
+		Expect(SelectInstallationDisk(disks, policy)).Should(BeNil())
+	})
+
+	It("serial policy", func() {
+		policy := decode("serial:hdd-*")
+		Expect(SelectInstallationDisk(disks, policy).Name).Should(Equal("sda"))
+		policy = decode("serial:none")
+		Expect(SelectInstallationDisk(disks, policy)).Should(BeNil())
+	})
+
+	It("invalid policies", func() {
+		for _, value := range []string{"largest", "by-path", "serial:", "smallest-ssd:x", "serial:[a"} {
+			var policy InstallationDiskPolicy
+			Expect(policy.Decode(value)).Should(HaveOccurred(), value)
+		}
+	})
+
+	It("selected disk takes precedence over the policy", func() {
+		policy := decode("")
+		Expect(GetInstallationDisk(disks, "/dev/sda", policy).Name).Should(Equal("sda"))
+		Expect(GetInstallationDisk(disks, "", policy).Name).Should(Equal("nvme0n1"))
+	})
+
+	It("selected disk that is not eligible is not replaced by the policy", func() {
+		Expect(GetInstallationDisk(disks, "/dev/sdz", decode(""))).Should(BeNil())
+	})
+})
@@ -34,30 +34,45 @@ func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
 }
 
 // GetHostValidDisks mocks base method
-func (m *MockValidator) GetHostValidDisks(host *models.Host) ([]*models.Disk, error) {
+func (m *MockValidator) GetHostValidDisks(host *models.Host, openshiftVersion string) ([]*models.Disk, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHostValidDisks", host)
+	ret := m.ctrl.Call(m, "GetHostValidDisks", host, openshiftVersion)
 	ret0, _ := ret[0].([]*models.Disk)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHostValidDisks indicates an expected call of GetHostValidDisks
-func (mr *MockValidatorMockRecorder) GetHostValidDisks(host interface{}) *gomock.Call {
+func (mr *MockValidatorMockRecorder) GetHostValidDisks(host, openshiftVersion interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostValidDisks", reflect.TypeOf((*MockValidator)(nil).GetHostValidDisks), host)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostValidDisks", reflect.TypeOf((*MockValidator)(nil).GetHostValidDisks), host, openshiftVersion)
+}
+
+// GetHostInstallationDisk mocks base method
+func (m *MockValidator) GetHostInstallationDisk(host *models.Host, openshiftVersion string) (*models.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostInstallationDisk", host, openshiftVersion)
+	ret0, _ := ret[0].(*models.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostInstallationDisk indicates an expected call of GetHostInstallationDisk
+func (mr *MockValidatorMockRecorder) GetHostInstallationDisk(host, openshiftVersion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostInstallationDisk", reflect.TypeOf((*MockValidator)(nil).GetHostInstallationDisk), host, openshiftVersion)
 }
 
 // GetHostRequirements mocks base method
-func (m *MockValidator) GetHostRequirements(role models.HostRole) models.HostRequirementsRole {
+func (m *MockValidator) GetHostRequirements(openshiftVersion string, role models.HostRole) models.HostRequirementsRole {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHostRequirements", role)
+	ret := m.ctrl.Call(m, "GetHostRequirements", openshiftVersion, role)
 	ret0, _ := ret[0].(models.HostRequirementsRole)
 	return ret0
 }
 
 // GetHostRequirements indicates an expected call of GetHostRequirements
-func (mr *MockValidatorMockRecorder) GetHostRequirements(role interface{}) *gomock.Call {
+func (mr *MockValidatorMockRecorder) GetHostRequirements(openshiftVersion, role interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostRequirements", reflect.TypeOf((*MockValidator)(nil).GetHostRequirements), role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostRequirements", reflect.TypeOf((*MockValidator)(nil).GetHostRequirements), openshiftVersion, role)
 }
@@ -16,14 +16,16 @@ import (
 
 //go:generate mockgen -source=validator.go -package=hardware -destination=mock_validator.go
 type Validator interface {
-	GetHostValidDisks(host *models.Host) ([]*models.Disk, error)
-	GetHostRequirements(role models.HostRole) models.HostRequirementsRole
+	GetHostValidDisks(host *models.Host, openshiftVersion string) ([]*models.Disk, error)
+	GetHostInstallationDisk(host *models.Host, openshiftVersion string) (*models.Disk, error)
+	GetHostRequirements(openshiftVersion string, role models.HostRole) models.HostRequirementsRole
 }
 
-func NewValidator(log logrus.FieldLogger, cfg ValidatorCfg) Validator {
+func NewValidator(log logrus.FieldLogger, cfg ValidatorCfg, openshiftVersions models.OpenshiftVersions) Validator {
 	return &validator{
-		ValidatorCfg: cfg,
-		log:          log,
+		ValidatorCfg:      cfg,
+		log:               log,
+		openshiftVersions: openshiftVersions,
 	}
 }
 
@@ -35,37 +37,141 @@ type ValidatorCfg struct {
 	MinRamGibWorker   int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_WORKER" default:"8"`
 	MinRamGibMaster   int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_MASTER" default:"16"`
 	MinDiskSizeGb     int64 `envconfig:"HW_VALIDATOR_MIN_DISK_SIZE_GIB" default:"120"` // Env variable is GIB to not break infra
+	// InstallationDiskPolicy selects the installation disk of hosts that the user did not choose a disk for
+	InstallationDiskPolicy InstallationDiskPolicy `envconfig:"HW_VALIDATOR_INSTALLATION_DISK_POLICY" default:"smallest-ssd"`
 }
 
 type validator struct {
 	ValidatorCfg
-	log logrus.FieldLogger
+	log               logrus.FieldLogger
+	openshiftVersions models.OpenshiftVersions
 }
 
-func (v *validator) GetHostValidDisks(host *models.Host) ([]*models.Disk, error) {
+// GetHostValidDisks returns the disks of the host that are eligible for installation, they must be as large as the
+// OpenShift version of its cluster requires for the role of the host
+func (v *validator) GetHostValidDisks(host *models.Host, openshiftVersion string) ([]*models.Disk, error) {
 	var inventory models.Inventory
 	if err := json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
 		return nil, err
 	}
-	disks := ListValidDisks(&inventory, gbToBytes(v.MinDiskSizeGb))
+	minDiskSizeGib := v.GetHostRequirements(openshiftVersion, host.Role).DiskSizeGb
+	disks := ListValidDisks(&inventory, gibToBytes(minDiskSizeGib))
 	if len(disks) == 0 {
 		return nil, fmt.Errorf("host %s doesn't have valid disks", host.ID)
 	}
 	return disks, nil
 }
 
-func gbToBytes(gb int64) int64 {
-	return gb * int64(units.GB)
+func (v *validator) GetHostInstallationDisk(host *models.Host, openshiftVersion string) (*models.Disk, error) {
+	disks, err := v.GetHostValidDisks(host, openshiftVersion)
+	if err != nil {
+		return nil, err
+	}
+	disk := GetInstallationDisk(disks, host.InstallationDiskID, v.InstallationDiskPolicy)
+	if disk == nil && host.InstallationDiskID != "" {
+		return nil, fmt.Errorf("selected installation disk %s of host %s is not eligible", host.InstallationDiskID, host.ID)
+	}
+	if disk == nil {
+		return nil, fmt.Errorf("no valid disk of host %s matches the installation disk policy %s", host.ID, v.InstallationDiskPolicy)
+	}
+	return disk, nil
+}
+
+func gibToBytes(gib int64) int64 {
+	return gib * int64(units.GiB)
 }
 
 func isNvme(name string) bool {
 	return strings.HasPrefix(name, "nvme")
 }
 
+// GetDiskEligibilityReasons returns the reasons why the host can not be installed on the disk, a disk without any
+// reasons is eligible for installation
+func GetDiskEligibilityReasons(disk *models.Disk, minSizeRequiredInBytes int64) []string {
+	reasons := make([]string, 0)
+	if disk.SizeBytes < minSizeRequiredInBytes {
+		reasons = append(reasons, fmt.Sprintf("too small (%d GiB < %d GiB)",
+			disk.SizeBytes/int64(units.GiB), minSizeRequiredInBytes/int64(units.GiB)))
+	}
+	if !funk.ContainsString([]string{"HDD", "SSD"}, disk.DriveType) {
+		reasons = append(reasons, fmt.Sprintf("drive type %s", disk.DriveType))
+	}
+	return reasons
+}
+
+// GetDiskEligibilityWarnings returns the attributes of the disk that don't prevent the installation on it but that the
+// user should be aware of, the installation overwrites removable disks and the existing partitions of disks
+func GetDiskEligibilityWarnings(disk *models.Disk) []string {
+	warnings := make([]string, 0)
+	if disk.Removable {
+		warnings = append(warnings, "removable")
+	}
+	if disk.HasPartitions {
+		warnings = append(warnings, "has existing partitions")
+	}
+	return warnings
+}
+
+// SetDisksEligibility annotates all the inventory disks with their installation eligibility
+func SetDisksEligibility(inventory *models.Inventory, minSizeRequiredInBytes int64) {
+	for _, disk := range inventory.Disks {
+		reasons := GetDiskEligibilityReasons(disk, minSizeRequiredInBytes)
+		disk.InstallationEligibility = &models.DiskInstallationEligibility{
+			Eligible:           len(reasons) == 0,
+			NotEligibleReasons: reasons,
+			Warnings:           GetDiskEligibilityWarnings(disk),
+		}
+	}
+}
+
+// AnnotateInventoryDisks returns the inventory with the id and the installation eligibility of every disk set. Only
+// the disks are changed, the rest of the inventory is kept as the agent reported it, including the attributes that the
+// inventory model of the service doesn't have.
+func AnnotateInventoryDisks(inventory string, minSizeRequiredInBytes int64) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(inventory), &fields); err != nil {
+		return "", err
+	}
+	if _, ok := fields["disks"]; !ok {
+		return inventory, nil
+	}
+	var parsed models.Inventory
+	if err := json.Unmarshal(fields["disks"], &parsed.Disks); err != nil {
+		return "", err
+	}
+	var disks []map[string]json.RawMessage
+	if err := json.Unmarshal(fields["disks"], &disks); err != nil {
+		return "", err
+	}
+	SetDisksID(&parsed)
+	SetDisksEligibility(&parsed, minSizeRequiredInBytes)
+	for i, disk := range parsed.Disks {
+		id, err := json.Marshal(disk.ID)
+		if err != nil {
+			return "", err
+		}
+		eligibility, err := json.Marshal(disk.InstallationEligibility)
+		if err != nil {
+			return "", err
+		}
+		disks[i]["id"] = id
+		disks[i]["installation_eligibility"] = eligibility
+	}
+	b, err := json.Marshal(disks)
+	if err != nil {
+		return "", err
+	}
+	fields["disks"] = b
+	if b, err = json.Marshal(fields); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 func ListValidDisks(inventory *models.Inventory, minSizeRequiredInBytes int64) []*models.Disk {
 	var disks []*models.Disk
 	for _, disk := range inventory.Disks {
-		if disk.SizeBytes >= minSizeRequiredInBytes && funk.ContainsString([]string{"HDD", "SSD"}, disk.DriveType) {
+		if len(GetDiskEligibilityReasons(disk, minSizeRequiredInBytes)) == 0 {
 			disks = append(disks, disk)
 		}
 	}
@@ -89,18 +195,38 @@ func ListValidDisks(inventory *models.Inventory, minSizeRequiredInBytes int64) [
 	return disks
 }
 
-func (v *validator) GetHostRequirements(role models.HostRole) models.HostRequirementsRole {
+// GetHostRequirements returns the minimal requirements of hosts of the role in clusters of the OpenShift version, the
+// requirements that the version doesn't set are the configured ones
+func (v *validator) GetHostRequirements(openshiftVersion string, role models.HostRole) models.HostRequirementsRole {
+	requirements := models.HostRequirementsRole{
+		CPUCores:   v.ValidatorCfg.MinCPUCoresWorker,
+		RAMGib:     v.ValidatorCfg.MinRamGibWorker,
+		DiskSizeGb: v.ValidatorCfg.MinDiskSizeGb,
+	}
+	if role == models.HostRoleMaster {
+		requirements.CPUCores = v.ValidatorCfg.MinCPUCoresMaster
+		requirements.RAMGib = v.ValidatorCfg.MinRamGibMaster
+	}
+
+	version, ok := v.openshiftVersions[openshiftVersion]
+	if !ok || version.HostRequirements == nil {
+		return requirements
+	}
+	versionRequirements := version.HostRequirements.Worker
 	if role == models.HostRoleMaster {
-		return models.HostRequirementsRole{
-			CPUCores:   v.ValidatorCfg.MinCPUCoresMaster,
-			RAMGib:     v.ValidatorCfg.MinRamGibMaster,
-			DiskSizeGb: v.ValidatorCfg.MinDiskSizeGb,
-		}
-	} else {
-		return models.HostRequirementsRole{
-			CPUCores:   v.ValidatorCfg.MinCPUCoresWorker,
-			RAMGib:     v.ValidatorCfg.MinRamGibWorker,
-			DiskSizeGb: v.ValidatorCfg.MinDiskSizeGb,
-		}
+		versionRequirements = version.HostRequirements.Master
+	}
+	if versionRequirements == nil {
+		return requirements
+	}
+	if versionRequirements.CPUCores > 0 {
+		requirements.CPUCores = versionRequirements.CPUCores
+	}
+	if versionRequirements.RAMGib > 0 {
+		requirements.RAMGib = versionRequirements.RAMGib
+	}
+	if versionRequirements.DiskSizeGb > 0 {
+		requirements.DiskSizeGb = versionRequirements.DiskSizeGb
 	}
+	return requirements
 }
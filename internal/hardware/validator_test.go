@@ -2,6 +2,7 @@ package hardware
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/openshift/assisted-service/internal/common"
@@ -36,7 +37,7 @@ var _ = Describe("hardware_validator", func() {
 	BeforeEach(func() {
 		var cfg ValidatorCfg
 		Expect(envconfig.Process("myapp", &cfg)).ShouldNot(HaveOccurred())
-		hwvalidator = NewValidator(logrus.New(), cfg)
+		hwvalidator = NewValidator(logrus.New(), cfg, nil)
 		id1 := strfmt.UUID(uuid.New().String())
 		id2 := strfmt.UUID(uuid.New().String())
 		id3 := strfmt.UUID(uuid.New().String())
@@ -81,7 +82,7 @@ var _ = Describe("hardware_validator", func() {
 		hw, err := json.Marshal(&inventory)
 		Expect(err).NotTo(HaveOccurred())
 		host1.Inventory = string(hw)
-		disks, err := hwvalidator.GetHostValidDisks(host1)
+		disks, err := hwvalidator.GetHostValidDisks(host1, "4.6")
 		Expect(err).NotTo(HaveOccurred())
 		Expect(disks[0].Name).Should(Equal("sdh"))
 		Expect(len(disks)).Should(Equal(5))
@@ -109,13 +110,123 @@ var _ = Describe("hardware_validator", func() {
 		hw, err := json.Marshal(&inventory)
 		Expect(err).NotTo(HaveOccurred())
 		host1.Inventory = string(hw)
-		disks, err := hwvalidator.GetHostValidDisks(host1)
+		disks, err := hwvalidator.GetHostValidDisks(host1, "4.6")
 		Expect(err).NotTo(HaveOccurred())
 		Expect(disks[0].Name).Should(Equal("xvda"))
 		Expect(len(disks)).Should(Equal(1))
 	})
 })
 
+var _ = Describe("host requirements", func() {
+	var hwvalidator Validator
+
+	BeforeEach(func() {
+		var cfg ValidatorCfg
+		Expect(envconfig.Process("myapp", &cfg)).ShouldNot(HaveOccurred())
+		hwvalidator = NewValidator(logrus.New(), cfg, models.OpenshiftVersions{
+			"4.5": models.OpenshiftVersion{},
+			"4.6": models.OpenshiftVersion{HostRequirements: &models.HostRequirements{
+				Master: &models.HostRequirementsRole{CPUCores: 6, DiskSizeGb: 200},
+			}},
+		})
+	})
+
+	It("configured requirements", func() {
+		Expect(hwvalidator.GetHostRequirements("4.5", models.HostRoleMaster)).Should(Equal(
+			models.HostRequirementsRole{CPUCores: 4, RAMGib: 16, DiskSizeGb: 120}))
+		Expect(hwvalidator.GetHostRequirements("4.5", models.HostRoleWorker)).Should(Equal(
+			models.HostRequirementsRole{CPUCores: 2, RAMGib: 8, DiskSizeGb: 120}))
+		Expect(hwvalidator.GetHostRequirements("", models.HostRoleMaster)).Should(Equal(
+			models.HostRequirementsRole{CPUCores: 4, RAMGib: 16, DiskSizeGb: 120}))
+	})
+
+	It("version requirements override the configured ones", func() {
+		Expect(hwvalidator.GetHostRequirements("4.6", models.HostRoleMaster)).Should(Equal(
+			models.HostRequirementsRole{CPUCores: 6, RAMGib: 16, DiskSizeGb: 200}))
+		Expect(hwvalidator.GetHostRequirements("4.6", models.HostRoleWorker)).Should(Equal(
+			models.HostRequirementsRole{CPUCores: 2, RAMGib: 8, DiskSizeGb: 120}))
+	})
+
+	It("valid disks are as large as the version requires for the role", func() {
+		inventory, err := json.Marshal(&models.Inventory{Disks: []*models.Disk{
+			{DriveType: "SSD", Name: "sda", SizeBytes: int64(150 * units.GiB)},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		id := strfmt.UUID(uuid.New().String())
+		host := &models.Host{ID: &id, Inventory: string(inventory), Role: models.HostRoleMaster}
+		disks, err := hwvalidator.GetHostValidDisks(host, "4.5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(disks).Should(HaveLen(1))
+		_, err = hwvalidator.GetHostValidDisks(host, "4.6")
+		Expect(err).To(HaveOccurred())
+		host.Role = models.HostRoleWorker
+		disks, err = hwvalidator.GetHostValidDisks(host, "4.6")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(disks).Should(HaveLen(1))
+	})
+})
+
+var _ = Describe("disk eligibility", func() {
+	minSize := int64(120 * units.GiB)
+
+	It("eligible disk", func() {
+		disk := &models.Disk{Name: "sda", DriveType: "SSD", SizeBytes: int64(2000 * units.GiB)}
+		Expect(GetDiskEligibilityReasons(disk, minSize)).Should(BeEmpty())
+	})
+
+	It("reports all the reasons", func() {
+		disk := &models.Disk{Name: "sdb", DriveType: "ODD", SizeBytes: int64(60 * units.GiB)}
+		Expect(GetDiskEligibilityReasons(disk, minSize)).Should(Equal([]string{
+			"too small (60 GiB < 120 GiB)", "drive type ODD"}))
+	})
+
+	It("removable disk with partitions is eligible with warnings", func() {
+		disk := &models.Disk{Name: "sdb", DriveType: "HDD", SizeBytes: int64(2000 * units.GiB), Removable: true, HasPartitions: true}
+		Expect(GetDiskEligibilityReasons(disk, minSize)).Should(BeEmpty())
+		Expect(GetDiskEligibilityWarnings(disk)).Should(Equal([]string{"removable", "has existing partitions"}))
+	})
+
+	It("annotates the inventory disks", func() {
+		inventory := &models.Inventory{Disks: []*models.Disk{
+			{Name: "sda", DriveType: "HDD", SizeBytes: int64(2000 * units.GiB)},
+			{Name: "sdb", DriveType: "HDD", SizeBytes: int64(2000 * units.GiB), HasPartitions: true},
+			{Name: "sdc", DriveType: "HDD", SizeBytes: int64(20 * units.GiB)},
+		}}
+		SetDisksEligibility(inventory, minSize)
+		Expect(inventory.Disks[0].InstallationEligibility.Eligible).Should(BeTrue())
+		Expect(inventory.Disks[0].InstallationEligibility.Warnings).Should(BeEmpty())
+		Expect(inventory.Disks[1].InstallationEligibility.Eligible).Should(BeTrue())
+		Expect(inventory.Disks[1].InstallationEligibility.Warnings).Should(Equal([]string{"has existing partitions"}))
+		Expect(inventory.Disks[2].InstallationEligibility.Eligible).Should(BeFalse())
+		Expect(inventory.Disks[2].InstallationEligibility.NotEligibleReasons).Should(Equal([]string{"too small (20 GiB < 120 GiB)"}))
+		Expect(ListValidDisks(inventory, minSize)).Should(HaveLen(2))
+	})
+
+	It("annotates the disks of the reported inventory and keeps its unknown attributes", func() {
+		inventory := fmt.Sprintf(`{"hostname": "h1", "new_attribute": {"a": 1},
+			"disks": [{"name": "sda", "drive_type": "HDD", "size_bytes": %d, "new_disk_attribute": "x"},
+			{"name": "sdb", "drive_type": "HDD", "size_bytes": %d, "by_path": "/dev/disk/by-path/pci-0000:00:1f.2-ata-2"}]}`,
+			int64(2000*units.GiB), int64(20*units.GiB))
+		annotated, err := AnnotateInventoryDisks(inventory, minSize)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(annotated).Should(ContainSubstring(`"new_attribute":{"a":1}`))
+		Expect(annotated).Should(ContainSubstring(`"new_disk_attribute":"x"`))
+		var parsed models.Inventory
+		Expect(json.Unmarshal([]byte(annotated), &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Hostname).Should(Equal("h1"))
+		Expect(parsed.Disks[0].ID).Should(Equal("/dev/sda"))
+		Expect(parsed.Disks[0].InstallationEligibility.Eligible).Should(BeTrue())
+		Expect(parsed.Disks[1].ID).Should(Equal("/dev/disk/by-path/pci-0000:00:1f.2-ata-2"))
+		Expect(parsed.Disks[1].InstallationEligibility.Eligible).Should(BeFalse())
+	})
+
+	It("keeps an inventory without disks", func() {
+		annotated, err := AnnotateInventoryDisks(`{"hostname": "h1"}`, minSize)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(annotated).Should(Equal(`{"hostname": "h1"}`))
+	})
+})
+
 func isBlockDeviceNameInlist(disks []*models.Disk, name string) bool {
 	for _, disk := range disks {
 		// Valid disk: type=disk, not removable, not readonly and size bigger than minimum required
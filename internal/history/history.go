@@ -0,0 +1,77 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
+)
+
+type Transition struct {
+	gorm.Model
+	models.StateTransition
+}
+
+// AddTransition records a state change of a cluster, or of one of its hosts when hostID is set.
+// The transition is written with the given db so it is committed or rolled back together with the state change itself
+func AddTransition(ctx context.Context, db *gorm.DB, clusterID strfmt.UUID, hostID *strfmt.UUID, transitionType string,
+	fromState string, toState string, reason string) error {
+	tt := strfmt.DateTime(time.Now())
+	uid := clusterID
+
+	t := Transition{
+		StateTransition: models.StateTransition{
+			ClusterID:      &uid,
+			TransitionType: swag.String(transitionType),
+			FromState:      swag.String(fromState),
+			ToState:        swag.String(toState),
+			Reason:         reason,
+			TransitionTime: &tt,
+			RequestID:      strfmt.UUID(requestid.FromContext(ctx)),
+		},
+	}
+	if hostID != nil {
+		t.HostID = *hostID
+	}
+
+	if err := db.Create(&t).Error; err != nil {
+		return errors.Wrapf(err, "failed to add transition of cluster %s from %s to %s", clusterID, fromState, toState)
+	}
+	return nil
+}
+
+// GetTransitions returns the transitions of a cluster ordered by their time. When hostID is nil only the transitions
+// of the cluster itself are returned, otherwise the transitions of the given host
+func GetTransitions(db *gorm.DB, clusterID strfmt.UUID, hostID *strfmt.UUID) ([]*Transition, error) {
+	var transitions []*Transition
+	var err error
+	if hostID == nil {
+		err = db.Order("transition_time").Find(&transitions, "cluster_id = ? AND (host_id IS NULL OR host_id = '')",
+			clusterID.String()).Error
+	} else {
+		err = db.Order("transition_time").Find(&transitions, "cluster_id = ? AND host_id = ?",
+			clusterID.String(), hostID.String()).Error
+	}
+	if err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
+
+// DeleteTransitions removes the transitions of a cluster and of all its hosts, or only of one host when hostID is set.
+// The transitions are deleted permanently, so a deregistered cluster or host leaves no history behind
+func DeleteTransitions(db *gorm.DB, clusterID strfmt.UUID, hostID *strfmt.UUID) error {
+	q := db.Unscoped().Where("cluster_id = ?", clusterID.String())
+	if hostID != nil {
+		q = q.Where("host_id = ?", hostID.String())
+	}
+	if err := q.Delete(&Transition{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete transitions of cluster %s", clusterID)
+	}
+	return nil
+}
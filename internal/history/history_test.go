@@ -0,0 +1,114 @@
+package history_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/history"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/requestid"
+)
+
+var _ = Describe("Transition history", func() {
+	var (
+		db        *gorm.DB
+		dbName    = "history_test"
+		clusterID = strfmt.UUID("46a8d745-dfce-4fd8-9df0-549ee8eabb3d")
+		hostID    = strfmt.UUID("1e45d128-4a69-4e71-9b50-a0c627217f3e")
+		ctx       = context.Background()
+	)
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+	})
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("no transitions initially", func() {
+		transitions, err := history.GetTransitions(db, clusterID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(transitions).Should(BeEmpty())
+	})
+
+	It("cluster and host transitions are kept apart", func() {
+		Expect(history.AddTransition(ctx, db, clusterID, nil, "RefreshStatus",
+			models.ClusterStatusInsufficient, models.ClusterStatusReady, "ready")).ShouldNot(HaveOccurred())
+		Expect(history.AddTransition(ctx, db, clusterID, &hostID, "RefreshHost",
+			models.HostStatusDiscovering, models.HostStatusKnown, "known")).ShouldNot(HaveOccurred())
+		Expect(history.AddTransition(ctx, db, clusterID, &hostID, "RefreshHost",
+			models.HostStatusKnown, models.HostStatusDisconnected, "disconnected")).ShouldNot(HaveOccurred())
+
+		transitions, err := history.GetTransitions(db, clusterID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(transitions).Should(HaveLen(1))
+		Expect(swag.StringValue(transitions[0].TransitionType)).Should(Equal("RefreshStatus"))
+		Expect(swag.StringValue(transitions[0].FromState)).Should(Equal(models.ClusterStatusInsufficient))
+		Expect(swag.StringValue(transitions[0].ToState)).Should(Equal(models.ClusterStatusReady))
+		Expect(transitions[0].Reason).Should(Equal("ready"))
+
+		transitions, err = history.GetTransitions(db, clusterID, &hostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(transitions).Should(HaveLen(2))
+		Expect(swag.StringValue(transitions[0].ToState)).Should(Equal(models.HostStatusKnown))
+		Expect(swag.StringValue(transitions[1].FromState)).Should(Equal(models.HostStatusKnown))
+		Expect(swag.StringValue(transitions[1].ToState)).Should(Equal(models.HostStatusDisconnected))
+	})
+
+	It("transition keeps the request id", func() {
+		requestID := requestid.NewID()
+		Expect(history.AddTransition(requestid.ToContext(ctx, requestID), db, clusterID, nil, "ResetCluster",
+			models.ClusterStatusError, models.ClusterStatusInsufficient, "")).ShouldNot(HaveOccurred())
+		transitions, err := history.GetTransitions(db, clusterID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(transitions).Should(HaveLen(1))
+		Expect(transitions[0].RequestID.String()).Should(Equal(requestID))
+	})
+
+	It("transitions are deleted", func() {
+		otherHostID := strfmt.UUID("5f1b2b3c-8a0e-4d2e-9c4e-2f5d9f7f0b11")
+		Expect(history.AddTransition(ctx, db, clusterID, nil, "RefreshStatus",
+			models.ClusterStatusInsufficient, models.ClusterStatusReady, "")).ShouldNot(HaveOccurred())
+		Expect(history.AddTransition(ctx, db, clusterID, &hostID, "RefreshHost",
+			models.HostStatusDiscovering, models.HostStatusKnown, "")).ShouldNot(HaveOccurred())
+		Expect(history.AddTransition(ctx, db, clusterID, &otherHostID, "RefreshHost",
+			models.HostStatusDiscovering, models.HostStatusKnown, "")).ShouldNot(HaveOccurred())
+
+		Expect(history.DeleteTransitions(db, clusterID, &hostID)).ShouldNot(HaveOccurred())
+		transitions, err := history.GetTransitions(db, clusterID, &hostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(transitions).Should(BeEmpty())
+		transitions, err = history.GetTransitions(db, clusterID, &otherHostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(transitions).Should(HaveLen(1))
+
+		Expect(history.DeleteTransitions(db, clusterID, nil)).ShouldNot(HaveOccurred())
+		var count int
+		Expect(db.Unscoped().Model(&history.Transition{}).Where("cluster_id = ?", clusterID.String()).
+			Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).Should(Equal(0))
+	})
+
+	It("transition is rolled back with its transaction", func() {
+		tx := db.Begin()
+		Expect(history.AddTransition(ctx, tx, clusterID, nil, "ResetCluster",
+			models.ClusterStatusError, models.ClusterStatusInsufficient, "")).ShouldNot(HaveOccurred())
+		Expect(tx.Rollback().Error).ShouldNot(HaveOccurred())
+		transitions, err := history.GetTransitions(db, clusterID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(transitions).Should(BeEmpty())
+	})
+})
+
+func TestHistory(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "History test Suite")
+}
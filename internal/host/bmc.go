@@ -0,0 +1,199 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/bmc"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	BmcActionBootDiscoveryImage   = "boot-discovery-image"
+	BmcActionBootInstallationDisk = "boot-installation-disk"
+)
+
+type BmcConfig struct {
+	MaxActionAttempts   int           `envconfig:"BMC_MAX_ACTION_ATTEMPTS" default:"5"`
+	ActionRetryInterval time.Duration `envconfig:"BMC_ACTION_RETRY_INTERVAL" default:"30s"`
+}
+
+// BmcManager reboots the hosts that wait for the user to reboot them, using the Redfish API of their BMC. Only hosts
+// with BMC credentials are handled, and every status of a host is handled once. A failed action is retried with an
+// exponential backoff until it succeeds or the maximum number of attempts is reached.
+type BmcManager struct {
+	log            logrus.FieldLogger
+	db             *gorm.DB
+	bmcClient      bmc.Client
+	eventsHandler  events.Handler
+	serviceBaseURL string
+	credentialsKey string
+	leaderElector  leader.Leader
+	cfg            BmcConfig
+}
+
+func NewBmcManager(log logrus.FieldLogger, db *gorm.DB, bmcClient bmc.Client, eventsHandler events.Handler,
+	serviceBaseURL, credentialsKey string, leaderElector leader.Leader, cfg BmcConfig) *BmcManager {
+	return &BmcManager{
+		log:            log,
+		db:             db,
+		bmcClient:      bmcClient,
+		eventsHandler:  eventsHandler,
+		serviceBaseURL: strings.TrimSpace(serviceBaseURL),
+		credentialsKey: credentialsKey,
+		leaderElector:  leaderElector,
+		cfg:            cfg,
+	}
+}
+
+func (m *BmcManager) BmcMonitoring() {
+	if !m.leaderElector.IsLeader() {
+		m.log.Debugf("Not a leader, exiting BmcMonitoring")
+		return
+	}
+
+	var (
+		hosts     []*models.Host
+		requestID = requestid.NewID()
+		ctx       = requestid.ToContext(context.Background(), requestID)
+		log       = requestid.RequestIDLogger(m.log, requestID)
+	)
+
+	if err := m.db.Where("status IN (?)", []string{models.HostStatusResettingPendingUserAction,
+		models.HostStatusInstallingPendingUserAction}).
+		Where("id IN (?)", m.db.Table("bmc_credentials").Select("host_id").QueryExpr()).
+		Find(&hosts).Error; err != nil {
+		log.WithError(err).Errorf("failed to get hosts pending user action")
+		return
+	}
+	for _, host := range hosts {
+		if !m.leaderElector.IsLeader() {
+			m.log.Debugf("Not a leader, exiting BmcMonitoring")
+			return
+		}
+		if err := m.handleHost(ctx, log, host); err != nil {
+			log.WithError(err).Errorf("failed to handle the BMC of host %s", host.ID.String())
+		}
+	}
+}
+
+func (m *BmcManager) handleHost(ctx context.Context, log logrus.FieldLogger, host *models.Host) error {
+	creds, err := common.GetBmcCredentials(m.db, host.ClusterID, *host.ID)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	statusUpdatedAt := time.Time(host.StatusUpdatedAt)
+	if creds.HandledStatusUpdatedAt.Equal(statusUpdatedAt) {
+		return nil
+	}
+	if !creds.AttemptedStatusUpdatedAt.Equal(statusUpdatedAt) {
+		creds.AttemptedStatusUpdatedAt = statusUpdatedAt
+		creds.FailedAttempts = 0
+		creds.NextAttemptAt = time.Time{}
+	}
+	if time.Now().Before(creds.NextAttemptAt) {
+		return nil
+	}
+
+	var action string
+	switch swag.StringValue(host.Status) {
+	case models.HostStatusResettingPendingUserAction:
+		action = BmcActionBootDiscoveryImage
+	default:
+		action = BmcActionBootInstallationDisk
+	}
+	err = m.runAction(ctx, host, creds, action)
+
+	creds.LastAction = action
+	creds.LastActionAt = strfmt.DateTime(time.Now())
+	creds.LastActionError = ""
+	severity := models.EventSeverityInfo
+	msg := fmt.Sprintf("Host %s: rebooted by its BMC to %s", hostutil.GetHostnameForMsg(host),
+		strings.ReplaceAll(action, "-", " "))
+	if err == nil {
+		creds.HandledStatusUpdatedAt = statusUpdatedAt
+	} else {
+		log.WithError(err).Warnf("BMC action %s failed for host %s", action, host.ID.String())
+		creds.LastActionError = err.Error()
+		creds.FailedAttempts++
+		severity = models.EventSeverityWarning
+		msg = fmt.Sprintf("Host %s: failed to reboot by its BMC to %s: %s", hostutil.GetHostnameForMsg(host),
+			strings.ReplaceAll(action, "-", " "), err.Error())
+		if creds.FailedAttempts >= m.cfg.MaxActionAttempts {
+			creds.HandledStatusUpdatedAt = statusUpdatedAt
+			msg = fmt.Sprintf("%s, giving up after %d attempts", msg, creds.FailedAttempts)
+		} else {
+			retryInterval := m.cfg.ActionRetryInterval * time.Duration(1<<uint(creds.FailedAttempts-1))
+			creds.NextAttemptAt = time.Now().Add(retryInterval)
+			msg = fmt.Sprintf("%s, retrying in %s", msg, retryInterval)
+		}
+	}
+	m.eventsHandler.AddEvent(ctx, host.ClusterID, host.ID, severity, msg, time.Now())
+	return m.db.Save(creds).Error
+}
+
+func (m *BmcManager) runAction(ctx context.Context, host *models.Host, creds *common.BmcCredentials, action string) error {
+	password, err := creds.GetPassword(m.credentialsKey)
+	if err != nil {
+		return err
+	}
+	bmcCreds := &bmc.Credentials{
+		Address:                        creds.Address,
+		Username:                       creds.Username,
+		Password:                       password,
+		DisableCertificateVerification: creds.DisableCertificateVerification,
+	}
+	if action == BmcActionBootDiscoveryImage {
+		return m.bootDiscoveryImage(ctx, host, bmcCreds)
+	}
+	return m.bootInstallationDisk(ctx, bmcCreds)
+}
+
+func (m *BmcManager) discoveryImageURL(clusterID strfmt.UUID) (string, error) {
+	var cluster common.Cluster
+	if err := m.db.Take(&cluster, "id = ?", clusterID.String()).Error; err != nil {
+		return "", err
+	}
+	if cluster.ImageInfo != nil && cluster.ImageInfo.DownloadURL != "" {
+		return cluster.ImageInfo.DownloadURL, nil
+	}
+	return fmt.Sprintf("%s/api/assisted-install/v1/clusters/%s/downloads/image", m.serviceBaseURL, clusterID), nil
+}
+
+func (m *BmcManager) bootDiscoveryImage(ctx context.Context, host *models.Host, creds *bmc.Credentials) error {
+	imageURL, err := m.discoveryImageURL(host.ClusterID)
+	if err != nil {
+		return err
+	}
+	if err = m.bmcClient.InsertVirtualMedia(ctx, creds, imageURL); err != nil {
+		return err
+	}
+	if err = m.bmcClient.SetOneTimeBoot(ctx, creds, bmc.BootTargetCd); err != nil {
+		return err
+	}
+	return m.bmcClient.PowerCycle(ctx, creds)
+}
+
+func (m *BmcManager) bootInstallationDisk(ctx context.Context, creds *bmc.Credentials) error {
+	if err := m.bmcClient.EjectVirtualMedia(ctx, creds); err != nil {
+		return err
+	}
+	if err := m.bmcClient.SetOneTimeBoot(ctx, creds, bmc.BootTargetHdd); err != nil {
+		return err
+	}
+	return m.bmcClient.PowerCycle(ctx, creds)
+}
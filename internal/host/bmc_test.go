@@ -0,0 +1,181 @@
+package host
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/bmc"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("BmcMonitoring", func() {
+	var (
+		ctrl              *gomock.Controller
+		db                *gorm.DB
+		dbName            = "bmc_monitoring"
+		mockBmc           *bmc.MockClient
+		mockEvents        *events.MockHandler
+		bmcManager        *BmcManager
+		hostID, clusterID strfmt.UUID
+		host              models.Host
+		expectedCreds     = &bmc.Credentials{Address: "10.0.0.1", Username: "admin", Password: "secret"}
+		credentialsKey    = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		ctrl = gomock.NewController(GinkgoT())
+		mockBmc = bmc.NewMockClient(ctrl)
+		mockEvents = events.NewMockHandler(ctrl)
+		bmcManager = NewBmcManager(getTestLog(), db, mockBmc, mockEvents, "http://assisted.example.com",
+			credentialsKey, &leader.DummyElector{}, BmcConfig{MaxActionAttempts: 2, ActionRetryInterval: time.Minute})
+		hostID = strfmt.UUID(uuid.New().String())
+		clusterID = strfmt.UUID(uuid.New().String())
+		cluster := getTestCluster(clusterID, "1.2.3.0/24")
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createHost := func(status string) {
+		host = getTestHost(hostID, clusterID, status)
+		host.StatusUpdatedAt = strfmt.DateTime(time.Now())
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	}
+
+	createCreds := func() {
+		creds := common.BmcCredentials{
+			BmcCredentials: models.BmcCredentials{
+				ClusterID: clusterID,
+				HostID:    hostID,
+				Address:   expectedCreds.Address,
+				Username:  expectedCreds.Username,
+			},
+		}
+		Expect(creds.SetPassword(credentialsKey, expectedCreds.Password)).ShouldNot(HaveOccurred())
+		Expect(db.Create(&creds).Error).ShouldNot(HaveOccurred())
+	}
+
+	getCreds := func() *common.BmcCredentials {
+		creds, err := common.GetBmcCredentials(db, clusterID, hostID)
+		Expect(err).ShouldNot(HaveOccurred())
+		return creds
+	}
+
+	It("boots a resetting host from the discovery image", func() {
+		createHost(models.HostStatusResettingPendingUserAction)
+		createCreds()
+		imageURL := "http://assisted.example.com/api/assisted-install/v1/clusters/" + clusterID.String() + "/downloads/image"
+		gomock.InOrder(
+			mockBmc.EXPECT().InsertVirtualMedia(gomock.Any(), expectedCreds, imageURL).Return(nil),
+			mockBmc.EXPECT().SetOneTimeBoot(gomock.Any(), expectedCreds, bmc.BootTargetCd).Return(nil),
+			mockBmc.EXPECT().PowerCycle(gomock.Any(), expectedCreds).Return(nil),
+		)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+		bmcManager.BmcMonitoring()
+		creds := getCreds()
+		Expect(creds.LastAction).Should(Equal(BmcActionBootDiscoveryImage))
+		Expect(creds.LastActionError).Should(BeEmpty())
+
+		By("the status is handled only once")
+		bmcManager.BmcMonitoring()
+	})
+
+	It("boots a host pending user action from its installation disk", func() {
+		createHost(models.HostStatusInstallingPendingUserAction)
+		createCreds()
+		gomock.InOrder(
+			mockBmc.EXPECT().EjectVirtualMedia(gomock.Any(), expectedCreds).Return(nil),
+			mockBmc.EXPECT().SetOneTimeBoot(gomock.Any(), expectedCreds, bmc.BootTargetHdd).Return(nil),
+			mockBmc.EXPECT().PowerCycle(gomock.Any(), expectedCreds).Return(nil),
+		)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+		bmcManager.BmcMonitoring()
+		Expect(getCreds().LastAction).Should(Equal(BmcActionBootInstallationDisk))
+	})
+
+	It("retries a failed action with a backoff until the maximum number of attempts", func() {
+		createHost(models.HostStatusInstallingPendingUserAction)
+		createCreds()
+		mockBmc.EXPECT().EjectVirtualMedia(gomock.Any(), expectedCreds).Return(errors.New("connection refused")).Times(2)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityWarning, gomock.Any(), gomock.Any()).Times(2)
+		bmcManager.BmcMonitoring()
+		creds := getCreds()
+		Expect(creds.LastActionError).Should(Equal("connection refused"))
+		Expect(creds.FailedAttempts).Should(Equal(1))
+		Expect(creds.NextAttemptAt).Should(BeTemporally("~", time.Now().Add(time.Minute), 10*time.Second))
+
+		By("the action is not retried before the backoff passes")
+		bmcManager.BmcMonitoring()
+
+		By("the action is retried after the backoff")
+		Expect(db.Model(creds).Update("next_attempt_at", time.Now().Add(-time.Second)).Error).ShouldNot(HaveOccurred())
+		bmcManager.BmcMonitoring()
+		Expect(getCreds().FailedAttempts).Should(Equal(2))
+
+		By("the action is not retried after the maximum number of attempts")
+		Expect(db.Model(creds).Update("next_attempt_at", time.Now().Add(-time.Second)).Error).ShouldNot(HaveOccurred())
+		bmcManager.BmcMonitoring()
+	})
+
+	It("a failed attempt of a previous status does not delay a new status", func() {
+		createHost(models.HostStatusInstallingPendingUserAction)
+		createCreds()
+		mockBmc.EXPECT().EjectVirtualMedia(gomock.Any(), expectedCreds).Return(errors.New("connection refused"))
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityWarning, gomock.Any(), gomock.Any())
+		bmcManager.BmcMonitoring()
+
+		Expect(db.Model(&host).Updates(map[string]interface{}{"status": models.HostStatusResettingPendingUserAction,
+			"status_updated_at": strfmt.DateTime(time.Now())}).Error).ShouldNot(HaveOccurred())
+		gomock.InOrder(
+			mockBmc.EXPECT().InsertVirtualMedia(gomock.Any(), expectedCreds, gomock.Any()).Return(nil),
+			mockBmc.EXPECT().SetOneTimeBoot(gomock.Any(), expectedCreds, bmc.BootTargetCd).Return(nil),
+			mockBmc.EXPECT().PowerCycle(gomock.Any(), expectedCreds).Return(nil),
+		)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+		bmcManager.BmcMonitoring()
+		Expect(getCreds().FailedAttempts).Should(Equal(0))
+	})
+
+	It("fails the action when the password can not be decrypted", func() {
+		createHost(models.HostStatusInstallingPendingUserAction)
+		createCreds()
+		bmcManager.credentialsKey = "MTIzNDU2Nzg5MGFiY2RlZjEyMzQ1Njc4OTBhYmNkZWY="
+		mockEvents.EXPECT().AddEvent(gomock.Any(), clusterID, &hostID, models.EventSeverityWarning, gomock.Any(), gomock.Any())
+		bmcManager.BmcMonitoring()
+		Expect(getCreds().LastActionError).Should(ContainSubstring("failed to decrypt the BMC password"))
+	})
+
+	It("ignores hosts without credentials", func() {
+		createHost(models.HostStatusResettingPendingUserAction)
+		bmcManager.BmcMonitoring()
+	})
+
+	It("ignores hosts that don't wait for a reboot", func() {
+		createHost(models.HostStatusKnown)
+		createCreds()
+		bmcManager.BmcMonitoring()
+		Expect(getCreds().LastAction).Should(BeEmpty())
+	})
+
+	It("does nothing when not the leader", func() {
+		createHost(models.HostStatusResettingPendingUserAction)
+		createCreds()
+		mockLeader := leader.NewMockElectorInterface(ctrl)
+		mockLeader.EXPECT().IsLeader().Return(false).AnyTimes()
+		bmcManager.leaderElector = mockLeader
+		bmcManager.BmcMonitoring()
+	})
+})
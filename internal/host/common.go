@@ -19,6 +19,7 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -40,13 +41,17 @@ const (
 	statusInfoInstallationTimedOut       = "Host failed to install because its installation stage $STAGE took longer than expected $MAX_TIME"
 )
 
+// Installation progress reported by the host may change its status without running the state machine,
+// such status changes are recorded in the transition history with this transition type
+const transitionTypeUpdateInstallProgress = "UpdateInstallProgress"
+
 type UpdateReply struct {
 	State     string
 	IsChanged bool
 }
 
 func updateHostProgress(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler, clusterId strfmt.UUID, hostId strfmt.UUID,
-	srcStatus string, newStatus string, statusInfo string,
+	transitionType string, srcStatus string, newStatus string, statusInfo string,
 	srcStage models.HostStage, newStage models.HostStage, progressInfo string, extra ...interface{}) (*models.Host, error) {
 
 	extra = append(append(make([]interface{}, 0), "progress_current_stage", newStage, "progress_progress_info", progressInfo,
@@ -56,11 +61,11 @@ func updateHostProgress(ctx context.Context, log logrus.FieldLogger, db *gorm.DB
 		extra = append(extra, "progress_stage_started_at", strfmt.DateTime(time.Now()))
 	}
 
-	return updateHostStatus(ctx, log, db, eventsHandler, clusterId, hostId, srcStatus, newStatus, statusInfo, extra...)
+	return updateHostStatus(ctx, log, db, eventsHandler, clusterId, hostId, transitionType, srcStatus, newStatus, statusInfo, extra...)
 }
 
 func updateHostStatus(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler, clusterId strfmt.UUID, hostId strfmt.UUID,
-	srcStatus string, newStatus string, statusInfo string, extra ...interface{}) (*models.Host, error) {
+	transitionType string, srcStatus string, newStatus string, statusInfo string, extra ...interface{}) (*models.Host, error) {
 	var host *models.Host
 	var err error
 
@@ -77,6 +82,9 @@ func updateHostStatus(ctx context.Context, log logrus.FieldLogger, db *gorm.DB,
 	}
 
 	if newStatus != srcStatus {
+		if err = history.AddTransition(ctx, db, clusterId, &hostId, transitionType, srcStatus, newStatus, statusInfo); err != nil {
+			return nil, err
+		}
 		msg := fmt.Sprintf("Host %s: updated status from \"%s\" to \"%s\"", hostutil.GetHostnameForMsg(host), srcStatus, newStatus)
 		if statusInfo != "" {
 			msg += fmt.Sprintf(" (%s)", statusInfo)
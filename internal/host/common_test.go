@@ -7,12 +7,14 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/history"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -51,26 +53,43 @@ var _ = Describe("update_host_state", func() {
 			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, models.EventSeverityInfo,
 				fmt.Sprintf("Host %s: updated status from \"status\" to \"newStatus\" (newStatusInfo)", host.ID.String()),
 				gomock.Any())
-			returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, defaultStatus,
+			returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, defaultStatus,
 				newStatus, newStatusInfo)
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(*returnedHost.Status).Should(Equal(newStatus))
 			Expect(*returnedHost.StatusInfo).Should(Equal(newStatusInfo))
 			Expect(returnedHost.StatusUpdatedAt.String()).ShouldNot(Equal(lastUpdatedTime.String()))
+
+			transitions, err := history.GetTransitions(db, host.ClusterID, host.ID)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(transitions).Should(HaveLen(1))
+			Expect(swag.StringValue(transitions[0].TransitionType)).Should(Equal(TransitionTypeRefresh))
+			Expect(swag.StringValue(transitions[0].FromState)).Should(Equal(defaultStatus))
+			Expect(swag.StringValue(transitions[0].ToState)).Should(Equal(newStatus))
+			Expect(transitions[0].Reason).Should(Equal(newStatusInfo))
+		})
+
+		It("same_status_is_not_recorded", func() {
+			returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, defaultStatus,
+				defaultStatus, newStatusInfo)
+			Expect(err).ShouldNot(HaveOccurred())
+			transitions, err := history.GetTransitions(db, host.ClusterID, host.ID)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(transitions).Should(BeEmpty())
 		})
 
 		Describe("negative", func() {
 			It("invalid_extras_amount", func() {
-				returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status,
+				returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, *host.Status,
 					newStatus, newStatusInfo, "1")
 				Expect(err).Should(HaveOccurred())
 				Expect(returnedHost).Should(BeNil())
-				returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status,
+				returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, *host.Status,
 					newStatus, newStatusInfo, "1", "2", "3")
 			})
 
 			It("no_matching_rows", func() {
-				returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, "otherStatus",
+				returnedHost, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, "otherStatus",
 					newStatus, newStatusInfo)
 			})
 
@@ -87,7 +106,7 @@ var _ = Describe("update_host_state", func() {
 
 		It("db_failure", func() {
 			db.Close()
-			_, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status,
+			_, err = updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, *host.Status,
 				newStatus, newStatusInfo)
 			Expect(err).Should(HaveOccurred())
 		})
@@ -96,7 +115,7 @@ var _ = Describe("update_host_state", func() {
 	Describe("updateHostProgress", func() {
 		Describe("same_status", func() {
 			It("new_stage", func() {
-				returnedHost, err = updateHostProgress(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status, defaultStatus, defaultStatusInfo,
+				returnedHost, err = updateHostProgress(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, *host.Status, defaultStatus, defaultStatusInfo,
 					host.Progress.CurrentStage, defaultProgressStage, host.Progress.ProgressInfo)
 				Expect(err).ShouldNot(HaveOccurred())
 
@@ -108,7 +127,7 @@ var _ = Describe("update_host_state", func() {
 
 			It("same_stage", func() {
 				// Still updates because stage_updated_at is being updated
-				returnedHost, err = updateHostProgress(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status, defaultStatus, defaultStatusInfo,
+				returnedHost, err = updateHostProgress(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, *host.Status, defaultStatus, defaultStatusInfo,
 					host.Progress.CurrentStage, host.Progress.CurrentStage, host.Progress.ProgressInfo)
 				Expect(err).ShouldNot(HaveOccurred())
 
@@ -131,7 +150,7 @@ var _ = Describe("update_host_state", func() {
 			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, models.EventSeverityInfo,
 				fmt.Sprintf("Host %s: updated status from \"status\" to \"newStatus\" (newStatusInfo)", host.ID.String()),
 				gomock.Any())
-			returnedHost, err = updateHostProgress(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status, newStatus, newStatusInfo,
+			returnedHost, err = updateHostProgress(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, *host.Status, newStatus, newStatusInfo,
 				host.Progress.CurrentStage, defaultProgressStage, "")
 			Expect(err).ShouldNot(HaveOccurred())
 
@@ -149,7 +168,7 @@ var _ = Describe("update_host_state", func() {
 
 		It("update_info", func() {
 			for _, i := range []int{5, 10, 15} {
-				returnedHost, err = updateHostProgress(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status, defaultStatus, defaultStatusInfo,
+				returnedHost, err = updateHostProgress(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, *host.Status, defaultStatus, defaultStatusInfo,
 					host.Progress.CurrentStage, host.Progress.CurrentStage, fmt.Sprintf("%d%%", i))
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(returnedHost.Progress.ProgressInfo).Should(Equal(fmt.Sprintf("%d%%", i)))
@@ -46,7 +46,7 @@ func convertInterfacesToConnectivityCheckHost(hostId *strfmt.UUID, interfaces []
 		var ipAddresses []string
 		connectivityNic.Mac = hostInterface.MacAddress
 		connectivityNic.Name = hostInterface.Name
-		for _, ip := range hostInterface.IPV4Addresses {
+		for _, ip := range append(append([]string{}, hostInterface.IPV4Addresses...), hostInterface.IPV6Addresses...) {
 			ipAddresses = append(ipAddresses, strings.Split(ip, "/")[0])
 		}
 		connectivityNic.IPAddresses = ipAddresses
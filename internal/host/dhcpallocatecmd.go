@@ -63,10 +63,11 @@ func (f *dhcpAllocateCmd) GetStep(ctx context.Context, host *models.Host) (*mode
 	 * filtering is done here to remove all valid (not errored) cases that the command should not be invoked.
 	 * These cases are:
 	 * - VipDhcpAllocation is false: DHCP mode is not enabled
+	 * - UserManagedNetworking is true: the cluster has no VIPs
 	 * - MachineNetworkCidr is empty: MachineNetworkCidr has not be set by the user
 	 * - Inventory is empty: Inventory has not been received yet from the host
 	 */
-	if !swag.BoolValue(cluster.VipDhcpAllocation) || cluster.MachineNetworkCidr == "" || host.Inventory == "" {
+	if !swag.BoolValue(cluster.VipDhcpAllocation) || common.HasNoVips(&cluster) || cluster.MachineNetworkCidr == "" || host.Inventory == "" {
 		return nil, nil
 	}
 	param, err := f.prepareParam(host, &cluster)
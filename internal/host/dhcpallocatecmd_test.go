@@ -62,6 +62,16 @@ var _ = Describe("dhcpallocate", func() {
 		Expect(stepErr).ShouldNot(HaveOccurred())
 	})
 
+	It("User managed networking", func() {
+		cluster = getTestCluster(clusterId, "1.2.3.0/24")
+		cluster.VipDhcpAllocation = swag.Bool(true)
+		cluster.UserManagedNetworking = swag.Bool(true)
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		stepReply, stepErr = dCmd.GetStep(ctx, &host)
+		Expect(stepReply).To(BeNil())
+		Expect(stepErr).ShouldNot(HaveOccurred())
+	})
+
 	It("CIDR missing", func() {
 		cluster = getTestCluster(clusterId, "")
 		cluster.VipDhcpAllocation = swag.Bool(true)
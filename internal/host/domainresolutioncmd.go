@@ -0,0 +1,72 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/sirupsen/logrus"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+type domainResolutionCmd struct {
+	baseCmd
+	domainResolutionImage string
+	db                    *gorm.DB
+}
+
+func NewDomainResolutionCmd(log logrus.FieldLogger, domainResolutionImage string, db *gorm.DB) *domainResolutionCmd {
+	return &domainResolutionCmd{
+		baseCmd:               baseCmd{log: log},
+		domainResolutionImage: domainResolutionImage,
+		db:                    db,
+	}
+}
+
+func (f *domainResolutionCmd) prepareParam(cluster *common.Cluster) (string, error) {
+	request := models.DomainResolutionRequest{
+		Domains: []*models.DomainResolutionRequestDomain{
+			{DomainName: swag.String(network.GetAPIDomainName(cluster))},
+			{DomainName: swag.String(network.GetAppsDomainName(cluster))},
+		},
+	}
+	b, err := json.Marshal(&request)
+	if err != nil {
+		f.log.WithError(err).Warn("Json marshal")
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (f *domainResolutionCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
+	var cluster common.Cluster
+	if err := f.db.Take(&cluster, "id = ?", host.ClusterID.String()).Error; err != nil {
+		return nil, err
+	}
+	// Only the hosts of user managed networking clusters resolve the domain names, the other clusters reach the API
+	// and the ingress through their VIPs
+	if !network.IsDomainResolutionRequired(&cluster) {
+		return nil, nil
+	}
+	param, err := f.prepareParam(&cluster)
+	if err != nil {
+		return nil, err
+	}
+	step := &models.Step{
+		StepType: models.StepTypeDomainResolution,
+		Command:  "podman",
+		Args: []string{
+			"run", "--privileged", "--net=host", "--rm", "--quiet",
+			"-v", "/var/log:/var/log",
+			"-v", "/run/systemd/journal/socket:/run/systemd/journal/socket",
+			f.domainResolutionImage,
+			"domain_resolution",
+			param,
+		},
+	}
+	return step, nil
+}
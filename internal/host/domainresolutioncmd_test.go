@@ -0,0 +1,78 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("domainresolution", func() {
+	ctx := context.Background()
+	var host models.Host
+	var cluster common.Cluster
+	var db *gorm.DB
+	var dCmd *domainResolutionCmd
+	var id, clusterId strfmt.UUID
+	var stepReply *models.Step
+	var stepErr error
+	dbName := "domainresolution_cmd"
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		dCmd = NewDomainResolutionCmd(getTestLog(), "quay.io/ocpmetal/domain_resolution:latest", db)
+
+		id = strfmt.UUID("32b4463e-5f94-4245-87cf-a6948014045c")
+		clusterId = strfmt.UUID("bd9d3b83-80a3-4b94-8b61-c12b2f1a2373")
+		host = getTestHost(id, clusterId, models.HostStatusInsufficient)
+		host.Inventory = masterInventory()
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		cluster = getTestCluster(clusterId, "1.2.3.0/24")
+		cluster.Name = "test"
+		cluster.BaseDNSDomain = "example.com"
+	})
+
+	It("happy flow", func() {
+		cluster.UserManagedNetworking = swag.Bool(true)
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		stepReply, stepErr = dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).ToNot(BeNil())
+		Expect(stepReply.StepType).To(Equal(models.StepTypeDomainResolution))
+		var req models.DomainResolutionRequest
+		Expect(json.Unmarshal([]byte(stepReply.Args[len(stepReply.Args)-1]), &req)).ToNot(HaveOccurred())
+		Expect(req.Domains).To(HaveLen(2))
+		Expect(req.Domains[0].DomainName).To(Equal(swag.String("api.test.example.com")))
+		Expect(req.Domains[1].DomainName).To(Equal(swag.String("console-openshift-console.apps.test.example.com")))
+	})
+
+	It("VIPs managed by the service", func() {
+		cluster.UserManagedNetworking = swag.Bool(false)
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		stepReply, stepErr = dCmd.GetStep(ctx, &host)
+		Expect(stepReply).To(BeNil())
+		Expect(stepErr).ShouldNot(HaveOccurred())
+	})
+
+	It("base DNS domain missing", func() {
+		cluster.UserManagedNetworking = swag.Bool(true)
+		cluster.BaseDNSDomain = ""
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		stepReply, stepErr = dCmd.GetStep(ctx, &host)
+		Expect(stepReply).To(BeNil())
+		Expect(stepErr).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		// cleanup
+		common.DeleteTestDB(db, dbName)
+		stepReply = nil
+		stepErr = nil
+	})
+})
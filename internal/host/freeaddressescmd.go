@@ -31,6 +31,7 @@ func (f *freeAddressesCmd) prepareParam(host *models.Host) (string, error) {
 		return "", err
 	}
 	m := make(map[string]struct{})
+	// IPv6 networks are too large to scan, VIPs in them are verified without a list of free addresses
 	for _, intf := range inventory.Interfaces {
 		for _, ipv4 := range intf.IPV4Addresses {
 			var cidr *net.IPNet
@@ -2,6 +2,7 @@ package host
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -21,6 +22,7 @@ import (
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 )
@@ -35,6 +37,10 @@ var MasterStages = [...]models.HostStage{
 	models.HostStageWritingImageToDisk, models.HostStageRebooting,
 	models.HostStageConfiguring, models.HostStageJoined, models.HostStageDone,
 }
+var BootstrapInPlaceStages = [...]models.HostStage{
+	models.HostStageStartingInstallation, models.HostStageInstalling,
+	models.HostStageWritingImageToDisk, models.HostStageRebooting, models.HostStageDone,
+}
 var WorkerStages = [...]models.HostStage{
 	models.HostStageStartingInstallation, models.HostStageInstalling,
 	models.HostStageWritingImageToDisk, models.HostStageRebooting,
@@ -60,7 +66,8 @@ var InstallationProgressTimeout = map[models.HostStage]time.Duration{
 }
 
 type Config struct {
-	ResetTimeout time.Duration `envconfig:"RESET_CLUSTER_TIMEOUT" default:"3m"`
+	ResetTimeout    time.Duration   `envconfig:"RESET_CLUSTER_TIMEOUT" default:"3m"`
+	ValidationRules ValidationRules `envconfig:"HOST_VALIDATION_RULES"`
 }
 
 //go:generate mockgen -source=host.go -package=host -aux_files=github.com/openshift/assisted-service/internal/host=instructionmanager.go -destination=mock_host_api.go
@@ -76,10 +83,14 @@ type API interface {
 	HostMonitoring()
 	UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error
 	UpdateHostname(ctx context.Context, h *models.Host, hostname string, db *gorm.DB) error
+	// Set the disk that the host will be installed on, it must be one of the valid disks of the host
+	UpdateInstallationDisk(ctx context.Context, h *models.Host, installationDiskID string, db *gorm.DB) error
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
 	ResetHost(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	ResetPendingUserAction(ctx context.Context, h *models.Host, db *gorm.DB) error
+	// Retry the installation of a failed host, hosts that did not fail keep their state
+	RetryInstallation(ctx context.Context, h *models.Host, db *gorm.DB) *common.ApiErrorResponse
 	// Disable host from getting any requests
 	DisableHost(ctx context.Context, h *models.Host, db *gorm.DB) error
 	// Enable host to get requests (disabled by default)
@@ -88,14 +99,14 @@ type API interface {
 	Install(ctx context.Context, h *models.Host, db *gorm.DB) error
 	// Set a new inventory information
 	UpdateInventory(ctx context.Context, h *models.Host, inventory string) error
-	GetStagesByRole(role models.HostRole, isbootstrap bool) []models.HostStage
+	GetStagesByRole(role models.HostRole, isbootstrap bool, isSingleNode bool) []models.HostStage
 	IsInstallable(h *models.Host) bool
 	PrepareForInstallation(ctx context.Context, h *models.Host, db *gorm.DB) error
 	// auto assign host role
 	AutoAssignRole(ctx context.Context, h *models.Host, db *gorm.DB) error
 	IsValidMasterCandidate(h *models.Host, db *gorm.DB, log logrus.FieldLogger) (bool, error)
 	SetUploadLogsAt(ctx context.Context, h *models.Host, db *gorm.DB) error
-	GetHostRequirements(role models.HostRole) models.HostRequirementsRole
+	GetHostRequirements(openshiftVersion string, role models.HostRole) models.HostRequirementsRole
 }
 
 type Manager struct {
@@ -103,9 +114,11 @@ type Manager struct {
 	db             *gorm.DB
 	instructionApi InstructionApi
 	hwValidator    hardware.Validator
+	hwValidatorCfg *hardware.ValidatorCfg
 	eventsHandler  events.Handler
 	sm             stateswitch.StateMachine
 	rp             *refreshPreprocessor
+	majorityGroups *majorityGroups
 	metricApi      metrics.API
 	Config         Config
 	leaderElector  leader.Leader
@@ -118,14 +131,17 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handle
 		log:           log,
 		eventsHandler: eventsHandler,
 	}
+	majorityGroups := newMajorityGroups()
 	return &Manager{
 		log:            log,
 		db:             db,
 		instructionApi: instructionApi,
 		hwValidator:    hwValidator,
+		hwValidatorCfg: hwValidatorCfg,
 		eventsHandler:  eventsHandler,
 		sm:             NewHostStateMachine(th),
-		rp:             newRefreshPreprocessor(log, hwValidatorCfg),
+		rp:             newRefreshPreprocessor(log, hwValidator, hwValidatorCfg, config.ValidationRules, majorityGroups),
+		majorityGroups: majorityGroups,
 		metricApi:      metricApi,
 		Config:         *config,
 		leaderElector:  leaderElector,
@@ -175,8 +191,71 @@ func (m *Manager) UpdateInventory(ctx context.Context, h *models.Host, inventory
 			errors.Errorf("Host is in %s state, host can be updated only in one of %s states",
 				hostStatus, allowedStatuses))
 	}
+	installationDiskID := h.InstallationDiskID
+	staticNetworkConfig := h.StaticNetworkConfig
+	var inv models.Inventory
+	if err := json.Unmarshal([]byte(inventory), &inv); err == nil {
+		minDiskSizeBytes := m.getMinDiskSizeBytes(m.db, h)
+		if annotated, err := hardware.AnnotateInventoryDisks(inventory, minDiskSizeBytes); err == nil {
+			inventory = annotated
+		} else {
+			m.log.WithError(err).Warnf("failed to annotate the disks of host %s", h.ID.String())
+		}
+		installationDiskID = m.getInstallationDiskID(&inv, h.InstallationDiskID, minDiskSizeBytes)
+		if config, err := m.getStaticNetworkConfig(h.ClusterID, &inv); err == nil {
+			staticNetworkConfig = config
+		} else {
+			m.log.WithError(err).Warnf("failed to match the static network configuration of host %s", h.ID.String())
+		}
+	} else {
+		m.log.WithError(err).Warnf("failed to parse the inventory of host %s", h.ID.String())
+	}
 	h.Inventory = inventory
-	return m.db.Model(h).Update("inventory", inventory).Error
+	h.InstallationDiskID = installationDiskID
+	h.StaticNetworkConfig = staticNetworkConfig
+	return m.db.Model(h).Updates(map[string]interface{}{
+		"inventory":             inventory,
+		"installation_disk_id":  installationDiskID,
+		"static_network_config": staticNetworkConfig,
+	}).Error
+}
+
+// getStaticNetworkConfig returns the static network configuration of the discovery image of the cluster that matches
+// the interfaces of the host
+func (m *Manager) getStaticNetworkConfig(clusterID strfmt.UUID, inventory *models.Inventory) (string, error) {
+	var cluster common.Cluster
+	if err := m.db.Select("image_static_network_config").Take(&cluster, "id = ?", clusterID.String()).Error; err != nil {
+		return "", err
+	}
+	if cluster.ImageInfo == nil {
+		return "", nil
+	}
+	return network.GetHostStaticNetworkConfig(cluster.ImageInfo.StaticNetworkConfig, inventory)
+}
+
+// getMinDiskSizeBytes returns the size of the smallest disk that the host can be installed on, as the OpenShift version
+// of its cluster requires for the role of the host
+func (m *Manager) getMinDiskSizeBytes(db *gorm.DB, h *models.Host) int64 {
+	var cluster common.Cluster
+	if err := db.Select("openshift_version").Take(&cluster, "id = ?", h.ClusterID.String()).Error; err != nil {
+		m.log.WithError(err).Warnf("failed to get the OpenShift version of the cluster of host %s", h.ID.String())
+	}
+	return gibToBytes(m.hwValidator.GetHostRequirements(cluster.OpenshiftVersion, h.Role).DiskSizeGb)
+}
+
+// getInstallationDiskID returns the selected installation disk, and the disk that the installation disk policy
+// selects when none was selected. A selected disk that is not eligible anymore is kept, so the host fails its disk
+// validation rather than being installed on a disk that the user didn't choose.
+func (m *Manager) getInstallationDiskID(inventory *models.Inventory, selectedDiskID string, minDiskSizeBytes int64) string {
+	if selectedDiskID != "" {
+		return selectedDiskID
+	}
+	disks := hardware.ListValidDisks(inventory, minDiskSizeBytes)
+	disk := hardware.GetInstallationDisk(disks, selectedDiskID, m.hwValidatorCfg.InstallationDiskPolicy)
+	if disk == nil {
+		return ""
+	}
+	return hardware.GetDiskID(disk)
 }
 
 func (m *Manager) RefreshStatus(ctx context.Context, h *models.Host, db *gorm.DB) error {
@@ -249,9 +328,15 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 		return fmt.Errorf("Can't set progress <%s> to host in status <%s>", progress.CurrentStage, swag.StringValue(h.Status))
 	}
 
+	var cluster common.Cluster
+	if err := m.db.Select("high_availability_mode, kind").Take(&cluster, "id = ?", h.ClusterID).Error; err != nil &&
+		!gorm.IsRecordNotFoundError(err) {
+		return errors.Wrapf(err, "failed to get cluster %s", h.ClusterID)
+	}
+
 	if previousProgress.CurrentStage != "" && progress.CurrentStage != models.HostStageFailed {
 		// Verify the new stage is higher or equal to the current host stage according to its role stages array
-		stages := m.GetStagesByRole(h.Role, h.Bootstrap)
+		stages := m.GetStagesByRole(h.Role, h.Bootstrap, common.IsSingleNodeCluster(&cluster))
 		currentIndex := indexOfStage(progress.CurrentStage, stages)
 
 		if currentIndex == -1 {
@@ -267,12 +352,17 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 	statusInfo := string(progress.CurrentStage)
 
 	var err error
-	switch progress.CurrentStage {
-	case models.HostStageDone:
+	switch {
+	case progress.CurrentStage == models.HostStageRebooting && common.IsDay2Cluster(&cluster):
+		// day2 hosts are done with the installation flow once they reboot into the existing cluster
+		_, err = updateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
+			transitionTypeUpdateInstallProgress, swag.StringValue(h.Status), models.HostStatusAddedToExistingCluster, statusInfo,
+			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo)
+	case progress.CurrentStage == models.HostStageDone:
 		_, err = updateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
-			swag.StringValue(h.Status), models.HostStatusInstalled, statusInfo,
+			transitionTypeUpdateInstallProgress, swag.StringValue(h.Status), models.HostStatusInstalled, statusInfo,
 			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo)
-	case models.HostStageFailed:
+	case progress.CurrentStage == models.HostStageFailed:
 		// Keeps the last progress
 
 		if progress.ProgressInfo != "" {
@@ -280,10 +370,10 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 		}
 
 		_, err = updateHostStatus(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
-			swag.StringValue(h.Status), models.HostStatusError, statusInfo)
+			transitionTypeUpdateInstallProgress, swag.StringValue(h.Status), models.HostStatusError, statusInfo)
 	default:
 		_, err = updateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
-			swag.StringValue(h.Status), models.HostStatusInstallingInProgress, statusInfo,
+			transitionTypeUpdateInstallProgress, swag.StringValue(h.Status), models.HostStatusInstallingInProgress, statusInfo,
 			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo)
 	}
 	m.reportInstallationMetrics(ctx, h, previousProgress, progress.CurrentStage)
@@ -346,6 +436,37 @@ func (m *Manager) UpdateHostname(ctx context.Context, h *models.Host, hostname s
 	return cdb.Model(h).Update("requested_hostname", hostname).Error
 }
 
+func (m *Manager) UpdateInstallationDisk(ctx context.Context, h *models.Host, installationDiskID string, db *gorm.DB) error {
+	hostStatus := swag.StringValue(h.Status)
+	allowedStatuses := []string{
+		models.HostStatusDiscovering, models.HostStatusKnown, models.HostStatusDisconnected,
+		models.HostStatusInsufficient, models.HostStatusPendingForInput,
+	}
+	if !funk.ContainsString(allowedStatuses, hostStatus) {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host is in %s state, installation disk can be set only in one of %s states",
+				hostStatus, allowedStatuses))
+	}
+
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host %s has no inventory, installation disk can not be set", h.ID.String()))
+	}
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	disks := hardware.ListValidDisks(&inventory, m.getMinDiskSizeBytes(cdb, h))
+	if hardware.FindDisk(disks, installationDiskID) == nil {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Disk %s is not a valid installation disk of host %s", installationDiskID, h.ID.String()))
+	}
+
+	h.InstallationDiskID = installationDiskID
+	return cdb.Model(h).Update("installation_disk_id", installationDiskID).Error
+}
+
 func (m *Manager) CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse {
 	eventSeverity := models.EventSeverityInfo
 	eventInfo := fmt.Sprintf("Installation canceled for host %s", hostutil.GetHostnameForMsg(h))
@@ -413,6 +534,29 @@ func (m *Manager) ResetHost(ctx context.Context, h *models.Host, reason string,
 	return nil
 }
 
+func (m *Manager) RetryInstallation(ctx context.Context, h *models.Host, db *gorm.DB) *common.ApiErrorResponse {
+	eventSeverity := models.EventSeverityInfo
+	eventInfo := fmt.Sprintf("Retrying installation of host %s", hostutil.GetHostnameForMsg(h))
+	shouldAddEvent := swag.StringValue(h.Status) == models.HostStatusError
+	defer func() {
+		if shouldAddEvent {
+			m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, eventSeverity, eventInfo, time.Now())
+		}
+	}()
+
+	err := m.sm.Run(TransitionTypeRetryInstallation, newStateHost(h), &TransitionArgsRetryInstallation{
+		ctx: ctx,
+		db:  db,
+	})
+	if err != nil {
+		shouldAddEvent = true
+		eventSeverity = models.EventSeverityError
+		eventInfo = fmt.Sprintf("Failed to retry installation of host %s. Error: %s", hostutil.GetHostnameForMsg(h), err.Error())
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
+}
+
 func (m *Manager) ResetPendingUserAction(ctx context.Context, h *models.Host, db *gorm.DB) error {
 	eventSeverity := models.EventSeverityInfo
 	eventInfo := fmt.Sprintf("User action is required in order to complete installation reset for host %s", hostutil.GetHostnameForMsg(h))
@@ -437,7 +581,12 @@ func (m *Manager) ResetPendingUserAction(ctx context.Context, h *models.Host, db
 	return nil
 }
 
-func (m *Manager) GetStagesByRole(role models.HostRole, isbootstrap bool) []models.HostStage {
+func (m *Manager) GetStagesByRole(role models.HostRole, isbootstrap bool, isSingleNode bool) []models.HostStage {
+	// single node cluster is installed by bootstrap-in-place on its only host
+	if isSingleNode {
+		return BootstrapInPlaceStages[:]
+	}
+
 	if isbootstrap || role == models.HostRoleBootstrap {
 		return BootstrapStages[:]
 	}
@@ -511,6 +660,17 @@ func (m *Manager) selectRole(ctx context.Context, h *models.Host, db *gorm.DB) (
 		log              = logutil.FromContext(ctx, m.log)
 	)
 
+	var cluster common.Cluster
+	if err := db.Take(&cluster, "id = ?", h.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", h.ClusterID.String())
+		return autoSelectedRole, err
+	}
+
+	// hosts added to an existing cluster can only join it as workers
+	if common.IsDay2Cluster(&cluster) {
+		return autoSelectedRole, nil
+	}
+
 	// count already existing masters
 	mastersCount := 0
 	if err := db.Model(&models.Host{}).Where("cluster_id = ? and status != ? and role = ?",
@@ -519,7 +679,7 @@ func (m *Manager) selectRole(ctx context.Context, h *models.Host, db *gorm.DB) (
 		return autoSelectedRole, err
 	}
 
-	if mastersCount < common.MinMasterHostsNeededForInstallation {
+	if mastersCount < common.GetMasterHostsNeededForInstallation(&cluster) {
 		h.Role = models.HostRoleMaster
 		vc, err := newValidationContext(h, db)
 		if err != nil {
@@ -565,12 +725,12 @@ func (m *Manager) IsValidMasterCandidate(h *models.Host, db *gorm.DB, log logrus
 }
 
 func (m *Manager) canBeMaster(conditions map[validationID]bool) bool {
-	if conditions[HasCPUCoresForRole] && conditions[HasMemoryForRole] {
+	if conditions[HasCPUCoresForRole] && conditions[HasMemoryForRole] && conditions[SatisfiesValidationRules] {
 		return true
 	}
 	return false
 }
 
-func (m *Manager) GetHostRequirements(role models.HostRole) models.HostRequirementsRole {
-	return m.hwValidator.GetHostRequirements(role)
+func (m *Manager) GetHostRequirements(openshiftVersion string, role models.HostRole) models.HostRequirementsRole {
+	return m.hwValidator.GetHostRequirements(openshiftVersion, role)
 }
@@ -23,6 +23,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -52,7 +53,7 @@ var _ = Describe("update_role", func() {
 	BeforeEach(func() {
 		dummy := &leader.DummyElector{}
 		db = common.PrepareTestDB(dbName, &events.Event{})
-		state = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy)
+		state = NewManager(getTestLog(), db, nil, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, dummy)
 		id = strfmt.UUID(uuid.New().String())
 		clusterID = strfmt.UUID(uuid.New().String())
 	})
@@ -193,7 +194,7 @@ var _ = Describe("update_progress", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
-		state = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, dummy)
+		state = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), mockMetric, defaultConfig, dummy)
 		id := strfmt.UUID(uuid.New().String())
 		clusterId := strfmt.UUID(uuid.New().String())
 		host = getTestHost(id, clusterId, "")
@@ -268,6 +269,20 @@ var _ = Describe("update_progress", func() {
 				Expect(*hostFromDB.Status).Should(Equal(models.HostStatusInstalled))
 			})
 
+			It("rebooting day2 host", func() {
+				cluster := getTestCluster(host.ClusterID, "")
+				cluster.Kind = swag.String(models.ClusterKindAddHostsCluster)
+				Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+				progress.CurrentStage = models.HostStageRebooting
+				mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, models.EventSeverityInfo,
+					fmt.Sprintf("Host %s: updated status from \"installing\" to \"added-to-existing-cluster\" (Rebooting)", host.ID.String()),
+					gomock.Any())
+				Expect(state.UpdateInstallProgress(ctx, &host, &progress)).ShouldNot(HaveOccurred())
+				hostFromDB = getHost(*host.ID, host.ClusterID, db)
+
+				Expect(*hostFromDB.Status).Should(Equal(models.HostStatusAddedToExistingCluster))
+			})
+
 			AfterEach(func() {
 				Expect(*hostFromDB.StatusInfo).Should(Equal(string(progress.CurrentStage)))
 				Expect(hostFromDB.Progress.CurrentStage).Should(Equal(progress.CurrentStage))
@@ -418,7 +433,7 @@ var _ = Describe("monitor_disconnection", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		state = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy)
+		state = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, dummy)
 		clusterID := strfmt.UUID(uuid.New().String())
 		host = getTestHost(strfmt.UUID(uuid.New().String()), clusterID, models.HostStatusDiscovering)
 		cluster := getTestCluster(clusterID, "1.1.0.0/16")
@@ -702,6 +717,88 @@ var _ = Describe("reset host", func() {
 
 })
 
+var _ = Describe("retry installation", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		state         API
+		h             models.Host
+		eventsHandler events.Handler
+		dbName        = "retry_installation"
+	)
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		eventsHandler = events.New(db, logrus.New())
+		dummy := &leader.DummyElector{}
+		state = NewManager(getTestLog(), db, eventsHandler, nil, nil, nil, nil, defaultConfig, dummy)
+	})
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createHost := func(status string, stage models.HostStage) {
+		id := strfmt.UUID(uuid.New().String())
+		clusterId := strfmt.UUID(uuid.New().String())
+		h = getTestHost(id, clusterId, status)
+		h.Progress = &models.HostProgressInfo{CurrentStage: stage, ProgressInfo: "some info"}
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+	}
+
+	tests := []struct {
+		name        string
+		srcState    string
+		stage       models.HostStage
+		dstState    string
+		dstStage    models.HostStage
+		expectEvent bool
+	}{
+		{name: "failed host is installed again", srcState: models.HostStatusError, stage: models.HostStageWritingImageToDisk,
+			dstState: models.HostStatusInstalling, dstStage: "", expectEvent: true},
+		{name: "failed host that is rebooting keeps its progress", srcState: models.HostStatusError, stage: models.HostStageRebooting,
+			dstState: models.HostStatusInstallingInProgress, dstStage: models.HostStageRebooting, expectEvent: true},
+		{name: "failed host that is configuring keeps its progress", srcState: models.HostStatusError, stage: models.HostStageConfiguring,
+			dstState: models.HostStatusInstallingInProgress, dstStage: models.HostStageConfiguring, expectEvent: true},
+		{name: "failed host that joined keeps its progress", srcState: models.HostStatusError, stage: models.HostStageJoined,
+			dstState: models.HostStatusInstallingInProgress, dstStage: models.HostStageJoined, expectEvent: true},
+		{name: "failed host that is done keeps its progress", srcState: models.HostStatusError, stage: models.HostStageDone,
+			dstState: models.HostStatusInstalled, dstStage: models.HostStageDone, expectEvent: true},
+		{name: "installed host is untouched", srcState: models.HostStatusInstalled, stage: models.HostStageDone,
+			dstState: models.HostStatusInstalled, dstStage: models.HostStageDone},
+		{name: "installing host is untouched", srcState: models.HostStatusInstallingInProgress, stage: models.HostStageRebooting,
+			dstState: models.HostStatusInstallingInProgress, dstStage: models.HostStageRebooting},
+		{name: "disabled host is untouched", srcState: models.HostStatusDisabled, stage: "",
+			dstState: models.HostStatusDisabled, dstStage: ""},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			createHost(t.srcState, t.stage)
+			Expect(state.RetryInstallation(ctx, &h, db)).ShouldNot(HaveOccurred())
+			resultHost := getHost(*h.ID, h.ClusterID, db)
+			Expect(swag.StringValue(resultHost.Status)).Should(Equal(t.dstState))
+			Expect(resultHost.Progress.CurrentStage).Should(Equal(t.dstStage))
+			events, err := eventsHandler.GetEvents(h.ClusterID, h.ID)
+			Expect(err).ShouldNot(HaveOccurred())
+			if t.expectEvent {
+				Expect(len(events)).ShouldNot(Equal(0))
+				retryEvent := events[len(events)-1]
+				Expect(*retryEvent.Severity).Should(Equal(models.EventSeverityInfo))
+				Expect(*retryEvent.Message).Should(Equal(fmt.Sprintf("Retrying installation of host %s", hostutil.GetHostnameForMsg(&h))))
+			} else {
+				Expect(len(events)).Should(Equal(0))
+			}
+		})
+	}
+
+	It("retry installation of host that is not installing", func() {
+		createHost(models.HostStatusKnown, "")
+		reply := state.RetryInstallation(ctx, &h, db)
+		Expect(int(reply.StatusCode())).Should(Equal(http.StatusConflict))
+		Expect(swag.StringValue(getHost(*h.ID, h.ClusterID, db).Status)).Should(Equal(models.HostStatusKnown))
+	})
+})
+
 func getHost(hostId, clusterId strfmt.UUID, db *gorm.DB) *models.Host {
 	var host models.Host
 	Expect(db.First(&host, "id = ? and cluster_id = ?", hostId, clusterId).Error).ShouldNot(HaveOccurred())
@@ -801,6 +898,20 @@ func workerInventory() string {
 	return string(b)
 }
 
+// connectivityTo returns a connectivity report in which the host reached all the given hosts on the machine network
+func connectivityTo(remoteHostIDs ...strfmt.UUID) string {
+	report := models.ConnectivityReport{}
+	for _, id := range remoteHostIDs {
+		report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+			HostID:         id,
+			L2Connectivity: []*models.L2Connectivity{{RemoteIPAddress: "1.2.3.4", Successful: true}},
+		})
+	}
+	b, err := json.Marshal(&report)
+	Expect(err).To(Not(HaveOccurred()))
+	return string(b)
+}
+
 func masterInventory() string {
 	return masterInventoryWithHostname("master-hostname")
 }
@@ -843,7 +954,7 @@ var _ = Describe("UpdateInventory", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy)
+		hapi = NewManager(getTestLog(), db, nil, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, dummy)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -959,7 +1070,7 @@ var _ = Describe("Update hostname", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy)
+		hapi = NewManager(getTestLog(), db, nil, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, dummy)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1059,6 +1170,225 @@ var _ = Describe("Update hostname", func() {
 	})
 })
 
+var _ = Describe("Installation disk", func() {
+	var (
+		ctx               = context.Background()
+		hapi              API
+		db                *gorm.DB
+		hostId, clusterId strfmt.UUID
+		host              models.Host
+		validatorCfg      *hardware.ValidatorCfg
+		openshiftVersions models.OpenshiftVersions
+		dbName            = "installation_disk"
+		validDiskSize     = int64(128849018880)
+	)
+
+	inventoryWithDisks := func() string {
+		inventory := models.Inventory{
+			Disks: []*models.Disk{
+				{Name: "sda", DriveType: "HDD", SizeBytes: validDiskSize, Serial: "hdd-1"},
+				{Name: "sdb", DriveType: "SSD", SizeBytes: validDiskSize * 2, ByPath: "/dev/disk/by-path/pci-0000:00:1f.2-ata-2"},
+				{Name: "nvme0n1", DriveType: "SSD", SizeBytes: validDiskSize, ByPath: "/dev/disk/by-path/pci-0000:3b:00.0-nvme-1"},
+				{Name: "sdc", DriveType: "SSD", SizeBytes: 130, Serial: "small-1"},
+			},
+		}
+		b, err := json.Marshal(&inventory)
+		Expect(err).To(Not(HaveOccurred()))
+		return string(b)
+	}
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		validatorCfg = createValidatorCfg()
+		openshiftVersions = models.OpenshiftVersions{
+			"4.6": models.OpenshiftVersion{HostRequirements: &models.HostRequirements{
+				Master: &models.HostRequirementsRole{DiskSizeGb: 200},
+			}},
+		}
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		host = getTestHost(hostId, clusterId, models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		hapi = NewManager(getTestLog(), db, nil, hardware.NewValidator(getTestLog(), *validatorCfg, openshiftVersions), nil, validatorCfg, nil, defaultConfig, &leader.DummyElector{})
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("UpdateInventory", func() {
+		It("sets the disks id and selects the smallest SSD by default", func() {
+			Expect(hapi.UpdateInventory(ctx, &host, inventoryWithDisks())).ShouldNot(HaveOccurred())
+			h := getHost(hostId, clusterId, db)
+			Expect(h.InstallationDiskID).Should(Equal("/dev/disk/by-path/pci-0000:3b:00.0-nvme-1"))
+			var inventory models.Inventory
+			Expect(json.Unmarshal([]byte(h.Inventory), &inventory)).ShouldNot(HaveOccurred())
+			Expect(inventory.Disks[0].ID).Should(Equal("/dev/sda"))
+			Expect(inventory.Disks[1].ID).Should(Equal("/dev/disk/by-path/pci-0000:00:1f.2-ata-2"))
+			Expect(inventory.Disks[1].InstallationEligibility.Eligible).Should(BeTrue())
+			Expect(inventory.Disks[1].InstallationEligibility.NotEligibleReasons).Should(BeEmpty())
+			Expect(inventory.Disks[3].InstallationEligibility.Eligible).Should(BeFalse())
+			Expect(inventory.Disks[3].InstallationEligibility.NotEligibleReasons).Should(Equal([]string{"too small (0 GiB < 120 GiB)"}))
+		})
+
+		It("requires the disk size of the OpenShift version of the cluster for the role of the host", func() {
+			cluster := getTestCluster(clusterId, "1.2.3.0/24")
+			cluster.OpenshiftVersion = "4.6"
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			Expect(db.Model(&host).Update("role", models.HostRoleMaster).Error).ShouldNot(HaveOccurred())
+			host = *getHost(hostId, clusterId, db)
+			Expect(hapi.UpdateInventory(ctx, &host, inventoryWithDisks())).ShouldNot(HaveOccurred())
+			h := getHost(hostId, clusterId, db)
+			Expect(h.InstallationDiskID).Should(Equal("/dev/disk/by-path/pci-0000:00:1f.2-ata-2"))
+			var inventory models.Inventory
+			Expect(json.Unmarshal([]byte(h.Inventory), &inventory)).ShouldNot(HaveOccurred())
+			Expect(inventory.Disks[0].InstallationEligibility.NotEligibleReasons).Should(Equal([]string{"too small (120 GiB < 200 GiB)"}))
+		})
+
+		It("keeps the selected disk while it is valid", func() {
+			Expect(db.Model(&host).Update("installation_disk_id", "/dev/sda").Error).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, inventoryWithDisks())).ShouldNot(HaveOccurred())
+			Expect(getHost(hostId, clusterId, db).InstallationDiskID).Should(Equal("/dev/sda"))
+		})
+
+		It("keeps the selected disk when it is not valid anymore", func() {
+			Expect(db.Model(&host).Update("installation_disk_id", "/dev/sdc").Error).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, inventoryWithDisks())).ShouldNot(HaveOccurred())
+			Expect(getHost(hostId, clusterId, db).InstallationDiskID).Should(Equal("/dev/sdc"))
+		})
+
+		It("keeps the attributes of the inventory that the service doesn't know", func() {
+			inventory := `{"new_attribute":"x","disks":[{"name":"sda","drive_type":"HDD","size_bytes":128849018880,"new_disk_attribute":"y"}]}`
+			Expect(hapi.UpdateInventory(ctx, &host, inventory)).ShouldNot(HaveOccurred())
+			h := getHost(hostId, clusterId, db)
+			Expect(h.Inventory).Should(ContainSubstring(`"new_attribute":"x"`))
+			Expect(h.Inventory).Should(ContainSubstring(`"new_disk_attribute":"y"`))
+			Expect(h.Inventory).Should(ContainSubstring(`"id":"/dev/sda"`))
+			Expect(h.InstallationDiskID).Should(Equal("/dev/sda"))
+		})
+
+		Context("serial policy", func() {
+			BeforeEach(func() {
+				Expect(validatorCfg.InstallationDiskPolicy.Decode("serial:hdd-*")).ShouldNot(HaveOccurred())
+			})
+
+			It("selects the matching disk", func() {
+				Expect(hapi.UpdateInventory(ctx, &host, inventoryWithDisks())).ShouldNot(HaveOccurred())
+				Expect(getHost(hostId, clusterId, db).InstallationDiskID).Should(Equal("/dev/sda"))
+			})
+		})
+
+		Context("by-path policy", func() {
+			BeforeEach(func() {
+				Expect(validatorCfg.InstallationDiskPolicy.Decode("by-path:*-ata-*")).ShouldNot(HaveOccurred())
+			})
+
+			It("selects the matching disk", func() {
+				Expect(hapi.UpdateInventory(ctx, &host, inventoryWithDisks())).ShouldNot(HaveOccurred())
+				Expect(getHost(hostId, clusterId, db).InstallationDiskID).Should(Equal("/dev/disk/by-path/pci-0000:00:1f.2-ata-2"))
+			})
+
+			It("doesn't select a disk when none matches", func() {
+				Expect(validatorCfg.InstallationDiskPolicy.Decode("by-path:*-sas-*")).ShouldNot(HaveOccurred())
+				Expect(hapi.UpdateInventory(ctx, &host, inventoryWithDisks())).ShouldNot(HaveOccurred())
+				Expect(getHost(hostId, clusterId, db).InstallationDiskID).Should(BeEmpty())
+			})
+		})
+
+		It("sets the matching static network configuration", func() {
+			networkYaml := "interfaces:\n- name: eth0\n  type: ethernet\n"
+			cluster := getTestCluster(clusterId, "1.2.3.0/24")
+			cluster.ImageInfo = &models.ImageInfo{StaticNetworkConfig: fmt.Sprintf(
+				`[{"mac_address":"52:54:00:aa:bb:02","network_yaml":"other"},{"mac_address":"52:54:00:aa:bb:01","network_yaml":%q}]`,
+				networkYaml)}
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			inventory := `{"interfaces":[{"name":"eth0","mac_address":"52:54:00:AA:BB:01"}]}`
+			Expect(hapi.UpdateInventory(ctx, &host, inventory)).ShouldNot(HaveOccurred())
+			Expect(getHost(hostId, clusterId, db).StaticNetworkConfig).Should(Equal(networkYaml))
+		})
+	})
+
+	Context("disk validation", func() {
+		var (
+			v         validator
+			inventory models.Inventory
+			cluster   common.Cluster
+		)
+
+		BeforeEach(func() {
+			v = validator{log: getTestLog(), hwValidator: hardware.NewValidator(getTestLog(), *validatorCfg, openshiftVersions),
+				hwValidatorCfg: validatorCfg}
+			Expect(json.Unmarshal([]byte(inventoryWithDisks()), &inventory)).ShouldNot(HaveOccurred())
+			cluster = getTestCluster(clusterId, "1.2.3.0/24")
+			cluster.OpenshiftVersion = "4.5"
+		})
+
+		It("fails when the selected disk is not eligible", func() {
+			host.InstallationDiskID = "/dev/sdc"
+			c := &validationContext{host: &host, cluster: &cluster, inventory: &inventory}
+			Expect(v.hasMinValidDisks(c)).Should(Equal(ValidationFailure))
+			Expect(v.printHasMinValidDisks(c, ValidationFailure)).Should(Equal(
+				"Selected installation disk /dev/sdc is not eligible: too small (0 GiB < 120 GiB)"))
+		})
+
+		It("fails when the selected disk was removed", func() {
+			host.InstallationDiskID = "/dev/sdz"
+			c := &validationContext{host: &host, cluster: &cluster, inventory: &inventory}
+			Expect(v.hasMinValidDisks(c)).Should(Equal(ValidationFailure))
+			Expect(v.printHasMinValidDisks(c, ValidationFailure)).Should(Equal(
+				"Selected installation disk /dev/sdz is not eligible: the host has no such disk"))
+		})
+
+		It("succeeds with a disk that the policy selects", func() {
+			c := &validationContext{host: &host, cluster: &cluster, inventory: &inventory}
+			Expect(v.hasMinValidDisks(c)).Should(Equal(ValidationSuccess))
+		})
+
+		It("fails when no disk is as large as the OpenShift version requires", func() {
+			cluster.OpenshiftVersion = "4.6"
+			host.Role = models.HostRoleMaster
+			host.InstallationDiskID = "/dev/sda"
+			c := &validationContext{host: &host, cluster: &cluster, inventory: &inventory}
+			Expect(v.hasMinValidDisks(c)).Should(Equal(ValidationFailure))
+			Expect(v.printHasMinValidDisks(c, ValidationFailure)).Should(Equal(
+				"Selected installation disk /dev/sda is not eligible: too small (120 GiB < 200 GiB)"))
+		})
+	})
+
+	Context("UpdateInstallationDisk", func() {
+		BeforeEach(func() {
+			Expect(db.Model(&host).Update("inventory", inventoryWithDisks()).Error).ShouldNot(HaveOccurred())
+			host = *getHost(hostId, clusterId, db)
+		})
+
+		It("valid disk", func() {
+			Expect(hapi.UpdateInstallationDisk(ctx, &host, "/dev/disk/by-path/pci-0000:00:1f.2-ata-2", nil)).ShouldNot(HaveOccurred())
+			Expect(getHost(hostId, clusterId, db).InstallationDiskID).Should(Equal("/dev/disk/by-path/pci-0000:00:1f.2-ata-2"))
+		})
+
+		It("disk that is too small", func() {
+			err := hapi.UpdateInstallationDisk(ctx, &host, "/dev/sdc", nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusBadRequest)))
+			Expect(getHost(hostId, clusterId, db).InstallationDiskID).Should(BeEmpty())
+		})
+
+		It("unknown disk", func() {
+			Expect(hapi.UpdateInstallationDisk(ctx, &host, "/dev/sdz", nil)).Should(HaveOccurred())
+		})
+
+		It("installing host", func() {
+			Expect(db.Model(&host).Update("status", models.HostStatusInstalling).Error).ShouldNot(HaveOccurred())
+			host = *getHost(hostId, clusterId, db)
+			Expect(hapi.UpdateInstallationDisk(ctx, &host, "/dev/sda", nil)).Should(HaveOccurred())
+			Expect(getHost(hostId, clusterId, db).InstallationDiskID).Should(BeEmpty())
+		})
+	})
+})
+
 var _ = Describe("SetBootstrap", func() {
 	var (
 		ctx               = context.Background()
@@ -1076,7 +1406,7 @@ var _ = Describe("SetBootstrap", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy)
+		hapi = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, dummy)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 
@@ -1130,7 +1460,7 @@ var _ = Describe("PrepareForInstallation", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, dummy)
+		hapi = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, dummy)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1201,7 +1531,7 @@ var _ = Describe("AutoAssignRole", func() {
 			getTestLog(),
 			db,
 			nil,
-			nil,
+			createValidator(),
 			nil,
 			createValidatorCfg(),
 			nil,
@@ -1292,6 +1622,18 @@ var _ = Describe("AutoAssignRole", func() {
 		Expect(hapi.AutoAssignRole(ctx, &h, db)).ShouldNot(HaveOccurred())
 		Expect(getHost(*h.ID, clusterId, db).Role).Should(Equal(models.HostRoleWorker))
 	})
+
+	It("add-hosts cluster assigns only workers", func() {
+		day2ClusterId := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &day2ClusterId,
+			Kind: swag.String(models.ClusterKindAddHostsCluster)}}).Error).ShouldNot(HaveOccurred())
+		h := getTestHost(strfmt.UUID(uuid.New().String()), day2ClusterId, models.HostStatusKnown)
+		h.Inventory = masterInventory()
+		h.Role = models.HostRoleAutoAssign
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		Expect(hapi.AutoAssignRole(ctx, &h, db)).ShouldNot(HaveOccurred())
+		Expect(getHost(*h.ID, day2ClusterId, db).Role).Should(Equal(models.HostRoleWorker))
+	})
 })
 
 var _ = Describe("IsValidMasterCandidate", func() {
@@ -1309,7 +1651,7 @@ var _ = Describe("IsValidMasterCandidate", func() {
 			getTestLog(),
 			db,
 			nil,
-			nil,
+			createValidator(),
 			nil,
 			createValidatorCfg(),
 			nil,
@@ -1385,3 +1727,28 @@ var _ = Describe("IsValidMasterCandidate", func() {
 		}
 	})
 })
+
+var _ = Describe("GetStagesByRole", func() {
+	var hapi API
+
+	BeforeEach(func() {
+		dummy := &leader.DummyElector{}
+		hapi = NewManager(getTestLog(), nil, nil, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, dummy)
+	})
+
+	It("bootstrap", func() {
+		Expect(hapi.GetStagesByRole(models.HostRoleMaster, true, false)).Should(Equal(BootstrapStages[:]))
+	})
+
+	It("master", func() {
+		Expect(hapi.GetStagesByRole(models.HostRoleMaster, false, false)).Should(Equal(MasterStages[:]))
+	})
+
+	It("worker", func() {
+		Expect(hapi.GetStagesByRole(models.HostRoleWorker, false, false)).Should(Equal(WorkerStages[:]))
+	})
+
+	It("single node cluster", func() {
+		Expect(hapi.GetStagesByRole(models.HostRoleMaster, true, true)).Should(Equal(BootstrapInPlaceStages[:]))
+	})
+})
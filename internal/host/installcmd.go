@@ -10,6 +10,7 @@ import (
 
 	"github.com/openshift/assisted-service/internal/hostutil"
 
+	"github.com/go-openapi/swag"
 	"github.com/sirupsen/logrus"
 
 	"github.com/jinzhu/gorm"
@@ -53,7 +54,8 @@ func (i *installCmd) GetStep(ctx context.Context, host *models.Host) (*models.St
 	}
 
 	cmdArgsTmpl := "podman run -v /dev:/dev:rw -v /opt:/opt:rw -v /run/systemd/journal/socket:/run/systemd/journal/socket --privileged --pid=host --net=host " +
-		"-v /var/log:/var/log:rw --env PULL_SECRET_TOKEN --name assisted-installer {{.INSTALLER}} --role {{.ROLE}} --cluster-id {{.CLUSTER_ID}} " +
+		"-v /var/log:/var/log:rw {{if .DISCONNECTED}}-v /etc/containers:/etc/containers:ro -v /etc/pki/ca-trust:/etc/pki/ca-trust:ro {{end}}" +
+		"--env PULL_SECRET_TOKEN --name assisted-installer {{.INSTALLER}} --role {{.ROLE}} --cluster-id {{.CLUSTER_ID}} " +
 		"--boot-device {{.BOOT_DEVICE}} --host-id {{.HOST_ID}} --openshift-version {{.OPENSHIFT_VERSION}} " +
 		"--controller-image {{.CONTROLLER_IMAGE}} --url {{.BASE_URL}} --insecure={{.SKIP_CERT_VERIFICATION}} --agent-image {{.AGENT_IMAGE}}"
 	data := map[string]string{
@@ -69,6 +71,17 @@ func (i *installCmd) GetStep(ctx context.Context, host *models.Host) (*models.St
 		"AGENT_IMAGE":            i.instructionConfig.InventoryImage,
 	}
 
+	// The installer pulls the release images through the mirror registries and trusts their CA, both are configured
+	// on the host by the discovery ignition
+	if cluster.ImageContentSources != "" || cluster.AdditionalTrustBundle != "" {
+		data["DISCONNECTED"] = "true"
+	}
+
+	if common.IsSingleNodeCluster(&cluster) {
+		cmdArgsTmpl = cmdArgsTmpl + " --high-availability-mode {{.HIGH_AVAILABILITY_MODE}}"
+		data["HIGH_AVAILABILITY_MODE"] = swag.StringValue(cluster.HighAvailabilityMode)
+	}
+
 	hostname, _ := hostutil.GetCurrentHostName(host)
 	if hostname != "" {
 		cmdArgsTmpl = cmdArgsTmpl + " --host-name {{.HOST_NAME}}"
@@ -105,10 +118,11 @@ func (i *installCmd) GetStep(ctx context.Context, host *models.Host) (*models.St
 
 	cmdArgsTmpl = cmdArgsTmpl + " || " + logsCommand
 
-	bootdevice, err := getBootDevice(i.log, i.hwValidator, *host)
+	installationDisk, err := getInstallationDisk(i.log, i.hwValidator, *host, cluster.OpenshiftVersion)
 	if err != nil {
 		return nil, err
 	}
+	bootdevice := hardware.GetDiskID(installationDisk)
 	data["BOOT_DEVICE"] = bootdevice
 
 	t, err := template.New("cmd").Parse(cmdArgsTmpl)
@@ -123,21 +137,21 @@ func (i *installCmd) GetStep(ctx context.Context, host *models.Host) (*models.St
 	step.Args = []string{"-c", buf.String()}
 
 	if _, err := UpdateHost(i.log, i.db, host.ClusterID, *host.ID, *host.Status,
-		"installer_version", i.instructionConfig.InstallerImage, "installation_disk_path", bootdevice); err != nil {
+		"installer_version", i.instructionConfig.InstallerImage, "installation_disk_path", bootdevice,
+		"installation_disk_id", bootdevice); err != nil {
 		return nil, err
 	}
 
 	return step, nil
 }
 
-func getBootDevice(log logrus.FieldLogger, hwValidator hardware.Validator, host models.Host) (string, error) {
-	disks, err := hwValidator.GetHostValidDisks(&host)
-	if err != nil || len(disks) == 0 {
-		err := fmt.Errorf("Failed to get valid disks on host with id %s", host.ID)
-		log.Errorf("Failed to get valid disks on host with id %s", host.ID)
-		return "", err
+func getInstallationDisk(log logrus.FieldLogger, hwValidator hardware.Validator, host models.Host, openshiftVersion string) (*models.Disk, error) {
+	disk, err := hwValidator.GetHostInstallationDisk(&host, openshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get installation disk of host with id %s", host.ID)
+		return nil, fmt.Errorf("Failed to get installation disk of host with id %s", host.ID)
 	}
-	return GetDeviceFullName(disks[0].Name), nil
+	return disk, nil
 }
 
 func GetDeviceFullName(name string) string {
@@ -64,12 +64,7 @@ var _ = Describe("installcmd", func() {
 
 	Context("negative", func() {
 		It("get_step_one_master", func() {
-			mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(nil, errors.New("error")).Times(1)
-		})
-
-		It("get_step_one_master_no_disks", func() {
-			var emptydisks []*models.Disk
-			mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(emptydisks, nil).Times(1)
+			mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any(), gomock.Any()).Return(nil, errors.New("error")).Times(1)
 		})
 
 		AfterEach(func() {
@@ -82,7 +77,7 @@ var _ = Describe("installcmd", func() {
 	})
 
 	It("get_step_one_master_success", func() {
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).Times(1)
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any(), gomock.Any()).Return(disks[0], nil).Times(1)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(false, false, stepReply, stepErr, models.HostRoleMaster)
 		validateInstallCommand(stepReply, models.HostRoleMaster, string(clusterId), string(*host.ID), "")
@@ -90,13 +85,26 @@ var _ = Describe("installcmd", func() {
 		hostFromDb := getHost(*host.ID, clusterId, db)
 		Expect(hostFromDb.InstallerVersion).Should(Equal(DefaultInstructionConfig.InstallerImage))
 		Expect(hostFromDb.InstallationDiskPath).Should(Equal(GetDeviceFullName(disks[0].Name)))
+		Expect(hostFromDb.InstallationDiskID).Should(Equal(GetDeviceFullName(disks[0].Name)))
+	})
+
+	It("get_step_by_path_installation_disk", func() {
+		disk := &models.Disk{DriveType: "SSD", Name: "sdc", ByPath: "/dev/disk/by-path/pci-0000:00:1f.2-ata-3"}
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any(), gomock.Any()).Return(disk, nil).Times(1)
+		stepReply, stepErr = installCmd.GetStep(ctx, &host)
+		postvalidation(false, false, stepReply, stepErr, models.HostRoleMaster)
+		Expect(stepReply.Args[1]).Should(ContainSubstring("--boot-device /dev/disk/by-path/pci-0000:00:1f.2-ata-3"))
+
+		hostFromDb := getHost(*host.ID, clusterId, db)
+		Expect(hostFromDb.InstallationDiskPath).Should(Equal(disk.ByPath))
+		Expect(hostFromDb.InstallationDiskID).Should(Equal(disk.ByPath))
 	})
 
 	It("get_step_three_master_success", func() {
 
 		host2 := createHostInDb(db, clusterId, models.HostRoleMaster, false, "")
 		host3 := createHostInDb(db, clusterId, models.HostRoleMaster, true, "some_hostname")
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).Times(3)
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any(), gomock.Any()).Return(disks[0], nil).Times(3)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(false, false, stepReply, stepErr, models.HostRoleMaster)
 		validateInstallCommand(stepReply, models.HostRoleMaster, string(clusterId), string(*host.ID), "")
@@ -108,6 +116,28 @@ var _ = Describe("installcmd", func() {
 		validateInstallCommand(stepReply, models.HostRoleBootstrap, string(clusterId), string(*host3.ID), "some_hostname")
 	})
 
+	It("get_step_single_node_success", func() {
+		Expect(db.Model(&cluster).Update("high_availability_mode", models.ClusterHighAvailabilityModeNone).Error).
+			ShouldNot(HaveOccurred())
+		bootstrap := createHostInDb(db, clusterId, models.HostRoleMaster, true, "")
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any(), gomock.Any()).Return(disks[0], nil).Times(1)
+		stepReply, stepErr = installCmd.GetStep(ctx, &bootstrap)
+		postvalidation(false, false, stepReply, stepErr, models.HostRoleBootstrap)
+		Expect(stepReply.Args[1]).Should(ContainSubstring("--high-availability-mode None"))
+	})
+
+	It("get_step_disconnected_success", func() {
+		Expect(db.Model(&cluster).Updates(map[string]interface{}{
+			"image_content_sources":   "- mirrors:\n  - mirror.example.com:5000/ocp4\n  source: quay.io/openshift-release-dev/ocp-release\n",
+			"additional_trust_bundle": "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+		}).Error).ShouldNot(HaveOccurred())
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any(), gomock.Any()).Return(disks[0], nil).Times(1)
+		stepReply, stepErr = installCmd.GetStep(ctx, &host)
+		postvalidation(false, false, stepReply, stepErr, models.HostRoleMaster)
+		Expect(stepReply.Args[1]).Should(ContainSubstring(
+			"-v /var/log:/var/log:rw -v /etc/containers:/etc/containers:ro -v /etc/pki/ca-trust:/etc/pki/ca-trust:ro --env PULL_SECRET_TOKEN"))
+	})
+
 	AfterEach(func() {
 		// cleanup
 		common.DeleteTestDB(db, dbName)
@@ -132,10 +162,10 @@ var _ = Describe("installcmd arguments", func() {
 		db = common.PrepareTestDB(dbName)
 		cluster := createClusterInDb(db)
 		host = createHostInDb(db, *cluster.ID, models.HostRoleMaster, false, "")
-		disks := []*models.Disk{{Name: "Disk1"}}
+		disk := &models.Disk{Name: "Disk1"}
 		controller = gomock.NewController(GinkgoT())
 		validator = hardware.NewMockValidator(controller)
-		validator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).AnyTimes()
+		validator.EXPECT().GetHostInstallationDisk(gomock.Any(), gomock.Any()).Return(disk, nil).AnyTimes()
 	})
 
 	AfterSuite(func() {
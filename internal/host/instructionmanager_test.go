@@ -150,6 +150,32 @@ var _ = Describe("instructionmanager", func() {
 		})
 	})
 
+	Context("User managed networking", func() {
+		BeforeEach(func() {
+			cluster := common.Cluster{Cluster: models.Cluster{ID: &clusterId, Name: "test", BaseDNSDomain: "example.com",
+				UserManagedNetworking: swag.Bool(true), VipDhcpAllocation: swag.Bool(true), MachineNetworkCidr: "1.2.3.0/24"}}
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		})
+		Context("get_next_steps", func() {
+			It("known", func() {
+				checkStepsByState(models.HostStatusKnown, &host, db, mockEvents, instMng, hwValidator, cnValidator, ctx,
+					[]models.StepType{models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses, models.StepTypeDomainResolution})
+			})
+			It("insufficient", func() {
+				checkStepsByState(models.HostStatusInsufficient, &host, db, mockEvents, instMng, hwValidator, cnValidator, ctx,
+					[]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses, models.StepTypeDomainResolution})
+			})
+			It("pending-for-input", func() {
+				checkStepsByState(models.HostStatusPendingForInput, &host, db, mockEvents, instMng, hwValidator, cnValidator, ctx,
+					[]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses, models.StepTypeDomainResolution})
+			})
+			It("installing", func() {
+				checkStepsByState(models.HostStatusInstalling, &host, db, mockEvents, instMng, hwValidator, cnValidator, ctx,
+					[]models.StepType{models.StepTypeInstall})
+			})
+		})
+	})
+
 	AfterEach(func() {
 		// cleanup
 		common.DeleteTestDB(db, dbName)
@@ -163,7 +189,7 @@ var _ = Describe("instructionmanager", func() {
 func checkStepsByState(state string, host *models.Host, db *gorm.DB, mockEvents *events.MockHandler, instMng *InstructionManager, mockValidator *hardware.MockValidator, mockConnecitvity *connectivity.MockValidator, ctx context.Context,
 	expectedStepTypes []models.StepType) {
 	mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, hostutil.GetEventSeverityFromHostStatus(state), gomock.Any(), gomock.Any())
-	updateReply, updateErr := updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, *host.Status, state, "")
+	updateReply, updateErr := updateHostStatus(ctx, getTestLog(), db, mockEvents, host.ClusterID, *host.ID, TransitionTypeRefresh, *host.Status, state, "")
 	ExpectWithOffset(1, updateErr).ShouldNot(HaveOccurred())
 	ExpectWithOffset(1, updateReply).ShouldNot(BeNil())
 	h := getHost(*host.ID, host.ClusterID, db)
@@ -174,7 +200,7 @@ func checkStepsByState(state string, host *models.Host, db *gorm.DB, mockEvents
 		{DriveType: "disk", Name: "sda", SizeBytes: validDiskSize},
 		{DriveType: "disk", Name: "sdh", SizeBytes: validDiskSize},
 	}
-	mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).AnyTimes()
+	mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any(), gomock.Any()).Return(disks[0], nil).AnyTimes()
 	if funk.Contains(expectedStepTypes, models.StepTypeConnectivityCheck) {
 		mockConnecitvity.EXPECT().GetHostValidInterfaces(gomock.Any()).Return([]*models.Interface{
 			{
@@ -0,0 +1,68 @@
+package host
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+)
+
+// majorityGroups keeps the majority group of every cluster together with a hash of the connectivity reports it was
+// found from, so the reports of a cluster are parsed once and not on the refresh of each of its hosts
+type majorityGroups struct {
+	mutex  sync.Mutex
+	groups map[strfmt.UUID]majorityGroup
+}
+
+type majorityGroup struct {
+	reportsHash string
+	hostIDs     []strfmt.UUID
+}
+
+func newMajorityGroups() *majorityGroups {
+	return &majorityGroups{groups: make(map[strfmt.UUID]majorityGroup)}
+}
+
+// reset drops the groups of all the clusters, it is called once per refresh of all the hosts so the groups of
+// deregistered clusters are not kept
+func (m *majorityGroups) reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.groups = make(map[strfmt.UUID]majorityGroup)
+}
+
+// get returns the majority group of the cluster hosts, it is found again only when one of the connectivity reports or
+// the machine network changed
+func (m *majorityGroups) get(clusterID strfmt.UUID, hosts []*models.Host, machineNetworkCidr string) ([]strfmt.UUID, error) {
+	reportsHash := hashConnectivityReports(hosts, machineNetworkCidr)
+	m.mutex.Lock()
+	group, ok := m.groups[clusterID]
+	m.mutex.Unlock()
+	if ok && group.reportsHash == reportsHash {
+		return group.hostIDs, nil
+	}
+
+	hostIDs, err := network.GetMajorityGroup(hosts, machineNetworkCidr)
+	if err != nil {
+		return nil, err
+	}
+	m.mutex.Lock()
+	m.groups[clusterID] = majorityGroup{reportsHash: reportsHash, hostIDs: hostIDs}
+	m.mutex.Unlock()
+	return hostIDs, nil
+}
+
+func hashConnectivityReports(hosts []*models.Host, machineNetworkCidr string) string {
+	sorted := append([]*models.Host{}, hosts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID.String() < sorted[j].ID.String() })
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n", machineNetworkCidr)
+	for _, h := range sorted {
+		fmt.Fprintf(hash, "%s\n%d\n%s\n", h.ID.String(), len(h.Connectivity), h.Connectivity)
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
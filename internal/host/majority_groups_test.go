@@ -0,0 +1,65 @@
+package host
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("majorityGroups", func() {
+	const machineNetworkCidr = "1.2.3.0/24"
+	var (
+		clusterID strfmt.UUID
+		hosts     []*models.Host
+		groups    *majorityGroups
+	)
+
+	BeforeEach(func() {
+		clusterID = strfmt.UUID(uuid.New().String())
+		groups = newMajorityGroups()
+		ids := []strfmt.UUID{strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String())}
+		hosts = nil
+		for i := range ids {
+			report := models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+				HostID:         ids[1-i],
+				L2Connectivity: []*models.L2Connectivity{{RemoteIPAddress: "1.2.3.4", Successful: true}},
+			}}}
+			b, err := json.Marshal(&report)
+			Expect(err).ShouldNot(HaveOccurred())
+			hosts = append(hosts, &models.Host{ID: &ids[i], ClusterID: clusterID, Connectivity: string(b)})
+		}
+	})
+
+	It("reuses the group of unchanged reports", func() {
+		group, err := groups.get(clusterID, hosts, machineNetworkCidr)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(group).Should(HaveLen(2))
+
+		cached := []strfmt.UUID{*hosts[0].ID}
+		groups.groups[clusterID] = majorityGroup{reportsHash: groups.groups[clusterID].reportsHash, hostIDs: cached}
+		group, err = groups.get(clusterID, []*models.Host{hosts[1], hosts[0]}, machineNetworkCidr)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(group).Should(Equal(cached))
+	})
+
+	It("finds the group again when a report changes", func() {
+		_, err := groups.get(clusterID, hosts, machineNetworkCidr)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		hosts[1].Connectivity = `{"remote_hosts": []}`
+		group, err := groups.get(clusterID, hosts, machineNetworkCidr)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(group).Should(HaveLen(1))
+	})
+
+	It("drops the groups on reset", func() {
+		_, err := groups.get(clusterID, hosts, machineNetworkCidr)
+		Expect(err).ShouldNot(HaveOccurred())
+		groups.reset()
+		Expect(groups.groups).Should(BeEmpty())
+	})
+})
@@ -176,6 +176,20 @@ func (mr *MockAPIMockRecorder) UpdateHostname(ctx, h, hostname, db interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostname", reflect.TypeOf((*MockAPI)(nil).UpdateHostname), ctx, h, hostname, db)
 }
 
+// UpdateInstallationDisk mocks base method
+func (m *MockAPI) UpdateInstallationDisk(ctx context.Context, h *models.Host, installationDiskID string, db *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstallationDisk", ctx, h, installationDiskID, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstallationDisk indicates an expected call of UpdateInstallationDisk
+func (mr *MockAPIMockRecorder) UpdateInstallationDisk(ctx, h, installationDiskID, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstallationDisk", reflect.TypeOf((*MockAPI)(nil).UpdateInstallationDisk), ctx, h, installationDiskID, db)
+}
+
 // CancelInstallation mocks base method
 func (m *MockAPI) CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse {
 	m.ctrl.T.Helper()
@@ -232,6 +246,20 @@ func (mr *MockAPIMockRecorder) ResetPendingUserAction(ctx, h, db interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPendingUserAction", reflect.TypeOf((*MockAPI)(nil).ResetPendingUserAction), ctx, h, db)
 }
 
+// RetryInstallation mocks base method
+func (m *MockAPI) RetryInstallation(ctx context.Context, h *models.Host, db *gorm.DB) *common.ApiErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryInstallation", ctx, h, db)
+	ret0, _ := ret[0].(*common.ApiErrorResponse)
+	return ret0
+}
+
+// RetryInstallation indicates an expected call of RetryInstallation
+func (mr *MockAPIMockRecorder) RetryInstallation(ctx, h, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryInstallation", reflect.TypeOf((*MockAPI)(nil).RetryInstallation), ctx, h, db)
+}
+
 // DisableHost mocks base method
 func (m *MockAPI) DisableHost(ctx context.Context, h *models.Host, db *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -289,17 +317,17 @@ func (mr *MockAPIMockRecorder) UpdateInventory(ctx, h, inventory interface{}) *g
 }
 
 // GetStagesByRole mocks base method
-func (m *MockAPI) GetStagesByRole(role models.HostRole, isbootstrap bool) []models.HostStage {
+func (m *MockAPI) GetStagesByRole(role models.HostRole, isbootstrap, isSingleNode bool) []models.HostStage {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStagesByRole", role, isbootstrap)
+	ret := m.ctrl.Call(m, "GetStagesByRole", role, isbootstrap, isSingleNode)
 	ret0, _ := ret[0].([]models.HostStage)
 	return ret0
 }
 
 // GetStagesByRole indicates an expected call of GetStagesByRole
-func (mr *MockAPIMockRecorder) GetStagesByRole(role, isbootstrap interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetStagesByRole(role, isbootstrap, isSingleNode interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStagesByRole", reflect.TypeOf((*MockAPI)(nil).GetStagesByRole), role, isbootstrap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStagesByRole", reflect.TypeOf((*MockAPI)(nil).GetStagesByRole), role, isbootstrap, isSingleNode)
 }
 
 // IsInstallable mocks base method
@@ -374,15 +402,15 @@ func (mr *MockAPIMockRecorder) SetUploadLogsAt(ctx, h, db interface{}) *gomock.C
 }
 
 // GetHostRequirements mocks base method
-func (m *MockAPI) GetHostRequirements(role models.HostRole) models.HostRequirementsRole {
+func (m *MockAPI) GetHostRequirements(openshiftVersion string, role models.HostRole) models.HostRequirementsRole {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHostRequirements", role)
+	ret := m.ctrl.Call(m, "GetHostRequirements", openshiftVersion, role)
 	ret0, _ := ret[0].(models.HostRequirementsRole)
 	return ret0
 }
 
 // GetHostRequirements indicates an expected call of GetHostRequirements
-func (mr *MockAPIMockRecorder) GetHostRequirements(role interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetHostRequirements(openshiftVersion, role interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostRequirements", reflect.TypeOf((*MockAPI)(nil).GetHostRequirements), role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostRequirements", reflect.TypeOf((*MockAPI)(nil).GetHostRequirements), openshiftVersion, role)
 }
@@ -37,6 +37,7 @@ func (m *Manager) HostMonitoring() {
 		log.WithError(err).Errorf("failed to get hosts")
 		return
 	}
+	m.majorityGroups.reset()
 	for _, host := range hosts {
 		if !m.leaderElector.IsLeader() {
 			m.log.Debugf("Not a leader, exiting HostMonitoring")
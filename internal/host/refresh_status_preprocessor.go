@@ -1,31 +1,29 @@
 package host
 
 import (
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/sirupsen/logrus"
 )
 
-type validationResult struct {
-	ID      validationID     `json:"id"`
-	Status  validationStatus `json:"status"`
-	Message string           `json:"message"`
-}
-
 type refreshPreprocessor struct {
 	log         logrus.FieldLogger
 	validations []validation
+	rules       ValidationRules
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hwValidator hardware.Validator, hwValidatorCfg *hardware.ValidatorCfg,
+	rules ValidationRules, majorityGroups *majorityGroups) *refreshPreprocessor {
 	return &refreshPreprocessor{
 		log:         log,
-		validations: newValidations(log, hwValidatorCfg),
+		validations: newValidations(log, hwValidator, hwValidatorCfg, majorityGroups),
+		rules:       rules,
 	}
 }
 
-func (r *refreshPreprocessor) preprocess(c *validationContext) (map[validationID]bool, map[string][]validationResult, error) {
+func (r *refreshPreprocessor) preprocess(c *validationContext) (map[validationID]bool, map[string][]common.ValidationResult, error) {
 	stateMachineInput := make(map[validationID]bool)
-	validationsOutput := make(map[string][]validationResult)
+	validationsOutput := make(map[string][]common.ValidationResult)
 	for _, v := range r.validations {
 		st := v.condition(c)
 		stateMachineInput[v.id] = st == ValidationSuccess
@@ -35,19 +33,39 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[validationID
 			logrus.WithError(err).Warn("id.category()")
 			return nil, nil, err
 		}
-		validationsOutput[category] = append(validationsOutput[category], validationResult{
-			ID:      v.id,
-			Status:  st,
+		validationsOutput[category] = append(validationsOutput[category], common.ValidationResult{
+			ID:      v.id.String(),
+			Status:  string(st),
+			Message: message,
+		})
+	}
+
+	rulesSatisfied := true
+	for _, rule := range r.rules {
+		if !rule.appliesTo(c.host) {
+			continue
+		}
+		st, message := rule.evaluate(c.inventory)
+		if st != ValidationSuccess && st != ValidationWarning {
+			rulesSatisfied = false
+		}
+		validationsOutput[validationRulesCategory] = append(validationsOutput[validationRulesCategory], common.ValidationResult{
+			ID:      rule.ID,
+			Status:  string(st),
 			Message: message,
 		})
 	}
+	stateMachineInput[SatisfiesValidationRules] = rulesSatisfied
 	return stateMachineInput, validationsOutput, nil
 }
 
-func newValidations(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg) []validation {
+func newValidations(log logrus.FieldLogger, hwValidator hardware.Validator, hwValidatorCfg *hardware.ValidatorCfg,
+	majorityGroups *majorityGroups) []validation {
 	v := validator{
 		log:            log,
+		hwValidator:    hwValidator,
 		hwValidatorCfg: hwValidatorCfg,
+		majorityGroups: majorityGroups,
 	}
 	ret := []validation{
 		{
@@ -105,6 +123,11 @@ func newValidations(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCf
 			condition: v.isHostnameValid,
 			formatter: v.printHostnameValid,
 		},
+		{
+			id:        BelongsToMajorityGroup,
+			condition: v.belongsToMajorityGroup,
+			formatter: v.printBelongsToMajorityGroup,
+		},
 	}
 	return ret
 }
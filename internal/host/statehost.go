@@ -7,8 +7,9 @@ import (
 )
 
 type stateHost struct {
-	srcState string
-	host     *models.Host
+	srcState       string
+	transitionType string
+	host           *models.Host
 }
 
 func newStateHost(h *models.Host) *stateHost {
@@ -26,3 +27,17 @@ func (sh *stateHost) SetState(state stateswitch.State) error {
 	sh.host.Status = swag.String(string(state))
 	return nil
 }
+
+// stateMachine keeps the type of the running transition on the host state, so the transition history
+// records which transition changed the host status
+type stateMachine struct {
+	stateswitch.StateMachine
+}
+
+func (sm *stateMachine) Run(transitionType stateswitch.TransitionType, stateSwitch stateswitch.StateSwitch,
+	args stateswitch.TransitionArgs) error {
+	if sHost, ok := stateSwitch.(*stateHost); ok {
+		sHost.transitionType = string(transitionType)
+	}
+	return sm.StateMachine.Run(transitionType, stateSwitch, args)
+}
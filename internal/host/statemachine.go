@@ -10,6 +10,7 @@ const (
 	TransitionTypeHostInstallationFailed     = "HostInstallationFailed"
 	TransitionTypeCancelInstallation         = "CancelInstallation"
 	TransitionTypeResetHost                  = "ResetHost"
+	TransitionTypeRetryInstallation          = "RetryInstallation"
 	TransitionTypeInstallHost                = "InstallHost"
 	TransitionTypeDisableHost                = "DisableHost"
 	TransitionTypeEnableHost                 = "EnableHost"
@@ -141,6 +142,59 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		PostTransition:   th.PostResetHost,
 	})
 
+	// Hosts that already wrote the image to their disk and rebooted run the installed OS, they don't get the
+	// install command anymore, so they are never installed again
+	postRebootStages := []models.HostStage{models.HostStageRebooting, models.HostStageWaitingForIgnition,
+		models.HostStageConfiguring, models.HostStageJoined}
+
+	// Retry installation - re-install a host that failed before it rebooted into the installed OS
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRetryInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusError),
+		},
+		Condition:        stateswitch.Not(th.IsInStage(append(postRebootStages, models.HostStageDone)...)),
+		DestinationState: stateswitch.State(models.HostStatusInstalling),
+		PostTransition:   th.PostRetryInstallation,
+	})
+
+	// Retry installation - host that already rebooted into the installed OS keeps its progress
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRetryInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusError),
+		},
+		Condition:        th.IsInStage(postRebootStages...),
+		DestinationState: stateswitch.State(models.HostStatusInstallingInProgress),
+		PostTransition:   th.PostRetryInstallationKeepProgress,
+	})
+
+	// Retry installation - host that already completed its installation keeps its progress
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRetryInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusError),
+		},
+		Condition:        th.IsInStage(models.HostStageDone),
+		DestinationState: stateswitch.State(models.HostStatusInstalled),
+		PostTransition:   th.PostRetryInstallationKeepProgress,
+	})
+
+	// Retry installation - hosts that did not fail are left untouched
+	for _, state := range []stateswitch.State{
+		stateswitch.State(models.HostStatusDisabled),
+		stateswitch.State(models.HostStatusInstalling),
+		stateswitch.State(models.HostStatusInstallingInProgress),
+		stateswitch.State(models.HostStatusInstallingPendingUserAction),
+		stateswitch.State(models.HostStatusInstalled),
+	} {
+		sm.AddTransition(stateswitch.TransitionRule{
+			TransitionType:   TransitionTypeRetryInstallation,
+			SourceStates:     []stateswitch.State{state},
+			DestinationState: state,
+		})
+	}
+
 	// Install host
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeInstallHost,
@@ -151,6 +205,17 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		PostTransition:   th.PostInstallHost,
 	})
 
+	// Install day2 host
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeInstallHost,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusKnown),
+		},
+		Condition:        th.IsDay2Host,
+		DestinationState: stateswitch.State(models.HostStatusInstalling),
+		PostTransition:   th.PostInstallHost,
+	})
+
 	// Install disabled host will not do anything
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeInstallHost,
@@ -283,7 +348,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 	var requiredInputFieldsExist = stateswitch.And(If(IsMachineCidrDefined))
 
 	var isSufficientForInstall = stateswitch.And(If(HasMemoryForRole), If(HasCPUCoresForRole), If(BelongsToMachineCidr),
-		If(IsHostnameUnique), If(IsHostnameValid))
+		If(IsHostnameUnique), If(IsHostnameValid), If(BelongsToMajorityGroup), If(SatisfiesValidationRules))
 
 	// In order for this transition to be fired at least one of the validations in minRequiredHardwareValidations must fail.
 	// This transition handles the case that a host does not pass minimum hardware requirements for any of the roles
@@ -382,6 +447,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		stateswitch.State(models.HostStatusResetting),
 		stateswitch.State(models.HostStatusInstallingPendingUserAction),
 		stateswitch.State(models.HostStatusResettingPendingUserAction),
+		stateswitch.State(models.HostStatusAddedToExistingCluster),
 	} {
 		sm.AddTransition(stateswitch.TransitionRule{
 			TransitionType:   TransitionTypeRefresh,
@@ -390,5 +456,5 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		})
 	}
 
-	return sm
+	return &stateMachine{StateMachine: sm}
 }
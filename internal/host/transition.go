@@ -12,6 +12,7 @@ import (
 	"encoding/json"
 
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/hardware"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -58,7 +59,7 @@ func (th *transitionHandler) PostRegisterHost(sw stateswitch.StateSwitch, args s
 	if err := th.db.First(&host, "id = ? and cluster_id = ?", sHost.host.ID, sHost.host.ClusterID).Error; err == nil {
 		// The reason for the double register is unknown (HW might have changed) -
 		// so we reset the hw info and progress, and start the discovery process again.
-		if host, err := updateHostProgress(params.ctx, log, th.db, th.eventsHandler, sHost.host.ClusterID, *sHost.host.ID, sHost.srcState,
+		if host, err := updateHostProgress(params.ctx, log, th.db, th.eventsHandler, sHost.host.ClusterID, *sHost.host.ID, sHost.transitionType, sHost.srcState,
 			swag.StringValue(sHost.host.Status), statusInfoDiscovering, sHost.host.Progress.CurrentStage, "", "",
 			"inventory", "", "discovery_agent_version", params.discoveryAgentVersion, "bootstrap", false); err != nil {
 			return err
@@ -123,7 +124,7 @@ func (th *transitionHandler) PostRegisterDuringReboot(sw stateswitch.StateSwitch
 	}
 
 	for _, disk := range inventory.Disks {
-		if GetDeviceFullName(disk.Name) == sHost.host.InstallationDiskPath {
+		if hardware.GetDiskID(disk) == sHost.host.InstallationDiskPath || GetDeviceFullName(disk.Name) == sHost.host.InstallationDiskPath {
 			installationDisk = disk
 			break
 		}
@@ -218,6 +219,56 @@ func (th *transitionHandler) PostResetHost(sw stateswitch.StateSwitch, args stat
 		params.reason, "StatusUpdatedAt", strfmt.DateTime(time.Now()))
 }
 
+////////////////////////////////////////////////////////////////////////////
+// Retry installation
+////////////////////////////////////////////////////////////////////////////
+
+type TransitionArgsRetryInstallation struct {
+	ctx context.Context
+	db  *gorm.DB
+}
+
+// Failed host is installed again from scratch, so its installation progress is cleared
+func (th *transitionHandler) PostRetryInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return errors.New("PostRetryInstallation incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsRetryInstallation)
+	if !ok {
+		return errors.New("PostRetryInstallation invalid argument")
+	}
+
+	return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost,
+		statusInfoInstalling, "progress_current_stage", "", "progress_progress_info", "",
+		"progress_stage_started_at", strfmt.DateTime(time.Now()), "progress_stage_updated_at", strfmt.DateTime(time.Now()))
+}
+
+func (th *transitionHandler) PostRetryInstallationKeepProgress(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return errors.New("PostRetryInstallationKeepProgress incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsRetryInstallation)
+	if !ok {
+		return errors.New("PostRetryInstallationKeepProgress invalid argument")
+	}
+
+	return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost,
+		string(sHost.host.Progress.CurrentStage))
+}
+
+// Return a condition that checks if the host installation progress reached one of the given stages
+func (th *transitionHandler) IsInStage(stages ...models.HostStage) stateswitch.Condition {
+	return func(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+		sHost, ok := sw.(*stateHost)
+		if !ok {
+			return false, errors.New("IsInStage incompatible type of StateSwitch")
+		}
+		return sHost.host.Progress != nil && funk.Contains(stages, sHost.host.Progress.CurrentStage), nil
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////
 // Install host
 ////////////////////////////////////////////////////////////////////////////
@@ -240,6 +291,22 @@ func (th *transitionHandler) PostInstallHost(sw stateswitch.StateSwitch, args st
 		statusInfoInstalling)
 }
 
+func (th *transitionHandler) IsDay2Host(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return false, errors.New("IsDay2Host incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsInstallHost)
+	if !ok {
+		return false, errors.New("IsDay2Host invalid argument")
+	}
+	var cluster common.Cluster
+	if err := params.db.Select("kind").Take(&cluster, "id = ?", sHost.host.ClusterID.String()).Error; err != nil {
+		return false, err
+	}
+	return common.IsDay2Cluster(&cluster), nil
+}
+
 ////////////////////////////////////////////////////////////////////////////
 // Disable host
 ////////////////////////////////////////////////////////////////////////////
@@ -342,7 +409,7 @@ func (th *transitionHandler) PostPrepareForInstallation(sw stateswitch.StateSwit
 func (th *transitionHandler) updateTransitionHost(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, state *stateHost,
 	statusInfo string, extra ...interface{}) error {
 
-	if host, err := updateHostStatus(ctx, log, db, th.eventsHandler, state.host.ClusterID, *state.host.ID, state.srcState,
+	if host, err := updateHostStatus(ctx, log, db, th.eventsHandler, state.host.ClusterID, *state.host.ID, state.transitionType, state.srcState,
 		swag.StringValue(state.host.Status), statusInfo, extra...); err != nil {
 		return err
 	} else {
@@ -359,7 +426,7 @@ type TransitionArgsRefreshHost struct {
 	ctx               context.Context
 	eventHandler      events.Handler
 	conditions        map[validationID]bool
-	validationResults map[string][]validationResult
+	validationResults map[string][]common.ValidationResult
 	db                *gorm.DB
 }
 
@@ -447,8 +514,11 @@ func (th *transitionHandler) PostRefreshHost(reason string) stateswitch.PostTran
 		reason = strings.Replace(reason, "$MAX_TIME", InstallationProgressTimeout[sHost.host.Progress.CurrentStage].String(), 1)
 
 		_, err = updateHostStatus(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, th.eventsHandler, sHost.host.ClusterID, *sHost.host.ID,
-			sHost.srcState, swag.StringValue(sHost.host.Status), reason, "validations_info", string(b))
-		return err
+			sHost.transitionType, sHost.srcState, swag.StringValue(sHost.host.Status), reason, "validations_info", string(b))
+		if err != nil {
+			return err
+		}
+		return common.UpdateValidations(params.db, sHost.host.ClusterID, sHost.host.ID, common.ToValidationModels(params.validationResults))
 	}
 	return ret
 }
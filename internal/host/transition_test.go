@@ -36,6 +36,10 @@ func createValidatorCfg() *hardware.ValidatorCfg {
 	}
 }
 
+func createValidator() hardware.Validator {
+	return hardware.NewValidator(getTestLog(), *createValidatorCfg(), nil)
+}
+
 var _ = Describe("RegisterHost", func() {
 	var (
 		ctx               = context.Background()
@@ -51,7 +55,7 @@ var _ = Describe("RegisterHost", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -360,7 +364,7 @@ var _ = Describe("HostInstallationFailed", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), mockMetric, defaultConfig, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 		host = getTestHost(hostId, clusterId, "")
@@ -400,7 +404,7 @@ var _ = Describe("Cancel host installation", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEventsHandler = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEventsHandler, nil, nil, createValidatorCfg(), nil, defaultConfig, nil)
+		hapi = NewManager(getTestLog(), db, mockEventsHandler, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, nil)
 	})
 
 	tests := []struct {
@@ -473,7 +477,7 @@ var _ = Describe("Reset host", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEventsHandler = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEventsHandler, nil, nil, createValidatorCfg(), nil, defaultConfig, nil)
+		hapi = NewManager(getTestLog(), db, mockEventsHandler, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, nil)
 	})
 
 	tests := []struct {
@@ -546,7 +550,7 @@ var _ = Describe("Install", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -644,6 +648,35 @@ var _ = Describe("Install", func() {
 		}
 	})
 
+	Context("install day2 host", func() {
+		BeforeEach(func() {
+			host = getTestHost(hostId, clusterId, models.HostStatusKnown)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("known host of add-hosts cluster", func() {
+			cluster := getTestCluster(clusterId, "")
+			cluster.Kind = swag.String(models.ClusterKindAddHostsCluster)
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, &hostId, models.EventSeverityInfo,
+				fmt.Sprintf("Host %s: updated status from \"known\" to \"installing\" (Installation is in progress)", host.ID.String()),
+				gomock.Any())
+			Expect(hapi.Install(ctx, &host, nil)).ShouldNot(HaveOccurred())
+			h := getHost(hostId, clusterId, db)
+			Expect(*h.Status).Should(Equal(models.HostStatusInstalling))
+			Expect(*h.StatusInfo).Should(Equal(statusInfoInstalling))
+		})
+
+		It("known host of regular cluster", func() {
+			cluster := getTestCluster(clusterId, "1.2.3.0/24")
+			cluster.Kind = swag.String(models.ClusterKindCluster)
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			Expect(hapi.Install(ctx, &host, nil)).Should(HaveOccurred())
+			h := getHost(hostId, clusterId, db)
+			Expect(*h.Status).Should(Equal(models.HostStatusKnown))
+		})
+	})
+
 	Context("install with transaction", func() {
 		BeforeEach(func() {
 			host = getTestHost(hostId, clusterId, models.HostStatusPreparingForInstallation)
@@ -699,7 +732,7 @@ var _ = Describe("Disable", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -830,7 +863,7 @@ var _ = Describe("Enable", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -967,7 +1000,7 @@ type validationsChecker struct {
 }
 
 func (j *validationsChecker) check(validationsStr string) {
-	validationMap := make(map[string][]validationResult)
+	validationMap := make(map[string][]common.ValidationResult)
 	Expect(json.Unmarshal([]byte(validationsStr), &validationMap)).ToNot(HaveOccurred())
 next:
 	for id, checkedResult := range j.expected {
@@ -976,8 +1009,8 @@ next:
 		results, ok := validationMap[category]
 		Expect(ok).To(BeTrue())
 		for _, r := range results {
-			if r.ID == id {
-				Expect(r.Status).To(Equal(checkedResult.status), "id = %s", id.String())
+			if r.ID == id.String() {
+				Expect(r.Status).To(Equal(string(checkedResult.status)), "id = %s", id.String())
 				Expect(r.Message).To(MatchRegexp(checkedResult.messagePattern))
 				continue next
 			}
@@ -1013,7 +1046,7 @@ var _ = Describe("Refresh Host", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, defaultConfig, nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, defaultConfig, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1243,7 +1276,7 @@ var _ = Describe("Refresh Host", func() {
 					HasInventory:         {status: ValidationSuccess, messagePattern: "Valid inventory exists for the host"},
 					HasMinCPUCores:       {status: ValidationSuccess, messagePattern: "Sufficient CPU cores"},
 					HasMinMemory:         {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM, found only 0 GiB"},
-					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GB"},
+					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GiB, no disk is eligible for installation: /dev/: too small \\(0 GiB < 120 GiB\\)"},
 					IsMachineCidrDefined: {status: ValidationFailure, messagePattern: "Machine network CIDR is undefined"},
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role auto-assign"},
 					HasMemoryForRole:     {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM role auto-assign, found only 0"},
@@ -1264,7 +1297,7 @@ var _ = Describe("Refresh Host", func() {
 					HasInventory:         {status: ValidationSuccess, messagePattern: "Valid inventory exists for the host"},
 					HasMinCPUCores:       {status: ValidationSuccess, messagePattern: "Sufficient CPU cores"},
 					HasMinMemory:         {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM, found only 0 GiB"},
-					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GB"},
+					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GiB"},
 					IsMachineCidrDefined: {status: ValidationFailure, messagePattern: "Machine network CIDR is undefined"},
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role auto-assign"},
 					HasMemoryForRole:     {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM role auto-assign, found only 0"},
@@ -1286,7 +1319,7 @@ var _ = Describe("Refresh Host", func() {
 					HasInventory:         {status: ValidationSuccess, messagePattern: "Valid inventory exists for the host"},
 					HasMinCPUCores:       {status: ValidationSuccess, messagePattern: "Sufficient CPU cores"},
 					HasMinMemory:         {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM, found only 0 GiB"},
-					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GB"},
+					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GiB"},
 					IsMachineCidrDefined: {status: ValidationFailure, messagePattern: "Machine network CIDR is undefined"},
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role"},
 					HasMemoryForRole:     {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM role"},
@@ -1668,6 +1701,124 @@ var _ = Describe("Refresh Host", func() {
 			})
 		}
 	})
+	Context("Day2 host", func() {
+		BeforeEach(func() {
+			cluster = getTestCluster(clusterId, "")
+			cluster.Kind = swag.String(models.ClusterKindAddHostsCluster)
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		})
+
+		It("known without machine network CIDR", func() {
+			host = getTestHost(hostId, clusterId, models.HostStatusDiscovering)
+			host.Inventory = workerInventory()
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, &hostId, hostutil.GetEventSeverityFromHostStatus(models.HostStatusKnown),
+				gomock.Any(), gomock.Any())
+			Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+			resultHost := getHost(hostId, clusterId, db)
+			Expect(swag.StringValue(resultHost.Status)).To(Equal(models.HostStatusKnown))
+			makeJsonChecker(map[validationID]validationCheckResult{
+				IsMachineCidrDefined: {status: ValidationSuccess, messagePattern: "No machine network CIDR needed: Day2 cluster"},
+				BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "No machine network CIDR validation needed: Day2 cluster"},
+			}).check(resultHost.ValidationsInfo)
+
+			By("validations are stored", func() {
+				validations, err := common.GetValidations(db, clusterId, &hostId)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(validations).ShouldNot(BeEmpty())
+				for _, v := range validations {
+					if v.ID == string(IsMachineCidrDefined) {
+						Expect(v.Status).Should(Equal(string(ValidationSuccess)))
+						Expect(v.Category).Should(Equal("network"))
+					}
+				}
+			})
+		})
+
+		It("added-to-existing-cluster is kept", func() {
+			host = getTestHost(hostId, clusterId, models.HostStatusAddedToExistingCluster)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+			Expect(swag.StringValue(getHost(hostId, clusterId, db).Status)).To(Equal(models.HostStatusAddedToExistingCluster))
+		})
+	})
+
+	Context("Majority group", func() {
+		var otherHostID1, otherHostID2 strfmt.UUID
+
+		BeforeEach(func() {
+			otherHostID1 = strfmt.UUID(uuid.New().String())
+			otherHostID2 = strfmt.UUID(uuid.New().String())
+			cluster = getTestCluster(clusterId, "1.2.3.0/24")
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			for i, t := range []struct {
+				id           strfmt.UUID
+				connectivity string
+			}{
+				{id: otherHostID1, connectivity: connectivityTo(hostId, otherHostID2)},
+				{id: otherHostID2, connectivity: connectivityTo(hostId, otherHostID1)},
+			} {
+				h := getTestHost(t.id, clusterId, models.HostStatusKnown)
+				h.Inventory = workerInventory()
+				h.RequestedHostname = fmt.Sprintf("other-%d", i)
+				h.Connectivity = t.connectivity
+				Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+			}
+		})
+
+		tests := []struct {
+			name               string
+			connectivity       string
+			dstState           string
+			validationsChecker *validationsChecker
+		}{
+			{
+				name:         "connected to all hosts",
+				connectivity: "connected",
+				dstState:     models.HostStatusKnown,
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					BelongsToMajorityGroup: {status: ValidationSuccess, messagePattern: "Host has connectivity to the majority of hosts in the cluster"},
+				}),
+			},
+			{
+				name:         "not connected to the other hosts",
+				connectivity: `{"remote_hosts":[]}`,
+				dstState:     models.HostStatusInsufficient,
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					BelongsToMajorityGroup: {status: ValidationFailure, messagePattern: "No connectivity to the majority of hosts in the cluster"},
+				}),
+			},
+			{
+				name:         "missing connectivity report",
+				connectivity: "",
+				dstState:     models.HostStatusInsufficient,
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					BelongsToMajorityGroup: {status: ValidationPending, messagePattern: "Missing connectivity report or machine network CIDR"},
+				}),
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				host = getTestHost(hostId, clusterId, models.HostStatusDiscovering)
+				host.Inventory = workerInventory()
+				host.RequestedHostname = "tested"
+				host.Connectivity = t.connectivity
+				if t.connectivity == "connected" {
+					host.Connectivity = connectivityTo(otherHostID1, otherHostID2)
+				}
+				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+				mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, &hostId, hostutil.GetEventSeverityFromHostStatus(t.dstState),
+					gomock.Any(), gomock.Any())
+				Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+				resultHost := getHost(hostId, clusterId, db)
+				Expect(swag.StringValue(resultHost.Status)).To(Equal(t.dstState))
+				t.validationsChecker.check(resultHost.ValidationsInfo)
+			})
+		}
+	})
+
 	Context("Pending timed out", func() {
 		tests := []struct {
 			name          string
@@ -2049,10 +2200,12 @@ var _ = Describe("Refresh Host", func() {
 				host.Role = t.role
 				host.CheckedInAt = strfmt.DateTime(time.Now())
 				host.RequestedHostname = t.requestedHostname
+				host.Connectivity = connectivityTo(otherHostID)
 				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
 				otherHost := getTestHost(otherHostID, clusterId, t.otherState)
 				otherHost.RequestedHostname = t.otherRequestedHostname
 				otherHost.Inventory = t.otherInventory
+				otherHost.Connectivity = connectivityTo(hostId)
 				Expect(db.Create(&otherHost).Error).ShouldNot(HaveOccurred())
 				cluster = getTestCluster(clusterId, t.machineNetworkCidr)
 				Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
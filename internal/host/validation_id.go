@@ -12,22 +12,23 @@ import (
 type validationID models.HostValidationID
 
 const (
-	IsConnected          = validationID(models.HostValidationIDConnected)
-	HasInventory         = validationID(models.HostValidationIDHasInventory)
-	IsMachineCidrDefined = validationID(models.HostValidationIDMachineCidrDefined)
-	BelongsToMachineCidr = validationID(models.HostValidationIDBelongsToMachineCidr)
-	HasMinCPUCores       = validationID(models.HostValidationIDHasMinCPUCores)
-	HasMinValidDisks     = validationID(models.HostValidationIDHasMinValidDisks)
-	HasMinMemory         = validationID(models.HostValidationIDHasMinMemory)
-	HasCPUCoresForRole   = validationID(models.HostValidationIDHasCPUCoresForRole)
-	HasMemoryForRole     = validationID(models.HostValidationIDHasMemoryForRole)
-	IsHostnameUnique     = validationID(models.HostValidationIDHostnameUnique)
-	IsHostnameValid      = validationID(models.HostValidationIDHostnameValid)
+	IsConnected            = validationID(models.HostValidationIDConnected)
+	HasInventory           = validationID(models.HostValidationIDHasInventory)
+	IsMachineCidrDefined   = validationID(models.HostValidationIDMachineCidrDefined)
+	BelongsToMachineCidr   = validationID(models.HostValidationIDBelongsToMachineCidr)
+	HasMinCPUCores         = validationID(models.HostValidationIDHasMinCPUCores)
+	HasMinValidDisks       = validationID(models.HostValidationIDHasMinValidDisks)
+	HasMinMemory           = validationID(models.HostValidationIDHasMinMemory)
+	HasCPUCoresForRole     = validationID(models.HostValidationIDHasCPUCoresForRole)
+	HasMemoryForRole       = validationID(models.HostValidationIDHasMemoryForRole)
+	IsHostnameUnique       = validationID(models.HostValidationIDHostnameUnique)
+	IsHostnameValid        = validationID(models.HostValidationIDHostnameValid)
+	BelongsToMajorityGroup = validationID(models.HostValidationIDBelongsToMajorityGroup)
 )
 
 func (v validationID) category() (string, error) {
 	switch v {
-	case IsConnected, IsMachineCidrDefined, BelongsToMachineCidr:
+	case IsConnected, IsMachineCidrDefined, BelongsToMachineCidr, BelongsToMajorityGroup:
 		return "network", nil
 	case HasInventory, HasMinCPUCores, HasMinValidDisks, HasMinMemory,
 		HasCPUCoresForRole, HasMemoryForRole, IsHostnameUnique, IsHostnameValid:
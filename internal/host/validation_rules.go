@@ -0,0 +1,309 @@
+package host
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	ValidationRuleSeverityBlocking = "blocking"
+	ValidationRuleSeverityWarning  = "warning"
+)
+
+const (
+	ValidationRuleOperatorEq    = "eq"
+	ValidationRuleOperatorNe    = "ne"
+	ValidationRuleOperatorIn    = "in"
+	ValidationRuleOperatorNotIn = "not_in"
+	ValidationRuleOperatorGt    = "gt"
+	ValidationRuleOperatorGte   = "gte"
+	ValidationRuleOperatorLt    = "lt"
+	ValidationRuleOperatorLte   = "lte"
+)
+
+// validationRulesCategory is the category that the results of the configured validation rules are reported under
+const validationRulesCategory = "policy"
+
+// SatisfiesValidationRules is the refresh condition that holds when all the blocking validation rules that apply
+// to the host succeed
+const SatisfiesValidationRules = validationID("satisfies-validation-rules")
+
+// ValidationRule is an operator supplied host validation that is evaluated against the host inventory.
+// Field is a path of inventory JSON field names separated by dots, where a "[]" suffix selects all the elements
+// of a list (e.g. "disks[].model"). A rule on a list holds only when it holds for every element of the list.
+type ValidationRule struct {
+	ID          string      `json:"id"`
+	Description string      `json:"description"`
+	Role        string      `json:"role,omitempty"`
+	Field       string      `json:"field"`
+	Operator    string      `json:"operator"`
+	Value       interface{} `json:"value"`
+	Severity    string      `json:"severity,omitempty"`
+}
+
+// ValidationRules is the list of configured validation rules, decoded from its JSON representation
+type ValidationRules []*ValidationRule
+
+// Decode implements envconfig.Decoder
+func (r *ValidationRules) Decode(value string) error {
+	var rules ValidationRules
+	if strings.TrimSpace(value) == "" {
+		*r = rules
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return errors.Wrap(err, "failed to parse host validation rules")
+	}
+	ids := make(map[string]bool)
+	for _, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if ids[rule.ID] {
+			return errors.Errorf("host validation rule %s is defined more than once", rule.ID)
+		}
+		ids[rule.ID] = true
+	}
+	*r = rules
+	return nil
+}
+
+func (rule *ValidationRule) validate() error {
+	if rule.ID == "" {
+		return errors.New("host validation rule without id")
+	}
+	if _, err := validationID(rule.ID).category(); err == nil {
+		return errors.Errorf("host validation rule %s has the id of a built-in validation", rule.ID)
+	}
+	switch rule.Severity {
+	case "":
+		rule.Severity = ValidationRuleSeverityBlocking
+	case ValidationRuleSeverityBlocking, ValidationRuleSeverityWarning:
+	default:
+		return errors.Errorf("host validation rule %s has unsupported severity %s", rule.ID, rule.Severity)
+	}
+	switch rule.Role {
+	case "", string(models.HostRoleMaster), string(models.HostRoleWorker):
+	default:
+		return errors.Errorf("host validation rule %s has unsupported role %s", rule.ID, rule.Role)
+	}
+	fieldType, err := fieldTypeOf(reflect.TypeOf(models.Inventory{}), rule.Field)
+	if err != nil {
+		return errors.Wrapf(err, "host validation rule %s", rule.ID)
+	}
+	switch rule.Operator {
+	case ValidationRuleOperatorEq, ValidationRuleOperatorNe:
+		return checkOperand(rule.ID, fieldType, rule.Value)
+	case ValidationRuleOperatorIn, ValidationRuleOperatorNotIn:
+		values, ok := rule.Value.([]interface{})
+		if !ok {
+			return errors.Errorf("host validation rule %s requires a list value for operator %s", rule.ID, rule.Operator)
+		}
+		for _, v := range values {
+			if err := checkOperand(rule.ID, fieldType, v); err != nil {
+				return err
+			}
+		}
+	case ValidationRuleOperatorGt, ValidationRuleOperatorGte, ValidationRuleOperatorLt, ValidationRuleOperatorLte:
+		if _, ok := rule.Value.(float64); !ok || !isNumeric(fieldType.Kind()) {
+			return errors.Errorf("host validation rule %s requires a numeric field and value for operator %s", rule.ID, rule.Operator)
+		}
+	default:
+		return errors.Errorf("host validation rule %s has unsupported operator %s", rule.ID, rule.Operator)
+	}
+	return nil
+}
+
+func checkOperand(ruleID string, fieldType reflect.Type, operand interface{}) error {
+	var ok bool
+	switch {
+	case isNumeric(fieldType.Kind()):
+		_, ok = operand.(float64)
+	case fieldType.Kind() == reflect.String:
+		_, ok = operand.(string)
+	case fieldType.Kind() == reflect.Bool:
+		_, ok = operand.(bool)
+	}
+	if !ok {
+		return errors.Errorf("host validation rule %s value %v does not match the field type %s", ruleID, operand, fieldType)
+	}
+	return nil
+}
+
+func isNumeric(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// structField returns the field of a struct type with the given JSON name
+func structField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if strings.Split(f.Tag.Get("json"), ",")[0] == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func pathElements(path string) []string {
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, ".")
+}
+
+// fieldTypeOf verifies that the path refers to a scalar field and returns its type
+func fieldTypeOf(t reflect.Type, path string) (reflect.Type, error) {
+	elements := pathElements(path)
+	if len(elements) == 0 {
+		return nil, errors.New("field is not set")
+	}
+	for _, element := range elements {
+		name := strings.TrimSuffix(element, "[]")
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return nil, errors.Errorf("field %s: %s is not an object", path, name)
+		}
+		f, ok := structField(t, name)
+		if !ok {
+			return nil, errors.Errorf("field %s: unknown inventory field %s", path, name)
+		}
+		t = f.Type
+		if strings.HasSuffix(element, "[]") {
+			if t.Kind() != reflect.Slice {
+				return nil, errors.Errorf("field %s: %s is not a list", path, name)
+			}
+			t = t.Elem()
+		} else if t.Kind() == reflect.Slice {
+			return nil, errors.Errorf("field %s: %s is a list, select its elements with %s[]", path, name, name)
+		}
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !isNumeric(t.Kind()) && t.Kind() != reflect.String && t.Kind() != reflect.Bool {
+		return nil, errors.Errorf("field %s is not a number, string or boolean", path)
+	}
+	return t, nil
+}
+
+// fieldValues returns all the values that the path refers to, a missing object in the path yields no values
+func fieldValues(v reflect.Value, elements []string) []reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if len(elements) == 0 {
+		return []reflect.Value{v}
+	}
+	name := strings.TrimSuffix(elements[0], "[]")
+	f, ok := structField(v.Type(), name)
+	if !ok {
+		return nil
+	}
+	fv := v.FieldByIndex(f.Index)
+	if !strings.HasSuffix(elements[0], "[]") {
+		return fieldValues(fv, elements[1:])
+	}
+	var ret []reflect.Value
+	for i := 0; i < fv.Len(); i++ {
+		ret = append(ret, fieldValues(fv.Index(i), elements[1:])...)
+	}
+	return ret
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+func equals(v reflect.Value, operand interface{}) bool {
+	switch {
+	case isNumeric(v.Kind()):
+		return toFloat(v) == operand.(float64)
+	case v.Kind() == reflect.String:
+		return v.String() == operand.(string)
+	default:
+		return v.Bool() == operand.(bool)
+	}
+}
+
+func (rule *ValidationRule) holds(v reflect.Value) bool {
+	switch rule.Operator {
+	case ValidationRuleOperatorEq:
+		return equals(v, rule.Value)
+	case ValidationRuleOperatorNe:
+		return !equals(v, rule.Value)
+	case ValidationRuleOperatorIn, ValidationRuleOperatorNotIn:
+		found := false
+		for _, operand := range rule.Value.([]interface{}) {
+			if equals(v, operand) {
+				found = true
+				break
+			}
+		}
+		return found == (rule.Operator == ValidationRuleOperatorIn)
+	case ValidationRuleOperatorGt:
+		return toFloat(v) > rule.Value.(float64)
+	case ValidationRuleOperatorGte:
+		return toFloat(v) >= rule.Value.(float64)
+	case ValidationRuleOperatorLt:
+		return toFloat(v) < rule.Value.(float64)
+	case ValidationRuleOperatorLte:
+		return toFloat(v) <= rule.Value.(float64)
+	}
+	return false
+}
+
+func (rule *ValidationRule) isBlocking() bool {
+	return rule.Severity != ValidationRuleSeverityWarning
+}
+
+// appliesTo returns true if the rule is relevant for the role of the host. Hosts with an auto-assign role are
+// validated as workers like the role validations do, they are validated as masters before being selected as masters
+func (rule *ValidationRule) appliesTo(h *models.Host) bool {
+	role := h.Role
+	if role == models.HostRoleAutoAssign {
+		role = models.HostRoleWorker
+	}
+	return rule.Role == "" || rule.Role == string(role)
+}
+
+// evaluate returns the status of the rule for the inventory, a rule that doesn't hold is a failure when it is
+// blocking and a warning otherwise
+func (rule *ValidationRule) evaluate(inventory *models.Inventory) (validationStatus, string) {
+	if inventory == nil {
+		return ValidationPending, fmt.Sprintf("Missing inventory for %s", rule.Description)
+	}
+	for _, v := range fieldValues(reflect.ValueOf(inventory), pathElements(rule.Field)) {
+		if !rule.holds(v) {
+			message := fmt.Sprintf("%s: %s is %v", rule.Description, rule.Field, v.Interface())
+			if !rule.isBlocking() {
+				return ValidationWarning, message
+			}
+			return ValidationFailure, message
+		}
+	}
+	return ValidationSuccess, rule.Description
+}
@@ -0,0 +1,230 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Validation rules", func() {
+	decode := func(value string) (ValidationRules, error) {
+		var rules ValidationRules
+		err := rules.Decode(value)
+		return rules, err
+	}
+
+	It("empty configuration", func() {
+		rules, err := decode("")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rules).Should(BeEmpty())
+	})
+
+	It("severity defaults to blocking", func() {
+		rules, err := decode(`[{"id": "fast-nics", "description": "NICs are fast", "field": "interfaces[].speed_mbps",
+			"operator": "gte", "value": 10000}]`)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rules).Should(HaveLen(1))
+		Expect(rules[0].Severity).Should(Equal(ValidationRuleSeverityBlocking))
+		Expect(rules[0].isBlocking()).Should(BeTrue())
+	})
+
+	for _, t := range []struct {
+		name  string
+		rules string
+	}{
+		{name: "invalid json", rules: `[{"id": `},
+		{name: "missing id", rules: `[{"field": "hostname", "operator": "eq", "value": "h"}]`},
+		{name: "built-in id", rules: `[{"id": "connected", "field": "hostname", "operator": "eq", "value": "h"}]`},
+		{name: "duplicate id", rules: `[{"id": "a", "field": "hostname", "operator": "eq", "value": "h"},
+			{"id": "a", "field": "hostname", "operator": "ne", "value": "h"}]`},
+		{name: "unknown field", rules: `[{"id": "a", "field": "system_vendor.color", "operator": "eq", "value": "red"}]`},
+		{name: "list without brackets", rules: `[{"id": "a", "field": "disks.model", "operator": "eq", "value": "m"}]`},
+		{name: "object field", rules: `[{"id": "a", "field": "cpu", "operator": "eq", "value": "m"}]`},
+		{name: "unknown operator", rules: `[{"id": "a", "field": "hostname", "operator": "like", "value": "h"}]`},
+		{name: "value type mismatch", rules: `[{"id": "a", "field": "system_vendor.virtual", "operator": "eq", "value": "no"}]`},
+		{name: "in without list", rules: `[{"id": "a", "field": "disks[].model", "operator": "in", "value": "m"}]`},
+		{name: "numeric operator on string", rules: `[{"id": "a", "field": "hostname", "operator": "gt", "value": 1}]`},
+		{name: "unknown severity", rules: `[{"id": "a", "field": "hostname", "operator": "eq", "value": "h", "severity": "fatal"}]`},
+		{name: "unknown role", rules: `[{"id": "a", "field": "hostname", "operator": "eq", "value": "h", "role": "bootstrap"}]`},
+	} {
+		t := t
+		It(t.name, func() {
+			_, err := decode(t.rules)
+			Expect(err).Should(HaveOccurred())
+		})
+	}
+
+	Context("evaluate", func() {
+		inventory := &models.Inventory{
+			SystemVendor: &models.SystemVendor{Manufacturer: "Red Hat", Virtual: true},
+			Disks:        []*models.Disk{{Model: "good"}, {Model: "bad"}},
+			Interfaces:   []*models.Interface{{Name: "eth0", SpeedMbps: 25000}, {Name: "eth1", SpeedMbps: 1000}},
+		}
+
+		evaluate := func(rule string, inv *models.Inventory) (validationStatus, string) {
+			rules, err := decode("[" + rule + "]")
+			Expect(err).ShouldNot(HaveOccurred())
+			return rules[0].evaluate(inv)
+		}
+
+		It("boolean field", func() {
+			st, message := evaluate(`{"id": "a", "description": "Not virtual", "field": "system_vendor.virtual",
+				"operator": "eq", "value": false}`, inventory)
+			Expect(st).Should(Equal(ValidationFailure))
+			Expect(message).Should(Equal("Not virtual: system_vendor.virtual is true"))
+			st, message = evaluate(`{"id": "a", "description": "Not virtual", "field": "system_vendor.virtual",
+				"operator": "eq", "value": false}`, &models.Inventory{SystemVendor: &models.SystemVendor{}})
+			Expect(st).Should(Equal(ValidationSuccess))
+			Expect(message).Should(Equal("Not virtual"))
+		})
+
+		It("list field must hold for all elements", func() {
+			st, message := evaluate(`{"id": "a", "description": "Supported disks", "field": "disks[].model",
+				"operator": "not_in", "value": ["bad", "worse"]}`, inventory)
+			Expect(st).Should(Equal(ValidationFailure))
+			Expect(message).Should(Equal("Supported disks: disks[].model is bad"))
+			st, _ = evaluate(`{"id": "a", "description": "Supported disks", "field": "disks[].model",
+				"operator": "in", "value": ["good", "bad"]}`, inventory)
+			Expect(st).Should(Equal(ValidationSuccess))
+		})
+
+		It("numeric field", func() {
+			st, _ := evaluate(`{"id": "a", "description": "Fast NICs", "field": "interfaces[].speed_mbps",
+				"operator": "gte", "value": 10000}`, inventory)
+			Expect(st).Should(Equal(ValidationFailure))
+			st, _ = evaluate(`{"id": "a", "description": "Fast NICs", "field": "interfaces[].speed_mbps",
+				"operator": "gte", "value": 1000}`, inventory)
+			Expect(st).Should(Equal(ValidationSuccess))
+		})
+
+		It("warning", func() {
+			st, message := evaluate(`{"id": "a", "description": "Fast NICs", "field": "interfaces[].speed_mbps",
+				"operator": "gt", "value": 1000, "severity": "warning"}`, inventory)
+			Expect(st).Should(Equal(ValidationWarning))
+			Expect(message).Should(Equal("Fast NICs: interfaces[].speed_mbps is 1000"))
+		})
+
+		It("missing inventory", func() {
+			st, _ := evaluate(`{"id": "a", "description": "Fast NICs", "field": "interfaces[].speed_mbps",
+				"operator": "gte", "value": 10000}`, nil)
+			Expect(st).Should(Equal(ValidationPending))
+		})
+	})
+})
+
+var _ = Describe("Refresh with validation rules", func() {
+	var (
+		ctx               = context.Background()
+		db                *gorm.DB
+		hostId, clusterId strfmt.UUID
+		host              models.Host
+		mockEvents        *events.MockHandler
+		ctrl              *gomock.Controller
+		dbName            = "host_validation_rules_test"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		cluster := getTestCluster(clusterId, "1.2.3.0/24")
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		host = getTestHost(hostId, clusterId, models.HostStatusDiscovering)
+		host.Inventory = workerInventory()
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	refresh := func(rule string) *models.Host {
+		config := *defaultConfig
+		Expect(config.ValidationRules.Decode("[" + rule + "]")).ShouldNot(HaveOccurred())
+		hapi := NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, &config, nil)
+		Expect(hapi.RefreshStatus(ctx, &host, db)).ShouldNot(HaveOccurred())
+		return getHost(hostId, clusterId, db)
+	}
+
+	policyResult := func(h *models.Host, id string) common.ValidationResult {
+		var validationsInfo map[string][]common.ValidationResult
+		Expect(json.Unmarshal([]byte(h.ValidationsInfo), &validationsInfo)).ShouldNot(HaveOccurred())
+		for _, r := range validationsInfo[validationRulesCategory] {
+			if r.ID == id {
+				return r
+			}
+		}
+		Fail("no result for validation rule " + id)
+		return common.ValidationResult{}
+	}
+
+	It("failing blocking rule makes the host insufficient", func() {
+		h := refresh(`{"id": "fast-nics", "description": "NICs are fast", "field": "interfaces[].speed_mbps",
+			"operator": "gte", "value": 10000}`)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusInsufficient))
+		Expect(policyResult(h, "fast-nics").Status).Should(Equal(string(ValidationFailure)))
+	})
+
+	It("failing warning rule is only reported", func() {
+		h := refresh(`{"id": "fast-nics", "description": "NICs are fast", "field": "interfaces[].speed_mbps",
+			"operator": "gte", "value": 10000, "severity": "warning"}`)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusKnown))
+		Expect(policyResult(h, "fast-nics").Status).Should(Equal(string(ValidationWarning)))
+	})
+
+	It("stores the results of many rules", func() {
+		var rules []string
+		for i := 0; i < 40; i++ {
+			rules = append(rules, fmt.Sprintf(`{"id": "fast-nics-%d", "description": "NICs are fast", "field": "interfaces[].speed_mbps",
+				"operator": "gte", "value": 10000, "severity": "warning"}`, i))
+		}
+		h := refresh(strings.Join(rules, ","))
+		Expect(len(h.ValidationsInfo)).Should(BeNumerically(">", 2048))
+		Expect(policyResult(h, "fast-nics-39").Status).Should(Equal(string(ValidationWarning)))
+	})
+
+	It("rule of another role is ignored", func() {
+		h := refresh(`{"id": "fast-nics", "description": "NICs are fast", "field": "interfaces[].speed_mbps",
+			"operator": "gte", "value": 10000, "role": "master"}`)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusKnown))
+		Expect(h.ValidationsInfo).ShouldNot(ContainSubstring("fast-nics"))
+	})
+
+	It("worker rule applies to an auto-assign host", func() {
+		Expect(db.Model(&host).Update("role", models.HostRoleAutoAssign).Error).ShouldNot(HaveOccurred())
+		host.Role = models.HostRoleAutoAssign
+		h := refresh(`{"id": "fast-nics", "description": "NICs are fast", "field": "interfaces[].speed_mbps",
+			"operator": "gte", "value": 10000, "role": "worker"}`)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusInsufficient))
+		Expect(policyResult(h, "fast-nics").Status).Should(Equal(string(ValidationFailure)))
+	})
+
+	It("master rule prevents selecting an auto-assign host as master", func() {
+		config := *defaultConfig
+		Expect(config.ValidationRules.Decode(`[{"id": "fast-nics", "description": "NICs are fast",
+			"field": "interfaces[].speed_mbps", "operator": "gte", "value": 10000, "role": "master"}]`)).ShouldNot(HaveOccurred())
+		hapi := NewManager(getTestLog(), db, mockEvents, createValidator(), nil, createValidatorCfg(), nil, &config, nil)
+		h := getHost(hostId, clusterId, db)
+		h.Inventory = masterInventory()
+		h.Role = models.HostRoleAutoAssign
+		Expect(db.Model(h).Updates(map[string]interface{}{"role": h.Role, "inventory": h.Inventory}).Error).ShouldNot(HaveOccurred())
+		Expect(hapi.AutoAssignRole(ctx, h, db)).ShouldNot(HaveOccurred())
+		Expect(getHost(hostId, clusterId, db).Role).Should(Equal(models.HostRoleWorker))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/swag"
@@ -31,6 +32,7 @@ const (
 	ValidationFailure validationStatus = "failure"
 	ValidationPending validationStatus = "pending"
 	ValidationError   validationStatus = "error"
+	ValidationWarning validationStatus = "warning"
 )
 
 var forbiddenHostnames = []string{
@@ -137,7 +139,9 @@ func boolValue(b bool) validationStatus {
 
 type validator struct {
 	log            logrus.FieldLogger
+	hwValidator    hardware.Validator
 	hwValidatorCfg *hardware.ValidatorCfg
+	majorityGroups *majorityGroups
 }
 
 func (v *validator) isConnected(c *validationContext) validationStatus {
@@ -215,8 +219,14 @@ func (v *validator) hasMinValidDisks(c *validationContext) validationStatus {
 	if c.inventory == nil {
 		return ValidationPending
 	}
-	disks := hardware.ListValidDisks(c.inventory, gibToBytes(v.hwValidatorCfg.MinDiskSizeGb))
-	return boolValue(len(disks) > 0)
+	disks := hardware.ListValidDisks(c.inventory, gibToBytes(v.getMinDiskSizeGib(c)))
+	return boolValue(hardware.GetInstallationDisk(disks, c.host.InstallationDiskID, v.hwValidatorCfg.InstallationDiskPolicy) != nil)
+}
+
+// getMinDiskSizeGib returns the size of the smallest disk that the host can be installed on, as the OpenShift version
+// of its cluster requires for the role of the host
+func (v *validator) getMinDiskSizeGib(c *validationContext) int64 {
+	return v.hwValidator.GetHostRequirements(c.cluster.OpenshiftVersion, c.host.Role).DiskSizeGb
 }
 
 func (v *validator) printHasMinValidDisks(c *validationContext, status validationStatus) string {
@@ -224,7 +234,20 @@ func (v *validator) printHasMinValidDisks(c *validationContext, status validatio
 	case ValidationSuccess:
 		return "Sufficient disk capacity"
 	case ValidationFailure:
-		return fmt.Sprintf("Require a disk of at least %d GB", v.hwValidatorCfg.MinDiskSizeGb)
+		if c.host.InstallationDiskID != "" {
+			return v.printSelectedDiskNotEligible(c)
+		}
+		minDiskSizeGib := v.getMinDiskSizeGib(c)
+		if len(hardware.ListValidDisks(c.inventory, gibToBytes(minDiskSizeGib))) > 0 {
+			return fmt.Sprintf("No valid disk matches the installation disk policy %s", v.hwValidatorCfg.InstallationDiskPolicy)
+		}
+		var notEligible []string
+		for _, disk := range c.inventory.Disks {
+			reasons := hardware.GetDiskEligibilityReasons(disk, gibToBytes(minDiskSizeGib))
+			notEligible = append(notEligible, fmt.Sprintf("%s: %s", hardware.GetDiskID(disk), strings.Join(reasons, ", ")))
+		}
+		return fmt.Sprintf("Require a disk of at least %d GiB, no disk is eligible for installation: %s",
+			minDiskSizeGib, strings.Join(notEligible, "; "))
 	case ValidationPending:
 		return "Missing inventory"
 	default:
@@ -232,13 +255,25 @@ func (v *validator) printHasMinValidDisks(c *validationContext, status validatio
 	}
 }
 
+func (v *validator) printSelectedDiskNotEligible(c *validationContext) string {
+	disk := hardware.FindDisk(c.inventory.Disks, c.host.InstallationDiskID)
+	if disk == nil {
+		return fmt.Sprintf("Selected installation disk %s is not eligible: the host has no such disk", c.host.InstallationDiskID)
+	}
+	reasons := hardware.GetDiskEligibilityReasons(disk, gibToBytes(v.getMinDiskSizeGib(c)))
+	return fmt.Sprintf("Selected installation disk %s is not eligible: %s", c.host.InstallationDiskID, strings.Join(reasons, ", "))
+}
+
 func (v *validator) isMachineCidrDefined(c *validationContext) validationStatus {
-	return boolValue(c.cluster.MachineNetworkCidr != "")
+	return boolValue(common.IsDay2Cluster(c.cluster) || c.cluster.MachineNetworkCidr != "")
 }
 
 func (v *validator) printIsMachineCidrDefined(context *validationContext, status validationStatus) string {
 	switch status {
 	case ValidationSuccess:
+		if common.IsDay2Cluster(context.cluster) {
+			return "No machine network CIDR needed: Day2 cluster"
+		}
 		return "Machine network CIDR is defined"
 	case ValidationFailure:
 		if swag.BoolValue(context.cluster.VipDhcpAllocation) {
@@ -256,22 +291,18 @@ func (v *validator) hasCpuCoresForRole(c *validationContext) validationStatus {
 		return ValidationPending
 	}
 	switch c.host.Role {
-	case models.HostRoleMaster:
-		return boolValue(c.inventory.CPU.Count >= v.hwValidatorCfg.MinCPUCoresMaster)
-	case models.HostRoleWorker, models.HostRoleAutoAssign:
-		return boolValue(c.inventory.CPU.Count >= v.hwValidatorCfg.MinCPUCoresWorker)
+	case models.HostRoleMaster, models.HostRoleWorker, models.HostRoleAutoAssign:
+		return boolValue(c.inventory.CPU.Count >= v.getCpuCountForRole(c))
 	default:
 		v.log.Errorf("Unexpected role %s", c.host.Role)
 		return ValidationError
 	}
 }
 
-func (v *validator) getCpuCountForRole(role models.HostRole) int64 {
-	switch role {
-	case models.HostRoleMaster:
-		return v.hwValidatorCfg.MinCPUCoresMaster
-	case models.HostRoleWorker, models.HostRoleAutoAssign:
-		return v.hwValidatorCfg.MinCPUCoresWorker
+func (v *validator) getCpuCountForRole(c *validationContext) int64 {
+	switch c.host.Role {
+	case models.HostRoleMaster, models.HostRoleWorker, models.HostRoleAutoAssign:
+		return v.hwValidator.GetHostRequirements(c.cluster.OpenshiftVersion, c.host.Role).CPUCores
 	default:
 		return v.hwValidatorCfg.MinCPUCores
 	}
@@ -283,7 +314,7 @@ func (v *validator) printHasCpuCoresForRole(c *validationContext, status validat
 		return fmt.Sprintf("Sufficient CPU cores for role %s", c.host.Role)
 	case ValidationFailure:
 		return fmt.Sprintf("Require at least %d CPU cores for %s role, found only %d",
-			v.getCpuCountForRole(c.host.Role), c.host.Role, c.inventory.CPU.Count)
+			v.getCpuCountForRole(c), c.host.Role, c.inventory.CPU.Count)
 	case ValidationPending:
 		return "Missing inventory or role"
 	default:
@@ -296,22 +327,18 @@ func (v *validator) hasMemoryForRole(c *validationContext) validationStatus {
 		return ValidationPending
 	}
 	switch c.host.Role {
-	case models.HostRoleMaster:
-		return boolValue(c.inventory.Memory.PhysicalBytes >= gibToBytes(v.hwValidatorCfg.MinRamGibMaster))
-	case models.HostRoleWorker, models.HostRoleAutoAssign:
-		return boolValue(c.inventory.Memory.PhysicalBytes >= gibToBytes(v.hwValidatorCfg.MinRamGibWorker))
+	case models.HostRoleMaster, models.HostRoleWorker, models.HostRoleAutoAssign:
+		return boolValue(c.inventory.Memory.PhysicalBytes >= gibToBytes(v.getMemoryForRole(c)))
 	default:
 		v.log.Errorf("Unexpected role %s", c.host.Role)
 		return ValidationError
 	}
 }
 
-func (v *validator) getMemoryForRole(role models.HostRole) int64 {
-	switch role {
-	case models.HostRoleMaster:
-		return v.hwValidatorCfg.MinRamGibMaster
-	case models.HostRoleWorker, models.HostRoleAutoAssign:
-		return v.hwValidatorCfg.MinRamGibWorker
+func (v *validator) getMemoryForRole(c *validationContext) int64 {
+	switch c.host.Role {
+	case models.HostRoleMaster, models.HostRoleWorker, models.HostRoleAutoAssign:
+		return v.hwValidator.GetHostRequirements(c.cluster.OpenshiftVersion, c.host.Role).RAMGib
 	default:
 		return v.hwValidatorCfg.MinRamGib
 	}
@@ -323,7 +350,7 @@ func (v *validator) printHasMemoryForRole(c *validationContext, status validatio
 		return fmt.Sprintf("Sufficient RAM for role %s", c.host.Role)
 	case ValidationFailure:
 		return fmt.Sprintf("Require at least %d GiB RAM role %s, found only %d",
-			v.getMemoryForRole(c.host.Role), c.host.Role, bytesToGiB(c.inventory.Memory.PhysicalBytes))
+			v.getMemoryForRole(c), c.host.Role, bytesToGiB(c.inventory.Memory.PhysicalBytes))
 	case ValidationPending:
 		return "Missing inventory or role"
 	default:
@@ -332,6 +359,9 @@ func (v *validator) printHasMemoryForRole(c *validationContext, status validatio
 }
 
 func (v *validator) belongsToMachineCidr(c *validationContext) validationStatus {
+	if common.IsDay2Cluster(c.cluster) {
+		return ValidationSuccess
+	}
 	if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
 		return ValidationPending
 	}
@@ -341,9 +371,12 @@ func (v *validator) belongsToMachineCidr(c *validationContext) validationStatus
 func (v *validator) printBelongsToMachineCidr(c *validationContext, status validationStatus) string {
 	switch status {
 	case ValidationSuccess:
-		return fmt.Sprintf("Host belongs to machine network CIDR %s", c.cluster.MachineNetworkCidr)
+		if common.IsDay2Cluster(c.cluster) {
+			return "No machine network CIDR validation needed: Day2 cluster"
+		}
+		return fmt.Sprintf("Host belongs to machine network CIDR %s", strings.Join(network.GetMachineNetworks(c.cluster), ", "))
 	case ValidationFailure:
-		return fmt.Sprintf("Host does not belong to machine network CIDR %s", c.cluster.MachineNetworkCidr)
+		return fmt.Sprintf("Host does not belong to machine network CIDR %s", strings.Join(network.GetMachineNetworks(c.cluster), ", "))
 	case ValidationPending:
 		return "Missing inventory or machine network CIDR"
 	default:
@@ -411,3 +444,55 @@ func (v *validator) printHostnameValid(c *validationContext, status validationSt
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
+
+// majorityGroupHosts returns the hosts of the cluster, where the validated host replaces its stored copy so its
+// latest connectivity report is used
+func (c *validationContext) majorityGroupHosts() []*models.Host {
+	hosts := []*models.Host{c.host}
+	for _, h := range c.cluster.Hosts {
+		if h.ID.String() != c.host.ID.String() {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+func (v *validator) belongsToMajorityGroup(c *validationContext) validationStatus {
+	hosts := c.majorityGroupHosts()
+	if common.IsDay2Cluster(c.cluster) || len(hosts) < 2 {
+		return ValidationSuccess
+	}
+	if c.cluster.MachineNetworkCidr == "" || c.host.Connectivity == "" {
+		return ValidationPending
+	}
+	group, err := v.majorityGroups.get(*c.cluster.ID, hosts, c.cluster.MachineNetworkCidr)
+	if err != nil {
+		v.log.WithError(err).Warnf("failed to find the majority group of cluster %s", c.cluster.ID.String())
+		return ValidationError
+	}
+	reported := 0
+	for _, h := range hosts {
+		if h.Connectivity != "" {
+			reported++
+		}
+	}
+	return boolValue(funk.Contains(group, *c.host.ID) && len(group)*2 > reported)
+}
+
+func (v *validator) printBelongsToMajorityGroup(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if common.IsDay2Cluster(c.cluster) {
+			return "No connectivity validation needed: Day2 cluster"
+		}
+		return "Host has connectivity to the majority of hosts in the cluster"
+	case ValidationFailure:
+		return fmt.Sprintf("No connectivity to the majority of hosts in the cluster on machine network CIDR %s", c.cluster.MachineNetworkCidr)
+	case ValidationPending:
+		return "Missing connectivity report or machine network CIDR"
+	case ValidationError:
+		return "Failed to parse the connectivity reports of the cluster hosts"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
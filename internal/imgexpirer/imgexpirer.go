@@ -2,6 +2,7 @@ package imgexpirer
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"time"
 
@@ -15,26 +16,46 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-const imagePrefix = "discovery-image-"
-const imageRegex = imagePrefix + `(?P<uuid>[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}).iso`
+const imageRegex = `(discovery-(?:image|minimal-image|initrd|ipxe-script)-)` +
+	`(?P<uuid>[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12})\.(?:ign|iso|ipxe)`
 
 var (
-	//Image name format is "discovery-image-<clusterID>.iso"
+	//Object names of the boot artifacts are "discovery-<artifact>-<clusterID>.<extension>", e.g.
+	//"discovery-image-<clusterID>.ign", images of earlier versions of the service are "discovery-image-<clusterID>.iso"
 	uuidRegex = regexp.MustCompile(imageRegex)
 )
 
+// Config is the expiration times of the per-cluster boot artifacts, every artifact expires on its own
+type Config struct {
+	ImageExpirationTime        time.Duration
+	MinimalImageExpirationTime time.Duration
+	InitrdExpirationTime       time.Duration
+	IPXEScriptExpirationTime   time.Duration
+}
+
+type bootArtifact struct {
+	prefix     string
+	name       string
+	deleteTime time.Duration
+}
+
 type Manager struct {
 	objectHandler s3wrapper.API
 	eventsHandler events.Handler
-	deleteTime    time.Duration
+	bootArtifacts []bootArtifact
 	leaderElector leader.Leader
 }
 
-func NewManager(objectHandler s3wrapper.API, eventsHandler events.Handler, deleteTime time.Duration, leaderElector leader.ElectorInterface) *Manager {
+func NewManager(objectHandler s3wrapper.API, eventsHandler events.Handler, cfg Config, leaderElector leader.ElectorInterface) *Manager {
 	return &Manager{
 		objectHandler: objectHandler,
 		eventsHandler: eventsHandler,
-		deleteTime:    deleteTime,
+		bootArtifacts: []bootArtifact{
+			{prefix: "discovery-image-", name: "image", deleteTime: cfg.ImageExpirationTime},
+			{prefix: "discovery-minimal-image-", name: "minimal image", deleteTime: cfg.MinimalImageExpirationTime},
+			{prefix: "discovery-initrd-", name: "initrd", deleteTime: cfg.InitrdExpirationTime},
+			{prefix: "discovery-ipxe-script-", name: "iPXE script", deleteTime: cfg.IPXEScriptExpirationTime},
+		},
 		leaderElector: leaderElector,
 	}
 }
@@ -44,7 +65,9 @@ func (m *Manager) ExpirationTask() {
 		return
 	}
 	ctx := requestid.ToContext(context.Background(), requestid.NewID())
-	m.objectHandler.ExpireObjects(ctx, imagePrefix, m.deleteTime, m.DeletedImageCallback)
+	for _, artifact := range m.bootArtifacts {
+		m.objectHandler.ExpireObjects(ctx, artifact.prefix, artifact.deleteTime, m.DeletedImageCallback)
+	}
 }
 
 func (m *Manager) DeletedImageCallback(ctx context.Context, log logrus.FieldLogger, objectName string) {
@@ -52,11 +75,17 @@ func (m *Manager) DeletedImageCallback(ctx context.Context, log logrus.FieldLogg
 		return
 	}
 	matches := uuidRegex.FindStringSubmatch(objectName)
-	if len(matches) != 2 {
+	if len(matches) != 3 {
 		log.Errorf("Cannot find cluster ID in object name: %s", objectName)
 		return
 	}
-	clusterID := strfmt.UUID(matches[1])
+	name := "image"
+	for _, artifact := range m.bootArtifacts {
+		if artifact.prefix == matches[1] {
+			name = artifact.name
+		}
+	}
+	clusterID := strfmt.UUID(matches[2])
 	m.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityInfo,
-		"Deleted image from backend because it expired. It may be generated again at any time.", time.Now())
+		fmt.Sprintf("Deleted %s from backend because it expired. It may be generated again at any time.", name), time.Now())
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/golang/mock/gomock"
@@ -26,12 +27,13 @@ func TestJob(t *testing.T) {
 
 var _ = Describe("imgexpirer", func() {
 	var (
-		imgExp     *Manager
-		ctx        = context.Background()
-		ctrl       *gomock.Controller
-		mockEvents *events.MockHandler
-		leaderMock *leader.MockElectorInterface
-		log        = logrus.New()
+		imgExp       *Manager
+		ctx          = context.Background()
+		ctrl         *gomock.Controller
+		mockEvents   *events.MockHandler
+		mockS3Client *s3wrapper.MockAPI
+		leaderMock   *leader.MockElectorInterface
+		log          = logrus.New()
 	)
 
 	leaderSuccess := func() {
@@ -42,9 +44,27 @@ var _ = Describe("imgexpirer", func() {
 		log.SetOutput(ioutil.Discard)
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
 		deleteTime, _ := time.ParseDuration("60m")
 		leaderMock = leader.NewMockElectorInterface(ctrl)
-		imgExp = NewManager(nil, mockEvents, deleteTime, leaderMock)
+		imgExp = NewManager(mockS3Client, mockEvents, Config{
+			ImageExpirationTime:        deleteTime,
+			MinimalImageExpirationTime: 2 * deleteTime,
+			InitrdExpirationTime:       3 * deleteTime,
+			IPXEScriptExpirationTime:   4 * deleteTime,
+		}, leaderMock)
+	})
+	It("expiration_task", func() {
+		leaderSuccess()
+		mockS3Client.EXPECT().ExpireObjects(gomock.Any(), "discovery-image-", 60*time.Minute, gomock.Any()).Times(1)
+		mockS3Client.EXPECT().ExpireObjects(gomock.Any(), "discovery-minimal-image-", 120*time.Minute, gomock.Any()).Times(1)
+		mockS3Client.EXPECT().ExpireObjects(gomock.Any(), "discovery-initrd-", 180*time.Minute, gomock.Any()).Times(1)
+		mockS3Client.EXPECT().ExpireObjects(gomock.Any(), "discovery-ipxe-script-", 240*time.Minute, gomock.Any()).Times(1)
+		imgExp.ExpirationTask()
+	})
+	It("expiration_task_not_leader", func() {
+		leaderMock.EXPECT().IsLeader().Return(false).Times(1)
+		imgExp.ExpirationTask()
 	})
 	It("callback_valid_objname", func() {
 		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
@@ -52,6 +72,24 @@ var _ = Describe("imgexpirer", func() {
 		mockEvents.EXPECT().AddEvent(gomock.Any(), strfmt.UUID(clusterId), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any())
 		imgExp.DeletedImageCallback(ctx, log, fmt.Sprintf("discovery-image-%s.iso", clusterId))
 	})
+	It("callback_valid_ignition_objname", func() {
+		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
+		leaderSuccess()
+		mockEvents.EXPECT().AddEvent(gomock.Any(), strfmt.UUID(clusterId), nil, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+		imgExp.DeletedImageCallback(ctx, log, fmt.Sprintf("discovery-image-%s.ign", clusterId))
+	})
+	It("callback_valid_boot_artifact_objname", func() {
+		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
+		for objectName, msg := range map[string]string{
+			"discovery-minimal-image-%s.ign": "Deleted minimal image from backend because it expired. It may be generated again at any time.",
+			"discovery-initrd-%s.ign":        "Deleted initrd from backend because it expired. It may be generated again at any time.",
+			"discovery-ipxe-script-%s.ipxe":  "Deleted iPXE script from backend because it expired. It may be generated again at any time.",
+		} {
+			leaderSuccess()
+			mockEvents.EXPECT().AddEvent(gomock.Any(), strfmt.UUID(clusterId), nil, models.EventSeverityInfo, msg, gomock.Any())
+			imgExp.DeletedImageCallback(ctx, log, fmt.Sprintf(objectName, clusterId))
+		}
+	})
 	It("callback_invalid_objname", func() {
 		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
 		leaderSuccess()
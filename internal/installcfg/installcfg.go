@@ -2,17 +2,23 @@ package installcfg
 
 import (
 	"encoding/json"
-	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/hostutil"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/registries"
 	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
 
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
+	k8syaml "sigs.k8s.io/yaml"
 )
 
 type host struct {
@@ -29,8 +35,16 @@ type baremetal struct {
 	Hosts               []host `yaml:"hosts"`
 }
 
+type platformNone struct {
+}
+
 type platform struct {
-	Baremetal baremetal `yaml:"baremetal"`
+	Baremetal *baremetal    `yaml:"baremetal,omitempty"`
+	None      *platformNone `yaml:"none,omitempty"`
+}
+
+type bootstrapInPlace struct {
+	InstallationDisk string `yaml:"installationDisk,omitempty"`
 }
 
 type proxy struct {
@@ -76,6 +90,7 @@ type InstallerConfigBaremetal struct {
 		Mirrors []string `yaml:"mirrors"`
 		Source  string   `yaml:"source"`
 	} `yaml:"imageContentSources,omitempty"`
+	BootstrapInPlace *bootstrapInPlace `yaml:"bootstrapInPlace,omitempty"`
 }
 
 func countHostsByRole(cluster *common.Cluster, role models.HostRole) int {
@@ -100,6 +115,24 @@ func getBMHName(host *models.Host, masterIdx, workerIdx *int) string {
 	return name
 }
 
+// setSecondaryNetworks adds the secondary networks of a dual-stack cluster after its primary networks
+func setSecondaryNetworks(cluster *common.Cluster, cfg *InstallerConfigBaremetal) {
+	if cluster.SecondaryClusterNetworkCidr != "" {
+		cfg.Networking.ClusterNetwork = append(cfg.Networking.ClusterNetwork, struct {
+			Cidr       string `yaml:"cidr"`
+			HostPrefix int    `yaml:"hostPrefix"`
+		}{Cidr: cluster.SecondaryClusterNetworkCidr, HostPrefix: int(cluster.SecondaryClusterNetworkHostPrefix)})
+	}
+	if cluster.SecondaryMachineNetworkCidr != "" {
+		cfg.Networking.MachineNetwork = append(cfg.Networking.MachineNetwork, struct {
+			Cidr string `yaml:"cidr"`
+		}{Cidr: cluster.SecondaryMachineNetworkCidr})
+	}
+	if cluster.SecondaryServiceNetworkCidr != "" {
+		cfg.Networking.ServiceNetwork = append(cfg.Networking.ServiceNetwork, cluster.SecondaryServiceNetworkCidr)
+	}
+}
+
 func getBasicInstallConfig(cluster *common.Cluster) *InstallerConfigBaremetal {
 	cfg := &InstallerConfigBaremetal{
 		APIVersion: "v1",
@@ -115,7 +148,7 @@ func getBasicInstallConfig(cluster *common.Cluster) *InstallerConfigBaremetal {
 			} `yaml:"machineNetwork"`
 			ServiceNetwork []string `yaml:"serviceNetwork"`
 		}{
-			NetworkType: "OpenShiftSDN",
+			NetworkType: network.GetNetworkType(cluster),
 			ClusterNetwork: []struct {
 				Cidr       string `yaml:"cidr"`
 				HostPrefix int    `yaml:"hostPrefix"`
@@ -158,6 +191,8 @@ func getBasicInstallConfig(cluster *common.Cluster) *InstallerConfigBaremetal {
 		SSHKey:     cluster.SSHPublicKey,
 	}
 
+	setSecondaryNetworks(cluster, cfg)
+
 	if cluster.HTTPProxy != "" || cluster.HTTPSProxy != "" {
 		cfg.Proxy = &proxy{
 			HTTPProxy:  cluster.HTTPProxy,
@@ -208,7 +243,7 @@ func setBMPlatformInstallconfig(log logrus.FieldLogger, cluster *common.Cluster,
 		yamlHostIdx += 1
 	}
 	cfg.Platform = platform{
-		Baremetal: baremetal{
+		Baremetal: &baremetal{
 			ProvisioningNetwork: "Unmanaged",
 			APIVIP:              cluster.APIVip,
 			IngressVIP:          cluster.IngressVip,
@@ -218,35 +253,104 @@ func setBMPlatformInstallconfig(log logrus.FieldLogger, cluster *common.Cluster,
 	return nil
 }
 
-func applyConfigOverrides(overrides string, cfg *InstallerConfigBaremetal) error {
-	if overrides == "" {
-		return nil
+// single node cluster is installed with bootstrap-in-place over its only master, without workers and without
+// the baremetal platform that requires the API and ingress VIPs to be managed by the cluster. The installation disk
+// is selected from the inventory of the master the same way as the install command of the host selects it, a master
+// that was not discovered yet has no installation disk
+func setSingleNodeInstallconfig(cluster *common.Cluster, cfg *InstallerConfigBaremetal, hwValidator hardware.Validator) error {
+	cfg.Compute[0].Replicas = common.AllowedNumberOfWorkersInNoneHaMode
+	cfg.ControlPlane.Replicas = common.AllowedNumberOfMasterHostsInNoneHaMode
+	cfg.Platform = platform{None: &platformNone{}}
+
+	for _, host := range cluster.Hosts {
+		if swag.StringValue(host.Status) != models.HostStatusDisabled && host.Role == models.HostRoleMaster {
+			cfg.BootstrapInPlace = &bootstrapInPlace{}
+			if host.Inventory == "" {
+				break
+			}
+			disk, err := hwValidator.GetHostInstallationDisk(host, cluster.OpenshiftVersion)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get the installation disk of host %s", hostutil.GetHostnameForMsg(host))
+			}
+			cfg.BootstrapInPlace.InstallationDisk = hardware.GetDiskID(disk)
+			break
+		}
 	}
+	return nil
+}
 
-	if err := json.Unmarshal([]byte(overrides), cfg); err != nil {
+// setDisconnectedInstallconfig sets the registry mirrors of the cluster and the certificates that the cluster trusts
+func setDisconnectedInstallconfig(cluster *common.Cluster, cfg *InstallerConfigBaremetal) error {
+	sources, err := registries.ParseImageContentSources(cluster.ImageContentSources)
+	if err != nil {
 		return err
 	}
+	for _, source := range sources {
+		cfg.ImageContentSources = append(cfg.ImageContentSources, source)
+	}
+	cfg.AdditionalTrustBundle = strings.TrimSpace(cluster.AdditionalTrustBundle)
 	return nil
 }
 
-func GetInstallConfig(log logrus.FieldLogger, cluster *common.Cluster, addRhCa bool, ca string) ([]byte, error) {
+// addRhCaToTrustBundle appends the Red Hat CA to the trust bundle of the install config. It is added after the
+// overrides are applied so that they can't drop it
+func addRhCaToTrustBundle(cfg []byte, ca string) ([]byte, error) {
+	cfgJSON, err := k8syaml.YAMLToJSON(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert the install config to JSON")
+	}
+	var current struct {
+		AdditionalTrustBundle string `json:"additionalTrustBundle"`
+	}
+	if err = json.Unmarshal(cfgJSON, &current); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the trust bundle of the install config")
+	}
+	bundle := ca
+	if trimmed := strings.TrimSpace(current.AdditionalTrustBundle); trimmed != "" {
+		bundle = trimmed + "\n" + ca
+	}
+	patch, err := json.Marshal(map[string]string{"additionalTrustBundle": bundle})
+	if err != nil {
+		return nil, err
+	}
+	merged, err := jsonpatch.MergePatch(cfgJSON, patch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add the Red Hat CA to the install config")
+	}
+	return k8syaml.JSONToYAML(merged)
+}
+
+func GetInstallConfig(log logrus.FieldLogger, cluster *common.Cluster, addRhCa bool, ca string, hwValidator hardware.Validator) ([]byte, error) {
 	cfg := getBasicInstallConfig(cluster)
-	err := setBMPlatformInstallconfig(log, cluster, cfg)
+	if common.IsSingleNodeCluster(cluster) {
+		if err := setSingleNodeInstallconfig(cluster, cfg, hwValidator); err != nil {
+			return nil, err
+		}
+	} else if common.IsUserManagedNetworking(cluster) {
+		// the load balancers of the API and the ingress are provided by the user, so the cluster has no VIPs to manage
+		cfg.Platform = platform{None: &platformNone{}}
+	} else {
+		err := setBMPlatformInstallconfig(log, cluster, cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := setDisconnectedInstallconfig(cluster, cfg); err != nil {
+		return nil, err
+	}
+
+	data, err := yaml.Marshal(*cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	err = applyConfigOverrides(cluster.InstallConfigOverrides, cfg)
+	data, err = applyConfigOverrides(cluster.InstallConfigOverrides, data)
 	if err != nil {
 		return nil, err
 	}
 	if addRhCa {
-		cfg.AdditionalTrustBundle = fmt.Sprintf(` | %s`, ca)
+		return addRhCaToTrustBundle(data, ca)
 	}
-
-	return yaml.Marshal(*cfg)
-}
-
-func ValidateInstallConfigJSON(s string) error {
-	return json.Unmarshal([]byte(s), &InstallerConfigBaremetal{})
+	return data, nil
 }
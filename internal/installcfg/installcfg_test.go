@@ -7,13 +7,16 @@ import (
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 
+	"github.com/alecthomas/units"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/kelseyhightower/envconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -33,7 +36,7 @@ var _ = Describe("installcfg", func() {
 			BaseDNSDomain:          "redhat.com",
 			APIVip:                 "102.345.34.34",
 			IngressVip:             "376.5.56.6",
-			InstallConfigOverrides: `{"networking":{"networkType": "OVN-Kubernetes"},"fips":true}`,
+			InstallConfigOverrides: `{"controlPlane":{"hyperthreading": "Disabled"},"fips":true}`,
 		}}
 		id := strfmt.UUID(uuid.New().String())
 		host1 = models.Host{
@@ -67,7 +70,7 @@ var _ = Describe("installcfg", func() {
 
 	It("create_configuration_with_all_hosts", func() {
 		var result InstallerConfigBaremetal
-		data, err := GetInstallConfig(logrus.New(), &cluster, false, "")
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
 		Expect(err).ShouldNot(HaveOccurred())
 		err = yaml.Unmarshal(data, &result)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -77,7 +80,7 @@ var _ = Describe("installcfg", func() {
 	It("create_configuration_with_one_host_disabled", func() {
 		var result InstallerConfigBaremetal
 		host3.Status = swag.String(models.HostStatusDisabled)
-		data, err := GetInstallConfig(logrus.New(), &cluster, false, "")
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
 		Expect(err).ShouldNot(HaveOccurred())
 		err = yaml.Unmarshal(data, &result)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -90,7 +93,7 @@ var _ = Describe("installcfg", func() {
 		proxyURL := "http://proxyserver:3218"
 		cluster.HTTPProxy = proxyURL
 		cluster.HTTPSProxy = proxyURL
-		data, err := GetInstallConfig(logrus.New(), &cluster, false, "")
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
 		Expect(err).ShouldNot(HaveOccurred())
 		err = yaml.Unmarshal(data, &result)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -98,49 +101,195 @@ var _ = Describe("installcfg", func() {
 		Expect(result.Proxy.HTTPSProxy).Should(Equal(proxyURL))
 	})
 
+	It("create_configuration_for_single_node_cluster", func() {
+		var result InstallerConfigBaremetal
+		cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
+		inventory := models.Inventory{
+			Hostname:   "hostname0",
+			Interfaces: []*models.Interface{{MacAddress: "some MAC address"}},
+			Disks: []*models.Disk{
+				{Name: "sda", DriveType: "HDD", SizeBytes: 130 * int64(units.GB)},
+				{Name: "sdb", DriveType: "SSD", SizeBytes: 150 * int64(units.GB)},
+				{Name: "sdc", DriveType: "SSD", SizeBytes: 20 * int64(units.GB)},
+			},
+		}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		host1.Inventory = string(b)
+		cluster.Hosts = []*models.Host{&host1}
+		var hwCfg hardware.ValidatorCfg
+		Expect(envconfig.Process("test", &hwCfg)).ShouldNot(HaveOccurred())
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", hardware.NewValidator(logrus.New(), hwCfg, nil))
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.ControlPlane.Replicas).Should(Equal(1))
+		Expect(result.Compute[0].Replicas).Should(Equal(0))
+		Expect(result.Platform.Baremetal).Should(BeNil())
+		Expect(result.Platform.None).ShouldNot(BeNil())
+		Expect(result.BootstrapInPlace.InstallationDisk).Should(Equal("/dev/sdb"))
+	})
+
+	It("create_configuration_for_user_managed_networking", func() {
+		var result InstallerConfigBaremetal
+		cluster.UserManagedNetworking = swag.Bool(true)
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Platform.Baremetal).Should(BeNil())
+		Expect(result.Platform.None).ShouldNot(BeNil())
+		Expect(result.BootstrapInPlace).Should(BeNil())
+	})
+
 	It("correctly applies cluster overrides", func() {
 		var result InstallerConfigBaremetal
-		data, err := GetInstallConfig(logrus.New(), &cluster, false, "")
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
 		Expect(err).ShouldNot(HaveOccurred())
 		err = yaml.Unmarshal(data, &result)
 		Expect(err).ShouldNot(HaveOccurred())
 		// test that overrides worked
-		Expect(result.Networking.NetworkType).Should(Equal("OVN-Kubernetes"))
+		Expect(result.ControlPlane.Hyperthreading).Should(Equal("Disabled"))
 		Expect(result.FIPS).Should(Equal(true))
 		// test that existing values are kept
 		Expect(result.APIVersion).Should(Equal("v1"))
 		Expect(result.BaseDomain).Should(Equal("redhat.com"))
 	})
 
+	It("applies cluster overrides as a merge patch", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = `{"imageContentSources": [{"source": "quay.io/ocp", "mirrors": ["mirror.example.com/ocp"]}], "proxy": null, "controlPlane": {"hyperthreading": "Disabled"}}`
+		cluster.HTTPProxy = "http://proxy.example.com:3128"
+		cluster.ImageContentSources = `[{"source": "quay.io/openshift", "mirrors": ["mirror.example.com/openshift"]}]`
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		// arrays are replaced and null values remove the field
+		Expect(result.ImageContentSources).Should(HaveLen(1))
+		Expect(result.ImageContentSources[0].Source).Should(Equal("quay.io/ocp"))
+		Expect(result.Proxy).Should(BeNil())
+		// objects are merged
+		Expect(result.ControlPlane.Hyperthreading).Should(Equal("Disabled"))
+		Expect(result.ControlPlane.Name).Should(Equal("master"))
+		Expect(result.Networking.ClusterNetwork).ShouldNot(BeEmpty())
+	})
+
+	It("fails with invalid overrides", func() {
+		cluster.InstallConfigOverrides = `{"networking": {"servceNetwork": ["172.31.0.0/16"]}}`
+		_, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
+		Expect(err).Should(HaveOccurred())
+	})
+
 	It("doesn't fail with empty overrides", func() {
 		var result InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
-		data, err := GetInstallConfig(logrus.New(), &cluster, false, "")
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
 		Expect(err).ShouldNot(HaveOccurred())
 		err = yaml.Unmarshal(data, &result)
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(result.Networking.NetworkType).Should(Equal("OpenShiftSDN"))
 	})
 
+	It("uses the network type of the cluster", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = ""
+		cluster.NetworkType = models.ClusterNetworkTypeOVNKubernetes
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Networking.NetworkType).Should(Equal("OVNKubernetes"))
+	})
+
+	It("create_configuration_for_ipv6_cluster", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = ""
+		cluster.MachineNetworkCidr = "fd2e:6f44:5dd8:c956::/120"
+		cluster.ClusterNetworkCidr = "fd01::/48"
+		cluster.ClusterNetworkHostPrefix = 64
+		cluster.ServiceNetworkCidr = "fd02::/112"
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Networking.NetworkType).Should(Equal("OVNKubernetes"))
+		Expect(result.Networking.MachineNetwork).Should(HaveLen(1))
+		Expect(result.Networking.ClusterNetwork[0].HostPrefix).Should(Equal(64))
+		Expect(result.Networking.ServiceNetwork).Should(Equal([]string{"fd02::/112"}))
+	})
+
+	It("create_configuration_for_dual_stack_cluster", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = ""
+		cluster.MachineNetworkCidr = "1.2.3.0/24"
+		cluster.ClusterNetworkCidr = "10.128.0.0/14"
+		cluster.ClusterNetworkHostPrefix = 23
+		cluster.ServiceNetworkCidr = "172.30.0.0/16"
+		cluster.SecondaryMachineNetworkCidr = "fd2e:6f44:5dd8:c956::/120"
+		cluster.SecondaryClusterNetworkCidr = "fd01::/48"
+		cluster.SecondaryClusterNetworkHostPrefix = 64
+		cluster.SecondaryServiceNetworkCidr = "fd02::/112"
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Networking.NetworkType).Should(Equal("OVNKubernetes"))
+		Expect(result.Networking.MachineNetwork).Should(HaveLen(2))
+		Expect(result.Networking.MachineNetwork[0].Cidr).Should(Equal("1.2.3.0/24"))
+		Expect(result.Networking.MachineNetwork[1].Cidr).Should(Equal("fd2e:6f44:5dd8:c956::/120"))
+		Expect(result.Networking.ClusterNetwork).Should(HaveLen(2))
+		Expect(result.Networking.ClusterNetwork[0].HostPrefix).Should(Equal(23))
+		Expect(result.Networking.ClusterNetwork[1].Cidr).Should(Equal("fd01::/48"))
+		Expect(result.Networking.ClusterNetwork[1].HostPrefix).Should(Equal(64))
+		Expect(result.Networking.ServiceNetwork).Should(Equal([]string{"172.30.0.0/16", "fd02::/112"}))
+	})
+
 	It("CA AdditionalTrustBundle", func() {
 		var result InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
 		ca := "-----BEGIN CERTIFICATE-----\nMIIDozCCAougAwIBAgIULCOqWTF" +
 			"aEA8gNEmV+rb7h1v0r3EwDQYJKoZIhvcNAQELBQAwYTELMAkGA1UEBhMCaXMxCzAJBgNVBAgMAmRk" +
 			"2lyDI6UR3Fbz4pVVAxGXnVhBExjBE=\n-----END CERTIFICATE-----"
-		data, err := GetInstallConfig(logrus.New(), &cluster, true, ca)
+		data, err := GetInstallConfig(logrus.New(), &cluster, true, ca, nil)
 		Expect(err).ShouldNot(HaveOccurred())
 		err = yaml.Unmarshal(data, &result)
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(result.AdditionalTrustBundle).Should(Equal(" | -----BEGIN CERTIFICATE-----\nMIIDozCCAougAwIBAgIULCOqWTF" +
+		Expect(result.AdditionalTrustBundle).Should(Equal("-----BEGIN CERTIFICATE-----\nMIIDozCCAougAwIBAgIULCOqWTF" +
 			"aEA8gNEmV+rb7h1v0r3EwDQYJKoZIhvcNAQELBQAwYTELMAkGA1UEBhMCaXMxCzAJBgNVBAgMAmRk" +
 			"2lyDI6UR3Fbz4pVVAxGXnVhBExjBE=\n-----END CERTIFICATE-----"))
 	})
 
+	It("create_configuration_for_disconnected_cluster", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = ""
+		cluster.ImageContentSources = "- mirrors:\n  - mirror.example.com:5000/ocp4/openshift4\n  source: quay.io/openshift-release-dev/ocp-release\n"
+		cluster.AdditionalTrustBundle = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+		data, err := GetInstallConfig(logrus.New(), &cluster, true, "CA-CERT", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.ImageContentSources).Should(HaveLen(1))
+		Expect(result.ImageContentSources[0].Source).Should(Equal("quay.io/openshift-release-dev/ocp-release"))
+		Expect(result.ImageContentSources[0].Mirrors).Should(Equal([]string{"mirror.example.com:5000/ocp4/openshift4"}))
+		Expect(result.AdditionalTrustBundle).Should(Equal("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\nCA-CERT"))
+	})
+
+	It("CA AdditionalTrustBundle added after the overrides", func() {
+		var result InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = `{"additionalTrustBundle":"OVERRIDE-CERT"}`
+		cluster.AdditionalTrustBundle = "CLUSTER-CERT"
+		data, err := GetInstallConfig(logrus.New(), &cluster, true, "CA-CERT", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.AdditionalTrustBundle).Should(Equal("OVERRIDE-CERT\nCA-CERT"))
+	})
+
 	It("CA AdditionalTrustBundle not added", func() {
 		var result InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
-		data, err := GetInstallConfig(logrus.New(), &cluster, false, "CA-CERT")
+		data, err := GetInstallConfig(logrus.New(), &cluster, false, "CA-CERT", nil)
 		Expect(err).ShouldNot(HaveOccurred())
 		err = yaml.Unmarshal(data, &result)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -155,15 +304,65 @@ var _ = Describe("installcfg", func() {
 
 var _ = Describe("ValidateInstallConfigJSON", func() {
 	It("Succeeds when provided valid json", func() {
-		s := `{"apiVersion": "v3", "baseDomain": "example.com", "metadata": {"name": "things"}}`
+		s := `{"controlPlane": {"hyperthreading": "Disabled"}, "imageContentSources": [{"source": "quay.io/ocp", "mirrors": ["mirror.example.com/ocp"]}], "fips": true, "proxy": null}`
 		err := ValidateInstallConfigJSON(s)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
 
 	It("Fails when provided invalid json", func() {
-		s := `{"apiVersion": 3, "baseDomain": "example.com", "metadata": {"name": "things"}}`
+		s := `{"fips": true`
+		err := ValidateInstallConfigJSON(s)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("Fails when provided a value that is not an object", func() {
+		Expect(ValidateInstallConfigJSON(`["fips"]`)).Should(HaveOccurred())
+	})
+
+	It("Fails with an error per invalid field", func() {
+		s := `{"pullSecret": "secret", "platform": {"baremetal": {"hosts": []}}, "networking": {"servceNetwork": ["172.30.0.0/16"], "clusterNetwork": [{"hostPrefix": 23}]}, "fips": "yes"}`
 		err := ValidateInstallConfigJSON(s)
 		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("fips: must be a boolean"))
+		Expect(err.Error()).Should(ContainSubstring("networking.clusterNetwork: field cannot be overridden"))
+		Expect(err.Error()).Should(ContainSubstring("networking.servceNetwork: unknown field"))
+		Expect(err.Error()).Should(ContainSubstring("platform.baremetal.hosts: field cannot be overridden"))
+		Expect(err.Error()).Should(ContainSubstring("pullSecret: field cannot be overridden"))
+		overridesErr, ok := err.(*OverridesError)
+		Expect(ok).Should(BeTrue())
+		Expect(overridesErr.FieldErrors).Should(Equal([]FieldError{
+			{Field: "fips", Message: "must be a boolean"},
+			{Field: "networking.clusterNetwork", Message: "field cannot be overridden"},
+			{Field: "networking.servceNetwork", Message: "unknown field"},
+			{Field: "platform.baremetal.hosts", Message: "field cannot be overridden"},
+			{Field: "pullSecret", Message: "field cannot be overridden"},
+		}))
+	})
+
+	It("Fails when the network fields are overridden", func() {
+		s := `{"networking": {"networkType": "OVNKubernetes", "machineNetwork": [{"cidr": "10.0.0.0/16"}], "serviceNetwork": ["172.30.0.0/16"]}, "compute": [{"hyperthreading": "Disabled"}]}`
+		err := ValidateInstallConfigJSON(s)
+		Expect(err).Should(HaveOccurred())
+		overridesErr, ok := err.(*OverridesError)
+		Expect(ok).Should(BeTrue())
+		Expect(overridesErr.FieldErrors).Should(Equal([]FieldError{
+			{Field: "compute", Message: "field cannot be overridden"},
+			{Field: "networking.machineNetwork", Message: "field cannot be overridden"},
+			{Field: "networking.networkType", Message: "field cannot be overridden"},
+			{Field: "networking.serviceNetwork", Message: "field cannot be overridden"},
+		}))
+	})
+
+	It("Fails when a null removes fields that cannot be overridden", func() {
+		for _, s := range []string{`{"platform": null}`, `{"platform": {"baremetal": null}}`, `{"controlPlane": null}`} {
+			err := ValidateInstallConfigJSON(s)
+			Expect(err).Should(HaveOccurred(), s)
+			Expect(err.Error()).Should(ContainSubstring("cannot be removed, it contains fields that cannot be overridden"), s)
+		}
+	})
+
+	It("Succeeds when a null removes fields that can be overridden", func() {
+		Expect(ValidateInstallConfigJSON(`{"proxy": null, "imageContentSources": null, "platform": {"baremetal": {"provisioningNetwork": null}}}`)).ShouldNot(HaveOccurred())
 	})
 })
 
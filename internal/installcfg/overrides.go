@@ -0,0 +1,236 @@
+package installcfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+type fieldKind int
+
+const (
+	stringField fieldKind = iota
+	booleanField
+	objectField
+	arrayField
+	forbiddenField
+)
+
+// fieldSchema describes an install-config field that can be overridden, objects list their fields and arrays their
+// items. Forbidden fields are the fields that the service sets from the cluster and its hosts
+type fieldSchema struct {
+	kind   fieldKind
+	fields map[string]*fieldSchema
+	items  *fieldSchema
+}
+
+func stringSchema() *fieldSchema {
+	return &fieldSchema{kind: stringField}
+}
+
+func booleanSchema() *fieldSchema {
+	return &fieldSchema{kind: booleanField}
+}
+
+func forbiddenSchema() *fieldSchema {
+	return &fieldSchema{kind: forbiddenField}
+}
+
+func objectSchema(fields map[string]*fieldSchema) *fieldSchema {
+	return &fieldSchema{kind: objectField, fields: fields}
+}
+
+func arraySchema(items *fieldSchema) *fieldSchema {
+	return &fieldSchema{kind: arrayField, items: items}
+}
+
+func machinePoolSchema() *fieldSchema {
+	return objectSchema(map[string]*fieldSchema{
+		"hyperthreading": stringSchema(),
+		"name":           forbiddenSchema(),
+		"replicas":       forbiddenSchema(),
+	})
+}
+
+// overridesSchema is the allow-list of the install-config fields that the overrides of a cluster may set
+var overridesSchema = objectSchema(map[string]*fieldSchema{
+	"apiVersion": forbiddenSchema(),
+	"baseDomain": forbiddenSchema(),
+	"metadata":   forbiddenSchema(),
+	"pullSecret": forbiddenSchema(),
+	"sshKey":     forbiddenSchema(),
+	"proxy": objectSchema(map[string]*fieldSchema{
+		"httpProxy":  stringSchema(),
+		"httpsProxy": stringSchema(),
+		"noProxy":    stringSchema(),
+	}),
+	// the networks and the network type are set from the cluster fields, which are validated against its hosts
+	"networking": objectSchema(map[string]*fieldSchema{
+		"networkType":    forbiddenSchema(),
+		"clusterNetwork": forbiddenSchema(),
+		"machineNetwork": forbiddenSchema(),
+		"serviceNetwork": forbiddenSchema(),
+	}),
+	// a merge patch replaces whole arrays, so overriding the compute pools would drop their names and replicas
+	"compute":      forbiddenSchema(),
+	"controlPlane": machinePoolSchema(),
+	"platform": objectSchema(map[string]*fieldSchema{
+		"baremetal": objectSchema(map[string]*fieldSchema{
+			"provisioningNetwork": stringSchema(),
+			"apiVIP":              forbiddenSchema(),
+			"ingressVIP":          forbiddenSchema(),
+			"hosts":               forbiddenSchema(),
+		}),
+		"none": forbiddenSchema(),
+	}),
+	"fips":                  booleanSchema(),
+	"additionalTrustBundle": stringSchema(),
+	"imageContentSources": arraySchema(objectSchema(map[string]*fieldSchema{
+		"mirrors": arraySchema(stringSchema()),
+		"source":  stringSchema(),
+	})),
+	"bootstrapInPlace": forbiddenSchema(),
+})
+
+// FieldError is an invalid field of the install config overrides, the field is its path in the install config
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// OverridesError is the error of install config overrides that set fields that they may not set
+type OverridesError struct {
+	FieldErrors []FieldError
+}
+
+func (e *OverridesError) Error() string {
+	msgs := make([]string, 0, len(e.FieldErrors))
+	for _, fieldErr := range e.FieldErrors {
+		if fieldErr.Field == "" {
+			msgs = append(msgs, fieldErr.Message)
+		} else {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", fieldErr.Field, fieldErr.Message))
+		}
+	}
+	return fmt.Sprintf("invalid install config overrides: %s", strings.Join(msgs, ", "))
+}
+
+func fieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// hasForbiddenField returns true if the schema is forbidden or contains a forbidden field at any depth
+func hasForbiddenField(schema *fieldSchema) bool {
+	switch schema.kind {
+	case forbiddenField:
+		return true
+	case arrayField:
+		return hasForbiddenField(schema.items)
+	case objectField:
+		for _, field := range schema.fields {
+			if hasForbiddenField(field) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validateField returns an error for every field of the value that the schema does not allow, null values of allowed
+// fields are valid as they remove the field in a merge patch, unless removing it would remove a forbidden field
+func validateField(path string, value interface{}, schema *fieldSchema) []FieldError {
+	if schema.kind == forbiddenField {
+		return []FieldError{{Field: path, Message: "field cannot be overridden"}}
+	}
+	if value == nil {
+		if hasForbiddenField(schema) {
+			return []FieldError{{Field: path, Message: "cannot be removed, it contains fields that cannot be overridden"}}
+		}
+		return nil
+	}
+	switch schema.kind {
+	case stringField:
+		if _, ok := value.(string); !ok {
+			return []FieldError{{Field: path, Message: "must be a string"}}
+		}
+	case booleanField:
+		if _, ok := value.(bool); !ok {
+			return []FieldError{{Field: path, Message: "must be a boolean"}}
+		}
+	case arrayField:
+		items, ok := value.([]interface{})
+		if !ok {
+			return []FieldError{{Field: path, Message: "must be an array"}}
+		}
+		var errs []FieldError
+		for i, item := range items {
+			errs = append(errs, validateField(fmt.Sprintf("%s[%d]", path, i), item, schema.items)...)
+		}
+		return errs
+	case objectField:
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			if path == "" {
+				return []FieldError{{Message: "install config overrides must be a JSON object"}}
+			}
+			return []FieldError{{Field: path, Message: "must be an object"}}
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var errs []FieldError
+		for _, name := range names {
+			fieldSchema, ok := schema.fields[name]
+			if !ok {
+				errs = append(errs, FieldError{Field: fieldPath(path, name), Message: "unknown field"})
+				continue
+			}
+			errs = append(errs, validateField(fieldPath(path, name), fields[name], fieldSchema)...)
+		}
+		return errs
+	}
+	return nil
+}
+
+// ValidateInstallConfigJSON validates that the install config overrides are a JSON merge patch of the fields that
+// the overrides may set, an *OverridesError lists every invalid field
+func ValidateInstallConfigJSON(s string) error {
+	var overrides interface{}
+	if err := json.Unmarshal([]byte(s), &overrides); err != nil {
+		return errors.Wrap(err, "install config overrides are not valid JSON")
+	}
+	if errs := validateField("", overrides, overridesSchema); len(errs) > 0 {
+		return &OverridesError{FieldErrors: errs}
+	}
+	return nil
+}
+
+// applyConfigOverrides applies the overrides as a JSON merge patch (RFC 7386) onto the generated install config,
+// objects are merged field by field while arrays and other values are replaced
+func applyConfigOverrides(overrides string, cfg []byte) ([]byte, error) {
+	if overrides == "" {
+		return cfg, nil
+	}
+	if err := ValidateInstallConfigJSON(overrides); err != nil {
+		return nil, err
+	}
+	cfgJSON, err := k8syaml.YAMLToJSON(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert the install config to JSON")
+	}
+	merged, err := jsonpatch.MergePatch(cfgJSON, []byte(overrides))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to apply the install config overrides")
+	}
+	return k8syaml.JSONToYAML(merged)
+}
@@ -0,0 +1,92 @@
+package manifests
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v2"
+)
+
+var allowedExtensions = []string{".yaml", ".yml", ".json"}
+
+// GetPrefix returns the prefix of the objects of the manifests of the cluster
+func GetPrefix(clusterID string) string {
+	return fmt.Sprintf("%s/manifests/", clusterID)
+}
+
+// GetObjectName returns the name of the object that stores the manifest of the cluster
+func GetObjectName(clusterID, folder, fileName string) string {
+	return fmt.Sprintf("%s%s/%s", GetPrefix(clusterID), folder, fileName)
+}
+
+// parseObjectName returns the manifest that the object stores, objects that are not in a manifests folder are ignored
+func parseObjectName(clusterID, objectName string) (*models.Manifest, bool) {
+	parts := strings.Split(strings.TrimPrefix(objectName, GetPrefix(clusterID)), "/")
+	if len(parts) != 2 || !funk.ContainsString([]string{models.ManifestFolderManifests, models.ManifestFolderOpenshift}, parts[0]) {
+		return nil, false
+	}
+	return &models.Manifest{Folder: parts[0], FileName: parts[1]}, true
+}
+
+// ValidateFileName verifies that the file name of the manifest is a plain YAML or JSON file name, so that the object
+// name of the manifest stays in the manifests folder of its cluster
+func ValidateFileName(fileName string) error {
+	if fileName == "" || filepath.Base(fileName) != fileName || strings.HasPrefix(fileName, ".") {
+		return errors.Errorf("Manifest file name %s is not a valid file name", fileName)
+	}
+	if !funk.ContainsString(allowedExtensions, strings.ToLower(filepath.Ext(fileName))) {
+		return errors.Errorf("Manifest %s must be one of the file types %s", fileName, allowedExtensions)
+	}
+	return nil
+}
+
+// ValidateManifest verifies that the file name of the manifest is a plain YAML or JSON file name and that the content
+// is a YAML (or JSON) document
+func ValidateManifest(fileName string, content []byte) error {
+	if err := ValidateFileName(fileName); err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(string(content))) == 0 {
+		return errors.Errorf("Manifest %s is empty", fileName)
+	}
+	var manifest interface{}
+	if err := yaml.Unmarshal(content, &manifest); err != nil {
+		return errors.Wrapf(err, "Manifest %s is not a valid YAML or JSON document", fileName)
+	}
+	return nil
+}
+
+// ListManifests returns the manifests of the cluster
+func ListManifests(ctx context.Context, objectHandler s3wrapper.API, clusterID string) ([]*models.Manifest, error) {
+	objectNames, err := objectHandler.ListObjectsByPrefix(ctx, GetPrefix(clusterID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the manifests of cluster %s", clusterID)
+	}
+	manifests := make([]*models.Manifest, 0, len(objectNames))
+	for _, objectName := range objectNames {
+		if manifest, ok := parseObjectName(clusterID, objectName); ok {
+			manifests = append(manifests, manifest)
+		}
+	}
+	return manifests, nil
+}
+
+// DeleteManifests removes all the manifests of the cluster
+func DeleteManifests(ctx context.Context, objectHandler s3wrapper.API, clusterID string) error {
+	objectNames, err := objectHandler.ListObjectsByPrefix(ctx, GetPrefix(clusterID))
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the manifests of cluster %s", clusterID)
+	}
+	for _, objectName := range objectNames {
+		if err = objectHandler.DeleteObject(ctx, objectName); err != nil {
+			return errors.Wrapf(err, "failed to delete the manifest %s of cluster %s", objectName, clusterID)
+		}
+	}
+	return nil
+}
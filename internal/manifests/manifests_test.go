@@ -0,0 +1,85 @@
+package manifests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+)
+
+func TestManifests(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Manifests Suite")
+}
+
+var _ = Describe("manifests", func() {
+	var (
+		ctx          = context.Background()
+		ctrl         *gomock.Controller
+		mockS3Client *s3wrapper.MockAPI
+		clusterID    = "a640ef36-dcb1-11ea-87d0-0242ac130003"
+		content      = []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("validates the manifests", func() {
+		Expect(ValidateManifest("99-test.yaml", content)).To(Succeed())
+		Expect(ValidateManifest("99-test.json", []byte(`{"kind": "ConfigMap"}`))).To(Succeed())
+		Expect(ValidateManifest("99-test.txt", content)).ToNot(Succeed())
+		Expect(ValidateManifest("../99-test.yaml", content)).ToNot(Succeed())
+		Expect(ValidateManifest(".yaml", content)).ToNot(Succeed())
+		Expect(ValidateManifest("99-test.yaml", []byte(" \n"))).ToNot(Succeed())
+		Expect(ValidateManifest("99-test.yaml", []byte("kind: [ConfigMap"))).ToNot(Succeed())
+	})
+
+	It("validates the file names of the manifests", func() {
+		Expect(ValidateFileName("99-test.yaml")).To(Succeed())
+		for _, fileName := range []string{"", "../99-test.yaml", "../../../" + clusterID + "/kubeconfig", "a/99-test.yaml", "..", ".yaml", "99-test"} {
+			Expect(ValidateFileName(fileName)).ToNot(Succeed(), fileName)
+		}
+	})
+
+	It("lists the manifests of the cluster", func() {
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, GetPrefix(clusterID)).Return([]string{
+			GetObjectName(clusterID, models.ManifestFolderManifests, "50-a.yaml"),
+			GetObjectName(clusterID, models.ManifestFolderOpenshift, "99-b.yaml"),
+			GetPrefix(clusterID) + "other/99-c.yaml",
+		}, nil).Times(1)
+		manifests, err := ListManifests(ctx, mockS3Client, clusterID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(Equal([]*models.Manifest{
+			{Folder: models.ManifestFolderManifests, FileName: "50-a.yaml"},
+			{Folder: models.ManifestFolderOpenshift, FileName: "99-b.yaml"},
+		}))
+	})
+
+	It("deletes the manifests of the cluster", func() {
+		objectNames := []string{
+			GetObjectName(clusterID, models.ManifestFolderManifests, "50-a.yaml"),
+			GetPrefix(clusterID) + "other/99-c.yaml",
+		}
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, GetPrefix(clusterID)).Return(objectNames, nil).Times(1)
+		for _, objectName := range objectNames {
+			mockS3Client.EXPECT().DeleteObject(ctx, objectName).Return(nil).Times(1)
+		}
+		Expect(DeleteManifests(ctx, mockS3Client, clusterID)).To(Succeed())
+	})
+
+	It("fails to delete the manifests when they can't be listed", func() {
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, GetPrefix(clusterID)).Return(nil, errors.New("error")).Times(1)
+		Expect(DeleteManifests(ctx, mockS3Client, clusterID)).ToNot(Succeed())
+	})
+})
@@ -28,6 +28,8 @@ const (
 	counterClusterHostRAMGb             = "assisted_installer_cluster_host_ram_gb"
 	counterClusterHostDiskGb            = "assisted_installer_cluster_host_disk_gb"
 	counterClusterHostNicGb             = "assisted_installer_cluster_host_nic_gb"
+	gaugeImageBuildQueueDepth           = "assisted_installer_image_build_queue_depth"
+	histogramImageBuildQueueWaitSeconds = "assisted_installer_image_build_queue_wait_seconds"
 )
 
 const (
@@ -40,6 +42,8 @@ const (
 	counterDescriptionClusterHostRAMGb             = "Histogram/sum/count of physical RAM in hosts of completed clusters, by role, result, and OCP version"
 	counterDescriptionClusterHostDiskGb            = "Histogram/sum/count of installation disk capacity in hosts of completed clusters, by type, raid (level), role, result, and OCP version"
 	counterDescriptionClusterHostNicGb             = "Histogram/sum/count of management network NIC speed in hosts of completed clusters, by role, result, and OCP version"
+	gaugeDescriptionImageBuildQueueDepth           = "Number of image builds that are queued and wait for a free build slot"
+	histogramDescriptionImageBuildQueueWaitSeconds = "Histogram/sum/count of the time image builds waited in the queue until a service replica started them"
 )
 
 const (
@@ -58,6 +62,8 @@ type API interface {
 	InstallationStarted(clusterVersion string)
 	ClusterInstallationFinished(log logrus.FieldLogger, result, clusterVersion string, installationStratedTime strfmt.DateTime)
 	ReportHostInstallationMetrics(log logrus.FieldLogger, clusterVersion string, h *models.Host, previousProgress *models.HostProgressInfo, currentStage models.HostStage)
+	ImageBuildQueueDepth(depth int)
+	ImageBuildStarted(queuedAt strfmt.DateTime)
 }
 
 type MetricsManager struct {
@@ -72,6 +78,8 @@ type MetricsManager struct {
 	serviceLogicClusterHostRAMGb             *prometheus.HistogramVec
 	serviceLogicClusterHostDiskGb            *prometheus.HistogramVec
 	serviceLogicClusterHostNicGb             *prometheus.HistogramVec
+	serviceLogicImageBuildQueueDepth         prometheus.Gauge
+	serviceLogicImageBuildQueueWaitSeconds   prometheus.Histogram
 }
 
 func NewMetricsManager(registry prometheus.Registerer) *MetricsManager {
@@ -152,6 +160,21 @@ func NewMetricsManager(registry prometheus.Registerer) *MetricsManager {
 			Help:      counterDescriptionClusterHostNicGb,
 			Buckets:   []float64{1, 10, 20, 40, 100},
 		}, []string{roleLabel, resultLabel, openshiftVersionLabel}),
+
+		serviceLogicImageBuildQueueDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      gaugeImageBuildQueueDepth,
+			Help:      gaugeDescriptionImageBuildQueueDepth,
+		}),
+
+		serviceLogicImageBuildQueueWaitSeconds: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      histogramImageBuildQueueWaitSeconds,
+			Help:      histogramDescriptionImageBuildQueueWaitSeconds,
+			Buckets:   []float64{.1, .5, 1, 2.5, 5, 10, 20, 30, 60, 120, 180, 300, 600, 900, 1200, 1800},
+		}),
 	}
 
 	registry.MustRegister(
@@ -164,6 +187,8 @@ func NewMetricsManager(registry prometheus.Registerer) *MetricsManager {
 		m.serviceLogicClusterHostRAMGb,
 		m.serviceLogicClusterHostDiskGb,
 		m.serviceLogicClusterHostNicGb,
+		m.serviceLogicImageBuildQueueDepth,
+		m.serviceLogicImageBuildQueueWaitSeconds,
 	)
 	return m
 }
@@ -210,6 +235,14 @@ func (m *MetricsManager) ReportHostInstallationMetrics(log logrus.FieldLogger, c
 	}
 }
 
+func (m *MetricsManager) ImageBuildQueueDepth(depth int) {
+	m.serviceLogicImageBuildQueueDepth.Set(float64(depth))
+}
+
+func (m *MetricsManager) ImageBuildStarted(queuedAt strfmt.DateTime) {
+	m.serviceLogicImageBuildQueueWaitSeconds.Observe(time.Since(time.Time(queuedAt)).Seconds())
+}
+
 func (m *MetricsManager) handleHostInstallationComplete(log logrus.FieldLogger, clusterVersion string, roleStr string, installationStageStr string, h *models.Host) {
 	log.Infof("service Logic Cluster Hosts clusterVersion %s, roleStr %s, result %s",
 		clusterVersion, roleStr, installationStageStr)
@@ -82,3 +82,27 @@ func (mr *MockAPIMockRecorder) ReportHostInstallationMetrics(log, clusterVersion
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportHostInstallationMetrics", reflect.TypeOf((*MockAPI)(nil).ReportHostInstallationMetrics), log, clusterVersion, h, previousProgress, currentStage)
 }
+
+// ImageBuildQueueDepth mocks base method
+func (m *MockAPI) ImageBuildQueueDepth(depth int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ImageBuildQueueDepth", depth)
+}
+
+// ImageBuildQueueDepth indicates an expected call of ImageBuildQueueDepth
+func (mr *MockAPIMockRecorder) ImageBuildQueueDepth(depth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageBuildQueueDepth", reflect.TypeOf((*MockAPI)(nil).ImageBuildQueueDepth), depth)
+}
+
+// ImageBuildStarted mocks base method
+func (m *MockAPI) ImageBuildStarted(queuedAt strfmt.DateTime) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ImageBuildStarted", queuedAt)
+}
+
+// ImageBuildStarted indicates an expected call of ImageBuildStarted
+func (mr *MockAPIMockRecorder) ImageBuildStarted(queuedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageBuildStarted", reflect.TypeOf((*MockAPI)(nil).ImageBuildStarted), queuedAt)
+}
@@ -29,20 +29,32 @@ func VerifyCIDRsNotOverlap(aCidrStr, bCidrStr string) error {
 	return verifyCIDRsNotOverlap(acidr, bcidr)
 }
 
+// IsIPv6CIDR returns true if the CIDR is an IPv6 network
+func IsIPv6CIDR(cidrStr string) bool {
+	ip, _, err := net.ParseCIDR(cidrStr)
+	return err == nil && ip.To4() == nil
+}
+
+// IsIPv6Addr returns true if the address is an IPv6 address
+func IsIPv6Addr(addr string) bool {
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.To4() == nil
+}
+
 // SubnetCIDR checks if the given IP net is a valid CIDR.
 func VerifySubnetCIDR(cidrStr string) error {
 	ip, cidr, err := net.ParseCIDR(cidrStr)
 	if err != nil {
 		return err
 	}
-	ones, _ := cidr.Mask.Size()
-	if ones < 1 || ones > 25 {
-		return errors.New("Address mask size must be between 1 to 25 and must include at least 128 addresses")
+	ones, bits := cidr.Mask.Size()
+	if ones < 1 || bits-ones < 7 {
+		return errors.Errorf("Address mask size must be between 1 to %d and must include at least 128 addresses", bits-7)
 	}
 	if cidr.IP.IsUnspecified() {
 		return errors.New("address must not be unspecified.  Unspecified address is the zero address (0.0.0.0)")
 	}
-	if ip.To4().String() != cidr.IP.To4().String() {
+	if !ip.Equal(cidr.IP) {
 		return errors.Errorf("invalid network address. got %s, expecting %s", (&net.IPNet{IP: ip, Mask: cidr.Mask}).String(), cidr.String())
 	}
 	return nil
@@ -64,9 +76,54 @@ func VerifyClusterCIDRsNotOverlap(machineNetworkCidr, clusterNetworkCidr, servic
 	return nil
 }
 
-func VerifyNetworkHostPrefix(prefix int64) error {
-	if prefix < 1 || prefix > 25 {
-		return errors.Errorf("Network prefix %d is out of the allowed range (1 , 25)", prefix)
+// VerifyNetworkHostPrefix verifies the host prefix of a cluster network, the host prefix of an IPv6 cluster network
+// may be longer than the one of an IPv4 network
+func VerifyNetworkHostPrefix(prefix int64, clusterNetworkCidr string) error {
+	maxPrefix := int64(25)
+	if IsIPv6CIDR(clusterNetworkCidr) {
+		maxPrefix = 121
+	}
+	if prefix < 1 || prefix > maxPrefix {
+		return errors.Errorf("Network prefix %d is out of the allowed range (1 , %d)", prefix, maxPrefix)
+	}
+	return nil
+}
+
+// VerifyNetworksFamily verifies that all the networks are of the same IP family, empty networks are ignored
+func VerifyNetworksFamily(ipv6 bool, cidrs ...string) error {
+	for _, cidr := range cidrs {
+		if cidr != "" && IsIPv6CIDR(cidr) != ipv6 {
+			family := "IPv4"
+			if ipv6 {
+				family = "IPv6"
+			}
+			return errors.Errorf("%s is not an %s network", cidr, family)
+		}
+	}
+	return nil
+}
+
+// VerifyDualStackNetworks verifies the networks of a cluster. All the primary networks of a cluster are of the same
+// IP family. A dual-stack cluster also has secondary networks, they are set together and are IPv6 networks, while its
+// primary networks are IPv4 networks.
+func VerifyDualStackNetworks(machineNetworkCidr, clusterNetworkCidr, serviceNetworkCidr,
+	secondaryMachineNetworkCidr, secondaryClusterNetworkCidr, secondaryServiceNetworkCidr string) error {
+	primary := nonEmpty(clusterNetworkCidr, serviceNetworkCidr, machineNetworkCidr)
+	primaryIPv6 := len(primary) > 0 && IsIPv6CIDR(primary[0])
+	if err := VerifyNetworksFamily(primaryIPv6, primary...); err != nil {
+		return errors.Wrap(err, "the primary networks must be of the same IP family")
+	}
+	if secondaryMachineNetworkCidr == "" && secondaryClusterNetworkCidr == "" && secondaryServiceNetworkCidr == "" {
+		return nil
+	}
+	if secondaryMachineNetworkCidr == "" || secondaryClusterNetworkCidr == "" || secondaryServiceNetworkCidr == "" {
+		return errors.New("the secondary machine, cluster and service networks of a dual-stack cluster must be set together")
+	}
+	if primaryIPv6 {
+		return errors.New("the primary networks of a dual-stack cluster must be IPv4 networks")
+	}
+	if err := VerifyNetworksFamily(true, secondaryMachineNetworkCidr, secondaryClusterNetworkCidr, secondaryServiceNetworkCidr); err != nil {
+		return errors.Wrap(err, "the secondary networks of a dual-stack cluster must be IPv6 networks")
 	}
 	return nil
 }
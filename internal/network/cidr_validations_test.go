@@ -0,0 +1,53 @@
+package network
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cidr validations", func() {
+	Context("VerifySubnetCIDR", func() {
+		It("IPv4", func() {
+			Expect(VerifySubnetCIDR("10.128.0.0/14")).ToNot(HaveOccurred())
+			Expect(VerifySubnetCIDR("10.128.0.0/26")).To(HaveOccurred())
+			Expect(VerifySubnetCIDR("10.128.0.1/14")).To(HaveOccurred())
+		})
+		It("IPv6", func() {
+			Expect(VerifySubnetCIDR("fd01::/48")).ToNot(HaveOccurred())
+			Expect(VerifySubnetCIDR("fd02::/112")).ToNot(HaveOccurred())
+			Expect(VerifySubnetCIDR("fd02::/122")).To(HaveOccurred())
+			Expect(VerifySubnetCIDR("fd01::1/48")).To(HaveOccurred())
+			Expect(VerifySubnetCIDR("::/48")).To(HaveOccurred())
+		})
+	})
+
+	It("VerifyNetworkHostPrefix", func() {
+		Expect(VerifyNetworkHostPrefix(23, "10.128.0.0/14")).ToNot(HaveOccurred())
+		Expect(VerifyNetworkHostPrefix(64, "10.128.0.0/14")).To(HaveOccurred())
+		Expect(VerifyNetworkHostPrefix(64, "fd01::/48")).ToNot(HaveOccurred())
+		Expect(VerifyNetworkHostPrefix(0, "fd01::/48")).To(HaveOccurred())
+	})
+
+	Context("VerifyDualStackNetworks", func() {
+		It("single stack", func() {
+			Expect(VerifyDualStackNetworks("1.2.3.0/24", "10.128.0.0/14", "172.30.0.0/16", "", "", "")).ToNot(HaveOccurred())
+			Expect(VerifyDualStackNetworks("fd00::/64", "fd01::/48", "fd02::/112", "", "", "")).ToNot(HaveOccurred())
+			Expect(VerifyDualStackNetworks("", "fd01::/48", "fd02::/112", "", "", "")).ToNot(HaveOccurred())
+		})
+		It("mixed primary families", func() {
+			Expect(VerifyDualStackNetworks("fd00::/64", "10.128.0.0/14", "172.30.0.0/16", "", "", "")).To(HaveOccurred())
+		})
+		It("dual stack", func() {
+			Expect(VerifyDualStackNetworks("1.2.3.0/24", "10.128.0.0/14", "172.30.0.0/16",
+				"fd00::/64", "fd01::/48", "fd02::/112")).ToNot(HaveOccurred())
+		})
+		It("partial secondary networks", func() {
+			Expect(VerifyDualStackNetworks("1.2.3.0/24", "10.128.0.0/14", "172.30.0.0/16",
+				"fd00::/64", "", "fd02::/112")).To(HaveOccurred())
+		})
+		It("IPv6 primary networks", func() {
+			Expect(VerifyDualStackNetworks("fd00::/64", "fd01::/48", "fd02::/112",
+				"1.2.3.0/24", "10.128.0.0/14", "172.30.0.0/16")).To(HaveOccurred())
+		})
+	})
+})
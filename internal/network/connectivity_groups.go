@@ -0,0 +1,265 @@
+package network
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// connectivity holds the connectivity that every host reported to each of the other hosts
+type connectivity map[strfmt.UUID]map[strfmt.UUID]*models.ConnectivityRemoteHost
+
+func parseConnectivity(hosts []*models.Host) (connectivity, error) {
+	ret := make(connectivity)
+	for _, h := range hosts {
+		if h.Connectivity == "" {
+			continue
+		}
+		var report models.ConnectivityReport
+		if err := json.Unmarshal([]byte(h.Connectivity), &report); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the connectivity report of host %s", h.ID.String())
+		}
+		remoteHosts := make(map[strfmt.UUID]*models.ConnectivityRemoteHost)
+		for _, r := range report.RemoteHosts {
+			remoteHosts[r.HostID] = r
+		}
+		ret[*h.ID] = remoteHosts
+	}
+	return ret, nil
+}
+
+func (c connectivity) hasReport(hostID strfmt.UUID) bool {
+	_, ok := c[hostID]
+	return ok
+}
+
+func inMachineNetwork(ipAddr, machineNetworkCidr string) bool {
+	if machineNetworkCidr == "" {
+		return true
+	}
+	in, err := IpInCidr(ipAddr, machineNetworkCidr)
+	return err == nil && in
+}
+
+func isL2Connected(remote *models.ConnectivityRemoteHost, machineNetworkCidr string) bool {
+	if remote == nil {
+		return false
+	}
+	for _, l2 := range remote.L2Connectivity {
+		if l2.Successful && inMachineNetwork(l2.RemoteIPAddress, machineNetworkCidr) {
+			return true
+		}
+	}
+	return false
+}
+
+func isL3Connected(remote *models.ConnectivityRemoteHost, machineNetworkCidr string) bool {
+	if remote == nil {
+		return false
+	}
+	for _, l3 := range remote.L3Connectivity {
+		if l3.Successful && inMachineNetwork(l3.RemoteIPAddress, machineNetworkCidr) {
+			return true
+		}
+	}
+	return false
+}
+
+// reaches returns true if the host reported that it reached the remote host on the machine network
+func (c connectivity) reaches(hostID, remoteHostID strfmt.UUID, machineNetworkCidr string) bool {
+	remote := c[hostID][remoteHostID]
+	return isL2Connected(remote, machineNetworkCidr) || isL3Connected(remote, machineNetworkCidr)
+}
+
+// connected returns true if both hosts reported that they reached each other on the machine network
+func (c connectivity) connected(a, b strfmt.UUID, machineNetworkCidr string) bool {
+	return c.reaches(a, b, machineNetworkCidr) && c.reaches(b, a, machineNetworkCidr)
+}
+
+// CreateConnectivityMatrix returns the connectivity of every ordered pair of the given hosts, as reported by the
+// first host of the pair. A pair is L2 or L3 connected when a successful check reached an address of the remote host
+// on the machine network.
+func CreateConnectivityMatrix(hosts []*models.Host, machineNetworkCidr string) (models.ConnectivityMatrix, error) {
+	c, err := parseConnectivity(hosts)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(models.ConnectivityMatrix, 0)
+	for _, h := range hosts {
+		for _, r := range hosts {
+			if *h.ID == *r.ID {
+				continue
+			}
+			entry := &models.ConnectivityMatrixEntry{
+				HostID:         h.ID,
+				RemoteHostID:   r.ID,
+				L2Connected:    swag.Bool(false),
+				L3Connected:    swag.Bool(false),
+				L2Connectivity: []*models.L2Connectivity{},
+				L3Connectivity: []*models.L3Connectivity{},
+			}
+			if remote := c[*h.ID][*r.ID]; remote != nil {
+				entry.L2Connected = swag.Bool(isL2Connected(remote, machineNetworkCidr))
+				entry.L3Connected = swag.Bool(isL3Connected(remote, machineNetworkCidr))
+				if remote.L2Connectivity != nil {
+					entry.L2Connectivity = remote.L2Connectivity
+				}
+				if remote.L3Connectivity != nil {
+					entry.L3Connectivity = remote.L3Connectivity
+				}
+			}
+			ret = append(ret, entry)
+		}
+	}
+	return ret, nil
+}
+
+// HostsPair is a pair of hosts that can not reach each other on the machine network
+type HostsPair struct {
+	Host       *models.Host
+	RemoteHost *models.Host
+}
+
+// GetDisconnectedHosts returns every pair of the given hosts that did not report that they reached each other on the
+// machine network, together with the hosts that did not report their connectivity yet
+func GetDisconnectedHosts(hosts []*models.Host, machineNetworkCidr string) ([]HostsPair, []*models.Host, error) {
+	c, err := parseConnectivity(hosts)
+	if err != nil {
+		return nil, nil, err
+	}
+	var disconnected []HostsPair
+	var missingReports []*models.Host
+	for i, h := range hosts {
+		if !c.hasReport(*h.ID) {
+			missingReports = append(missingReports, h)
+			continue
+		}
+		for _, r := range hosts[i+1:] {
+			if c.hasReport(*r.ID) && !c.connected(*h.ID, *r.ID, machineNetworkCidr) {
+				disconnected = append(disconnected, HostsPair{Host: h, RemoteHost: r})
+			}
+		}
+	}
+	return disconnected, missingReports, nil
+}
+
+// GetMajorityGroup returns the largest group of hosts that reach each other on the machine network, out of the hosts
+// that reported their connectivity. Between groups of the same size, the one with the lowest host IDs is chosen.
+func GetMajorityGroup(hosts []*models.Host, machineNetworkCidr string) ([]strfmt.UUID, error) {
+	c, err := parseConnectivity(hosts)
+	if err != nil {
+		return nil, err
+	}
+	var ids []strfmt.UUID
+	for _, h := range hosts {
+		if c.hasReport(*h.ID) {
+			ids = append(ids, *h.ID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	// the hosts are referred to by their index in the sorted IDs, so a group of indexes sorts like its IDs
+	adjacent := make([][]bool, len(ids))
+	for i := range ids {
+		adjacent[i] = make([]bool, len(ids))
+	}
+	for i := range ids {
+		for j := i + 1; j < len(ids); j++ {
+			adjacent[i][j] = c.connected(ids[i], ids[j], machineNetworkCidr)
+			adjacent[j][i] = adjacent[i][j]
+		}
+	}
+	candidates := make([]int, len(ids))
+	for i := range candidates {
+		candidates[i] = i
+	}
+
+	var best []int
+	findMaximalGroups(adjacent, nil, candidates, nil, func(group []int) {
+		if len(group) > len(best) || (len(group) == len(best) && lessIndexes(group, best)) {
+			best = group
+		}
+	}, func(size int) bool { return size < len(best) })
+
+	ret := make([]strfmt.UUID, 0, len(best))
+	for _, i := range best {
+		ret = append(ret, ids[i])
+	}
+	return ret, nil
+}
+
+// findMaximalGroups is the Bron-Kerbosch enumeration of the maximal groups of hosts that are adjacent to each other.
+// It branches only on the candidates that are not adjacent to a pivot with the most adjacent candidates, as every
+// maximal group contains the pivot or one of them, so a fully connected cluster is a single branch. Branches that
+// can't grow into a group of at least the given size are skipped.
+func findMaximalGroups(adjacent [][]bool, group, candidates, excluded []int, found func([]int), tooSmall func(int) bool) {
+	if len(candidates) == 0 && len(excluded) == 0 {
+		ret := append([]int{}, group...)
+		sort.Ints(ret)
+		found(ret)
+		return
+	}
+	if tooSmall(len(group) + len(candidates)) {
+		return
+	}
+	pivot, pivotDegree := -1, -1
+	for _, list := range [][]int{candidates, excluded} {
+		for _, u := range list {
+			degree := 0
+			for _, v := range candidates {
+				if adjacent[u][v] {
+					degree++
+				}
+			}
+			if degree > pivotDegree {
+				pivot, pivotDegree = u, degree
+			}
+		}
+	}
+	branches := make([]int, 0, len(candidates))
+	for _, v := range candidates {
+		if !adjacent[pivot][v] {
+			branches = append(branches, v)
+		}
+	}
+	for _, v := range branches {
+		var nextCandidates, nextExcluded []int
+		for _, u := range candidates {
+			if adjacent[v][u] {
+				nextCandidates = append(nextCandidates, u)
+			}
+		}
+		for _, u := range excluded {
+			if adjacent[v][u] {
+				nextExcluded = append(nextExcluded, u)
+			}
+		}
+		findMaximalGroups(adjacent, append(group[:len(group):len(group)], v), nextCandidates, nextExcluded, found, tooSmall)
+		candidates = removeIndex(candidates, v)
+		excluded = append(excluded[:len(excluded):len(excluded)], v)
+	}
+}
+
+func removeIndex(list []int, index int) []int {
+	ret := make([]int, 0, len(list))
+	for _, i := range list {
+		if i != index {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
+
+// lessIndexes compares two sorted lists of host indexes of the same length
+func lessIndexes(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
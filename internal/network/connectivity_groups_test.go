@@ -0,0 +1,202 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("connectivity groups", func() {
+	const machineNetworkCidr = "1.2.3.0/24"
+
+	ids := []strfmt.UUID{
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000002",
+		"00000000-0000-0000-0000-000000000003",
+		"00000000-0000-0000-0000-000000000004",
+	}
+
+	// createHost returns a host that reached the given hosts on the given address
+	createHost := func(id strfmt.UUID, remoteIPAddress string, remoteHostIDs ...strfmt.UUID) *models.Host {
+		report := models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{}}
+		for _, r := range remoteHostIDs {
+			report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+				HostID:         r,
+				L2Connectivity: []*models.L2Connectivity{{RemoteIPAddress: remoteIPAddress, Successful: true}},
+			})
+		}
+		b, err := json.Marshal(&report)
+		Expect(err).ToNot(HaveOccurred())
+		hostID := id
+		return &models.Host{ID: &hostID, Connectivity: string(b)}
+	}
+
+	Context("CreateConnectivityMatrix", func() {
+		It("reports the connectivity of every ordered pair", func() {
+			hosts := []*models.Host{
+				createHost(ids[0], "1.2.3.4", ids[1]),
+				createHost(ids[1], "10.0.0.4", ids[0]),
+				{ID: &ids[2]},
+			}
+			matrix, err := CreateConnectivityMatrix(hosts, machineNetworkCidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matrix).To(HaveLen(6))
+			Expect(*matrix[0].HostID).To(Equal(ids[0]))
+			Expect(*matrix[0].RemoteHostID).To(Equal(ids[1]))
+			Expect(swag.BoolValue(matrix[0].L2Connected)).To(BeTrue())
+			Expect(swag.BoolValue(matrix[0].L3Connected)).To(BeFalse())
+			Expect(matrix[0].L2Connectivity).To(HaveLen(1))
+			// reached outside of the machine network
+			Expect(*matrix[2].HostID).To(Equal(ids[1]))
+			Expect(*matrix[2].RemoteHostID).To(Equal(ids[0]))
+			Expect(swag.BoolValue(matrix[2].L2Connected)).To(BeFalse())
+			Expect(matrix[2].L2Connectivity).To(HaveLen(1))
+			// no report
+			Expect(*matrix[4].HostID).To(Equal(ids[2]))
+			Expect(swag.BoolValue(matrix[4].L2Connected)).To(BeFalse())
+			Expect(matrix[4].L2Connectivity).To(BeEmpty())
+		})
+
+		It("invalid report", func() {
+			_, err := CreateConnectivityMatrix([]*models.Host{{ID: &ids[0], Connectivity: "{"}}, machineNetworkCidr)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("GetDisconnectedHosts", func() {
+		It("all connected", func() {
+			hosts := []*models.Host{
+				createHost(ids[0], "1.2.3.4", ids[1], ids[2]),
+				createHost(ids[1], "1.2.3.4", ids[0], ids[2]),
+				createHost(ids[2], "1.2.3.4", ids[0], ids[1]),
+			}
+			disconnected, missing, err := GetDisconnectedHosts(hosts, machineNetworkCidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(disconnected).To(BeEmpty())
+			Expect(missing).To(BeEmpty())
+		})
+
+		It("connectivity in one direction only", func() {
+			hosts := []*models.Host{
+				createHost(ids[0], "1.2.3.4", ids[1], ids[2]),
+				createHost(ids[1], "1.2.3.4", ids[0]),
+				createHost(ids[2], "1.2.3.4", ids[0]),
+				{ID: &ids[3]},
+			}
+			disconnected, missing, err := GetDisconnectedHosts(hosts, machineNetworkCidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(disconnected).To(HaveLen(1))
+			Expect(*disconnected[0].Host.ID).To(Equal(ids[1]))
+			Expect(*disconnected[0].RemoteHost.ID).To(Equal(ids[2]))
+			Expect(missing).To(HaveLen(1))
+			Expect(*missing[0].ID).To(Equal(ids[3]))
+		})
+	})
+
+	Context("GetMajorityGroup", func() {
+		It("largest group", func() {
+			hosts := []*models.Host{
+				createHost(ids[0], "1.2.3.4"),
+				createHost(ids[1], "1.2.3.4", ids[2], ids[3]),
+				createHost(ids[2], "1.2.3.4", ids[1], ids[3]),
+				createHost(ids[3], "1.2.3.4", ids[1], ids[2]),
+			}
+			group, err := GetMajorityGroup(hosts, machineNetworkCidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).To(Equal([]strfmt.UUID{ids[1], ids[2], ids[3]}))
+		})
+
+		It("groups of the same size", func() {
+			hosts := []*models.Host{
+				createHost(ids[3], "1.2.3.4", ids[2]),
+				createHost(ids[2], "1.2.3.4", ids[3]),
+				createHost(ids[1], "1.2.3.4", ids[0]),
+				createHost(ids[0], "1.2.3.4", ids[1]),
+			}
+			group, err := GetMajorityGroup(hosts, machineNetworkCidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).To(Equal([]strfmt.UUID{ids[0], ids[1]}))
+		})
+
+		It("ignores hosts without a report", func() {
+			hosts := []*models.Host{
+				createHost(ids[0], "1.2.3.4", ids[1]),
+				createHost(ids[1], "1.2.3.4", ids[0]),
+				{ID: &ids[2]},
+			}
+			group, err := GetMajorityGroup(hosts, machineNetworkCidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).To(Equal([]strfmt.UUID{ids[0], ids[1]}))
+		})
+
+		// createGroups returns hosts that are connected to all the hosts of their group
+		createGroups := func(sizes ...int) ([]*models.Host, [][]strfmt.UUID) {
+			var hosts []*models.Host
+			var groups [][]strfmt.UUID
+			for g, size := range sizes {
+				var group []strfmt.UUID
+				for i := 0; i < size; i++ {
+					group = append(group, strfmt.UUID(fmt.Sprintf("00000000-0000-0000-%04d-%012d", g, i)))
+				}
+				for i, id := range group {
+					remoteHostIDs := append(append([]strfmt.UUID{}, group[:i]...), group[i+1:]...)
+					hosts = append(hosts, createHost(id, "1.2.3.4", remoteHostIDs...))
+				}
+				groups = append(groups, group)
+			}
+			return hosts, groups
+		}
+
+		It("fully connected large cluster", func() {
+			hosts, groups := createGroups(40)
+			start := time.Now()
+			group, err := GetMajorityGroup(hosts, machineNetworkCidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).To(Equal(groups[0]))
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+
+		It("large cluster split into groups", func() {
+			hosts, groups := createGroups(12, 20, 8)
+			start := time.Now()
+			group, err := GetMajorityGroup(hosts, machineNetworkCidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).To(Equal(groups[1]))
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+	})
+})
+
+func BenchmarkGetMajorityGroup(b *testing.B) {
+	var ids []strfmt.UUID
+	for i := 0; i < 30; i++ {
+		ids = append(ids, strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i)))
+	}
+	var hosts []*models.Host
+	for i := range ids {
+		report := models.ConnectivityReport{}
+		for j := range ids {
+			if i != j {
+				report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+					HostID:         ids[j],
+					L2Connectivity: []*models.L2Connectivity{{RemoteIPAddress: "1.2.3.4", Successful: true}},
+				})
+			}
+		}
+		b, _ := json.Marshal(&report)
+		hosts = append(hosts, &models.Host{ID: &ids[i], Connectivity: string(b)})
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := GetMajorityGroup(hosts, "1.2.3.0/24"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// appsProbeLabel is the label of the name that is resolved to verify the *.apps wildcard record, the console route of
+// the cluster is one of the names that the wildcard serves
+const appsProbeLabel = "console-openshift-console"
+
+// GetAPIDomainName returns the domain name of the API of the cluster
+func GetAPIDomainName(cluster *common.Cluster) string {
+	return fmt.Sprintf("api.%s.%s", cluster.Name, cluster.BaseDNSDomain)
+}
+
+// GetAppsDomainName returns a domain name that the *.apps wildcard record of the cluster resolves
+func GetAppsDomainName(cluster *common.Cluster) string {
+	return fmt.Sprintf("%s.apps.%s.%s", appsProbeLabel, cluster.Name, cluster.BaseDNSDomain)
+}
+
+// IsDomainResolutionRequired returns true if the hosts of the cluster must be able to resolve the domain names of the
+// cluster, the load balancers of user managed networking clusters are found by their domain names
+func IsDomainResolutionRequired(cluster *common.Cluster) bool {
+	return common.IsUserManagedNetworking(cluster) && cluster.Name != "" && cluster.BaseDNSDomain != ""
+}
+
+func findResolution(resolutions *models.DomainResolutionResponse, domainName string) *models.DomainResolutionResponseDomain {
+	for _, r := range resolutions.Resolutions {
+		if r != nil && strings.EqualFold(strings.TrimSuffix(swag.StringValue(r.DomainName), "."), domainName) {
+			return r
+		}
+	}
+	return nil
+}
+
+// GetUnresolvedHosts returns the hosts that reported that they could not resolve the domain name, together with the
+// hosts that did not report their resolution of the domain name yet
+func GetUnresolvedHosts(hosts []*models.Host, domainName string) ([]*models.Host, []*models.Host, error) {
+	var unresolved []*models.Host
+	var missingReports []*models.Host
+	for _, h := range hosts {
+		if h.DomainResolutions == "" {
+			missingReports = append(missingReports, h)
+			continue
+		}
+		var resolutions models.DomainResolutionResponse
+		if err := json.Unmarshal([]byte(h.DomainResolutions), &resolutions); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse the domain resolutions of host %s", h.ID.String())
+		}
+		r := findResolution(&resolutions, domainName)
+		if r == nil {
+			missingReports = append(missingReports, h)
+			continue
+		}
+		if len(r.IPV4Addresses) == 0 && len(r.IPV6Addresses) == 0 {
+			unresolved = append(unresolved, h)
+		}
+	}
+	return unresolved, missingReports, nil
+}
@@ -0,0 +1,66 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("domain resolution", func() {
+	cluster := &common.Cluster{Cluster: models.Cluster{
+		Name:                  "test",
+		BaseDNSDomain:         "example.com",
+		UserManagedNetworking: swag.Bool(true),
+	}}
+
+	// createHost returns a host that resolved the domain names to the given addresses
+	createHost := func(id strfmt.UUID, resolutions map[string][]strfmt.IPv4) *models.Host {
+		response := models.DomainResolutionResponse{Resolutions: []*models.DomainResolutionResponseDomain{}}
+		for name, addresses := range resolutions {
+			response.Resolutions = append(response.Resolutions, &models.DomainResolutionResponseDomain{
+				DomainName:    swag.String(name),
+				IPV4Addresses: addresses,
+			})
+		}
+		b, err := json.Marshal(&response)
+		Expect(err).ToNot(HaveOccurred())
+		hostID := id
+		return &models.Host{ID: &hostID, DomainResolutions: string(b)}
+	}
+
+	It("returns the domain names of the cluster", func() {
+		Expect(GetAPIDomainName(cluster)).To(Equal("api.test.example.com"))
+		Expect(GetAppsDomainName(cluster)).To(Equal("console-openshift-console.apps.test.example.com"))
+		Expect(IsDomainResolutionRequired(cluster)).To(BeTrue())
+		Expect(IsDomainResolutionRequired(&common.Cluster{Cluster: models.Cluster{Name: "test", BaseDNSDomain: "example.com"}})).
+			To(BeFalse())
+	})
+
+	It("finds the hosts that didn't resolve the domain name", func() {
+		ids := []strfmt.UUID{
+			"00000000-0000-0000-0000-000000000001",
+			"00000000-0000-0000-0000-000000000002",
+			"00000000-0000-0000-0000-000000000003",
+			"00000000-0000-0000-0000-000000000004",
+		}
+		hosts := []*models.Host{
+			createHost(ids[0], map[string][]strfmt.IPv4{"api.test.example.com.": {"1.2.3.4"}}),
+			createHost(ids[1], map[string][]strfmt.IPv4{"api.test.example.com": {}}),
+			createHost(ids[2], map[string][]strfmt.IPv4{"other.example.com": {"1.2.3.4"}}),
+			{ID: &ids[3]},
+		}
+		unresolved, missingReports, err := GetUnresolvedHosts(hosts, GetAPIDomainName(cluster))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unresolved).To(Equal([]*models.Host{hosts[1]}))
+		Expect(missingReports).To(Equal([]*models.Host{hosts[2], hosts[3]}))
+
+		hosts[0].DomainResolutions = "blah"
+		_, _, err = GetUnresolvedHosts(hosts, GetAPIDomainName(cluster))
+		Expect(err).To(HaveOccurred())
+	})
+})
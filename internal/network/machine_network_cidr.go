@@ -9,7 +9,6 @@ import (
 
 	"github.com/go-openapi/swag"
 
-	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 
 	"github.com/openshift/assisted-service/internal/common"
@@ -18,11 +17,17 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// getInterfaceAddresses returns the IPv4 and IPv6 addresses of the interface, in CIDR notation
+func getInterfaceAddresses(intf *models.Interface) []string {
+	return append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...)
+}
+
 /*
  * Calculate the machine network CIDR from the one of (ApiVip, IngressVip) and the ip addresses of the hosts.
  * The ip addresses of the host appear with CIDR notation. Therefore, the network can be calculated from it.
  * The goal of this function is to find the first network that one of the vips belongs to it.
  * This network is returned as a result.
+ * The vips may be either IPv4 or IPv6 addresses, and the network is of the same family.
  */
 func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*models.Host) (string, error) {
 	var ip string
@@ -47,8 +52,8 @@ func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*mode
 			continue
 		}
 		for _, intf := range inventory.Interfaces {
-			for _, ipv4addr := range intf.IPV4Addresses {
-				_, ipnet, err := net.ParseCIDR(ipv4addr)
+			for _, addr := range getInterfaceAddresses(intf) {
+				_, ipnet, err := net.ParseCIDR(addr)
 				if err != nil {
 					continue
 				}
@@ -109,7 +114,7 @@ func VerifyMachineCIDR(machineCidr string, hosts []*models.Host, log logrus.Fiel
 	if err != nil {
 		return err
 	}
-	if ipNet.IP.To4().String() != ip.To4().String() {
+	if !ipNet.IP.Equal(ip) {
 		return common.NewApiError(http.StatusBadRequest, errors.Errorf("%s is not a valid machine CIDR", machineCidr))
 	}
 	for _, h := range hosts {
@@ -131,7 +136,7 @@ func GetMachineCIDRInterface(host *models.Host, cluster *common.Cluster) (string
 		return "", err
 	}
 	for _, intf := range inventory.Interfaces {
-		for _, a := range intf.IPV4Addresses {
+		for _, a := range getInterfaceAddresses(intf) {
 			ip, _, err := net.ParseCIDR(a)
 			if err != nil {
 				return "", err
@@ -164,10 +169,10 @@ func belongsToNetwork(log logrus.FieldLogger, h *models.Host, machineIpnet *net.
 		return false
 	}
 	for _, intf := range inventory.Interfaces {
-		for _, ipv4addr := range intf.IPV4Addresses {
-			ip, _, err := net.ParseCIDR(ipv4addr)
+		for _, addr := range getInterfaceAddresses(intf) {
+			ip, _, err := net.ParseCIDR(addr)
 			if err != nil {
-				log.WithError(err).Warnf("Could not parse cidr %s", ipv4addr)
+				log.WithError(err).Warnf("Could not parse cidr %s", addr)
 				continue
 			}
 			if machineIpnet.Contains(ip) {
@@ -195,18 +200,64 @@ func GetMachineCIDRHosts(log logrus.FieldLogger, cluster *common.Cluster) ([]*mo
 	return ret, nil
 }
 
+// IsHostInMachineNetCidr returns true if the host has an address in the machine network of the cluster, and also in
+// its secondary machine network when the cluster is dual-stack
 func IsHostInMachineNetCidr(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) bool {
-	_, machineIpnet, err := net.ParseCIDR(cluster.MachineNetworkCidr)
-	if err != nil {
-		return false
+	for _, cidr := range GetMachineNetworks(cluster) {
+		_, machineIpnet, err := net.ParseCIDR(cidr)
+		if err != nil || !belongsToNetwork(log, host, machineIpnet) {
+			return false
+		}
+	}
+	return cluster.MachineNetworkCidr != ""
+}
+
+// GetMachineNetworks returns the machine networks of the cluster, the secondary network of a dual-stack cluster follows
+// its primary network
+func GetMachineNetworks(cluster *common.Cluster) []string {
+	return nonEmpty(cluster.MachineNetworkCidr, cluster.SecondaryMachineNetworkCidr)
+}
+
+// GetClusterNetworks returns the cluster networks of the cluster, the secondary network of a dual-stack cluster follows
+// its primary network
+func GetClusterNetworks(cluster *common.Cluster) []string {
+	return nonEmpty(cluster.ClusterNetworkCidr, cluster.SecondaryClusterNetworkCidr)
+}
+
+// GetServiceNetworks returns the service networks of the cluster, the secondary network of a dual-stack cluster follows
+// its primary network
+func GetServiceNetworks(cluster *common.Cluster) []string {
+	return nonEmpty(cluster.ServiceNetworkCidr, cluster.SecondaryServiceNetworkCidr)
+}
+
+func nonEmpty(values ...string) []string {
+	ret := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			ret = append(ret, v)
+		}
 	}
-	return belongsToNetwork(log, host, machineIpnet)
+	return ret
 }
 
-type IPSet map[strfmt.IPv4]struct{}
+// IPSet is a set of IPv4 and IPv6 addresses, the addresses are kept in their canonical form so the same address
+// is found however it was written
+type IPSet map[string]struct{}
+
+func canonicalIP(str string) string {
+	if ip := net.ParseIP(str); ip != nil {
+		return ip.String()
+	}
+	return str
+}
+
+func (s IPSet) Add(str string) {
+	s[canonicalIP(str)] = struct{}{}
+}
 
-func (s IPSet) Add(str strfmt.IPv4) {
-	s[str] = struct{}{}
+func (s IPSet) Contains(str string) bool {
+	_, ok := s[canonicalIP(str)]
+	return ok
 }
 
 func (s IPSet) Intersect(other IPSet) IPSet {
@@ -229,7 +280,7 @@ func freeAddressesUnmarshal(network, freeAddressesStr string, prefix *string) (I
 		if f.Network == network {
 			ret := make(IPSet)
 			for _, a := range f.FreeAddresses {
-				if prefix == nil || strings.HasPrefix(a.String(), *prefix) {
+				if prefix == nil || strings.HasPrefix(a, *prefix) {
 					ret.Add(a)
 				}
 			}
@@ -280,7 +331,7 @@ func IpInFreeList(hosts []*models.Host, vipIPStr, network string, log logrus.Fie
 	isFree := true
 	freeSet := MakeFreeAddressesSet(hosts, network, nil, log)
 	if len(freeSet) > 0 {
-		_, isFree = freeSet[strfmt.IPv4(vipIPStr)]
+		isFree = freeSet.Contains(vipIPStr)
 	}
 	return isFree
 }
@@ -23,6 +23,12 @@ var _ = Describe("inventory", func() {
 		}
 	}
 
+	createIPv6Interface := func(ipv6Addresses ...string) *models.Interface {
+		return &models.Interface{
+			IPV6Addresses: append([]string{}, ipv6Addresses...),
+		}
+	}
+
 	createInventory := func(interfaces ...*models.Interface) string {
 		inventory := models.Inventory{Interfaces: interfaces}
 		ret, _ := json.Marshal(&inventory)
@@ -95,6 +101,14 @@ var _ = Describe("inventory", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(cidr).To(Equal(""))
 		})
+		It("IPv6", func() {
+			cluster := createCluster("fd2e:6f44:5dd8:c956::16", "",
+				createInventory(createInterface("1.2.5.7/23"), createIPv6Interface("fe80::1/64", "fd2e:6f44:5dd8:c956::14/120")),
+				createInventory(createIPv6Interface("fd2e:6f44:5dd8:c956::15/120")))
+			cidr, err := CalculateMachineNetworkCIDR(cluster.APIVip, cluster.IngressVip, cluster.Hosts)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(cidr).To(Equal("fd2e:6f44:5dd8:c956::/120"))
+		})
 		It("Bad inventory", func() {
 			cluster := createCluster("1.2.5.6", "",
 				"Bad inventory",
@@ -134,6 +148,26 @@ var _ = Describe("inventory", func() {
 			}))
 
 		})
+		It("IPv6 matched", func() {
+			cluster := createCluster("", "fd2e:6f44:5dd8:c956::/120",
+				createInventory(createInterface("1.2.5.7/23"), createIPv6Interface("fd2e:6f44:5dd8:c956::14/120")),
+				createInventory(createInterface("1.2.4.79/23")))
+			hosts, err := GetMachineCIDRHosts(logrus.New(), cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(hosts).To(Equal([]*models.Host{cluster.Hosts[0]}))
+		})
+	})
+	Context("IsHostInMachineNetCidr", func() {
+		It("dual-stack", func() {
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(createInterface("1.2.5.7/23"), createIPv6Interface("fd2e:6f44:5dd8:c956::14/120")),
+				createInventory(createInterface("1.2.4.79/23")))
+			Expect(IsHostInMachineNetCidr(logrus.New(), cluster, cluster.Hosts[0])).To(BeTrue())
+			Expect(IsHostInMachineNetCidr(logrus.New(), cluster, cluster.Hosts[1])).To(BeTrue())
+			cluster.SecondaryMachineNetworkCidr = "fd2e:6f44:5dd8:c956::/120"
+			Expect(IsHostInMachineNetCidr(logrus.New(), cluster, cluster.Hosts[0])).To(BeTrue())
+			Expect(IsHostInMachineNetCidr(logrus.New(), cluster, cluster.Hosts[1])).To(BeFalse())
+		})
 	})
 	Context("VerifyVips", func() {
 		var log logrus.FieldLogger
@@ -212,6 +246,24 @@ var _ = Describe("inventory", func() {
 			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
+		It("IPv6", func() {
+			cluster := createCluster("fd2e:6f44:5dd8:c956::16", "fd2e:6f44:5dd8:c956::/120",
+				createInventory(createIPv6Interface("fd2e:6f44:5dd8:c956::14/120")))
+			cluster.IngressVip = "fd2e:6f44:5dd8:c956::17"
+			cluster.Hosts = []*models.Host{
+				{
+					FreeAddresses: "[{\"network\":\"fd2e:6f44:5dd8:c956::/120\",\"free_addresses\":[\"fd2e:6f44:5dd8:c956:0:0:0:16\",\"fd2e:6f44:5dd8:c956::17\"]}]",
+				},
+			}
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			Expect(err).ToNot(HaveOccurred())
+			cluster.IngressVip = "fd2e:6f44:5dd8:c956::18"
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			Expect(err).To(HaveOccurred())
+			cluster.IngressVip = "1.2.5.8"
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			Expect(err).To(HaveOccurred())
+		})
 		It("Free", func() {
 			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
 				createInventory(createInterface("1.2.5.7/23")))
@@ -0,0 +1,67 @@
+package network
+
+import (
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// HasIPv6Network returns true if one of the networks of the cluster is an IPv6 network
+func HasIPv6Network(cluster *common.Cluster) bool {
+	for _, cidr := range []string{cluster.MachineNetworkCidr, cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr,
+		cluster.SecondaryMachineNetworkCidr, cluster.SecondaryClusterNetworkCidr, cluster.SecondaryServiceNetworkCidr} {
+		if IsIPv6CIDR(cidr) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultNetworkType returns the network type of a cluster that didn't select one, OpenShiftSDN doesn't support IPv6
+// networks
+func DefaultNetworkType(ipv6 bool) string {
+	if ipv6 {
+		return models.ClusterNetworkTypeOVNKubernetes
+	}
+	return models.ClusterNetworkTypeOpenShiftSDN
+}
+
+// GetNetworkType returns the network type of the cluster, or the default network type for its networks if it isn't set
+func GetNetworkType(cluster *common.Cluster) string {
+	if cluster.NetworkType != "" {
+		return cluster.NetworkType
+	}
+	return DefaultNetworkType(HasIPv6Network(cluster))
+}
+
+// VerifyNetworkTypeVersion verifies that the OpenShift version supports the network type, the supported network types
+// of every version are listed in the versions catalog
+func VerifyNetworkTypeVersion(networkType, openshiftVersion string, versionsHandler versions.Handler) error {
+	if networkType == "" {
+		return nil
+	}
+	types, err := versionsHandler.GetSupportedNetworkTypes(openshiftVersion)
+	if err != nil {
+		return err
+	}
+	if !funk.ContainsString(types, networkType) {
+		return errors.Errorf("Network type %s is not supported by OpenShift version %s", networkType, openshiftVersion)
+	}
+	return nil
+}
+
+// VerifyNetworkType verifies that the network type of the cluster is supported by its OpenShift version and by the IP
+// family of its networks
+func VerifyNetworkType(cluster *common.Cluster, versionsHandler versions.Handler) error {
+	networkType := GetNetworkType(cluster)
+	if err := VerifyNetworkTypeVersion(networkType, cluster.OpenshiftVersion, versionsHandler); err != nil {
+		return err
+	}
+	if HasIPv6Network(cluster) && networkType != models.ClusterNetworkTypeOVNKubernetes {
+		return errors.Errorf("Network type %s doesn't support IPv6 networks, IPv6 and dual-stack clusters require network type %s",
+			networkType, models.ClusterNetworkTypeOVNKubernetes)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package network
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("network type", func() {
+	var (
+		cluster         *common.Cluster
+		versionsHandler versions.Handler
+	)
+
+	BeforeEach(func() {
+		openshiftVersions, err := versions.ParseOpenshiftVersions("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		versionsHandler = versions.NewHandler(versions.Versions{}, openshiftVersions)
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			OpenshiftVersion:   "4.6",
+			MachineNetworkCidr: "1.2.3.0/24",
+			ClusterNetworkCidr: "10.128.0.0/14",
+			ServiceNetworkCidr: "172.30.0.0/16",
+		}}
+	})
+
+	It("defaults by IP family", func() {
+		Expect(GetNetworkType(cluster)).To(Equal(models.ClusterNetworkTypeOpenShiftSDN))
+		cluster.SecondaryClusterNetworkCidr = "fd01::/48"
+		Expect(GetNetworkType(cluster)).To(Equal(models.ClusterNetworkTypeOVNKubernetes))
+		cluster.NetworkType = models.ClusterNetworkTypeOpenShiftSDN
+		Expect(GetNetworkType(cluster)).To(Equal(models.ClusterNetworkTypeOpenShiftSDN))
+	})
+
+	It("IPv4 cluster", func() {
+		Expect(VerifyNetworkType(cluster, versionsHandler)).ToNot(HaveOccurred())
+		cluster.NetworkType = models.ClusterNetworkTypeOVNKubernetes
+		Expect(VerifyNetworkType(cluster, versionsHandler)).ToNot(HaveOccurred())
+	})
+
+	It("IPv6 cluster", func() {
+		cluster.MachineNetworkCidr = "fd00::/64"
+		cluster.ClusterNetworkCidr = "fd01::/48"
+		cluster.ServiceNetworkCidr = "fd02::/112"
+		Expect(VerifyNetworkType(cluster, versionsHandler)).ToNot(HaveOccurred())
+		cluster.NetworkType = models.ClusterNetworkTypeOpenShiftSDN
+		Expect(VerifyNetworkType(cluster, versionsHandler)).To(HaveOccurred())
+	})
+
+	It("OpenShift version", func() {
+		cluster.OpenshiftVersion = "4.5"
+		Expect(VerifyNetworkType(cluster, versionsHandler)).ToNot(HaveOccurred())
+		cluster.NetworkType = models.ClusterNetworkTypeOVNKubernetes
+		Expect(VerifyNetworkType(cluster, versionsHandler)).To(HaveOccurred())
+		Expect(VerifyNetworkTypeVersion(models.ClusterNetworkTypeOVNKubernetes, "4.6", versionsHandler)).ToNot(HaveOccurred())
+		Expect(VerifyNetworkTypeVersion("", "4.5", versionsHandler)).ToNot(HaveOccurred())
+		Expect(VerifyNetworkTypeVersion(models.ClusterNetworkTypeOpenShiftSDN, "4.4", versionsHandler)).To(HaveOccurred())
+	})
+
+	It("versions catalog", func() {
+		versionsHandler = versions.NewHandler(versions.Versions{}, models.OpenshiftVersions{
+			"4.7": models.OpenshiftVersion{SupportedNetworkTypes: []string{models.ClusterNetworkTypeOVNKubernetes}},
+		})
+		cluster.OpenshiftVersion = "4.7"
+		Expect(VerifyNetworkType(cluster, versionsHandler)).To(HaveOccurred())
+		cluster.NetworkType = models.ClusterNetworkTypeOVNKubernetes
+		Expect(VerifyNetworkType(cluster, versionsHandler)).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,348 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const NetworkManagerConnectionsDir = "/etc/NetworkManager/system-connections"
+
+// interfaceNameRegex matches the names that the kernel accepts for an interface (up to 15 characters) without the
+// characters that have a meaning in a keyfile
+var interfaceNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,15}$`)
+
+// nmstate is the subset of the nmstate network state that can be applied to a host with NetworkManager keyfiles
+type nmstate struct {
+	Interfaces  []nmstateInterface `yaml:"interfaces"`
+	DNSResolver struct {
+		Config struct {
+			Server []string `yaml:"server"`
+			Search []string `yaml:"search"`
+		} `yaml:"config"`
+	} `yaml:"dns-resolver"`
+	Routes struct {
+		Config []nmstateRoute `yaml:"config"`
+	} `yaml:"routes"`
+}
+
+type nmstateInterface struct {
+	Name       string     `yaml:"name"`
+	Type       string     `yaml:"type"`
+	State      string     `yaml:"state"`
+	MacAddress string     `yaml:"mac-address"`
+	MTU        int        `yaml:"mtu"`
+	IPv4       *nmstateIP `yaml:"ipv4"`
+	IPv6       *nmstateIP `yaml:"ipv6"`
+}
+
+type nmstateIP struct {
+	Enabled  bool               `yaml:"enabled"`
+	DHCP     bool               `yaml:"dhcp"`
+	Autoconf bool               `yaml:"autoconf"`
+	Address  []nmstateIPAddress `yaml:"address"`
+}
+
+type nmstateIPAddress struct {
+	IP           string `yaml:"ip"`
+	PrefixLength int    `yaml:"prefix-length"`
+}
+
+type nmstateRoute struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address"`
+	NextHopInterface string `yaml:"next-hop-interface"`
+	Metric           int    `yaml:"metric"`
+}
+
+// NMConnectionFile is a NetworkManager keyfile of one interface of a host
+type NMConnectionFile struct {
+	Path     string
+	Contents string
+}
+
+func normalizeMac(mac string) string {
+	return strings.ToLower(strings.ReplaceAll(mac, "-", ":"))
+}
+
+func parseNmstate(networkYaml string) (*nmstate, error) {
+	var state nmstate
+	if err := yaml.Unmarshal([]byte(networkYaml), &state); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the nmstate YAML")
+	}
+	if len(state.Interfaces) == 0 {
+		return nil, errors.New("the nmstate YAML doesn't contain any interface")
+	}
+	return &state, nil
+}
+
+// ValidateStaticNetworkConfig verifies that every configuration is a valid nmstate YAML that can be rendered to
+// NetworkManager keyfiles, and that every MAC address identifies one host
+func ValidateStaticNetworkConfig(configs []*models.HostStaticNetworkConfig) error {
+	_, err := GenerateNMConnectionFiles(configs)
+	return err
+}
+
+// GenerateNMConnectionFiles renders the static network configurations to NetworkManager keyfiles. The keyfiles are
+// bound to the interfaces by their MAC address, so a single image can carry the keyfiles of all the hosts and every
+// host applies only its own ones
+func GenerateNMConnectionFiles(configs []*models.HostStaticNetworkConfig) ([]NMConnectionFile, error) {
+	var files []NMConnectionFile
+	macs := make(map[string]bool)
+	for _, config := range configs {
+		hostMac := normalizeMac(swag.StringValue(config.MacAddress))
+		state, err := parseNmstate(swag.StringValue(config.NetworkYaml))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid network configuration of host %s", hostMac)
+		}
+		hostFiles, err := state.connectionFiles(hostMac)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid network configuration of host %s", hostMac)
+		}
+		for _, f := range hostFiles {
+			if macs[f.mac] {
+				return nil, errors.Errorf("MAC address %s is configured more than once", f.mac)
+			}
+			macs[f.mac] = true
+			files = append(files, f.NMConnectionFile)
+		}
+	}
+	return files, nil
+}
+
+type macConnectionFile struct {
+	NMConnectionFile
+	mac string
+}
+
+// validateNames verifies that the interface names and the DNS search domains are plain names, they are written to the
+// keyfiles as is
+func (s *nmstate) validateNames() error {
+	for _, intf := range s.Interfaces {
+		if intf.Name != "" && !interfaceNameRegex.MatchString(intf.Name) {
+			return errors.Errorf("%q is not a valid interface name", intf.Name)
+		}
+	}
+	for _, domain := range s.DNSResolver.Config.Search {
+		if err := validations.ValidateDomainNameFormat(domain); err != nil {
+			return errors.Wrap(err, "invalid DNS search domain")
+		}
+	}
+	return nil
+}
+
+func (s *nmstate) connectionFiles(hostMac string) ([]macConnectionFile, error) {
+	if err := s.validateNames(); err != nil {
+		return nil, err
+	}
+	var files []macConnectionFile
+	for _, intf := range s.Interfaces {
+		if intf.State != "" && intf.State != "up" {
+			continue
+		}
+		if intf.Type != "ethernet" {
+			return nil, errors.Errorf("interface %s: unsupported interface type %q, only ethernet interfaces are supported",
+				intf.Name, intf.Type)
+		}
+		mac := normalizeMac(intf.MacAddress)
+		if mac == "" {
+			if len(s.Interfaces) > 1 {
+				return nil, errors.Errorf("interface %s: mac-address must be set when more than one interface is configured",
+					intf.Name)
+			}
+			mac = hostMac
+		}
+		if _, err := net.ParseMAC(mac); err != nil {
+			return nil, errors.Wrapf(err, "interface %s", intf.Name)
+		}
+		contents, err := s.keyfile(&intf, mac)
+		if err != nil {
+			return nil, errors.Wrapf(err, "interface %s", intf.Name)
+		}
+		files = append(files, macConnectionFile{
+			NMConnectionFile: NMConnectionFile{
+				Path:     fmt.Sprintf("%s/%s.nmconnection", NetworkManagerConnectionsDir, strings.ReplaceAll(mac, ":", "")),
+				Contents: contents,
+			},
+			mac: mac,
+		})
+	}
+	if len(files) == 0 {
+		return nil, errors.New("the nmstate YAML doesn't contain any interface that is up")
+	}
+	return files, nil
+}
+
+func (s *nmstate) keyfile(intf *nmstateInterface, mac string) (string, error) {
+	var b strings.Builder
+	id := intf.Name
+	if id == "" {
+		id = strings.ReplaceAll(mac, ":", "")
+	}
+	fmt.Fprintf(&b, "[connection]\nid=%s\ntype=ethernet\nautoconnect=true\n\n", id)
+	fmt.Fprintf(&b, "[ethernet]\nmac-address=%s\n", mac)
+	if intf.MTU > 0 {
+		fmt.Fprintf(&b, "mtu=%d\n", intf.MTU)
+	}
+	for _, family := range []struct {
+		section string
+		ipv6    bool
+		config  *nmstateIP
+	}{{"ipv4", false, intf.IPv4}, {"ipv6", true, intf.IPv6}} {
+		section, err := s.ipSection(intf.Name, family.ipv6, family.config)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "\n[%s]\n%s", family.section, section)
+	}
+	return b.String(), nil
+}
+
+func (s *nmstate) ipSection(intfName string, ipv6 bool, config *nmstateIP) (string, error) {
+	if config == nil || !config.Enabled {
+		return "method=disabled\n", nil
+	}
+	if config.DHCP || config.Autoconf {
+		if len(config.Address) > 0 {
+			return "", errors.New("static addresses can't be set together with dhcp or autoconf")
+		}
+		return "method=auto\n", nil
+	}
+	if len(config.Address) == 0 {
+		return "", errors.New("static addressing requires at least one address")
+	}
+
+	var b strings.Builder
+	b.WriteString("method=manual\n")
+	for i, address := range config.Address {
+		ip := net.ParseIP(address.IP)
+		if ip == nil || (ip.To4() == nil) != ipv6 {
+			return "", errors.Errorf("%s is not a valid %s address", address.IP, ipFamilyName(ipv6))
+		}
+		if address.PrefixLength < 1 || address.PrefixLength > ipFamilyBits(ipv6) {
+			return "", errors.Errorf("invalid prefix length %d of address %s", address.PrefixLength, address.IP)
+		}
+		fmt.Fprintf(&b, "address%d=%s/%d\n", i+1, address.IP, address.PrefixLength)
+	}
+
+	routeIndex := 1
+	for _, route := range s.Routes.Config {
+		if route.NextHopInterface != "" && route.NextHopInterface != intfName {
+			continue
+		}
+		_, destination, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid route destination")
+		}
+		if (destination.IP.To4() == nil) != ipv6 {
+			continue
+		}
+		nextHop := net.ParseIP(route.NextHopAddress)
+		if nextHop == nil || (nextHop.To4() == nil) != ipv6 {
+			return "", errors.Errorf("%s is not a valid %s next hop address", route.NextHopAddress, ipFamilyName(ipv6))
+		}
+		if ones, _ := destination.Mask.Size(); ones == 0 {
+			fmt.Fprintf(&b, "gateway=%s\n", route.NextHopAddress)
+			if route.Metric > 0 {
+				fmt.Fprintf(&b, "route-metric=%d\n", route.Metric)
+			}
+			continue
+		}
+		fmt.Fprintf(&b, "route%d=%s,%s", routeIndex, destination.String(), route.NextHopAddress)
+		if route.Metric > 0 {
+			fmt.Fprintf(&b, ",%d", route.Metric)
+		}
+		b.WriteString("\n")
+		routeIndex++
+	}
+
+	var servers []string
+	for _, server := range s.DNSResolver.Config.Server {
+		ip := net.ParseIP(server)
+		if ip == nil {
+			return "", errors.Errorf("%s is not a valid DNS server address", server)
+		}
+		if (ip.To4() == nil) == ipv6 {
+			servers = append(servers, server)
+		}
+	}
+	if len(servers) > 0 {
+		fmt.Fprintf(&b, "dns=%s;\n", strings.Join(servers, ";"))
+	}
+	if len(s.DNSResolver.Config.Search) > 0 {
+		fmt.Fprintf(&b, "dns-search=%s;\n", strings.Join(s.DNSResolver.Config.Search, ";"))
+	}
+	return b.String(), nil
+}
+
+func ipFamilyName(ipv6 bool) string {
+	if ipv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+func ipFamilyBits(ipv6 bool) int {
+	if ipv6 {
+		return 128
+	}
+	return 32
+}
+
+// FormatStaticNetworkConfig returns the JSON representation of the static network configurations, sorted by MAC
+// address, in which they are kept with the image information of the cluster
+func FormatStaticNetworkConfig(configs []*models.HostStaticNetworkConfig) (string, error) {
+	if len(configs) == 0 {
+		return "", nil
+	}
+	sorted := make([]*models.HostStaticNetworkConfig, len(configs))
+	copy(sorted, configs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return normalizeMac(swag.StringValue(sorted[i].MacAddress)) < normalizeMac(swag.StringValue(sorted[j].MacAddress))
+	})
+	b, err := json.Marshal(sorted)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetHostStaticNetworkConfig returns the nmstate YAML of the static network configuration that matches one of the
+// interfaces of the host, or an empty string if the host isn't configured statically
+func GetHostStaticNetworkConfig(formattedConfigs string, inventory *models.Inventory) (string, error) {
+	if formattedConfigs == "" || inventory == nil {
+		return "", nil
+	}
+	var configs []*models.HostStaticNetworkConfig
+	if err := json.Unmarshal([]byte(formattedConfigs), &configs); err != nil {
+		return "", err
+	}
+	hostMacs := make(map[string]bool)
+	for _, intf := range inventory.Interfaces {
+		hostMacs[normalizeMac(intf.MacAddress)] = true
+	}
+	for _, config := range configs {
+		if hostMacs[normalizeMac(swag.StringValue(config.MacAddress))] {
+			return swag.StringValue(config.NetworkYaml), nil
+		}
+		state, err := parseNmstate(swag.StringValue(config.NetworkYaml))
+		if err != nil {
+			continue
+		}
+		for _, intf := range state.Interfaces {
+			if intf.MacAddress != "" && hostMacs[normalizeMac(intf.MacAddress)] {
+				return swag.StringValue(config.NetworkYaml), nil
+			}
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,137 @@
+package network
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+const staticNetworkYaml = `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: false
+    address:
+    - ip: 192.168.126.30
+      prefix-length: 24
+  ipv6:
+    enabled: false
+dns-resolver:
+  config:
+    server:
+    - 192.168.126.1
+    search:
+    - example.com
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.168.126.1
+    next-hop-interface: eth0
+  - destination: 10.0.0.0/8
+    next-hop-address: 192.168.126.254
+    metric: 100
+`
+
+func staticNetworkConfig(mac, networkYaml string) *models.HostStaticNetworkConfig {
+	return &models.HostStaticNetworkConfig{MacAddress: swag.String(mac), NetworkYaml: swag.String(networkYaml)}
+}
+
+var _ = Describe("static network config", func() {
+	It("renders a keyfile bound to the MAC address", func() {
+		files, err := GenerateNMConnectionFiles([]*models.HostStaticNetworkConfig{
+			staticNetworkConfig("52:54:00:AA:BB:01", staticNetworkYaml),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(files[0].Path).To(Equal("/etc/NetworkManager/system-connections/525400aabb01.nmconnection"))
+		Expect(files[0].Contents).To(Equal(`[connection]
+id=eth0
+type=ethernet
+autoconnect=true
+
+[ethernet]
+mac-address=52:54:00:aa:bb:01
+
+[ipv4]
+method=manual
+address1=192.168.126.30/24
+gateway=192.168.126.1
+route1=10.0.0.0/8,192.168.126.254,100
+dns=192.168.126.1;
+dns-search=example.com;
+
+[ipv6]
+method=disabled
+`))
+	})
+
+	It("renders every interface of a host", func() {
+		networkYaml := `interfaces:
+- name: eth0
+  type: ethernet
+  mac-address: 52:54:00:aa:bb:01
+  ipv4:
+    enabled: true
+    dhcp: true
+- name: eth1
+  type: ethernet
+  mac-address: 52:54:00:aa:bb:02
+  ipv6:
+    enabled: true
+    address:
+    - ip: fd00::30
+      prefix-length: 64
+`
+		files, err := GenerateNMConnectionFiles([]*models.HostStaticNetworkConfig{
+			staticNetworkConfig("52:54:00:aa:bb:01", networkYaml),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(2))
+		Expect(files[0].Contents).To(ContainSubstring("[ipv4]\nmethod=auto\n"))
+		Expect(files[1].Contents).To(ContainSubstring("[ipv6]\nmethod=manual\naddress1=fd00::30/64\n"))
+	})
+
+	It("invalid configurations", func() {
+		for _, networkYaml := range []string{
+			"not: [valid",
+			"interfaces: []",
+			"interfaces:\n- name: bond0\n  type: bond\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\n  ipv4:\n    enabled: true\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\n  ipv4:\n    enabled: true\n    address:\n    - ip: fd00::1\n      prefix-length: 24\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\n- name: eth1\n  type: ethernet\n",
+			"interfaces:\n- name: \"eth0\\n[ipv4]\"\n  type: ethernet\n",
+			"interfaces:\n- name: eth0;eth1\n  type: ethernet\n",
+			"interfaces:\n- name: an-interface-name-too-long\n  type: ethernet\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\ndns-resolver:\n  config:\n    search:\n    - \"example.com;\\n[ipv6]\"\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\ndns-resolver:\n  config:\n    search:\n    - example.com;other.com\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\ndns-resolver:\n  config:\n    search:\n    - -example.com\n",
+		} {
+			Expect(ValidateStaticNetworkConfig([]*models.HostStaticNetworkConfig{
+				staticNetworkConfig("52:54:00:aa:bb:01", networkYaml),
+			})).To(HaveOccurred(), networkYaml)
+		}
+	})
+
+	It("duplicate MAC addresses", func() {
+		Expect(ValidateStaticNetworkConfig([]*models.HostStaticNetworkConfig{
+			staticNetworkConfig("52:54:00:aa:bb:01", staticNetworkYaml),
+			staticNetworkConfig("52-54-00-AA-BB-01", staticNetworkYaml),
+		})).To(HaveOccurred())
+	})
+
+	It("matches the configuration of a host", func() {
+		formatted, err := FormatStaticNetworkConfig([]*models.HostStaticNetworkConfig{
+			staticNetworkConfig("52:54:00:aa:bb:02", "other"),
+			staticNetworkConfig("52:54:00:AA:BB:01", staticNetworkYaml),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		inventory := &models.Inventory{Interfaces: []*models.Interface{{MacAddress: "52:54:00:aa:bb:01"}}}
+		Expect(GetHostStaticNetworkConfig(formatted, inventory)).To(Equal(staticNetworkYaml))
+		inventory.Interfaces[0].MacAddress = "52:54:00:aa:bb:03"
+		Expect(GetHostStaticNetworkConfig(formatted, inventory)).To(BeEmpty())
+		Expect(GetHostStaticNetworkConfig("", inventory)).To(BeEmpty())
+	})
+})
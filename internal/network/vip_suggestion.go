@@ -0,0 +1,162 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ParseIPRange parses an address range in first-last format
+func ParseIPRange(ipRange string) (net.IP, net.IP, error) {
+	parts := strings.Split(ipRange, "-")
+	if len(parts) != 2 {
+		return nil, nil, errors.Errorf("%s is not a valid address range, the format is first-last", ipRange)
+	}
+	first := net.ParseIP(strings.TrimSpace(parts[0]))
+	last := net.ParseIP(strings.TrimSpace(parts[1]))
+	if first == nil || last == nil || (first.To4() == nil) != (last.To4() == nil) {
+		return nil, nil, errors.Errorf("%s is not a valid address range, the format is first-last", ipRange)
+	}
+	if bytes.Compare(first.To16(), last.To16()) > 0 {
+		return nil, nil, errors.Errorf("The first address of the range %s is greater than the last one", ipRange)
+	}
+	return first, last, nil
+}
+
+// IPInRange returns true if the address belongs to the range, an empty or an invalid range contains no address
+func IPInRange(ipStr, ipRange string) bool {
+	if ipRange == "" {
+		return false
+	}
+	first, last, err := ParseIPRange(ipRange)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return false
+	}
+	return bytes.Compare(ip.To16(), first.To16()) >= 0 && bytes.Compare(ip.To16(), last.To16()) <= 0
+}
+
+// vipCandidateHosts returns the hosts that take part in the VIP selection, the hosts that are connected to the service
+func vipCandidateHosts(hosts []*models.Host) []*models.Host {
+	ret := make([]*models.Host, 0, len(hosts))
+	for _, h := range hosts {
+		switch swag.StringValue(h.Status) {
+		case models.HostStatusDisabled, models.HostStatusDisconnected:
+			continue
+		}
+		ret = append(ret, h)
+	}
+	return ret
+}
+
+// reportedNetworks returns the networks that the free addresses report of the host covers
+func reportedNetworks(h *models.Host) []string {
+	if h.FreeAddresses == "" {
+		return nil
+	}
+	var reports models.FreeNetworksAddresses
+	if err := json.Unmarshal([]byte(h.FreeAddresses), &reports); err != nil {
+		return nil
+	}
+	ret := make([]string, 0, len(reports))
+	for _, r := range reports {
+		ret = append(ret, r.Network)
+	}
+	return ret
+}
+
+// commonFreeAddresses returns the addresses of the network that every host reports as free, or an error if one of the
+// hosts didn't report the network
+func commonFreeAddresses(hosts []*models.Host, network string) (IPSet, error) {
+	var result IPSet
+	for _, h := range hosts {
+		s, err := freeAddressesUnmarshal(network, h.FreeAddresses, nil)
+		if err != nil {
+			return nil, errors.Errorf("Host %s didn't report the free addresses of network %s", h.ID.String(), network)
+		}
+		if result == nil {
+			result = s
+		} else {
+			result = result.Intersect(s)
+		}
+	}
+	return result, nil
+}
+
+// SuggestVips returns two addresses of the machine network that every connected host reports as free, and that are
+// not in the DHCP range of the network. When the machine network isn't known, the first network that all the hosts
+// report and that has two such addresses is used
+func SuggestVips(hosts []*models.Host, machineNetworkCidr, dhcpRange string, log logrus.FieldLogger) (*models.VipsSuggestion, error) {
+	candidates := vipCandidateHosts(hosts)
+	if len(candidates) == 0 {
+		return nil, errors.New("No connected hosts to look for free addresses")
+	}
+
+	networks := []string{machineNetworkCidr}
+	if machineNetworkCidr == "" {
+		networks = reportedNetworks(candidates[0])
+		sort.Strings(networks)
+	}
+	var lastErr error
+	for _, network := range networks {
+		free, err := commonFreeAddresses(candidates, network)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		addresses := make([]string, 0, len(free))
+		for a := range free {
+			if !IPInRange(a, dhcpRange) {
+				addresses = append(addresses, a)
+			}
+		}
+		if len(addresses) < 2 {
+			lastErr = errors.Errorf("Less than two addresses of network %s are free on all the hosts", network)
+			continue
+		}
+		sort.Slice(addresses, func(i, j int) bool {
+			return bytes.Compare(net.ParseIP(addresses[i]).To16(), net.ParseIP(addresses[j]).To16()) < 0
+		})
+		log.Debugf("Suggesting VIPs %s and %s of network %s", addresses[0], addresses[1], network)
+		return &models.VipsSuggestion{
+			MachineNetworkCidr: network,
+			APIVip:             addresses[0],
+			IngressVip:         addresses[1],
+		}, nil
+	}
+	if lastErr == nil {
+		lastErr = errors.New("The hosts didn't report free addresses yet")
+	}
+	return nil, lastErr
+}
+
+// VerifySuggestedVips verifies that automatically assigned VIPs are still free on all the connected hosts and outside
+// the DHCP range of the machine network
+func VerifySuggestedVips(hosts []*models.Host, machineNetworkCidr, apiVip, ingressVip, dhcpRange string) error {
+	if apiVip == "" || ingressVip == "" || machineNetworkCidr == "" {
+		return errors.New("VIPs are not assigned")
+	}
+	if err := verifyDifferentVipAddresses(apiVip, ingressVip); err != nil {
+		return err
+	}
+	free, err := commonFreeAddresses(vipCandidateHosts(hosts), machineNetworkCidr)
+	if err != nil {
+		return err
+	}
+	for _, vip := range []string{apiVip, ingressVip} {
+		if !ipInCidr(vip, machineNetworkCidr) || !free.Contains(vip) || IPInRange(vip, dhcpRange) {
+			return errors.Errorf("VIP %s is not free in machine network %s", vip, machineNetworkCidr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package network
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("VIP suggestion", func() {
+	log := logrus.New()
+
+	makeHost := func(status, freeAddresses string) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		return &models.Host{ID: &id, Status: swag.String(status), FreeAddresses: freeAddresses}
+	}
+
+	It("IPInRange", func() {
+		Expect(IPInRange("1.2.3.10", "1.2.3.10-1.2.3.20")).To(BeTrue())
+		Expect(IPInRange("1.2.3.20", "1.2.3.10-1.2.3.20")).To(BeTrue())
+		Expect(IPInRange("1.2.3.21", "1.2.3.10-1.2.3.20")).To(BeFalse())
+		Expect(IPInRange("1.2.3.15", "")).To(BeFalse())
+		_, _, err := ParseIPRange("1.2.3.20-1.2.3.10")
+		Expect(err).To(HaveOccurred())
+		_, _, err = ParseIPRange("1.2.3.20")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("suggests addresses that are free on all the hosts", func() {
+		hosts := []*models.Host{
+			makeHost(models.HostStatusKnown, `[{"network":"1.2.3.0/24","free_addresses":["1.2.3.9","1.2.3.10","1.2.3.11","1.2.3.12"]}]`),
+			makeHost(models.HostStatusInsufficient, `[{"network":"1.2.3.0/24","free_addresses":["1.2.3.12","1.2.3.11","1.2.3.10"]}]`),
+			makeHost(models.HostStatusDisconnected, `[{"network":"1.2.3.0/24","free_addresses":["1.2.3.100"]}]`),
+		}
+		suggestion, err := SuggestVips(hosts, "", "", log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(suggestion).To(Equal(&models.VipsSuggestion{MachineNetworkCidr: "1.2.3.0/24", APIVip: "1.2.3.10", IngressVip: "1.2.3.11"}))
+
+		suggestion, err = SuggestVips(hosts, "1.2.3.0/24", "1.2.3.1-1.2.3.10", log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(suggestion.APIVip).To(Equal("1.2.3.11"))
+		Expect(suggestion.IngressVip).To(Equal("1.2.3.12"))
+
+		Expect(VerifySuggestedVips(hosts, "1.2.3.0/24", "1.2.3.11", "1.2.3.12", "")).ToNot(HaveOccurred())
+		Expect(VerifySuggestedVips(hosts, "1.2.3.0/24", "1.2.3.9", "1.2.3.12", "")).To(HaveOccurred())
+		Expect(VerifySuggestedVips(hosts, "1.2.3.0/24", "1.2.3.11", "1.2.3.12", "1.2.3.12-1.2.3.20")).To(HaveOccurred())
+	})
+
+	It("not enough free addresses", func() {
+		hosts := []*models.Host{
+			makeHost(models.HostStatusKnown, `[{"network":"1.2.3.0/24","free_addresses":["1.2.3.10","1.2.3.11"]}]`),
+			makeHost(models.HostStatusKnown, `[{"network":"1.2.3.0/24","free_addresses":["1.2.3.11","1.2.3.12"]}]`),
+		}
+		_, err := SuggestVips(hosts, "1.2.3.0/24", "", log)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("a host didn't report the network", func() {
+		hosts := []*models.Host{
+			makeHost(models.HostStatusKnown, `[{"network":"1.2.3.0/24","free_addresses":["1.2.3.10","1.2.3.11"]}]`),
+			makeHost(models.HostStatusKnown, ""),
+		}
+		_, err := SuggestVips(hosts, "1.2.3.0/24", "", log)
+		Expect(err).To(HaveOccurred())
+		_, err = SuggestVips(nil, "1.2.3.0/24", "", log)
+		Expect(err).To(HaveOccurred())
+	})
+})
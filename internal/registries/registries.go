@@ -0,0 +1,64 @@
+package registries
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// RegistriesConfPath is the path of the drop-in of the containers registries configuration of the hosts, the
+// configuration of the hosts itself is left as is
+const RegistriesConfPath = "/etc/containers/registries.conf.d/99-assisted-mirrors.conf"
+
+// ImageContentSource is a source of images and the mirrors that serve its images, in the format of the
+// imageContentSources of the install-config
+type ImageContentSource struct {
+	Mirrors []string `yaml:"mirrors"`
+	Source  string   `yaml:"source"`
+}
+
+// ParseImageContentSources parses and validates a YAML formatted list of image content sources, an empty string is an
+// empty list
+func ParseImageContentSources(imageContentSources string) ([]ImageContentSource, error) {
+	var sources []ImageContentSource
+	if strings.TrimSpace(imageContentSources) == "" {
+		return sources, nil
+	}
+	if err := yaml.UnmarshalStrict([]byte(imageContentSources), &sources); err != nil {
+		return nil, errors.Wrap(err, "Image content sources are not a valid YAML list of sources and mirrors")
+	}
+	for i, s := range sources {
+		if s.Source == "" {
+			return nil, errors.Errorf("Image content source %d has no source", i)
+		}
+		if len(s.Mirrors) == 0 {
+			return nil, errors.Errorf("Image content source %s has no mirrors", s.Source)
+		}
+		for _, m := range s.Mirrors {
+			if m == "" {
+				return nil, errors.Errorf("Image content source %s has an empty mirror", s.Source)
+			}
+		}
+	}
+	return sources, nil
+}
+
+// GenerateRegistriesConf returns the drop-in of the containers registries configuration, in version 2 format, that
+// pulls the images of the sources from their mirrors. Unlike the image content source policies of OpenShift, the
+// mirrors are used for pulls by tag too, as the hosts pull the agent and the installer images by tag
+func GenerateRegistriesConf(sources []ImageContentSource) string {
+	var b strings.Builder
+	for i, s := range sources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "[[registry]]\n  prefix = \"\"\n  location = %s\n  mirror-by-digest-only = false\n", strconv.Quote(s.Source))
+		for _, m := range s.Mirrors {
+			fmt.Fprintf(&b, "\n  [[registry.mirror]]\n    location = %s\n", strconv.Quote(m))
+		}
+	}
+	return b.String()
+}
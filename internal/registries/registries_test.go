@@ -0,0 +1,47 @@
+package registries
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRegistries(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Registries Suite")
+}
+
+var _ = Describe("registries", func() {
+	It("parses the image content sources", func() {
+		sources, err := ParseImageContentSources(`
+- mirrors:
+  - mirror.example.com:5000/ocp4/openshift4
+  - mirror2.example.com/ocp4/openshift4
+  source: quay.io/openshift-release-dev/ocp-release
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sources).To(Equal([]ImageContentSource{{
+			Mirrors: []string{"mirror.example.com:5000/ocp4/openshift4", "mirror2.example.com/ocp4/openshift4"},
+			Source:  "quay.io/openshift-release-dev/ocp-release",
+		}}))
+		sources, err = ParseImageContentSources(" ")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sources).To(BeEmpty())
+	})
+
+	It("generates the registries configuration of the mirrors", func() {
+		conf := GenerateRegistriesConf([]ImageContentSource{{
+			Mirrors: []string{"mirror.example.com:5000/ocp4/openshift4"},
+			Source:  "quay.io/openshift-release-dev/ocp-release",
+		}})
+		Expect(conf).To(Equal(`[[registry]]
+  prefix = ""
+  location = "quay.io/openshift-release-dev/ocp-release"
+  mirror-by-digest-only = false
+
+  [[registry.mirror]]
+    location = "mirror.example.com:5000/ocp4/openshift4"
+`))
+	})
+})
@@ -2,13 +2,39 @@ package versions
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/restapi"
 	operations "github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
 )
 
+// defaultOpenshiftVersions is the catalog of the OpenShift versions when OPENSHIFT_VERSIONS is not set, both versions
+// are discovered with the default RHCOS live ISO. OPENSHIFT_INSTALL_RELEASE_IMAGE overrides the release image of the
+// default version.
+const defaultOpenshiftVersions = `{
+	"4.5": {
+		"display_name": "4.5",
+		"release_image": "quay.io/openshift-release-dev/ocp-release:4.5.16-x86_64",
+		"support_level": "production",
+		"supported_network_types": ["OpenShiftSDN"]
+	},
+	"4.6": {
+		"display_name": "4.6",
+		"release_image": "quay.io/openshift-release-dev/ocp-release@sha256:eab93b4591699a5a4ff50ad3517892653f04fb840127895bb3609b3cc68f98f3",
+		"support_level": "beta",
+		"supported_network_types": ["OpenShiftSDN", "OVNKubernetes"]
+	}
+}`
+
+// defaultOpenshiftVersion is the version of the default catalog that clusters are installed with by default
+const defaultOpenshiftVersion = "4.6"
+
 type Versions struct {
 	SelfVersion       string `envconfig:"SELF_VERSION" default:"quay.io/ocpmetal/assisted-iso-create:latest"`
 	ImageBuilder      string `envconfig:"IMAGE_BUILDER" default:"quay.io/ocpmetal/assisted-iso-create:latest"`
@@ -17,16 +43,64 @@ type Versions struct {
 	InstallerImage    string `envconfig:"INSTALLER_IMAGE" default:"quay.io/ocpmetal/assisted-installer:latest"`
 	ControllerImage   string `envconfig:"CONTROLLER_IMAGE" default:"quay.io/ocpmetal/assisted-installer-controller:latest"`
 	ReleaseTag        string `envconfig:"RELEASE_TAG" default:""`
+	// RHCOSImage is the RHCOS live ISO of the versions that do not set one, a URL or a path of the service
+	RHCOSImage string `envconfig:"RHCOS_IMAGE" default:"https://mirror.openshift.com/pub/openshift-v4/dependencies/rhcos/4.6/4.6.1/rhcos-4.6.1-x86_64-live.x86_64.iso"`
+	// RHCOSMinimalImage is the RHCOS minimal live ISO of the versions that do not set one, a URL or a path of the
+	// service, the minimal discovery image is not available for versions without one
+	RHCOSMinimalImage string `envconfig:"RHCOS_MINIMAL_IMAGE" default:""`
+	// OpenshiftVersions is the catalog of the supported OpenShift versions, a JSON object of openshift-version
+	// definitions keyed by the version
+	OpenshiftVersions string `envconfig:"OPENSHIFT_VERSIONS" default:""`
+	// ReleaseImage is the release image of the default OpenShift version when OPENSHIFT_VERSIONS is not set
+	ReleaseImage string `envconfig:"OPENSHIFT_INSTALL_RELEASE_IMAGE" default:""`
+}
+
+//go:generate mockgen -source=versions.go -package=versions -destination=mock_versions.go
+type Handler interface {
+	GetReleaseImage(openshiftVersion string) (string, error)
+	GetRHCOSImage(openshiftVersion string) (string, error)
+	GetRHCOSImages() []string
+	GetRHCOSMinimalImage(openshiftVersion string) (string, error)
+	GetRHCOSMinimalImages() []string
+	IsOpenshiftVersionSupported(openshiftVersion string) bool
+	GetSupportedNetworkTypes(openshiftVersion string) ([]string, error)
 }
 
-func NewHandler(versions Versions) *handler {
-	return &handler{versions: versions}
+// ParseOpenshiftVersions parses and validates the catalog of the OpenShift versions, the default catalog is returned
+// for an empty catalog, with the release image of its default version replaced by releaseImage when it is set
+func ParseOpenshiftVersions(catalog, releaseImage string) (models.OpenshiftVersions, error) {
+	isDefaultCatalog := catalog == ""
+	if isDefaultCatalog {
+		catalog = defaultOpenshiftVersions
+	}
+	var openshiftVersions models.OpenshiftVersions
+	if err := json.Unmarshal([]byte(catalog), &openshiftVersions); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the OpenShift versions")
+	}
+	if isDefaultCatalog && releaseImage != "" {
+		version := openshiftVersions[defaultOpenshiftVersion]
+		version.ReleaseImage = &releaseImage
+		openshiftVersions[defaultOpenshiftVersion] = version
+	}
+	if len(openshiftVersions) == 0 {
+		return nil, errors.New("no OpenShift version is supported")
+	}
+	if err := openshiftVersions.Validate(strfmt.Default); err != nil {
+		return nil, errors.Wrap(err, "invalid OpenShift versions")
+	}
+	return openshiftVersions, nil
+}
+
+func NewHandler(versions Versions, openshiftVersions models.OpenshiftVersions) *handler {
+	return &handler{versions: versions, openshiftVersions: openshiftVersions}
 }
 
 var _ restapi.VersionsAPI = (*handler)(nil)
+var _ Handler = (*handler)(nil)
 
 type handler struct {
-	versions Versions
+	versions          Versions
+	openshiftVersions models.OpenshiftVersions
 }
 
 func (h *handler) ListComponentVersions(ctx context.Context, params operations.ListComponentVersionsParams) middleware.Responder {
@@ -43,3 +117,90 @@ func (h *handler) ListComponentVersions(ctx context.Context, params operations.L
 			ReleaseTag: h.versions.ReleaseTag,
 		})
 }
+
+func (h *handler) ListSupportedOpenshiftVersions(ctx context.Context, params operations.ListSupportedOpenshiftVersionsParams) middleware.Responder {
+	return operations.NewListSupportedOpenshiftVersionsOK().WithPayload(h.openshiftVersions)
+}
+
+func (h *handler) getOpenshiftVersion(openshiftVersion string) (*models.OpenshiftVersion, error) {
+	version, ok := h.openshiftVersions[openshiftVersion]
+	if !ok {
+		return nil, errors.Errorf("OpenShift version %s is not supported", openshiftVersion)
+	}
+	return &version, nil
+}
+
+// GetReleaseImage returns the release image that clusters of the OpenShift version are installed from
+func (h *handler) GetReleaseImage(openshiftVersion string) (string, error) {
+	version, err := h.getOpenshiftVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+	return *version.ReleaseImage, nil
+}
+
+// GetRHCOSImage returns the RHCOS live ISO that the discovery image of clusters of the OpenShift version is based on
+func (h *handler) GetRHCOSImage(openshiftVersion string) (string, error) {
+	version, err := h.getOpenshiftVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+	if version.RhcosImage == "" {
+		return h.versions.RHCOSImage, nil
+	}
+	return version.RhcosImage, nil
+}
+
+// GetRHCOSImages returns the RHCOS live ISOs of all the OpenShift versions, sorted and without duplicates
+func (h *handler) GetRHCOSImages() []string {
+	return h.getImages(h.GetRHCOSImage)
+}
+
+// GetRHCOSMinimalImage returns the RHCOS minimal live ISO that the minimal discovery image of clusters of the OpenShift
+// version is based on, it is empty if the version has no minimal live ISO
+func (h *handler) GetRHCOSMinimalImage(openshiftVersion string) (string, error) {
+	version, err := h.getOpenshiftVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+	if version.RhcosMinimalImage == "" {
+		return h.versions.RHCOSMinimalImage, nil
+	}
+	return version.RhcosMinimalImage, nil
+}
+
+// GetRHCOSMinimalImages returns the RHCOS minimal live ISOs of all the OpenShift versions, sorted and without
+// duplicates
+func (h *handler) GetRHCOSMinimalImages() []string {
+	return h.getImages(h.GetRHCOSMinimalImage)
+}
+
+func (h *handler) getImages(getImage func(openshiftVersion string) (string, error)) []string {
+	var images []string
+	for openshiftVersion := range h.openshiftVersions {
+		image, _ := getImage(openshiftVersion)
+		if image != "" && !funk.ContainsString(images, image) {
+			images = append(images, image)
+		}
+	}
+	sort.Strings(images)
+	return images
+}
+
+func (h *handler) IsOpenshiftVersionSupported(openshiftVersion string) bool {
+	_, ok := h.openshiftVersions[openshiftVersion]
+	return ok
+}
+
+// GetSupportedNetworkTypes returns the network types that clusters of the OpenShift version can be installed with, all
+// the network types if the version doesn't list them
+func (h *handler) GetSupportedNetworkTypes(openshiftVersion string) ([]string, error) {
+	version, err := h.getOpenshiftVersion(openshiftVersion)
+	if err != nil {
+		return nil, err
+	}
+	if len(version.SupportedNetworkTypes) == 0 {
+		return []string{models.ClusterNetworkTypeOpenShiftSDN, models.ClusterNetworkTypeOVNKubernetes}, nil
+	}
+	return version.SupportedNetworkTypes, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
 	operations "github.com/openshift/assisted-service/restapi/operations/versions"
 )
 
@@ -24,7 +25,7 @@ var _ = Describe("list versions", func() {
 	)
 	It("default values", func() {
 		Expect(envconfig.Process("test", &versions)).ShouldNot(HaveOccurred())
-		h = NewHandler(versions)
+		h = NewHandler(versions, nil)
 		reply := h.ListComponentVersions(context.Background(), operations.ListComponentVersionsParams{})
 		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListComponentVersionsOK()))
 		val, _ := reply.(*operations.ListComponentVersionsOK)
@@ -45,7 +46,7 @@ var _ = Describe("list versions", func() {
 		os.Setenv("INSTALLER_IMAGE", "installer-image")
 		os.Setenv("CONTROLLER_IMAGE", "controller-image")
 		Expect(envconfig.Process("test", &versions)).ShouldNot(HaveOccurred())
-		h = NewHandler(versions)
+		h = NewHandler(versions, nil)
 		reply := h.ListComponentVersions(context.Background(), operations.ListComponentVersionsParams{})
 		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListComponentVersionsOK()))
 		val, _ := reply.(*operations.ListComponentVersionsOK)
@@ -59,3 +60,118 @@ var _ = Describe("list versions", func() {
 		Expect(val.Payload.ReleaseTag).Should(Equal(""))
 	})
 })
+
+var _ = Describe("list supported openshift versions", func() {
+	var (
+		h                 *handler
+		openshiftVersions models.OpenshiftVersions
+	)
+
+	BeforeEach(func() {
+		var err error
+		openshiftVersions, err = ParseOpenshiftVersions("", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		h = NewHandler(Versions{}, openshiftVersions)
+	})
+
+	It("default versions", func() {
+		reply := h.ListSupportedOpenshiftVersions(context.Background(), operations.ListSupportedOpenshiftVersionsParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListSupportedOpenshiftVersionsOK()))
+		val, _ := reply.(*operations.ListSupportedOpenshiftVersionsOK)
+		Expect(val.Payload).Should(HaveLen(2))
+		Expect(val.Payload).Should(HaveKey("4.5"))
+		Expect(val.Payload).Should(HaveKey("4.6"))
+	})
+
+	It("per version values", func() {
+		Expect(h.IsOpenshiftVersionSupported("4.5")).Should(BeTrue())
+		Expect(h.IsOpenshiftVersionSupported("4.4")).Should(BeFalse())
+		releaseImage, err := h.GetReleaseImage("4.5")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(releaseImage).Should(Equal("quay.io/openshift-release-dev/ocp-release:4.5.16-x86_64"))
+		releaseImage, err = h.GetReleaseImage("4.6")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(releaseImage).Should(Equal("quay.io/openshift-release-dev/ocp-release@sha256:" +
+			"eab93b4591699a5a4ff50ad3517892653f04fb840127895bb3609b3cc68f98f3"))
+		rhcosImage, err := h.GetRHCOSImage("4.6")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rhcosImage).Should(Equal(""))
+		h = NewHandler(Versions{RHCOSImage: "https://example.com/rhcos-live.iso"}, openshiftVersions)
+		rhcosImage, err = h.GetRHCOSImage("4.6")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rhcosImage).Should(Equal("https://example.com/rhcos-live.iso"))
+		Expect(h.GetRHCOSImages()).Should(Equal([]string{"https://example.com/rhcos-live.iso"}))
+		_, err = h.GetReleaseImage("4.4")
+		Expect(err).Should(HaveOccurred())
+		_, err = h.GetRHCOSImage("4.4")
+		Expect(err).Should(HaveOccurred())
+		rhcosMinimalImage, err := h.GetRHCOSMinimalImage("4.6")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rhcosMinimalImage).Should(Equal(""))
+		Expect(h.GetRHCOSMinimalImages()).Should(BeEmpty())
+		_, err = h.GetRHCOSMinimalImage("4.4")
+		Expect(err).Should(HaveOccurred())
+		networkTypes, err := h.GetSupportedNetworkTypes("4.5")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(networkTypes).Should(Equal([]string{models.ClusterNetworkTypeOpenShiftSDN}))
+		networkTypes, err = h.GetSupportedNetworkTypes("4.6")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(networkTypes).Should(Equal([]string{models.ClusterNetworkTypeOpenShiftSDN, models.ClusterNetworkTypeOVNKubernetes}))
+		_, err = h.GetSupportedNetworkTypes("4.4")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("configured versions", func() {
+		var err error
+		openshiftVersions, err = ParseOpenshiftVersions(`{"4.6": {"display_name": "4.6.1", `+
+			`"release_image": "quay.io/openshift-release-dev/ocp-release:4.6.1-x86_64", `+
+			`"rhcos_image": "https://example.com/rhcos-4.6.1-x86_64-live.x86_64.iso", "support_level": "production"}, `+
+			`"4.7": {"display_name": "4.7.0", "release_image": "quay.io/openshift-release-dev/ocp-release:4.7.0-x86_64", `+
+			`"rhcos_minimal_image": "https://example.com/rhcos-4.7.0-x86_64-live-minimal.x86_64.iso", "support_level": "beta"}}`,
+			"quay.io/openshift-release-dev/ocp-release:4.6.2-x86_64")
+		Expect(err).ShouldNot(HaveOccurred())
+		h = NewHandler(Versions{RHCOSImage: "https://example.com/rhcos-live.iso"}, openshiftVersions)
+		Expect(h.IsOpenshiftVersionSupported("4.5")).Should(BeFalse())
+		releaseImage, err := h.GetReleaseImage("4.6")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(releaseImage).Should(Equal("quay.io/openshift-release-dev/ocp-release:4.6.1-x86_64"))
+		rhcosImage, err := h.GetRHCOSImage("4.6")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rhcosImage).Should(Equal("https://example.com/rhcos-4.6.1-x86_64-live.x86_64.iso"))
+		Expect(h.GetRHCOSImages()).Should(Equal([]string{"https://example.com/rhcos-4.6.1-x86_64-live.x86_64.iso",
+			"https://example.com/rhcos-live.iso"}))
+		rhcosMinimalImage, err := h.GetRHCOSMinimalImage("4.7")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rhcosMinimalImage).Should(Equal("https://example.com/rhcos-4.7.0-x86_64-live-minimal.x86_64.iso"))
+		Expect(h.GetRHCOSMinimalImages()).Should(Equal([]string{"https://example.com/rhcos-4.7.0-x86_64-live-minimal.x86_64.iso"}))
+		networkTypes, err := h.GetSupportedNetworkTypes("4.7")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(networkTypes).Should(Equal([]string{models.ClusterNetworkTypeOpenShiftSDN, models.ClusterNetworkTypeOVNKubernetes}))
+	})
+
+	It("default version release image", func() {
+		var err error
+		openshiftVersions, err = ParseOpenshiftVersions("", "quay.io/openshift-release-dev/ocp-release:4.6.2-x86_64")
+		Expect(err).ShouldNot(HaveOccurred())
+		h = NewHandler(Versions{}, openshiftVersions)
+		releaseImage, err := h.GetReleaseImage("4.6")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(releaseImage).Should(Equal("quay.io/openshift-release-dev/ocp-release:4.6.2-x86_64"))
+		releaseImage, err = h.GetReleaseImage("4.5")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(releaseImage).Should(Equal("quay.io/openshift-release-dev/ocp-release:4.5.16-x86_64"))
+	})
+
+	It("invalid versions", func() {
+		for _, catalog := range []string{
+			"4.6",
+			"{}",
+			`{"4.6": {"display_name": "4.6"}}`,
+			`{"4.6": {"display_name": "4.6", "release_image": "release", "support_level": "unknown"}}`,
+			`{"4.6": {"display_name": "4.6", "release_image": "release", "support_level": "beta", "supported_network_types": ["Calico"]}}`,
+		} {
+			_, err := ParseOpenshiftVersions(catalog, "")
+			Expect(err).Should(HaveOccurred(), catalog)
+		}
+	})
+})
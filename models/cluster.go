@@ -20,19 +20,28 @@ import (
 // swagger:model cluster
 type Cluster struct {
 
+	// PEM-encoded X.509 certificate bundle that is added to the trusted certificates of the hosts and of the cluster, for example the CA of the registry mirrors.
+	AdditionalTrustBundle string `json:"additional_trust_bundle,omitempty" gorm:"type:text"`
+
 	// Virtual IP used to reach the OpenShift cluster API.
-	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3})?$
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$
 	APIVip string `json:"api_vip,omitempty"`
 
+	// The domain name used to reach the OpenShift cluster API.
+	APIVipDnsname string `json:"api_vip_dnsname,omitempty"`
+
+	// Indicate if the service assigns free addresses of the machine network to the API VIP and the ingress VIP, and replaces them when they are not free anymore.
+	AutoAssignVips bool `json:"auto_assign_vips,omitempty"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
 	// IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ClusterNetworkCidr string `json:"cluster_network_cidr,omitempty"`
 
 	// The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.
-	// Maximum: 32
+	// Maximum: 128
 	// Minimum: 1
 	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
 
@@ -40,6 +49,10 @@ type Cluster struct {
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster over multiple master nodes whereas 'None' installs a full cluster over one node.
+	// Enum: [Full None]
+	HighAvailabilityMode *string `json:"high_availability_mode,omitempty" gorm:"default:'Full'"`
+
 	// List of host networks to be filled during query.
 	HostNetworks []*HostNetwork `json:"host_networks" gorm:"-"`
 
@@ -68,12 +81,20 @@ type Cluster struct {
 	// ignition generator version
 	IgnitionGeneratorVersion string `json:"ignition_generator_version,omitempty"`
 
+	// The mirrors of the image sources of the installation, for installations in disconnected networks. YAML formatted
+	// list in the format of the imageContentSources of the install-config, for example:
+	// - mirrors:
+	//   - mirror.example.com:5000/ocp4/openshift4
+	//   source: quay.io/openshift-release-dev/ocp-release
+	//
+	ImageContentSources string `json:"image_content_sources,omitempty" gorm:"type:text"`
+
 	// image info
 	// Required: true
 	ImageInfo *ImageInfo `json:"image_info" gorm:"embedded;embedded_prefix:image_"`
 
 	// Virtual IP used for cluster ingress traffic.
-	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3})?$
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$
 	IngressVip string `json:"ingress_vip,omitempty"`
 
 	// The time that this cluster completed installation.
@@ -87,23 +108,30 @@ type Cluster struct {
 	// Format: date-time
 	InstallStartedAt strfmt.DateTime `json:"install_started_at,omitempty" gorm:"type:timestamp with time zone;default:'2000-01-01 00:00:00z'"`
 
-	// Indicates the type of this object. Will be 'Cluster' if this is a complete object or 'ClusterLink' if it is just a link.
+	// Indicates the type of this object. Will be 'Cluster' if this is a complete object, 'AddHostsCluster' for cluster that add hosts to existing OCP cluster, or 'ClusterLink' if it is just a link.
 	// Required: true
-	// Enum: [Cluster]
+	// Enum: [Cluster AddHostsCluster]
 	Kind *string `json:"kind"`
 
 	// A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	MachineNetworkCidr string `json:"machine_network_cidr,omitempty"`
 
+	// The range of addresses, in first-last format, that the DHCP server of the machine network leases. VIPs are not suggested from this range.
+	// Pattern: ^(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}-(?:[0-9]{1,3}\.){3}[0-9]{1,3})?$
+	MachineNetworkDhcpRange string `json:"machine_network_dhcp_range,omitempty"`
+
 	// Name of the OpenShift cluster.
 	Name string `json:"name,omitempty"`
 
+	// The network plugin of the cluster. When it is not set, OVNKubernetes is used if one of the networks is an IPv6 network and OpenShiftSDN otherwise.
+	// Enum: [OpenShiftSDN OVNKubernetes]
+	NetworkType string `json:"network_type,omitempty"`
+
 	// A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.
 	NoProxy string `json:"no_proxy,omitempty"`
 
-	// Version of the OpenShift cluster.
-	// Enum: [4.5 4.6]
+	// Version of the OpenShift cluster, one of the versions that /openshift_versions lists.
 	OpenshiftVersion string `json:"openshift_version,omitempty"`
 
 	// org id
@@ -112,8 +140,25 @@ type Cluster struct {
 	// True if the pull-secret has been added to the cluster
 	PullSecretSet bool `json:"pull_secret_set,omitempty"`
 
+	// The cluster network of the second IP family of a dual-stack cluster.
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$
+	SecondaryClusterNetworkCidr string `json:"secondary_cluster_network_cidr,omitempty"`
+
+	// The subnet prefix length to assign to each individual node from the secondary cluster network.
+	// Maximum: 128
+	// Minimum: 1
+	SecondaryClusterNetworkHostPrefix int64 `json:"secondary_cluster_network_host_prefix,omitempty"`
+
+	// The machine network of the second IP family of a dual-stack cluster. The primary networks of a dual-stack cluster are IPv4 and its secondary networks are IPv6.
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$
+	SecondaryMachineNetworkCidr string `json:"secondary_machine_network_cidr,omitempty"`
+
+	// The service network of the second IP family of a dual-stack cluster.
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$
+	SecondaryServiceNetworkCidr string `json:"secondary_service_network_cidr,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
 
 	// SSH public key for debugging OpenShift nodes.
@@ -121,7 +166,7 @@ type Cluster struct {
 
 	// Status of the OpenShift cluster.
 	// Required: true
-	// Enum: [insufficient ready error preparing-for-installation pending-for-input installing finalizing installed]
+	// Enum: [insufficient ready error preparing-for-installation pending-for-input installing finalizing installed adding-hosts]
 	Status *string `json:"status"`
 
 	// Additional information pertaining to the status of the OpenShift cluster.
@@ -136,11 +181,14 @@ type Cluster struct {
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// Indicate if the load balancers of the API and the ingress are managed by the user. The cluster has no VIPs in this mode, and the installation is of platform none.
+	UserManagedNetworking *bool `json:"user_managed_networking,omitempty"`
+
 	// user name
 	UserName string `json:"user_name,omitempty"`
 
 	// Json formatted string containing the validations results for each validation id grouped by category (network, hosts-data, etc.)
-	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:varchar(2048)"`
+	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:text"`
 
 	// Indicate if VIP DHCP allocation mode is enabled.
 	VipDhcpAllocation *bool `json:"vip_dhcp_allocation,omitempty"`
@@ -166,6 +214,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHighAvailabilityMode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostNetworks(formats); err != nil {
 		res = append(res, err)
 	}
@@ -206,7 +258,27 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateOpenshiftVersion(formats); err != nil {
+	if err := m.validateMachineNetworkDhcpRange(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNetworkType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryClusterNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryClusterNetworkHostPrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryMachineNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -242,7 +314,7 @@ func (m *Cluster) validateAPIVip(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("api_vip", "body", string(m.APIVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3})?$`); err != nil {
+	if err := validate.Pattern("api_vip", "body", string(m.APIVip), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$`); err != nil {
 		return err
 	}
 
@@ -255,7 +327,7 @@ func (m *Cluster) validateClusterNetworkCidr(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
 		return err
 	}
 
@@ -272,7 +344,7 @@ func (m *Cluster) validateClusterNetworkHostPrefix(formats strfmt.Registry) erro
 		return err
 	}
 
-	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 32, false); err != nil {
+	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 128, false); err != nil {
 		return err
 	}
 
@@ -292,6 +364,49 @@ func (m *Cluster) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+var clusterTypeHighAvailabilityModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Full","None"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTypeHighAvailabilityModePropEnum = append(clusterTypeHighAvailabilityModePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterHighAvailabilityModeFull captures enum value "Full"
+	ClusterHighAvailabilityModeFull string = "Full"
+
+	// ClusterHighAvailabilityModeNone captures enum value "None"
+	ClusterHighAvailabilityModeNone string = "None"
+)
+
+// prop value enum
+func (m *Cluster) validateHighAvailabilityModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTypeHighAvailabilityModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Cluster) validateHighAvailabilityMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HighAvailabilityMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHighAvailabilityModeEnum("high_availability_mode", "body", *m.HighAvailabilityMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateHostNetworks(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.HostNetworks) { // not required
@@ -388,7 +503,7 @@ func (m *Cluster) validateIngressVip(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("ingress_vip", "body", string(m.IngressVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3})?$`); err != nil {
+	if err := validate.Pattern("ingress_vip", "body", string(m.IngressVip), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$`); err != nil {
 		return err
 	}
 
@@ -425,7 +540,7 @@ var clusterTypeKindPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["Cluster"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["Cluster","AddHostsCluster"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -437,6 +552,9 @@ const (
 
 	// ClusterKindCluster captures enum value "Cluster"
 	ClusterKindCluster string = "Cluster"
+
+	// ClusterKindAddHostsCluster captures enum value "AddHostsCluster"
+	ClusterKindAddHostsCluster string = "AddHostsCluster"
 )
 
 // prop value enum
@@ -467,50 +585,119 @@ func (m *Cluster) validateMachineNetworkCidr(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("machine_network_cidr", "body", string(m.MachineNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("machine_network_cidr", "body", string(m.MachineNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateMachineNetworkDhcpRange(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MachineNetworkDhcpRange) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("machine_network_dhcp_range", "body", string(m.MachineNetworkDhcpRange), `^(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}-(?:[0-9]{1,3}\.){3}[0-9]{1,3})?$`); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var clusterTypeOpenshiftVersionPropEnum []interface{}
+var clusterTypeNetworkTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["4.5","4.6"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["OpenShiftSDN","OVNKubernetes"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
-		clusterTypeOpenshiftVersionPropEnum = append(clusterTypeOpenshiftVersionPropEnum, v)
+		clusterTypeNetworkTypePropEnum = append(clusterTypeNetworkTypePropEnum, v)
 	}
 }
 
 const (
 
-	// ClusterOpenshiftVersionNr45 captures enum value "4.5"
-	ClusterOpenshiftVersionNr45 string = "4.5"
+	// ClusterNetworkTypeOpenShiftSDN captures enum value "OpenShiftSDN"
+	ClusterNetworkTypeOpenShiftSDN string = "OpenShiftSDN"
 
-	// ClusterOpenshiftVersionNr46 captures enum value "4.6"
-	ClusterOpenshiftVersionNr46 string = "4.6"
+	// ClusterNetworkTypeOVNKubernetes captures enum value "OVNKubernetes"
+	ClusterNetworkTypeOVNKubernetes string = "OVNKubernetes"
 )
 
 // prop value enum
-func (m *Cluster) validateOpenshiftVersionEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, clusterTypeOpenshiftVersionPropEnum, true); err != nil {
+func (m *Cluster) validateNetworkTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTypeNetworkTypePropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (m *Cluster) validateOpenshiftVersion(formats strfmt.Registry) error {
+func (m *Cluster) validateNetworkType(formats strfmt.Registry) error {
 
-	if swag.IsZero(m.OpenshiftVersion) { // not required
+	if swag.IsZero(m.NetworkType) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := m.validateOpenshiftVersionEnum("openshift_version", "body", m.OpenshiftVersion); err != nil {
+	if err := m.validateNetworkTypeEnum("network_type", "body", m.NetworkType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateSecondaryClusterNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryClusterNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_cluster_network_cidr", "body", string(m.SecondaryClusterNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateSecondaryClusterNetworkHostPrefix(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryClusterNetworkHostPrefix) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("secondary_cluster_network_host_prefix", "body", int64(m.SecondaryClusterNetworkHostPrefix), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("secondary_cluster_network_host_prefix", "body", int64(m.SecondaryClusterNetworkHostPrefix), 128, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateSecondaryMachineNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryMachineNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_machine_network_cidr", "body", string(m.SecondaryMachineNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateSecondaryServiceNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryServiceNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_service_network_cidr", "body", string(m.SecondaryServiceNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$`); err != nil {
 		return err
 	}
 
@@ -523,7 +710,7 @@ func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
 		return err
 	}
 
@@ -534,7 +721,7 @@ var clusterTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["insufficient","ready","error","preparing-for-installation","pending-for-input","installing","finalizing","installed"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["insufficient","ready","error","preparing-for-installation","pending-for-input","installing","finalizing","installed","adding-hosts"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -567,6 +754,9 @@ const (
 
 	// ClusterStatusInstalled captures enum value "installed"
 	ClusterStatusInstalled string = "installed"
+
+	// ClusterStatusAddingHosts captures enum value "adding-hosts"
+	ClusterStatusAddingHosts string = "adding-hosts"
 )
 
 // prop value enum
@@ -61,6 +61,18 @@ const (
 
 	// ClusterValidationIDPullSecretSet captures enum value "pull-secret-set"
 	ClusterValidationIDPullSecretSet ClusterValidationID = "pull-secret-set"
+
+	// ClusterValidationIDAllHostsAreConnected captures enum value "all-hosts-are-connected"
+	ClusterValidationIDAllHostsAreConnected ClusterValidationID = "all-hosts-are-connected"
+
+	// ClusterValidationIDNetworkTypeValid captures enum value "network-type-valid"
+	ClusterValidationIDNetworkTypeValid ClusterValidationID = "network-type-valid"
+
+	// ClusterValidationIDAPIDomainNameResolved captures enum value "api-domain-name-resolved"
+	ClusterValidationIDAPIDomainNameResolved ClusterValidationID = "api-domain-name-resolved"
+
+	// ClusterValidationIDAppsDomainNameResolved captures enum value "apps-domain-name-resolved"
+	ClusterValidationIDAppsDomainNameResolved ClusterValidationID = "apps-domain-name-resolved"
 )
 
 // for schema
@@ -68,7 +80,7 @@ var clusterValidationIdEnum []interface{}
 
 func init() {
 	var res []ClusterValidationID
-	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vip-defined","api-vip-valid","ingress-vip-defined","ingress-vip-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vip-defined","api-vip-valid","ingress-vip-defined","ingress-vip-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","all-hosts-are-connected","network-type-valid","api-domain-name-resolved","apps-domain-name-resolved"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
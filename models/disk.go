@@ -6,6 +6,7 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -21,9 +22,18 @@ type Disk struct {
 	// drive type
 	DriveType string `json:"drive_type,omitempty"`
 
+	// Whether the disk has existing partitions.
+	HasPartitions bool `json:"has_partitions,omitempty"`
+
 	// hctl
 	Hctl string `json:"hctl,omitempty"`
 
+	// Stable identifier of the disk, its by-path link when available or its device path otherwise.
+	ID string `json:"id,omitempty"`
+
+	// installation eligibility
+	InstallationEligibility *DiskInstallationEligibility `json:"installation_eligibility,omitempty"`
+
 	// model
 	Model string `json:"model,omitempty"`
 
@@ -33,6 +43,9 @@ type Disk struct {
 	// path
 	Path string `json:"path,omitempty"`
 
+	// Whether the disk is a removable device.
+	Removable bool `json:"removable,omitempty"`
+
 	// serial
 	Serial string `json:"serial,omitempty"`
 
@@ -48,6 +61,33 @@ type Disk struct {
 
 // Validate validates this disk
 func (m *Disk) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateInstallationEligibility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Disk) validateInstallationEligibility(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.InstallationEligibility) { // not required
+		return nil
+	}
+
+	if m.InstallationEligibility != nil {
+		if err := m.InstallationEligibility.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("installation_eligibility")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
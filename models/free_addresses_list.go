@@ -16,7 +16,7 @@ import (
 // FreeAddressesList free addresses list
 //
 // swagger:model free-addresses-list
-type FreeAddressesList []strfmt.IPv4
+type FreeAddressesList []string
 
 // Validate validates this free addresses list
 func (m FreeAddressesList) Validate(formats strfmt.Registry) error {
@@ -24,7 +24,7 @@ func (m FreeAddressesList) Validate(formats strfmt.Registry) error {
 
 	for i := 0; i < len(m); i++ {
 
-		if err := validate.FormatOf(strconv.Itoa(i), "body", "ipv4", m[i].String(), formats); err != nil {
+		if err := validate.Pattern(strconv.Itoa(i), "body", string(m[i]), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$`); err != nil {
 			return err
 		}
 
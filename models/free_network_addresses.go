@@ -6,8 +6,6 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
-	"strconv"
-
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -20,10 +18,10 @@ import (
 type FreeNetworkAddresses struct {
 
 	// free addresses
-	FreeAddresses []strfmt.IPv4 `json:"free_addresses"`
+	FreeAddresses []string `json:"free_addresses"`
 
 	// network
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	Network string `json:"network,omitempty"`
 }
 
@@ -31,10 +29,6 @@ type FreeNetworkAddresses struct {
 func (m *FreeNetworkAddresses) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateFreeAddresses(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if err := m.validateNetwork(formats); err != nil {
 		res = append(res, err)
 	}
@@ -45,30 +39,13 @@ func (m *FreeNetworkAddresses) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *FreeNetworkAddresses) validateFreeAddresses(formats strfmt.Registry) error {
-
-	if swag.IsZero(m.FreeAddresses) { // not required
-		return nil
-	}
-
-	for i := 0; i < len(m.FreeAddresses); i++ {
-
-		if err := validate.FormatOf("free_addresses"+"."+strconv.Itoa(i), "body", "ipv4", m.FreeAddresses[i].String(), formats); err != nil {
-			return err
-		}
-
-	}
-
-	return nil
-}
-
 func (m *FreeNetworkAddresses) validateNetwork(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Network) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("network", "body", string(m.Network), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("network", "body", string(m.Network), `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
 		return err
 	}
 
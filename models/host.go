@@ -41,6 +41,9 @@ type Host struct {
 	// discovery agent version
 	DiscoveryAgentVersion string `json:"discovery_agent_version,omitempty"`
 
+	// Json formatted string containing the addresses that the host resolved for the domain names of the cluster.
+	DomainResolutions string `json:"domain_resolutions,omitempty" gorm:"type:text"`
+
 	// free addresses
 	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
 
@@ -53,6 +56,9 @@ type Host struct {
 	// Format: uuid
 	ID *strfmt.UUID `json:"id" gorm:"primary_key"`
 
+	// The id of the inventory disk that the host will be installed on.
+	InstallationDiskID string `json:"installation_disk_id,omitempty"`
+
 	// Host installation path
 	InstallationDiskPath string `json:"installation_disk_path,omitempty"`
 
@@ -91,9 +97,12 @@ type Host struct {
 	// Format: date-time
 	StageUpdatedAt strfmt.DateTime `json:"stage_updated_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// The static network configuration, in nmstate YAML format, of the discovery image that matched the host.
+	StaticNetworkConfig string `json:"static_network_config,omitempty" gorm:"type:text"`
+
 	// status
 	// Required: true
-	// Enum: [discovering known disconnected insufficient disabled preparing-for-installation pending-for-input installing installing-in-progress installing-pending-user-action resetting-pending-user-action installed error resetting]
+	// Enum: [discovering known disconnected insufficient disabled preparing-for-installation pending-for-input installing installing-in-progress installing-pending-user-action resetting-pending-user-action installed error resetting added-to-existing-cluster]
 	Status *string `json:"status"`
 
 	// status info
@@ -112,7 +121,7 @@ type Host struct {
 	UserName string `json:"user_name,omitempty"`
 
 	// Json formatted string containing the validations results for each validation id grouped by category (network, hardware, etc.)
-	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:varchar(2048)"`
+	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:text"`
 }
 
 // Validate validates this host
@@ -387,7 +396,7 @@ var hostTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["discovering","known","disconnected","insufficient","disabled","preparing-for-installation","pending-for-input","installing","installing-in-progress","installing-pending-user-action","resetting-pending-user-action","installed","error","resetting"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["discovering","known","disconnected","insufficient","disabled","preparing-for-installation","pending-for-input","installing","installing-in-progress","installing-pending-user-action","resetting-pending-user-action","installed","error","resetting","added-to-existing-cluster"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -438,6 +447,9 @@ const (
 
 	// HostStatusResetting captures enum value "resetting"
 	HostStatusResetting string = "resetting"
+
+	// HostStatusAddedToExistingCluster captures enum value "added-to-existing-cluster"
+	HostStatusAddedToExistingCluster string = "added-to-existing-cluster"
 )
 
 // prop value enum
@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -17,6 +19,13 @@ import (
 // swagger:model image_info
 type ImageInfo struct {
 
+	// Status of the build of the image, the image can be downloaded once it is uploaded.
+	// Enum: [queued running uploaded failed]
+	BuildStatus string `json:"build_status,omitempty"`
+
+	// Reason of the failure of the build of the image.
+	BuildStatusInfo string `json:"build_status_info,omitempty"`
+
 	// created at
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -37,12 +46,19 @@ type ImageInfo struct {
 
 	// SSH public key for debugging the installation
 	SSHPublicKey string `json:"ssh_public_key,omitempty" gorm:"type:varchar(1024)"`
+
+	// JSON-formatted list of the static network configurations of the hosts that boot from the image.
+	StaticNetworkConfig string `json:"static_network_config,omitempty" gorm:"type:text"`
 }
 
 // Validate validates this image info
 func (m *ImageInfo) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBuildStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCreatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -61,6 +77,55 @@ func (m *ImageInfo) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var imageInfoTypeBuildStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["queued","running","uploaded","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		imageInfoTypeBuildStatusPropEnum = append(imageInfoTypeBuildStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ImageInfoBuildStatusQueued captures enum value "queued"
+	ImageInfoBuildStatusQueued string = "queued"
+
+	// ImageInfoBuildStatusRunning captures enum value "running"
+	ImageInfoBuildStatusRunning string = "running"
+
+	// ImageInfoBuildStatusUploaded captures enum value "uploaded"
+	ImageInfoBuildStatusUploaded string = "uploaded"
+
+	// ImageInfoBuildStatusFailed captures enum value "failed"
+	ImageInfoBuildStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *ImageInfo) validateBuildStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, imageInfoTypeBuildStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ImageInfo) validateBuildStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BuildStatus) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateBuildStatusEnum("build_status", "body", m.BuildStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ImageInfo) validateCreatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.CreatedAt) { // not required
@@ -16,7 +16,7 @@ import (
 // ListManagedDomains list managed domains
 //
 // swagger:model list-managed-domains
-type ListManagedDomains []*ManagedDomain
+type ListManagedDomains []*ManagedDomainSummary
 
 // Validate validates this list managed domains
 func (m ListManagedDomains) Validate(formats strfmt.Registry) error {
@@ -19,33 +19,95 @@ import (
 // swagger:model managed-domain
 type ManagedDomain struct {
 
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The credentials that manage the zone, an AWS shared credentials profile for route53 and a TSIG key name for rfc2136. The default credentials of the provider are used when it is empty.
+	CredentialsRef string `json:"credentials_ref,omitempty"`
+
 	// domain
-	Domain string `json:"domain,omitempty"`
+	Domain string `json:"domain,omitempty" gorm:"unique_index"`
+
+	// Unique identifier of the managed domain.
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty" gorm:"primary_key"`
+
+	// The organization that owns the domain, a domain without an organization is available to all the users.
+	OrgID string `json:"org_id,omitempty"`
 
 	// provider
-	// Enum: [route53]
+	// Enum: [route53 rfc2136]
 	Provider string `json:"provider,omitempty"`
+
+	// The address of the DNS server that accepts the dynamic updates of an rfc2136 zone, in host:port format.
+	Server string `json:"server,omitempty"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The DNS zone of the domain, a hosted zone ID for route53 and a zone name for rfc2136.
+	ZoneID string `json:"zone_id,omitempty"`
 }
 
 // Validate validates this managed domain
 func (m *ManagedDomain) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProvider(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *ManagedDomain) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ManagedDomain) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var managedDomainTypeProviderPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["route53"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["route53","rfc2136"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -57,6 +119,9 @@ const (
 
 	// ManagedDomainProviderRoute53 captures enum value "route53"
 	ManagedDomainProviderRoute53 string = "route53"
+
+	// ManagedDomainProviderRfc2136 captures enum value "rfc2136"
+	ManagedDomainProviderRfc2136 string = "rfc2136"
 )
 
 // prop value enum
@@ -81,6 +146,19 @@ func (m *ManagedDomain) validateProvider(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ManagedDomain) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ManagedDomain) MarshalBinary() ([]byte, error) {
 	if m == nil {
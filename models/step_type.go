@@ -40,6 +40,9 @@ const (
 
 	// StepTypeDhcpLeaseAllocate captures enum value "dhcp-lease-allocate"
 	StepTypeDhcpLeaseAllocate StepType = "dhcp-lease-allocate"
+
+	// StepTypeDomainResolution captures enum value "domain-resolution"
+	StepTypeDomainResolution StepType = "domain-resolution"
 )
 
 // for schema
@@ -47,7 +50,7 @@ var stepTypeEnum []interface{}
 
 func init() {
 	var res []StepType
-	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","reset-installation","dhcp-lease-allocate"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","reset-installation","dhcp-lease-allocate","domain-resolution"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -2,11 +2,13 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/go-openapi/runtime"
@@ -22,28 +24,43 @@ type Config struct {
 	JwkCertURL string `envconfig:"JWKS_URL" default:"https://api.openshift.com/.well-known/jwks.json"`
 	// Will be split with "," as separator
 	AllowedDomains string `envconfig:"ALLOWED_DOMAINS" default:""`
+	// The secret that signs the URL tokens, it is shared by the replicas of the service
+	URLAuthSecret     string        `envconfig:"URL_AUTH_SECRET" default:""`
+	URLAuthExpiration time.Duration `envconfig:"URL_AUTH_EXPIRATION" default:"4h"`
 }
 
 type AuthHandler struct {
-	EnableAuth bool
-	KeyMap     map[string]*rsa.PublicKey
-	utils      AUtilsInteface
-	log        logrus.FieldLogger
-	client     *ocm.Client
+	EnableAuth        bool
+	KeyMap            map[string]*rsa.PublicKey
+	utils             AUtilsInteface
+	log               logrus.FieldLogger
+	client            *ocm.Client
+	urlAuthSecret     []byte
+	urlAuthExpiration time.Duration
 }
 
 func NewAuthHandler(cfg Config, ocmCLient *ocm.Client, log logrus.FieldLogger) *AuthHandler {
 	a := &AuthHandler{
-		EnableAuth: cfg.EnableAuth,
-		utils:      NewAuthUtils(cfg.JwkCert, cfg.JwkCertURL),
-		client:     ocmCLient,
-		log:        log,
+		EnableAuth:        cfg.EnableAuth,
+		utils:             NewAuthUtils(cfg.JwkCert, cfg.JwkCertURL),
+		client:            ocmCLient,
+		log:               log,
+		urlAuthSecret:     []byte(cfg.URLAuthSecret),
+		urlAuthExpiration: cfg.URLAuthExpiration,
 	}
 	if a.EnableAuth {
 		err := a.populateKeyMap()
 		if err != nil {
 			log.Fatalln("Failed to init auth handler,", err)
 		}
+		if len(a.urlAuthSecret) == 0 {
+			log.Warn("URL_AUTH_SECRET is not set, the URL tokens are signed with a random secret that the other " +
+				"replicas of the service do not share")
+			a.urlAuthSecret = make([]byte, 32)
+			if _, err = rand.Read(a.urlAuthSecret); err != nil {
+				log.Fatalln("Failed to init auth handler,", err)
+			}
+		}
 	}
 	return a
 }
@@ -202,8 +219,11 @@ func (a *AuthHandler) isAdmin(username string) (bool, error) {
 }
 
 func (a *AuthHandler) CreateAuthenticator() func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator {
-	return func(name string, _ string, authenticate security.TokenAuthentication) runtime.Authenticator {
+	return func(name string, in string, authenticate security.TokenAuthentication) runtime.Authenticator {
 		getToken := func(r *http.Request) string { return r.Header.Get(name) }
+		if in == "query" {
+			getToken = func(r *http.Request) string { return r.URL.Query().Get(name) }
+		}
 
 		return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
 			if !a.EnableAuth {
@@ -228,3 +248,55 @@ func (a *AuthHandler) CreateAuthenticator() func(name, in string, authenticate s
 		})
 	}
 }
+
+// CreateClusterURLToken returns a token that authenticates the URLs of the cluster as its owner until it expires, for
+// the clients that cannot send headers (e.g. iPXE and the initramfs of RHCOS). It is empty when auth is disabled
+func (a *AuthHandler) CreateClusterURLToken(clusterID, username, orgID string) (string, error) {
+	if !a.EnableAuth {
+		return "", nil
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":      clusterID,
+		"exp":      time.Now().Add(a.urlAuthExpiration).Unix(),
+		"username": username,
+		"org_id":   orgID,
+	})
+	signed, err := token.SignedString(a.urlAuthSecret)
+	if err != nil {
+		return "", fmt.Errorf("Failed to sign the URL token of cluster %s: %v", clusterID, err)
+	}
+	return signed, nil
+}
+
+// AuthURLAuth authenticates the token of a cluster URL, the payload is limited to the cluster of the token
+func (a *AuthHandler) AuthURLAuth(token string) (interface{}, error) {
+	parsedToken, err := jwt.Parse(token, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Expected %s signing method but token specified %s",
+				jwt.SigningMethodHS256.Alg(), t.Header["alg"])
+		}
+		return a.urlAuthSecret, nil
+	})
+	if err != nil {
+		a.log.Errorf("Error parsing URL token: %s", err.Error())
+		return nil, fmt.Errorf("Error parsing URL token: %v", err)
+	}
+	claims, ok := parsedToken.Claims.(jwt.MapClaims)
+	if !ok || !parsedToken.Valid {
+		return nil, fmt.Errorf("URL token is invalid")
+	}
+	// the expiration is optional for jwt, a URL token must have one
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, fmt.Errorf("URL token has no expiration")
+	}
+
+	payload := &ocm.AuthPayload{}
+	payload.ClusterID, _ = claims["sub"].(string)
+	payload.Username, _ = claims["username"].(string)
+	payload.Organization, _ = claims["org_id"].(string)
+	if payload.ClusterID == "" || payload.Username == "" {
+		a.log.Error("Missing cluster or username in URL token")
+		return nil, fmt.Errorf("Missing cluster or username in URL token")
+	}
+	return payload, nil
+}
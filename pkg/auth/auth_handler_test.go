@@ -3,9 +3,11 @@ package auth
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,15 +37,19 @@ func TestAuth(t *testing.T) {
 	userToken, JwkCert := GetTokenAndCert()
 	agentKeyValue := "fake_pull_secret"
 	userKeyValue := "bearer " + userToken
+	urlAuthSecret := "fake_url_auth_secret"
+	urlToken := createTestURLToken(urlAuthSecret, time.Hour)
 	t.Parallel()
 	tests := []struct {
-		name            string
-		authInfo        runtime.ClientAuthInfoWriter
-		isListOperation bool
-		enableAuth      bool
-		addHeaders      bool
-		mockOcmAuth     func(a *ocm.MockOCMAuthentication)
-		expectedError   interface{}
+		name                    string
+		authInfo                runtime.ClientAuthInfoWriter
+		isListOperation         bool
+		isBootArtifactOperation bool
+		isBaseArtifactOperation bool
+		enableAuth              bool
+		addHeaders              bool
+		mockOcmAuth             func(a *ocm.MockOCMAuthentication)
+		expectedError           interface{}
 	}{
 		{
 			name:            "User Successful Authentication",
@@ -111,6 +117,59 @@ func TestAuth(t *testing.T) {
 			addHeaders:      false,
 			expectedError:   installer.NewGetClusterUnauthorized(),
 		},
+		{
+			name:                    "URL Successful Authentication",
+			authInfo:                URLAuthQueryWriter(urlToken),
+			isBootArtifactOperation: true,
+			enableAuth:              true,
+			addHeaders:              true,
+		},
+		{
+			name:                    "URL Unsuccessful Authentication",
+			authInfo:                URLAuthQueryWriter("bad_token"),
+			isBootArtifactOperation: true,
+			enableAuth:              true,
+			addHeaders:              true,
+			expectedError:           installer.NewDownloadClusterBootArtifactUnauthorized(),
+		},
+		{
+			name:                    "URL Authentication With Another Secret",
+			authInfo:                URLAuthQueryWriter(createTestURLToken("another_secret", time.Hour)),
+			isBootArtifactOperation: true,
+			enableAuth:              true,
+			addHeaders:              true,
+			expectedError:           installer.NewDownloadClusterBootArtifactUnauthorized(),
+		},
+		{
+			name:                    "URL Authentication With Expired Token",
+			authInfo:                URLAuthQueryWriter(createTestURLToken(urlAuthSecret, -time.Hour)),
+			isBootArtifactOperation: true,
+			enableAuth:              true,
+			addHeaders:              true,
+			expectedError:           installer.NewDownloadClusterBootArtifactUnauthorized(),
+		},
+		{
+			name:                    "Fail URL Auth Without Token",
+			authInfo:                URLAuthQueryWriter(urlToken),
+			isBootArtifactOperation: true,
+			enableAuth:              true,
+			addHeaders:              false,
+			expectedError:           installer.NewDownloadClusterBootArtifactUnauthorized(),
+		},
+		{
+			name:                    "Base Boot Artifact Without Auth",
+			authInfo:                URLAuthQueryWriter(urlToken),
+			isBaseArtifactOperation: true,
+			enableAuth:              true,
+			addHeaders:              false,
+		},
+		{
+			name:                    "Ignore URL Auth If Auth Disabled",
+			authInfo:                URLAuthQueryWriter(urlToken),
+			isBootArtifactOperation: true,
+			enableAuth:              false,
+			addHeaders:              false,
+		},
 		{
 			name:            "Ignore User Auth If Auth Disabled",
 			authInfo:        UserAuthHeaderWriter(userKeyValue),
@@ -137,9 +196,11 @@ func TestAuth(t *testing.T) {
 			}
 
 			fakeConfig := Config{
-				EnableAuth: tt.enableAuth,
-				JwkCertURL: "",
-				JwkCert:    string(JwkCert),
+				EnableAuth:        tt.enableAuth,
+				JwkCertURL:        "",
+				JwkCert:           string(JwkCert),
+				URLAuthSecret:     urlAuthSecret,
+				URLAuthExpiration: time.Hour,
 			}
 			AuthHandler := NewAuthHandler(fakeConfig, nil, log.WithField("pkg", "auth"))
 			AuthHandler.client = &ocm.Client{
@@ -151,6 +212,7 @@ func TestAuth(t *testing.T) {
 			h, _ := restapi.Handler(restapi.Config{
 				AuthAgentAuth:       AuthHandler.AuthAgentAuth,
 				AuthUserAuth:        AuthHandler.AuthUserAuth,
+				AuthURLAuth:         AuthHandler.AuthURLAuth,
 				APIKeyAuthenticator: AuthHandler.CreateAuthenticator(),
 				InstallerAPI:        fakeInventory{},
 				EventsAPI:           nil,
@@ -180,6 +242,16 @@ func TestAuth(t *testing.T) {
 			var e error
 			if tt.isListOperation {
 				_, e = bmclient.Installer.ListClusters(context.TODO(), &clientInstaller.ListClustersParams{})
+			} else if tt.isBootArtifactOperation {
+				_, e = bmclient.Installer.DownloadClusterBootArtifact(context.TODO(), &clientInstaller.DownloadClusterBootArtifactParams{
+					ClusterID: strfmt.UUID(uuid.New().String()),
+					Artifact:  "ipxe-script",
+				}, ioutil.Discard)
+			} else if tt.isBaseArtifactOperation {
+				_, e = bmclient.Installer.DownloadBaseBootArtifact(context.TODO(), &clientInstaller.DownloadBaseBootArtifactParams{
+					OpenshiftVersion: "4.6",
+					Artifact:         "rootfs",
+				}, ioutil.Discard)
 			} else {
 				id := uuid.New()
 				_, e = bmclient.Installer.GetCluster(context.TODO(), &clientInstaller.GetClusterParams{
@@ -197,6 +269,15 @@ func TestAuth(t *testing.T) {
 	}
 }
 
+func createTestURLToken(secret string, expiration time.Duration) string {
+	a := &AuthHandler{EnableAuth: true, urlAuthSecret: []byte(secret), urlAuthExpiration: expiration}
+	token, err := a.CreateClusterURLToken(uuid.New().String(), "jdoe123@example.com", "1010101")
+	if err != nil {
+		panic(err)
+	}
+	return token
+}
+
 type fakeInventory struct{}
 
 func (f fakeInventory) CancelInstallation(ctx context.Context, params installer.CancelInstallationParams) middleware.Responder {
@@ -227,6 +308,14 @@ func (f fakeInventory) DownloadClusterFiles(ctx context.Context, params installe
 	panic("Implement Me!")
 }
 
+func (f fakeInventory) DownloadBaseBootArtifact(ctx context.Context, params installer.DownloadBaseBootArtifactParams) middleware.Responder {
+	return installer.NewDownloadBaseBootArtifactOK().WithPayload(ioutil.NopCloser(strings.NewReader("rootfs")))
+}
+
+func (f fakeInventory) DownloadClusterBootArtifact(ctx context.Context, params installer.DownloadClusterBootArtifactParams) middleware.Responder {
+	return installer.NewDownloadClusterBootArtifactOK().WithPayload(ioutil.NopCloser(strings.NewReader("#!ipxe")))
+}
+
 func (f fakeInventory) DownloadClusterISO(ctx context.Context, params installer.DownloadClusterISOParams) middleware.Responder {
 	panic("Implement Me!")
 }
@@ -267,6 +356,46 @@ func (f fakeInventory) InstallCluster(ctx context.Context, params installer.Inst
 	panic("Implement Me!")
 }
 
+func (f fakeInventory) InstallHosts(ctx context.Context, params installer.InstallHostsParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) ListClusterHistory(ctx context.Context, params installer.ListClusterHistoryParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) ListHostHistory(ctx context.Context, params installer.ListHostHistoryParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) ListClusterValidations(ctx context.Context, params installer.ListClusterValidationsParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) ListHostValidations(ctx context.Context, params installer.ListHostValidationsParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) GetHostBmcCredentials(ctx context.Context, params installer.GetHostBmcCredentialsParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) UpdateHostBmcCredentials(ctx context.Context, params installer.UpdateHostBmcCredentialsParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) DeleteHostBmcCredentials(ctx context.Context, params installer.DeleteHostBmcCredentialsParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) SuggestVips(ctx context.Context, params installer.SuggestVipsParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) GetClusterConnectivity(ctx context.Context, params installer.GetClusterConnectivityParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
 func (f fakeInventory) ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder {
 	return installer.NewListClustersOK()
 }
@@ -283,6 +412,10 @@ func (f fakeInventory) RegisterCluster(ctx context.Context, params installer.Reg
 	return installer.NewRegisterClusterCreated()
 }
 
+func (f fakeInventory) RegisterAddHostsCluster(ctx context.Context, params installer.RegisterAddHostsClusterParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
 func (f fakeInventory) RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder {
 	return installer.NewRegisterHostCreated()
 }
@@ -291,6 +424,10 @@ func (f fakeInventory) ResetCluster(ctx context.Context, params installer.ResetC
 	panic("Implement Me!")
 }
 
+func (f fakeInventory) RetryInstallation(ctx context.Context, params installer.RetryInstallationParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
 func (f fakeInventory) UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder {
 	panic("Implement Me!")
 }
@@ -307,6 +444,18 @@ func (f fakeInventory) UpdateHostInstallProgress(ctx context.Context, params ins
 	panic("Implement Me!")
 }
 
+func (f fakeInventory) CreateClusterManifest(ctx context.Context, params installer.CreateClusterManifestParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) ListClusterManifests(ctx context.Context, params installer.ListClusterManifestsParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
+func (f fakeInventory) DeleteClusterManifest(ctx context.Context, params installer.DeleteClusterManifestParams) middleware.Responder {
+	panic("Implement Me!")
+}
+
 func (f fakeInventory) UploadClusterIngressCert(ctx context.Context, params installer.UploadClusterIngressCertParams) middleware.Responder {
 	panic("Implement Me!")
 }
@@ -8,6 +8,8 @@ import (
 const (
 	userAuthHeader  = "Authorization"
 	agentAuthHeader = "X-Secret-Key"
+	// URLAuthParam is the query parameter of the URL tokens
+	URLAuthParam = "api_key"
 )
 
 func AuthHeaderWriter(token string, header string) runtime.ClientAuthInfoWriter {
@@ -23,3 +25,9 @@ func AgentAuthHeaderWriter(token string) runtime.ClientAuthInfoWriter {
 func UserAuthHeaderWriter(token string) runtime.ClientAuthInfoWriter {
 	return AuthHeaderWriter(token, userAuthHeader)
 }
+
+func URLAuthQueryWriter(token string) runtime.ClientAuthInfoWriter {
+	return runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		return r.SetQueryParam(URLAuthParam, token)
+	})
+}
@@ -0,0 +1,272 @@
+package bmc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	BootTargetCd  = "Cd"
+	BootTargetHdd = "Hdd"
+)
+
+const redfishRoot = "/redfish/v1"
+
+type Config struct {
+	RequestTimeout time.Duration `envconfig:"BMC_REQUEST_TIMEOUT" default:"30s"`
+}
+
+// Credentials of the Redfish API of a BMC. The address is a host name or an IP address, optionally with a scheme
+// and a port, https is used when the scheme is not set
+type Credentials struct {
+	Address                        string
+	Username                       string
+	Password                       string
+	DisableCertificateVerification bool
+}
+
+//go:generate mockgen -source=bmc.go -package=bmc -destination=mock_bmc.go
+type Client interface {
+	// InsertVirtualMedia mounts the image at the given URL as the virtual CD of the system, replacing any inserted image
+	InsertVirtualMedia(ctx context.Context, creds *Credentials, imageURL string) error
+	// EjectVirtualMedia ejects the image of the virtual CD of the system, if there is one
+	EjectVirtualMedia(ctx context.Context, creds *Credentials) error
+	// SetOneTimeBoot sets the device that the system boots from on its next boot only
+	SetOneTimeBoot(ctx context.Context, creds *Credentials, target string) error
+	// PowerCycle restarts the system, or powers it on if it is off
+	PowerCycle(ctx context.Context, creds *Credentials) error
+}
+
+func NewClient(log logrus.FieldLogger, cfg Config) Client {
+	return &redfishClient{
+		log: log,
+		cfg: cfg,
+	}
+}
+
+type redfishClient struct {
+	log logrus.FieldLogger
+	cfg Config
+}
+
+type odataID struct {
+	ID string `json:"@odata.id"`
+}
+
+type collection struct {
+	Members []odataID `json:"Members"`
+}
+
+type manager struct {
+	VirtualMedia odataID `json:"VirtualMedia"`
+}
+
+type virtualMedia struct {
+	MediaTypes []string `json:"MediaTypes"`
+	Inserted   bool     `json:"Inserted"`
+	Image      string   `json:"Image"`
+	Actions    struct {
+		InsertMedia struct {
+			Target string `json:"target"`
+		} `json:"#VirtualMedia.InsertMedia"`
+		EjectMedia struct {
+			Target string `json:"target"`
+		} `json:"#VirtualMedia.EjectMedia"`
+	} `json:"Actions"`
+}
+
+type system struct {
+	PowerState string `json:"PowerState"`
+	Actions    struct {
+		Reset struct {
+			Target string `json:"target"`
+		} `json:"#ComputerSystem.Reset"`
+	} `json:"Actions"`
+}
+
+// session is a connection to the Redfish API of a single BMC
+type session struct {
+	client  *http.Client
+	baseURL string
+	creds   *Credentials
+}
+
+func (c *redfishClient) newSession(creds *Credentials) *session {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if creds.DisableCertificateVerification {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec
+	}
+	baseURL := strings.TrimSuffix(creds.Address, "/")
+	if !strings.Contains(baseURL, "://") {
+		baseURL = "https://" + baseURL
+	}
+	return &session{
+		client:  &http.Client{Transport: transport, Timeout: c.cfg.RequestTimeout},
+		baseURL: baseURL,
+		creds:   creds,
+	}
+}
+
+func (s *session) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(s.creds.Username, s.creds.Password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "%s %s failed", method, path)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the response of %s %s", method, path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("%s %s failed with status %d: %s", method, path, resp.StatusCode, string(respBody))
+	}
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return errors.Wrapf(err, "failed to parse the response of %s %s", method, path)
+		}
+	}
+	return nil
+}
+
+// firstMember returns the path of the first member of a collection, a BMC of a bare metal host manages one system
+func (s *session) firstMember(ctx context.Context, path string) (string, error) {
+	var c collection
+	if err := s.do(ctx, http.MethodGet, path, nil, &c); err != nil {
+		return "", err
+	}
+	if len(c.Members) == 0 {
+		return "", errors.Errorf("%s has no members", path)
+	}
+	return c.Members[0].ID, nil
+}
+
+// getVirtualCd returns the path of the virtual media of the manager that can hold a CD image
+func (s *session) getVirtualCd(ctx context.Context) (string, *virtualMedia, error) {
+	managerPath, err := s.firstMember(ctx, redfishRoot+"/Managers")
+	if err != nil {
+		return "", nil, err
+	}
+	var m manager
+	if err = s.do(ctx, http.MethodGet, managerPath, nil, &m); err != nil {
+		return "", nil, err
+	}
+	if m.VirtualMedia.ID == "" {
+		return "", nil, errors.Errorf("manager %s doesn't support virtual media", managerPath)
+	}
+	var media collection
+	if err = s.do(ctx, http.MethodGet, m.VirtualMedia.ID, nil, &media); err != nil {
+		return "", nil, err
+	}
+	for _, member := range media.Members {
+		var vm virtualMedia
+		if err = s.do(ctx, http.MethodGet, member.ID, nil, &vm); err != nil {
+			return "", nil, err
+		}
+		if funk.ContainsString(vm.MediaTypes, "CD") || funk.ContainsString(vm.MediaTypes, "DVD") {
+			return member.ID, &vm, nil
+		}
+	}
+	return "", nil, errors.Errorf("manager %s has no virtual CD", managerPath)
+}
+
+func actionTarget(target, path, action string) string {
+	if target != "" {
+		return target
+	}
+	return fmt.Sprintf("%s/Actions/%s", path, action)
+}
+
+func (s *session) eject(ctx context.Context, path string, vm *virtualMedia) error {
+	if !vm.Inserted {
+		return nil
+	}
+	return s.do(ctx, http.MethodPost, actionTarget(vm.Actions.EjectMedia.Target, path, "VirtualMedia.EjectMedia"),
+		map[string]interface{}{}, nil)
+}
+
+func (c *redfishClient) InsertVirtualMedia(ctx context.Context, creds *Credentials, imageURL string) error {
+	s := c.newSession(creds)
+	path, vm, err := s.getVirtualCd(ctx)
+	if err != nil {
+		return err
+	}
+	if err = s.eject(ctx, path, vm); err != nil {
+		return err
+	}
+	c.log.Infof("Inserting image %s into the virtual CD of BMC %s", imageURL, creds.Address)
+	return s.do(ctx, http.MethodPost, actionTarget(vm.Actions.InsertMedia.Target, path, "VirtualMedia.InsertMedia"),
+		map[string]interface{}{"Image": imageURL, "Inserted": true, "WriteProtected": true}, nil)
+}
+
+func (c *redfishClient) EjectVirtualMedia(ctx context.Context, creds *Credentials) error {
+	s := c.newSession(creds)
+	path, vm, err := s.getVirtualCd(ctx)
+	if err != nil {
+		return err
+	}
+	c.log.Infof("Ejecting the virtual CD of BMC %s", creds.Address)
+	return s.eject(ctx, path, vm)
+}
+
+func (c *redfishClient) SetOneTimeBoot(ctx context.Context, creds *Credentials, target string) error {
+	s := c.newSession(creds)
+	systemPath, err := s.firstMember(ctx, redfishRoot+"/Systems")
+	if err != nil {
+		return err
+	}
+	c.log.Infof("Setting one time boot from %s on BMC %s", target, creds.Address)
+	return s.do(ctx, http.MethodPatch, systemPath, map[string]interface{}{
+		"Boot": map[string]string{
+			"BootSourceOverrideEnabled": "Once",
+			"BootSourceOverrideTarget":  target,
+		},
+	}, nil)
+}
+
+func (c *redfishClient) PowerCycle(ctx context.Context, creds *Credentials) error {
+	s := c.newSession(creds)
+	systemPath, err := s.firstMember(ctx, redfishRoot+"/Systems")
+	if err != nil {
+		return err
+	}
+	var sys system
+	if err = s.do(ctx, http.MethodGet, systemPath, nil, &sys); err != nil {
+		return err
+	}
+	resetType := "ForceRestart"
+	if sys.PowerState == "Off" {
+		resetType = "On"
+	}
+	c.log.Infof("Resetting the system of BMC %s with reset type %s", creds.Address, resetType)
+	return s.do(ctx, http.MethodPost, actionTarget(sys.Actions.Reset.Target, systemPath, "ComputerSystem.Reset"),
+		map[string]string{"ResetType": resetType}, nil)
+}
@@ -0,0 +1,202 @@
+package bmc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestBmc(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "BMC tests Suite")
+}
+
+// redfishMock is a minimal Redfish API of a BMC with one system and a manager with a floppy and a CD virtual media
+type redfishMock struct {
+	mu         sync.Mutex
+	powerState string
+	image      string
+	inserted   bool
+	boot       map[string]string
+	resets     []string
+}
+
+func (m *redfishMock) handler() http.Handler {
+	mux := http.NewServeMux()
+	reply := func(w http.ResponseWriter, body interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		Expect(json.NewEncoder(w).Encode(body)).ShouldNot(HaveOccurred())
+	}
+	member := func(id string) map[string]string { return map[string]string{"@odata.id": id} }
+	decode := func(r *http.Request, body interface{}) {
+		Expect(json.NewDecoder(r.Body).Decode(body)).ShouldNot(HaveOccurred())
+	}
+
+	mux.HandleFunc("/redfish/v1/Systems", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"Members": []interface{}{member("/redfish/v1/Systems/1")}})
+	})
+	mux.HandleFunc("/redfish/v1/Systems/1", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			reply(w, map[string]interface{}{
+				"PowerState": m.powerState,
+				"Actions": map[string]interface{}{
+					"#ComputerSystem.Reset": map[string]string{"target": "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset"},
+				},
+			})
+		case http.MethodPatch:
+			var body struct {
+				Boot map[string]string
+			}
+			decode(r, &body)
+			m.boot = body.Boot
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/redfish/v1/Systems/1/Actions/ComputerSystem.Reset", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		var body struct {
+			ResetType string
+		}
+		decode(r, &body)
+		m.resets = append(m.resets, body.ResetType)
+		m.powerState = "On"
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/redfish/v1/Managers", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"Members": []interface{}{member("/redfish/v1/Managers/1")}})
+	})
+	mux.HandleFunc("/redfish/v1/Managers/1", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"VirtualMedia": member("/redfish/v1/Managers/1/VirtualMedia")})
+	})
+	mux.HandleFunc("/redfish/v1/Managers/1/VirtualMedia", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"Members": []interface{}{
+			member("/redfish/v1/Managers/1/VirtualMedia/Floppy1"),
+			member("/redfish/v1/Managers/1/VirtualMedia/Cd1"),
+		}})
+	})
+	mux.HandleFunc("/redfish/v1/Managers/1/VirtualMedia/Floppy1", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"MediaTypes": []string{"Floppy", "USBStick"}})
+	})
+	mux.HandleFunc("/redfish/v1/Managers/1/VirtualMedia/Cd1", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		reply(w, map[string]interface{}{"MediaTypes": []string{"CD", "DVD"}, "Inserted": m.inserted, "Image": m.image})
+	})
+	mux.HandleFunc("/redfish/v1/Managers/1/VirtualMedia/Cd1/Actions/VirtualMedia.InsertMedia", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.inserted {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		var body struct {
+			Image string
+		}
+		decode(r, &body)
+		m.image = body.Image
+		m.inserted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/redfish/v1/Managers/1/VirtualMedia/Cd1/Actions/VirtualMedia.EjectMedia", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.image = ""
+		m.inserted = false
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+var _ = Describe("redfish client", func() {
+	var (
+		ctx    = context.Background()
+		mock   *redfishMock
+		server *httptest.Server
+		client Client
+		creds  *Credentials
+	)
+
+	BeforeEach(func() {
+		mock = &redfishMock{powerState: "On"}
+		server = httptest.NewTLSServer(mock.handler())
+		client = NewClient(logrus.New(), Config{})
+		creds = &Credentials{
+			Address:                        server.URL,
+			Username:                       "admin",
+			Password:                       "secret",
+			DisableCertificateVerification: true,
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("inserts virtual media", func() {
+		Expect(client.InsertVirtualMedia(ctx, creds, "http://images/discovery.iso")).ShouldNot(HaveOccurred())
+		Expect(mock.inserted).Should(BeTrue())
+		Expect(mock.image).Should(Equal("http://images/discovery.iso"))
+	})
+
+	It("replaces inserted virtual media", func() {
+		mock.inserted = true
+		mock.image = "http://images/old.iso"
+		Expect(client.InsertVirtualMedia(ctx, creds, "http://images/discovery.iso")).ShouldNot(HaveOccurred())
+		Expect(mock.image).Should(Equal("http://images/discovery.iso"))
+	})
+
+	It("ejects virtual media", func() {
+		mock.inserted = true
+		Expect(client.EjectVirtualMedia(ctx, creds)).ShouldNot(HaveOccurred())
+		Expect(mock.inserted).Should(BeFalse())
+		Expect(client.EjectVirtualMedia(ctx, creds)).ShouldNot(HaveOccurred())
+	})
+
+	It("sets one time boot", func() {
+		Expect(client.SetOneTimeBoot(ctx, creds, BootTargetCd)).ShouldNot(HaveOccurred())
+		Expect(mock.boot).Should(Equal(map[string]string{
+			"BootSourceOverrideEnabled": "Once",
+			"BootSourceOverrideTarget":  BootTargetCd,
+		}))
+	})
+
+	It("restarts a powered on system", func() {
+		Expect(client.PowerCycle(ctx, creds)).ShouldNot(HaveOccurred())
+		Expect(mock.resets).Should(Equal([]string{"ForceRestart"}))
+	})
+
+	It("powers on a powered off system", func() {
+		mock.powerState = "Off"
+		Expect(client.PowerCycle(ctx, creds)).ShouldNot(HaveOccurred())
+		Expect(mock.resets).Should(Equal([]string{"On"}))
+	})
+
+	It("wrong credentials", func() {
+		creds.Password = "wrong"
+		Expect(client.PowerCycle(ctx, creds)).Should(HaveOccurred())
+		Expect(mock.resets).Should(BeEmpty())
+	})
+
+	It("certificate verification", func() {
+		creds.DisableCertificateVerification = false
+		Expect(client.PowerCycle(ctx, creds)).Should(HaveOccurred())
+	})
+})
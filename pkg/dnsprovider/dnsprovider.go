@@ -0,0 +1,96 @@
+package dnsprovider
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ProviderRoute53 = "route53"
+	ProviderRFC2136 = "rfc2136"
+)
+
+type Config struct {
+	RecordTTL            int64         `envconfig:"DNS_RECORD_TTL" default:"60"`
+	RFC2136TSIGAlgorithm string        `envconfig:"RFC2136_TSIG_ALGORITHM" default:"hmac-sha256."`
+	RFC2136Timeout       time.Duration `envconfig:"RFC2136_TIMEOUT" default:"10s"`
+	// RFC2136TSIGSecrets are the secrets of the TSIG keys that managed domains reference by name
+	RFC2136TSIGSecrets map[string]string `envconfig:"RFC2136_TSIG_SECRETS" default:""`
+}
+
+// Domain is the DNS configuration of a managed domain
+type Domain struct {
+	Provider string
+	// ZoneID is the hosted zone ID for route53 domains and the zone name for rfc2136 domains
+	ZoneID string
+	// Server is the address of the DNS server that accepts the dynamic updates of an rfc2136 zone, in host:port format
+	Server string
+	// CredentialsRef is the AWS shared credentials profile for route53 domains and the TSIG key name for rfc2136
+	// domains. The route53 profile is used for route53 domains and the updates are not signed for rfc2136 domains
+	// when it is empty
+	CredentialsRef string
+}
+
+//go:generate mockgen -source=dnsprovider.go -package=dnsprovider -destination=mock_dnsprovider.go
+type Provider interface {
+	// CreateRecord creates a record of the name that resolves to the address, an A record for an IPv4 address and an
+	// AAAA record for an IPv6 address
+	CreateRecord(name, address string) error
+	// DeleteRecord deletes the record of the name that resolves to the address
+	DeleteRecord(name, address string) error
+	// RecordExists returns true if the name has an A or an AAAA record
+	RecordExists(name string) (bool, error)
+	// GetDomainName returns the name of the zone that the provider manages
+	GetDomainName() (string, error)
+}
+
+// NewProvider returns the provider of a managed domain
+func NewProvider(cfg Config, domain Domain) (Provider, error) {
+	switch domain.Provider {
+	case ProviderRoute53:
+		return newRoute53(cfg, domain.ZoneID, domain.CredentialsRef)
+	case ProviderRFC2136:
+		return newRFC2136(cfg, domain.ZoneID, domain.Server, domain.CredentialsRef)
+	default:
+		return nil, errors.Errorf("Unsupported DNS provider %s", domain.Provider)
+	}
+}
+
+// IsSupported returns true if the provider type has an implementation
+func IsSupported(providerType string) bool {
+	switch providerType {
+	case ProviderRoute53, ProviderRFC2136:
+		return true
+	}
+	return false
+}
+
+// ParseDomainConfig parses the configuration of a managed domain, in zone-id/provider format. The DNS server of rfc2136
+// domains follows their provider, in zone/rfc2136/server format
+func ParseDomainConfig(val string) (Domain, error) {
+	s := strings.SplitN(val, "/", 3)
+	if len(s) < 2 || (len(s) == 3) != (s[1] == ProviderRFC2136) {
+		return Domain{}, errors.Errorf("Invalid DNS domain: %s", val)
+	}
+	domain := Domain{ZoneID: s[0], Provider: s[1]}
+	if len(s) == 3 {
+		domain.Server = s[2]
+	}
+	return domain, nil
+}
+
+// recordType returns the type of the record that resolves to the address
+func recordType(address string) (string, error) {
+	ip := net.ParseIP(address)
+	switch {
+	case ip == nil:
+		return "", errors.Errorf("%s is not a valid IP address", address)
+	case ip.To4() != nil:
+		return "A", nil
+	default:
+		return "AAAA", nil
+	}
+}
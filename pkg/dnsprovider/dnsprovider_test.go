@@ -0,0 +1,339 @@
+package dnsprovider
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/miekg/dns"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDNSProvider(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DNS provider tests Suite")
+}
+
+const (
+	testKeyName = "assisted-key."
+	testSecret  = "c2VjcmV0LWtleS1mb3ItdGVzdGluZw=="
+)
+
+// testDNSServer is an authoritative server of a single zone that accepts TSIG signed dynamic updates, it keeps the
+// addresses of the RRsets of every name
+type testDNSServer struct {
+	sync.Mutex
+	zone    string
+	records map[string][]string
+	server  *dns.Server
+}
+
+// isRRType returns true if the address is of an RRset of the type
+func isRRType(address string, rrType uint16) bool {
+	return (net.ParseIP(address).To4() != nil) == (rrType == dns.TypeA)
+}
+
+// update applies a dynamic update RR to the RRsets of its name, see RFC 2136 section 3.4.2
+func (s *testDNSServer) update(rr dns.RR) {
+	name := strings.ToLower(rr.Header().Name)
+	var addr string
+	switch r := rr.(type) {
+	case *dns.A:
+		addr = r.A.String()
+	case *dns.AAAA:
+		addr = r.AAAA.String()
+	}
+	var addrs []string
+	for _, a := range s.records[name] {
+		switch {
+		case rr.Header().Class == dns.ClassANY && isRRType(a, rr.Header().Rrtype):
+		case a == addr:
+		default:
+			addrs = append(addrs, a)
+		}
+	}
+	if rr.Header().Class == dns.ClassINET {
+		addrs = append(addrs, addr)
+	}
+	if len(addrs) == 0 {
+		delete(s.records, name)
+	} else {
+		s.records[name] = addrs
+	}
+}
+
+func (s *testDNSServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	s.Lock()
+	defer s.Unlock()
+	m := new(dns.Msg)
+	m.SetReply(req)
+	switch {
+	case req.Opcode == dns.OpcodeUpdate:
+		if req.IsTsig() == nil || w.TsigStatus() != nil {
+			m.SetRcode(req, dns.RcodeRefused)
+			break
+		}
+		if req.Question[0].Name != s.zone {
+			m.SetRcode(req, dns.RcodeNotAuth)
+			break
+		}
+		for _, rr := range req.Ns {
+			s.update(rr)
+		}
+	default:
+		name := strings.ToLower(req.Question[0].Name)
+		addrs, ok := s.records[name]
+		if !ok {
+			m.SetRcode(req, dns.RcodeNameError)
+			break
+		}
+		for _, addr := range addrs {
+			hdr := dns.RR_Header{Name: name, Class: dns.ClassINET, Ttl: 60}
+			ip := net.ParseIP(addr)
+			switch {
+			case req.Question[0].Qtype == dns.TypeA && ip.To4() != nil:
+				hdr.Rrtype = dns.TypeA
+				m.Answer = append(m.Answer, &dns.A{Hdr: hdr, A: ip})
+			case req.Question[0].Qtype == dns.TypeAAAA && ip.To4() == nil:
+				hdr.Rrtype = dns.TypeAAAA
+				m.Answer = append(m.Answer, &dns.AAAA{Hdr: hdr, AAAA: ip})
+			}
+		}
+	}
+	if req.IsTsig() != nil {
+		m.SetTsig(testKeyName, dns.HmacSHA256, tsigFudge, time.Now().Unix())
+	}
+	Expect(w.WriteMsg(m)).ToNot(HaveOccurred())
+}
+
+func startTestDNSServer(zone string) *testDNSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	s := &testDNSServer{zone: dns.Fqdn(zone), records: map[string][]string{}}
+	started := make(chan struct{})
+	s.server = &dns.Server{
+		Listener:          listener,
+		Handler:           s,
+		TsigSecret:        map[string]string{testKeyName: testSecret},
+		NotifyStartedFunc: func() { close(started) },
+		// The default accept function answers updates with NOTIMP
+		MsgAcceptFunc: func(dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+	}
+	go func() {
+		defer GinkgoRecover()
+		_ = s.server.ActivateAndServe()
+	}()
+	Eventually(started).Should(BeClosed())
+	return s
+}
+
+var _ = Describe("NewProvider", func() {
+	It("selects the implementation by the provider type", func() {
+		cfg := Config{RecordTTL: 60}
+		p, err := NewProvider(cfg, Domain{Provider: ProviderRoute53, ZoneID: "abc"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p).To(BeAssignableToTypeOf(&route53Provider{}))
+		p, err = NewProvider(cfg, Domain{Provider: ProviderRFC2136, ZoneID: "example.com", Server: "127.0.0.1:53"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p).To(BeAssignableToTypeOf(&rfc2136Provider{}))
+		_, err = NewProvider(cfg, Domain{Provider: "unknown", ZoneID: "abc"})
+		Expect(err).To(HaveOccurred())
+	})
+	It("rfc2136 requires a DNS server", func() {
+		_, err := NewProvider(Config{}, Domain{Provider: ProviderRFC2136, ZoneID: "example.com"})
+		Expect(err).To(HaveOccurred())
+	})
+	It("parses the domain configuration", func() {
+		domain, err := ParseDomainConfig("abc/route53")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domain).To(Equal(Domain{Provider: ProviderRoute53, ZoneID: "abc"}))
+		domain, err = ParseDomainConfig("example.com/rfc2136/10.0.0.1:53")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domain).To(Equal(Domain{Provider: ProviderRFC2136, ZoneID: "example.com", Server: "10.0.0.1:53"}))
+		for _, val := range []string{"abc", "example.com/rfc2136", "abc/route53/10.0.0.1:53"} {
+			_, err = ParseDomainConfig(val)
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
+
+var _ = Describe("RFC2136 provider", func() {
+	var (
+		server *testDNSServer
+		cfg    Config
+		domain Domain
+	)
+
+	BeforeEach(func() {
+		server = startTestDNSServer("example.com")
+		cfg = Config{
+			RecordTTL:            60,
+			RFC2136TSIGAlgorithm: dns.HmacSHA256,
+			RFC2136Timeout:       5 * time.Second,
+			RFC2136TSIGSecrets:   map[string]string{testKeyName: testSecret, "wrong-key.": "d3Jvbmctc2VjcmV0"},
+		}
+		domain = Domain{
+			Provider:       ProviderRFC2136,
+			ZoneID:         "example.com",
+			Server:         server.server.Listener.Addr().String(),
+			CredentialsRef: testKeyName,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(server.server.Shutdown()).ToNot(HaveOccurred())
+	})
+
+	It("creates, finds and deletes records", func() {
+		p, err := NewProvider(cfg, domain)
+		Expect(err).ToNot(HaveOccurred())
+		domainName, err := p.GetDomainName()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domainName).To(Equal("example.com"))
+
+		for _, name := range []string{"api.test.example.com", "*.apps.test.example.com"} {
+			for _, address := range []string{"1.2.3.4", "1001:db8::1"} {
+				exists, err := p.RecordExists(name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeFalse())
+
+				Expect(p.CreateRecord(name, address)).ToNot(HaveOccurred())
+				exists, err = p.RecordExists(name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+				Expect(server.records).To(HaveKeyWithValue(name+".", []string{address}))
+
+				Expect(p.DeleteRecord(name, address)).ToNot(HaveOccurred())
+				exists, err = p.RecordExists(name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeFalse())
+			}
+		}
+	})
+
+	It("replaces the address of a record that is created again", func() {
+		p, err := NewProvider(cfg, domain)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.CreateRecord("api.test.example.com", "1.2.3.4")).ToNot(HaveOccurred())
+		Expect(p.CreateRecord("api.test.example.com", "1001:db8::1")).ToNot(HaveOccurred())
+		Expect(p.CreateRecord("api.test.example.com", "1.2.3.5")).ToNot(HaveOccurred())
+		Expect(server.records).To(HaveKeyWithValue("api.test.example.com.", []string{"1001:db8::1", "1.2.3.5"}))
+
+		Expect(p.DeleteRecord("api.test.example.com", "1.2.3.5")).ToNot(HaveOccurred())
+		Expect(server.records).To(HaveKeyWithValue("api.test.example.com.", []string{"1001:db8::1"}))
+	})
+
+	It("fails with a wrong TSIG secret", func() {
+		domain.CredentialsRef = "wrong-key."
+		p, err := NewProvider(cfg, domain)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.CreateRecord("api.test.example.com", "1.2.3.4")).To(HaveOccurred())
+		Expect(server.records).To(BeEmpty())
+	})
+
+	It("fails on unsigned updates", func() {
+		domain.CredentialsRef = ""
+		p, err := NewProvider(cfg, domain)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.CreateRecord("api.test.example.com", "1.2.3.4")).To(HaveOccurred())
+	})
+
+	It("fails on a zone that the server doesn't serve", func() {
+		domain.ZoneID = "other.com"
+		p, err := NewProvider(cfg, domain)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.CreateRecord("api.test.other.com", "1.2.3.4")).To(HaveOccurred())
+	})
+
+	It("fails on a TSIG key without a secret", func() {
+		domain.CredentialsRef = "unknown-key."
+		_, err := NewProvider(cfg, domain)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("uses the server of the domain", func() {
+		other := startTestDNSServer("example.org")
+		defer func() { Expect(other.server.Shutdown()).ToNot(HaveOccurred()) }()
+		p, err := NewProvider(cfg, Domain{Provider: ProviderRFC2136, ZoneID: "example.org",
+			Server: other.server.Listener.Addr().String(), CredentialsRef: testKeyName})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.CreateRecord("api.test.example.org", "1.2.3.4")).ToNot(HaveOccurred())
+		Expect(other.records).To(HaveKey("api.test.example.org."))
+		Expect(server.records).To(BeEmpty())
+	})
+
+	It("rejects invalid addresses", func() {
+		p, err := NewProvider(cfg, domain)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.CreateRecord("api.test.example.com", "not-an-address")).To(HaveOccurred())
+	})
+})
+
+// route53Recorder records the record sets that are changed and lists only them
+type route53Recorder struct {
+	route53iface.Route53API
+	recordSets []*route53.ResourceRecordSet
+}
+
+func (m *route53Recorder) ChangeResourceRecordSets(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	m.recordSets = append(m.recordSets, input.ChangeBatch.Changes[0].ResourceRecordSet)
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func (m *route53Recorder) ListResourceRecordSets(input *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error) {
+	output := &route53.ListResourceRecordSetsOutput{}
+	for _, recordSet := range m.recordSets {
+		if aws.StringValue(recordSet.Name) == aws.StringValue(input.StartRecordName) &&
+			aws.StringValue(recordSet.Type) == aws.StringValue(input.StartRecordType) {
+			output.ResourceRecordSets = append(output.ResourceRecordSets, &route53.ResourceRecordSet{
+				Name: aws.String(aws.StringValue(recordSet.Name) + "."),
+				Type: recordSet.Type,
+			})
+		}
+	}
+	return output, nil
+}
+
+var _ = Describe("Route53 provider", func() {
+	var (
+		recorder *route53Recorder
+		p        Provider
+	)
+
+	BeforeEach(func() {
+		recorder = &route53Recorder{}
+		p = NewRoute53Provider(dnsproviders.Route53{
+			RecordSet:    dnsproviders.RecordSet{TTL: 60},
+			HostedZoneID: "abc",
+			SVC:          recorder,
+		})
+	})
+
+	It("creates an A record for an IPv4 address", func() {
+		Expect(p.CreateRecord("api.test.example.com", "1.2.3.4")).ToNot(HaveOccurred())
+		Expect(aws.StringValue(recorder.recordSets[0].Type)).To(Equal("A"))
+		exists, err := p.RecordExists("api.test.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("creates an AAAA record for an IPv6 address", func() {
+		Expect(p.CreateRecord("api.test.example.com", "1001:db8::1")).ToNot(HaveOccurred())
+		Expect(aws.StringValue(recorder.recordSets[0].Type)).To(Equal("AAAA"))
+		exists, err := p.RecordExists("api.test.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("rejects invalid addresses", func() {
+		Expect(p.CreateRecord("api.test.example.com", "not-an-address")).To(HaveOccurred())
+		Expect(recorder.recordSets).To(BeEmpty())
+	})
+})
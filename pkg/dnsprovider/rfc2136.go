@@ -0,0 +1,151 @@
+package dnsprovider
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+// tsigFudge is the time difference in seconds that the server allows between its clock and the signing time
+const tsigFudge = 300
+
+// rfc2136Provider manages the records of a zone with TSIG signed dynamic updates (RFC 2136), as supported by BIND,
+// PowerDNS and other on-premise DNS servers
+type rfc2136Provider struct {
+	cfg       Config
+	zone      string
+	server    string
+	keyName   string
+	keySecret string
+}
+
+// newRFC2136 returns a provider of the zone whose updates are accepted by the server, the updates are signed with the
+// named TSIG key and are not signed when the key name is empty
+func newRFC2136(cfg Config, zone, server, keyName string) (*rfc2136Provider, error) {
+	if zone == "" {
+		return nil, errors.New("The zone of an rfc2136 domain is missing")
+	}
+	if server == "" {
+		return nil, errors.Errorf("The DNS server of rfc2136 zone %s is missing", zone)
+	}
+	p := &rfc2136Provider{cfg: cfg, zone: dns.Fqdn(zone), server: server}
+	if keyName != "" {
+		secret, ok := cfg.RFC2136TSIGSecrets[keyName]
+		if !ok || secret == "" {
+			return nil, errors.Errorf("The secret of TSIG key %s is not configured", keyName)
+		}
+		p.keyName = dns.Fqdn(keyName)
+		p.keySecret = secret
+	}
+	return p, nil
+}
+
+func (r *rfc2136Provider) record(name, address string) (dns.RR, error) {
+	rrType, err := recordType(address)
+	if err != nil {
+		return nil, err
+	}
+	hdr := dns.RR_Header{
+		Name:  dns.Fqdn(name),
+		Class: dns.ClassINET,
+		Ttl:   uint32(r.cfg.RecordTTL),
+	}
+	ip := net.ParseIP(address)
+	if rrType == "A" {
+		hdr.Rrtype = dns.TypeA
+		return &dns.A{Hdr: hdr, A: ip.To4()}, nil
+	}
+	hdr.Rrtype = dns.TypeAAAA
+	return &dns.AAAA{Hdr: hdr, AAAA: ip}, nil
+}
+
+// exchange sends the message to the DNS server, signed with the TSIG key if the domain has one
+func (r *rfc2136Provider) exchange(m *dns.Msg) (*dns.Msg, error) {
+	c := &dns.Client{Net: "tcp", Timeout: r.cfg.RFC2136Timeout}
+	if r.keyName != "" {
+		c.TsigSecret = map[string]string{r.keyName: r.keySecret}
+		m.SetTsig(r.keyName, dns.Fqdn(r.cfg.RFC2136TSIGAlgorithm), tsigFudge, time.Now().Unix())
+	}
+	reply, _, err := c.Exchange(m, r.server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "DNS request to %s failed", r.server)
+	}
+	return reply, nil
+}
+
+func (r *rfc2136Provider) replyError(reply *dns.Msg) error {
+	return errors.Errorf("DNS server %s replied %s", r.server, dns.RcodeToString[reply.Rcode])
+}
+
+func (r *rfc2136Provider) update(name, address string, remove bool) error {
+	rr, err := r.record(name, address)
+	if err != nil {
+		return err
+	}
+	m := new(dns.Msg)
+	m.SetUpdate(r.zone)
+	if remove {
+		m.Remove([]dns.RR{rr})
+	} else {
+		// The record replaces the RRset of its name and type in the same update, as the route53 UPSERT does, so
+		// that the address of a cluster that changed is not served together with the old one
+		m.RemoveRRset([]dns.RR{rr})
+		m.Insert([]dns.RR{rr})
+	}
+	reply, err := r.exchange(m)
+	if err != nil {
+		return err
+	}
+	if reply.Rcode != dns.RcodeSuccess {
+		return r.replyError(reply)
+	}
+	return nil
+}
+
+func (r *rfc2136Provider) CreateRecord(name, address string) error {
+	return r.update(name, address, false)
+}
+
+func (r *rfc2136Provider) DeleteRecord(name, address string) error {
+	return r.update(name, address, true)
+}
+
+func (r *rfc2136Provider) RecordExists(name string) (bool, error) {
+	for _, rrType := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		exists, err := r.recordExists(name, rrType)
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+	return false, nil
+}
+
+func (r *rfc2136Provider) recordExists(name string, rrType uint16) (bool, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(name), rrType)
+	m.RecursionDesired = false
+	reply, err := r.exchange(m)
+	if err != nil {
+		return false, err
+	}
+	if reply.Rcode == dns.RcodeNameError {
+		return false, nil
+	}
+	if reply.Rcode != dns.RcodeSuccess {
+		return false, r.replyError(reply)
+	}
+	for _, rr := range reply.Answer {
+		// The records of a CNAME target are owned by the target name
+		if rr.Header().Rrtype == rrType && strings.EqualFold(rr.Header().Name, dns.Fqdn(name)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *rfc2136Provider) GetDomainName() (string, error) {
+	return strings.TrimSuffix(r.zone, "."), nil
+}
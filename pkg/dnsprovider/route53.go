@@ -0,0 +1,83 @@
+package dnsprovider
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+)
+
+type route53Provider struct {
+	client dnsproviders.Route53
+}
+
+// newRoute53 returns a provider of the hosted zone that uses the shared credentials profile, the route53 profile is
+// used when the profile is empty
+func newRoute53(cfg Config, hostedZoneID, profile string) (*route53Provider, error) {
+	client := dnsproviders.Route53{
+		RecordSet: dnsproviders.RecordSet{
+			TTL: cfg.RecordTTL,
+		},
+		HostedZoneID: hostedZoneID,
+		SharedCreds:  true,
+	}
+	if profile != "" {
+		sess, err := session.NewSession(&aws.Config{
+			Credentials: credentials.NewSharedCredentials("", profile),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.SVC = route53.New(sess)
+	}
+	return &route53Provider{client: client}, nil
+}
+
+// NewRoute53Provider returns a provider of the hosted zone of the route53 client, the record type of the client is
+// set by every request
+func NewRoute53Provider(client dnsproviders.Route53) Provider {
+	return &route53Provider{client: client}
+}
+
+// clientOf returns the client of records of the type
+func (r *route53Provider) clientOf(recordSetType string) dnsproviders.Route53 {
+	client := r.client
+	client.RecordSet.RecordSetType = recordSetType
+	return client
+}
+
+func (r *route53Provider) CreateRecord(name, address string) error {
+	recordSetType, err := recordType(address)
+	if err != nil {
+		return err
+	}
+	_, err = r.clientOf(recordSetType).CreateRecordSet(name, address)
+	return err
+}
+
+func (r *route53Provider) DeleteRecord(name, address string) error {
+	recordSetType, err := recordType(address)
+	if err != nil {
+		return err
+	}
+	_, err = r.clientOf(recordSetType).DeleteRecordSet(name, address)
+	return err
+}
+
+func (r *route53Provider) RecordExists(name string) (bool, error) {
+	for _, recordSetType := range []string{"A", "AAAA"} {
+		res, err := r.clientOf(recordSetType).GetRecordSet(name)
+		if err != nil {
+			return false, err
+		}
+		if res != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *route53Provider) GetDomainName() (string, error) {
+	return r.client.GetDomainName()
+}
@@ -4,19 +4,15 @@ import (
 	"context"
 
 	"github.com/openshift/assisted-service/internal/common"
-	"github.com/openshift/assisted-service/internal/events"
 )
 
-type ISOGenerator interface {
-	GenerateISO(ctx context.Context, cluster common.Cluster, jobName string, imageName string, ignitionConfig string, eventsHandler events.Handler) error
-}
-
+// InstallConfigGenerator generates the installation files of a cluster. The ignition generator places the additional
+// manifests of the cluster in their folders of the installation files, next to the manifests that the installer
+// creates. It reads them from the storage of the service rather than from its environment, whose size is limited:
+// the kube job reads the objects under the MANIFESTS_S3_PREFIX prefix of its S3 bucket, and the local job reads the
+// files under the MANIFESTS_DIR directory. A manifest is stored as <folder>/<file name> under both, and there are no
+// manifests when nothing is stored there
 type InstallConfigGenerator interface {
-	GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte) error
+	GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string) error
 	AbortInstallConfig(ctx context.Context, cluster common.Cluster) error
 }
-
-type ISOInstallConfigGenerator interface {
-	ISOGenerator
-	InstallConfigGenerator
-}
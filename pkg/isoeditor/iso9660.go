@@ -0,0 +1,159 @@
+package isoeditor
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+/*
+An ISO 9660 image starts with volume descriptors after its system area, each one sector long. The primary volume
+descriptor holds the directory record of the root directory, a directory is an extent of directory records that
+locate its files and subdirectories. Directory records do not cross sectors, the rest of a sector that does not fit a
+record is zeros. Rock Ridge records keep the original name of a file in an NM entry of the system use area of its
+directory record, the ISO 9660 name is upper case with a version suffix (e.g. "ROOTFS.IMG;1").
+*/
+
+const (
+	// KernelPath is the path of the kernel in the RHCOS live ISO
+	KernelPath = "/images/pxeboot/vmlinuz"
+	// InitrdPath is the path of the initrd in the RHCOS live ISO
+	InitrdPath = "/images/pxeboot/initrd.img"
+	// RootfsPath is the path of the rootfs in the RHCOS live ISO
+	RootfsPath = "/images/pxeboot/rootfs.img"
+
+	sectorLength                   = 2048
+	volumeDescriptorPrimary        = 1
+	volumeDescriptorSetTerminator  = 255
+	rootDirectoryRecordOffset      = 156
+	directoryRecordHeaderLength    = 33
+	directoryRecordFlagIsDirectory = 0x02
+)
+
+type directoryRecord struct {
+	name   string
+	extent int64
+	length int64
+	isDir  bool
+}
+
+// OpenFile returns a reader of the file at the path in the ISO 9660 image, the path is relative to the root directory
+// of the image and the names in it are compared regardless of case
+func OpenFile(iso io.ReaderAt, filePath string) (*io.SectionReader, error) {
+	record, err := findFile(iso, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return io.NewSectionReader(iso, record.extent*sectorLength, record.length), nil
+}
+
+// findFile returns the directory record of the file at the path in the ISO 9660 image
+func findFile(iso io.ReaderAt, filePath string) (*directoryRecord, error) {
+	record, err := readRootDirectoryRecord(iso)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range strings.Split(strings.Trim(filePath, "/"), "/") {
+		if !record.isDir {
+			return nil, errors.Errorf("failed to find %s in the ISO: %s is not a directory", filePath, record.name)
+		}
+		if record, err = findDirectoryRecord(iso, record, name); err != nil {
+			return nil, errors.Wrapf(err, "failed to find %s in the ISO", filePath)
+		}
+	}
+	if record.isDir {
+		return nil, errors.Errorf("failed to find %s in the ISO: it is a directory", filePath)
+	}
+	return record, nil
+}
+
+func readRootDirectoryRecord(iso io.ReaderAt) (*directoryRecord, error) {
+	descriptor := make([]byte, sectorLength)
+	for offset := int64(SystemAreaLength); ; offset += sectorLength {
+		if _, err := iso.ReadAt(descriptor, offset); err != nil {
+			return nil, errors.Wrap(err, "failed to read the volume descriptors of the ISO")
+		}
+		if string(descriptor[1:6]) != "CD001" || descriptor[0] == volumeDescriptorSetTerminator {
+			return nil, errors.New("the ISO has no primary volume descriptor")
+		}
+		if descriptor[0] == volumeDescriptorPrimary {
+			return parseDirectoryRecord(descriptor[rootDirectoryRecordOffset:])
+		}
+	}
+}
+
+func findDirectoryRecord(iso io.ReaderAt, dir *directoryRecord, name string) (*directoryRecord, error) {
+	data := make([]byte, dir.length)
+	if _, err := iso.ReadAt(data, dir.extent*sectorLength); err != nil {
+		return nil, errors.Wrapf(err, "failed to read the directory %s", dir.name)
+	}
+	for offset := 0; offset < len(data); {
+		if data[offset] == 0 {
+			offset = (offset/sectorLength + 1) * sectorLength
+			continue
+		}
+		record, err := parseDirectoryRecord(data[offset:])
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(record.name, name) {
+			return record, nil
+		}
+		offset += int(data[offset])
+	}
+	return nil, errors.Errorf("%s not found in the directory %s", name, dir.name)
+}
+
+func parseDirectoryRecord(data []byte) (*directoryRecord, error) {
+	recordLength := int(data[0])
+	if recordLength < directoryRecordHeaderLength || recordLength > len(data) {
+		return nil, errors.New("invalid directory record in the ISO")
+	}
+	record := data[:recordLength]
+	nameLength := int(record[32])
+	if directoryRecordHeaderLength+nameLength > recordLength {
+		return nil, errors.New("invalid directory record in the ISO")
+	}
+	name := string(record[directoryRecordHeaderLength : directoryRecordHeaderLength+nameLength])
+	systemUseOffset := directoryRecordHeaderLength + nameLength
+	if nameLength%2 == 0 {
+		// the name is padded to an odd length
+		systemUseOffset++
+	}
+	if systemUseOffset > recordLength {
+		systemUseOffset = recordLength
+	}
+	if rockRidgeName, ok := parseRockRidgeName(record[systemUseOffset:]); ok {
+		name = rockRidgeName
+	} else {
+		name = strings.TrimSuffix(strings.SplitN(name, ";", 2)[0], ".")
+	}
+	return &directoryRecord{
+		name:   name,
+		extent: int64(binary.LittleEndian.Uint32(record[2:6])),
+		length: int64(binary.LittleEndian.Uint32(record[10:14])),
+		isDir:  record[25]&directoryRecordFlagIsDirectory != 0,
+	}, nil
+}
+
+// parseRockRidgeName returns the name in the NM entries of the system use area of a directory record, a long name is
+// split across several entries
+func parseRockRidgeName(systemUse []byte) (string, bool) {
+	var name string
+	found := false
+	for len(systemUse) >= 4 {
+		entryLength := int(systemUse[2])
+		if entryLength < 4 || entryLength > len(systemUse) {
+			break
+		}
+		// signature, length, version and flags precede the name
+		if string(systemUse[:2]) == "NM" && entryLength > 5 {
+			name += string(systemUse[5:entryLength])
+			found = true
+		}
+		systemUse = systemUse[entryLength:]
+	}
+	return name, found
+}
@@ -0,0 +1,204 @@
+package isoeditor
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+/*
+The RHCOS live ISO reserves an embed area for an ignition config, a header in the system area of the ISO locates the
+embed area. The header is the 8 bytes magic "coreiso+" followed by the offset and the length of the embed area as
+little endian 64 bit integers, it ends where the ISO 9660 volume descriptors start. The initramfs of the live ISO reads
+the embed area as a compressed cpio archive that contains the ignition config as config.ign, the rest of the embed area
+is zeros.
+*/
+
+const (
+	embedAreaHeaderMagic  = "coreiso+"
+	embedAreaHeaderLength = 24
+	// SystemAreaLength is the length of the system area of an ISO 9660 image, the embed area header ends with it
+	SystemAreaLength     = 32768
+	ignitionArchiveEntry = "config.ign"
+)
+
+// EmbedArea is the area of the RHCOS live ISO that is reserved for an ignition config
+type EmbedArea struct {
+	Offset int64
+	Length int64
+}
+
+// ReadEmbedArea reads the embed area header from the beginning of an RHCOS live ISO
+func ReadEmbedArea(iso io.Reader) (*EmbedArea, error) {
+	systemArea := make([]byte, SystemAreaLength)
+	if _, err := io.ReadFull(iso, systemArea); err != nil {
+		return nil, errors.Wrap(err, "failed to read the system area of the ISO")
+	}
+	return parseEmbedArea(systemArea)
+}
+
+func parseEmbedArea(systemArea []byte) (*EmbedArea, error) {
+	header := systemArea[SystemAreaLength-embedAreaHeaderLength:]
+	if string(header[:len(embedAreaHeaderMagic)]) != embedAreaHeaderMagic {
+		return nil, errors.New("the ISO has no embed area for an ignition config")
+	}
+	area := &EmbedArea{
+		Offset: int64(binary.LittleEndian.Uint64(header[8:16])),
+		Length: int64(binary.LittleEndian.Uint64(header[16:24])),
+	}
+	if area.Offset < SystemAreaLength || area.Length <= 0 {
+		return nil, errors.Errorf("the embed area of the ISO (offset %d, length %d) is invalid", area.Offset, area.Length)
+	}
+	return area, nil
+}
+
+// IgnitionArchive returns the ignition config as the gzip compressed newc cpio archive that the embed area holds
+func IgnitionArchive(ignitionConfig []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := writeCpioEntry(zw, ignitionArchiveEntry, 0100644, ignitionConfig); err != nil {
+		return nil, err
+	}
+	if err := writeCpioEntry(zw, "TRAILER!!!", 0, nil); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to compress the ignition archive")
+	}
+	return buf.Bytes(), nil
+}
+
+// NewInitrdReader returns a reader of the initrd followed by the ignition archive as an extra initrd, the archive starts
+// at a 4 bytes boundary as the kernel requires. The length of the combined initrd is returned with the reader
+func NewInitrdReader(initrd io.ReadCloser, initrdLength int64, ignitionConfig []byte) (io.ReadCloser, int64, error) {
+	archive, err := IgnitionArchive(ignitionConfig)
+	if err != nil {
+		return nil, 0, err
+	}
+	alignment := padding(int(initrdLength % 4))
+	return &readCloser{
+		Reader: io.MultiReader(initrd, bytes.NewReader(alignment), bytes.NewReader(archive)),
+		close:  initrd.Close,
+	}, initrdLength + int64(len(alignment)+len(archive)), nil
+}
+
+func writeCpioEntry(w io.Writer, name string, mode int, content []byte) error {
+	var entry bytes.Buffer
+	// magic, inode, mode, uid, gid, nlink, mtime, file size, dev major/minor, rdev major/minor, name size and check
+	fmt.Fprintf(&entry, "070701%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X",
+		0, mode, 0, 0, 1, 0, len(content), 0, 0, 0, 0, len(name)+1, 0)
+	entry.WriteString(name)
+	entry.WriteByte(0)
+	entry.Write(padding(entry.Len()))
+	entry.Write(content)
+	entry.Write(padding(len(content)))
+	if _, err := w.Write(entry.Bytes()); err != nil {
+		return errors.Wrap(err, "failed to write the ignition archive")
+	}
+	return nil
+}
+
+// padding returns the zeros that align the length to the 4 bytes of the newc format
+func padding(length int) []byte {
+	return make([]byte, (4-length%4)%4)
+}
+
+// patch is data that overwrites the ISO at the offset
+type patch struct {
+	offset int64
+	data   []byte
+}
+
+type embedReader struct {
+	iso     io.Reader
+	patches []patch
+	pos     int64
+}
+
+// NewEmbedReader returns a reader of the RHCOS live ISO with the ignition config written into its embed area, the ISO
+// is patched while it is read
+func NewEmbedReader(iso io.ReadCloser, ignitionConfig []byte) (io.ReadCloser, error) {
+	return newEmbedReader(iso, ignitionConfig, nil)
+}
+
+// NewEmbedKargsReader returns a reader of the RHCOS live ISO with the ignition config written into its embed area and
+// the kernel argument appended to the default kernel arguments in its kargs areas, the ISO is patched while it is read
+func NewEmbedKargsReader(iso io.ReadCloser, ignitionConfig []byte, kargsAreas *KargsAreas, karg string) (io.ReadCloser, error) {
+	patches, err := kargsAreas.patches(karg)
+	if err != nil {
+		return nil, err
+	}
+	return newEmbedReader(iso, ignitionConfig, patches)
+}
+
+func newEmbedReader(iso io.ReadCloser, ignitionConfig []byte, patches []patch) (io.ReadCloser, error) {
+	systemArea := make([]byte, SystemAreaLength)
+	if _, err := io.ReadFull(iso, systemArea); err != nil {
+		return nil, errors.Wrap(err, "failed to read the system area of the ISO")
+	}
+	area, err := parseEmbedArea(systemArea)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := IgnitionArchive(ignitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(archive)) > area.Length {
+		return nil, errors.Errorf("the ignition archive (%d bytes) does not fit the embed area of the ISO (%d bytes)",
+			len(archive), area.Length)
+	}
+	// the rest of the embed area is zeros
+	embedArea := make([]byte, area.Length)
+	copy(embedArea, archive)
+	r := &embedReader{
+		iso:     io.MultiReader(bytes.NewReader(systemArea), iso),
+		patches: append(patches, patch{offset: area.Offset, data: embedArea}),
+	}
+	return &readCloser{Reader: r, close: iso.Close}, nil
+}
+
+func (r *embedReader) Read(p []byte) (int, error) {
+	n, err := r.iso.Read(p)
+	for _, pt := range r.patches {
+		r.patch(p[:n], pt)
+	}
+	r.pos += int64(n)
+	return n, err
+}
+
+// patch overwrites the bytes of the patch in the chunk that starts at the current position
+func (r *embedReader) patch(chunk []byte, pt patch) {
+	start := max(r.pos, pt.offset)
+	end := min(r.pos+int64(len(chunk)), pt.offset+int64(len(pt.data)))
+	if start < end {
+		copy(chunk[start-r.pos:end-r.pos], pt.data[start-pt.offset:end-pt.offset])
+	}
+}
+
+func max(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (r *readCloser) Close() error {
+	return r.close()
+}
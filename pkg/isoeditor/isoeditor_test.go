@@ -0,0 +1,294 @@
+package isoeditor
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestIsoEditor(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "isoeditor tests")
+}
+
+const (
+	testEmbedAreaOffset = 40960
+	testEmbedAreaLength = 4096
+)
+
+func testISO(withEmbedArea bool) []byte {
+	iso := bytes.Repeat([]byte{0xaa}, 65536)
+	if withEmbedArea {
+		header := iso[SystemAreaLength-embedAreaHeaderLength : SystemAreaLength]
+		copy(header, embedAreaHeaderMagic)
+		binary.LittleEndian.PutUint64(header[8:16], testEmbedAreaOffset)
+		binary.LittleEndian.PutUint64(header[16:24], testEmbedAreaLength)
+	}
+	return iso
+}
+
+func testDirectoryRecord(isoName, rockRidgeName string, extent, length int, isDir bool) []byte {
+	record := make([]byte, directoryRecordHeaderLength+len(isoName))
+	if len(isoName)%2 == 0 {
+		record = append(record, 0)
+	}
+	if rockRidgeName != "" {
+		record = append(record, 'N', 'M', byte(5+len(rockRidgeName)), 1, 0)
+		record = append(record, rockRidgeName...)
+	}
+	if len(record)%2 == 1 {
+		record = append(record, 0)
+	}
+	record[0] = byte(len(record))
+	binary.LittleEndian.PutUint32(record[2:6], uint32(extent))
+	binary.LittleEndian.PutUint32(record[10:14], uint32(length))
+	if isDir {
+		record[25] = directoryRecordFlagIsDirectory
+	}
+	record[32] = byte(len(isoName))
+	copy(record[directoryRecordHeaderLength:], isoName)
+	return record
+}
+
+// testISO9660 returns an ISO 9660 image with the kernel and the rootfs of the RHCOS live ISO, the second sector of the
+// pxeboot directory holds the rootfs with its Rock Ridge name
+func testISO9660() []byte {
+	iso := make([]byte, 24*sectorLength)
+	writeSector := func(sector int, records ...[]byte) {
+		offset := sector * sectorLength
+		for _, record := range records {
+			offset += copy(iso[offset:], record)
+		}
+	}
+	pvd := iso[16*sectorLength:]
+	pvd[0] = volumeDescriptorPrimary
+	copy(pvd[1:6], "CD001")
+	copy(pvd[rootDirectoryRecordOffset:], testDirectoryRecord("\x00", "", 18, sectorLength, true))
+	terminator := iso[17*sectorLength:]
+	terminator[0] = volumeDescriptorSetTerminator
+	copy(terminator[1:6], "CD001")
+
+	writeSector(18, testDirectoryRecord("\x00", "", 18, sectorLength, true), testDirectoryRecord("\x01", "", 18, sectorLength, true),
+		testDirectoryRecord("IMAGES", "", 19, sectorLength, true))
+	writeSector(19, testDirectoryRecord("\x00", "", 19, sectorLength, true), testDirectoryRecord("\x01", "", 18, sectorLength, true),
+		testDirectoryRecord("PXEBOOT", "", 20, 2*sectorLength, true))
+	writeSector(20, testDirectoryRecord("\x00", "", 20, 2*sectorLength, true), testDirectoryRecord("\x01", "", 19, sectorLength, true),
+		testDirectoryRecord("VMLINUZ.;1", "", 22, len("kernel"), false))
+	writeSector(21, testDirectoryRecord("ROOTFS.IMG;1", "rootfs.img", 23, len("rootfs"), false))
+	writeSector(22, []byte("kernel"))
+	writeSector(23, []byte("rootfs"))
+	return iso
+}
+
+const (
+	testKargsDefault = "coreos.liveiso=rhcos"
+	testKargsLength  = 100
+)
+
+// testKargsISO returns an RHCOS live ISO with an embed area and the kargs areas of the GRUB and the isolinux configs,
+// the embed area takes the sectors 20 and 21
+func testKargsISO() []byte {
+	iso := make([]byte, 28*sectorLength)
+	copy(iso, testISO(true)[:SystemAreaLength])
+	writeSector := func(sector int, records ...[]byte) {
+		offset := sector * sectorLength
+		for _, record := range records {
+			offset += copy(iso[offset:], record)
+		}
+	}
+	pvd := iso[16*sectorLength:]
+	pvd[0] = volumeDescriptorPrimary
+	copy(pvd[1:6], "CD001")
+	copy(pvd[rootDirectoryRecordOffset:], testDirectoryRecord("\x00", "", 18, sectorLength, true))
+	terminator := iso[17*sectorLength:]
+	terminator[0] = volumeDescriptorSetTerminator
+	copy(terminator[1:6], "CD001")
+
+	kargsArea := testKargsDefault + strings.Repeat("#", testKargsLength-len(testKargsDefault))
+	kargsJSON := fmt.Sprintf(`{"default": %q, "files": [{"path": "EFI/redhat/grub.cfg", "offset": 6}, `+
+		`{"path": "isolinux/isolinux.cfg", "offset": 7}], "size": %d}`, testKargsDefault, testKargsLength)
+	grubConfig := "linux " + kargsArea + "\n"
+	isolinuxConfig := "append " + kargsArea + "\n"
+	writeSector(18, testDirectoryRecord("\x00", "", 18, sectorLength, true),
+		testDirectoryRecord("COREOS", "", 19, sectorLength, true), testDirectoryRecord("EFI", "", 22, sectorLength, true),
+		testDirectoryRecord("ISOLINUX", "", 24, sectorLength, true))
+	writeSector(19, testDirectoryRecord("KARGS.JSON;1", "kargs.json", 25, len(kargsJSON), false))
+	writeSector(22, testDirectoryRecord("REDHAT", "", 23, sectorLength, true))
+	writeSector(23, testDirectoryRecord("GRUB.CFG;1", "grub.cfg", 26, len(grubConfig), false))
+	writeSector(24, testDirectoryRecord("ISOLINUX.CFG;1", "isolinux.cfg", 27, len(isolinuxConfig), false))
+	writeSector(25, []byte(kargsJSON))
+	writeSector(26, []byte(grubConfig))
+	writeSector(27, []byte(isolinuxConfig))
+	return iso
+}
+
+func extractIgnitionConfig(archive []byte) string {
+	zr, err := gzip.NewReader(bytes.NewReader(archive))
+	Expect(err).ToNot(HaveOccurred())
+	cpio, err := ioutil.ReadAll(zr)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(cpio[:6])).To(Equal("070701"))
+	nameEnd := 110 + bytes.IndexByte(cpio[110:], 0)
+	Expect(string(cpio[110:nameEnd])).To(Equal(ignitionArchiveEntry))
+	size, err := strconv.ParseInt(string(cpio[54:62]), 16, 64)
+	Expect(err).ToNot(HaveOccurred())
+	contentStart := nameEnd + 1 + len(padding(nameEnd+1))
+	contentEnd := contentStart + int(size)
+	Expect(string(cpio[contentEnd:])).To(ContainSubstring("TRAILER!!!"))
+	return string(cpio[contentStart:contentEnd])
+}
+
+var _ = Describe("isoeditor", func() {
+	ignitionConfig := []byte(`{"ignition":{"version":"3.1.0"}}`)
+
+	It("reads the embed area", func() {
+		area, err := ReadEmbedArea(bytes.NewReader(testISO(true)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*area).To(Equal(EmbedArea{Offset: testEmbedAreaOffset, Length: testEmbedAreaLength}))
+	})
+
+	It("fails to read the embed area of an ISO without one", func() {
+		_, err := ReadEmbedArea(bytes.NewReader(testISO(false)))
+		Expect(err).To(HaveOccurred())
+		_, err = ReadEmbedArea(bytes.NewReader(testISO(true)[:1024]))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("archives the ignition config", func() {
+		archive, err := IgnitionArchive(ignitionConfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extractIgnitionConfig(archive)).To(Equal(string(ignitionConfig)))
+	})
+
+	It("embeds the ignition config while the ISO is read", func() {
+		iso := testISO(true)
+		reader, err := NewEmbedReader(ioutil.NopCloser(bytes.NewReader(iso)), ignitionConfig)
+		Expect(err).ToNot(HaveOccurred())
+		patched, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+
+		Expect(patched).To(HaveLen(len(iso)))
+		Expect(patched[:testEmbedAreaOffset]).To(Equal(iso[:testEmbedAreaOffset]))
+		Expect(patched[testEmbedAreaOffset+testEmbedAreaLength:]).To(Equal(iso[testEmbedAreaOffset+testEmbedAreaLength:]))
+
+		archive, err := IgnitionArchive(ignitionConfig)
+		Expect(err).ToNot(HaveOccurred())
+		embedArea := patched[testEmbedAreaOffset : testEmbedAreaOffset+testEmbedAreaLength]
+		Expect(embedArea[:len(archive)]).To(Equal(archive))
+		Expect(embedArea[len(archive):]).To(Equal(make([]byte, testEmbedAreaLength-len(archive))))
+		Expect(extractIgnitionConfig(embedArea[:len(archive)])).To(Equal(string(ignitionConfig)))
+	})
+
+	It("fails to embed an ignition config that does not fit the embed area", func() {
+		// random content does not compress
+		large := make([]byte, 2*testEmbedAreaLength)
+		rand.New(rand.NewSource(1)).Read(large)
+		_, err := NewEmbedReader(ioutil.NopCloser(bytes.NewReader(testISO(true))), large)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("does not fit"))
+	})
+
+	It("fails to embed into an ISO without an embed area", func() {
+		_, err := NewEmbedReader(ioutil.NopCloser(strings.NewReader(string(testISO(false)))), ignitionConfig)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("appends the ignition config to the initrd", func() {
+		initrd := []byte("initrd")
+		reader, length, err := NewInitrdReader(ioutil.NopCloser(bytes.NewReader(initrd)), int64(len(initrd)), ignitionConfig)
+		Expect(err).ToNot(HaveOccurred())
+		combined, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+
+		Expect(combined).To(HaveLen(int(length)))
+		Expect(combined[:len(initrd)]).To(Equal(initrd))
+		Expect(combined[len(initrd):8]).To(Equal([]byte{0, 0}))
+		Expect(extractIgnitionConfig(combined[8:])).To(Equal(string(ignitionConfig)))
+	})
+
+	It("opens the files of the ISO", func() {
+		iso := bytes.NewReader(testISO9660())
+		for filePath, content := range map[string]string{KernelPath: "kernel", RootfsPath: "rootfs"} {
+			file, err := OpenFile(iso, filePath)
+			Expect(err).ToNot(HaveOccurred())
+			data, err := ioutil.ReadAll(file)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(content))
+		}
+	})
+
+	It("fails to open files that are not in the ISO", func() {
+		iso := bytes.NewReader(testISO9660())
+		for _, filePath := range []string{InitrdPath, "/images", "/images/pxeboot/vmlinuz/kernel"} {
+			_, err := OpenFile(iso, filePath)
+			Expect(err).To(HaveOccurred(), filePath)
+		}
+		_, err := OpenFile(bytes.NewReader(testISO(true)), KernelPath)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reads the kargs areas", func() {
+		areas, err := ReadKargsAreas(bytes.NewReader(testKargsISO()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*areas).To(Equal(KargsAreas{
+			Default: testKargsDefault,
+			Length:  testKargsLength,
+			Areas: []KargsArea{
+				{Path: "EFI/redhat/grub.cfg", Offset: 26*sectorLength + 6},
+				{Path: "isolinux/isolinux.cfg", Offset: 27*sectorLength + 7},
+			},
+		}))
+	})
+
+	It("fails to read the kargs areas of an ISO without them", func() {
+		_, err := ReadKargsAreas(bytes.NewReader(testISO9660()))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("embeds the ignition config and the kernel argument while the ISO is read", func() {
+		iso := testKargsISO()
+		areas, err := ReadKargsAreas(bytes.NewReader(iso))
+		Expect(err).ToNot(HaveOccurred())
+		karg := "coreos.live.rootfs_url=https://example.com/rootfs?a=1&b=2"
+		reader, err := NewEmbedKargsReader(ioutil.NopCloser(bytes.NewReader(iso)), ignitionConfig, areas, karg)
+		Expect(err).ToNot(HaveOccurred())
+		patched, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+
+		Expect(patched).To(HaveLen(len(iso)))
+		grubConfig := string(patched[26*sectorLength : 26*sectorLength+6+testKargsLength+1])
+		grubKargs := testKargsDefault + " '" + karg + "'"
+		Expect(grubConfig).To(Equal("linux " + grubKargs + strings.Repeat("#", testKargsLength-len(grubKargs)) + "\n"))
+		isolinuxConfig := string(patched[27*sectorLength : 27*sectorLength+7+testKargsLength+1])
+		isolinuxKargs := testKargsDefault + " " + karg
+		Expect(isolinuxConfig).To(Equal("append " + isolinuxKargs + strings.Repeat("#", testKargsLength-len(isolinuxKargs)) + "\n"))
+
+		archive, err := IgnitionArchive(ignitionConfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patched[testEmbedAreaOffset : testEmbedAreaOffset+len(archive)]).To(Equal(archive))
+		Expect(patched[:testEmbedAreaOffset]).To(Equal(iso[:testEmbedAreaOffset]))
+	})
+
+	It("fails to embed a kernel argument that does not fit the kargs areas", func() {
+		iso := testKargsISO()
+		areas, err := ReadKargsAreas(bytes.NewReader(iso))
+		Expect(err).ToNot(HaveOccurred())
+		karg := "coreos.live.rootfs_url=https://example.com/" + strings.Repeat("a", testKargsLength)
+		Expect(areas.VerifyKargFits(karg)).ToNot(Succeed())
+		_, err = NewEmbedKargsReader(ioutil.NopCloser(bytes.NewReader(iso)), ignitionConfig, areas, karg)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("do not fit"))
+	})
+})
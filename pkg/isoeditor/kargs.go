@@ -0,0 +1,106 @@
+package isoeditor
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+/*
+The RHCOS live ISO reserves areas for extra kernel arguments in its boot config files, /coreos/kargs.json lists the
+default kernel arguments, the length of the areas and the files that have one together with the offset of the area in
+the file. An area holds the kernel arguments padded with '#' to its length, GRUB and isolinux read the padding as a
+comment. GRUB reads '&', ';' and the like as metacharacters, so the kernel arguments that are appended to the GRUB
+config are quoted.
+*/
+
+const (
+	// KargsPath is the path of the description of the kargs areas in the RHCOS live ISO
+	KargsPath         = "/coreos/kargs.json"
+	kargsPadding      = '#'
+	grubConfigSuffix  = "grub.cfg"
+	kargsAreaMaxFiles = 16
+)
+
+// KargsArea is an area of a boot config file of the RHCOS live ISO that is reserved for kernel arguments, the offset
+// is from the beginning of the ISO
+type KargsArea struct {
+	Path   string `json:"path"`
+	Offset int64  `json:"offset"`
+}
+
+// KargsAreas are the areas of the RHCOS live ISO that are reserved for kernel arguments, they hold the default kernel
+// arguments and have the same length
+type KargsAreas struct {
+	Default string      `json:"default"`
+	Length  int64       `json:"length"`
+	Areas   []KargsArea `json:"areas"`
+}
+
+type kargsFile struct {
+	Default string `json:"default"`
+	Files   []struct {
+		Path   string `json:"path"`
+		Offset int64  `json:"offset"`
+	} `json:"files"`
+	Size int64 `json:"size"`
+}
+
+// ReadKargsAreas reads the kargs areas of an RHCOS live ISO from its /coreos/kargs.json
+func ReadKargsAreas(iso io.ReaderAt) (*KargsAreas, error) {
+	reader, err := OpenFile(iso, KargsPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "the ISO has no kargs areas")
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s from the ISO", KargsPath)
+	}
+	var kargs kargsFile
+	if err = json.Unmarshal(data, &kargs); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s of the ISO", KargsPath)
+	}
+	if kargs.Size <= int64(len(kargs.Default)) || len(kargs.Files) == 0 || len(kargs.Files) > kargsAreaMaxFiles {
+		return nil, errors.Errorf("the kargs areas of the ISO (size %d, %d files) are invalid", kargs.Size, len(kargs.Files))
+	}
+
+	areas := &KargsAreas{Default: kargs.Default, Length: kargs.Size}
+	for _, file := range kargs.Files {
+		record, err := findFile(iso, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		if file.Offset < 0 || file.Offset+kargs.Size > record.length {
+			return nil, errors.Errorf("the kargs area of %s (offset %d) is out of the file", file.Path, file.Offset)
+		}
+		areas.Areas = append(areas.Areas, KargsArea{Path: file.Path, Offset: record.extent*sectorLength + file.Offset})
+	}
+	return areas, nil
+}
+
+// VerifyKargFits returns an error if the default kernel arguments and the kernel argument do not fit the kargs areas
+func (a *KargsAreas) VerifyKargFits(karg string) error {
+	_, err := a.patches(karg)
+	return err
+}
+
+// patches returns the patches that append the kernel argument to the default kernel arguments in every kargs area
+func (a *KargsAreas) patches(karg string) ([]patch, error) {
+	patches := make([]patch, 0, len(a.Areas))
+	for _, area := range a.Areas {
+		kargs := a.Default + " " + karg
+		if strings.HasSuffix(area.Path, grubConfigSuffix) {
+			kargs = a.Default + " '" + strings.ReplaceAll(karg, "'", `'\''`) + "'"
+		}
+		if int64(len(kargs)) > a.Length {
+			return nil, errors.Errorf("the kernel arguments (%d bytes) do not fit the kargs area of %s (%d bytes)",
+				len(kargs), area.Path, a.Length)
+		}
+		data := []byte(kargs + strings.Repeat(string(kargsPadding), int(a.Length)-len(kargs)))
+		patches = append(patches, patch{offset: area.Offset, data: data})
+	}
+	return patches, nil
+}
@@ -7,12 +7,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/network"
 
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
-	"github.com/openshift/assisted-service/internal/events"
-	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/generator"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/pkg/errors"
@@ -28,12 +27,6 @@ import (
 
 const ignitionGeneratorPrefix = "ignition-generator"
 
-// Dummy is used to represent the ignition config for the dummy ISO that is kicked off in
-// inventory.go to pull the base ISO image when the service starts up.
-// It is also used to detect if the image should be uploaded to S3. The dummy image is not
-// uploaded to S3.
-const Dummy = "Dummy"
-
 //go:generate mockgen -source=job.go -package=job -destination=mock_job.go
 type API interface {
 	// Create k8s job
@@ -42,14 +35,13 @@ type API interface {
 	Monitor(ctx context.Context, name, namespace string) error
 	// Delete k8s job
 	Delete(ctx context.Context, name, namespace string, force bool) error
-	generator.ISOInstallConfigGenerator
+	generator.InstallConfigGenerator
 }
 
 type Config struct {
 	MonitorLoopInterval time.Duration `envconfig:"JOB_MONITOR_INTERVAL" default:"500ms"`
 	RetryInterval       time.Duration `envconfig:"JOB_RETRY_INTERVAL" default:"1s"`
 	RetryAttempts       int           `envconfig:"JOB_RETRY_ATTEMPTS" default:"30"`
-	ImageBuilder        string        `envconfig:"IMAGE_BUILDER" default:"quay.io/ocpmetal/assisted-iso-create:latest"`
 	Namespace           string        `envconfig:"NAMESPACE" default:"assisted-installer"`
 	S3SecretName        string        `envconfig:"S3_SECRET_NAME" default:"assisted-installer-s3"`
 	S3EndpointURL       string        `envconfig:"S3_ENDPOINT_URL" default:"http://10.35.59.36:30925"`
@@ -63,10 +55,9 @@ type Config struct {
 	JobMemoryRequests   string        `envconfig:"JOB_MEMORY_REQUESTS" default:"400Mi"`
 	IgnitionGenerator   string        `envconfig:"IGNITION_GENERATE_IMAGE" default:"quay.io/ocpmetal/assisted-ignition-generator:latest"` // TODO: update the latest once the repository has git workflow
 	ServiceBaseURL      string        `envconfig:"SERVICE_BASE_URL"`
-	//[TODO] -  change the default of Releae image to "", once everyine wll update their environment
-	SubsystemRun         bool   `envconfig:"SUBSYSTEM_RUN"`
-	ReleaseImage         string `envconfig:"OPENSHIFT_INSTALL_RELEASE_IMAGE" default:"quay.io/openshift-release-dev/ocp-release@sha256:eab93b4591699a5a4ff50ad3517892653f04fb840127895bb3609b3cc68f98f3"`
-	SkipCertVerification bool   `envconfig:"SKIP_CERT_VERIFICATION" default:"false"`
+
+	SubsystemRun         bool `envconfig:"SUBSYSTEM_RUN"`
+	SkipCertVerification bool `envconfig:"SKIP_CERT_VERIFICATION" default:"false"`
 }
 
 func New(log logrus.FieldLogger, kube client.Client, cfg Config) *kubeJob {
@@ -187,156 +178,8 @@ func getQuantity(s string) resource.Quantity {
 	return reply
 }
 
-// create discovery image generation job, return job name and error
-func (k *kubeJob) createImageJob(jobName, imgName, ignitionConfig string, performUpload bool) *batch.Job {
-	var command []string
-	if !performUpload {
-		command = []string{"echo", "pass"}
-	}
-	var pullPolicy core.PullPolicy = "Always"
-	if k.Config.SubsystemRun {
-		pullPolicy = "Never"
-	}
-	return &batch.Job{
-		TypeMeta: meta.TypeMeta{
-			Kind:       "Job",
-			APIVersion: "batch/v1",
-		},
-		ObjectMeta: meta.ObjectMeta{
-			Name:      jobName,
-			Namespace: k.Config.Namespace,
-		},
-		Spec: batch.JobSpec{
-			BackoffLimit: swag.Int32(2),
-			Template: core.PodTemplateSpec{
-				ObjectMeta: meta.ObjectMeta{
-					Name:      jobName,
-					Namespace: k.Config.Namespace,
-				},
-				Spec: core.PodSpec{
-					Containers: []core.Container{
-						{
-							Resources: core.ResourceRequirements{
-								Limits: core.ResourceList{
-									"cpu":    getQuantity(k.Config.JobCPULimit),
-									"memory": getQuantity(k.Config.JobMemoryLimit),
-								},
-								Requests: core.ResourceList{
-									"cpu":    getQuantity(k.Config.JobCPURequests),
-									"memory": getQuantity(k.Config.JobMemoryRequests),
-								},
-							},
-							Command:         command,
-							Name:            "image-creator",
-							Image:           k.Config.ImageBuilder,
-							ImagePullPolicy: pullPolicy,
-							Env: []core.EnvVar{
-								{
-									Name:  "S3_ENDPOINT_URL",
-									Value: k.Config.S3EndpointURL,
-								},
-								{
-									Name:  "IGNITION_CONFIG",
-									Value: ignitionConfig,
-								},
-								{
-									Name:  "IMAGE_NAME",
-									Value: imgName,
-								},
-								{
-									Name: "S3_BUCKET",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											LocalObjectReference: core.LocalObjectReference{
-												Name: k.Config.S3SecretName,
-											},
-											Key: "bucket",
-										},
-									},
-								},
-								{
-									Name: "S3_REGION",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											LocalObjectReference: core.LocalObjectReference{
-												Name: k.Config.S3SecretName,
-											},
-											Key: "aws_region",
-										},
-									},
-								},
-								{
-									Name: "AWS_ACCESS_KEY_ID",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											LocalObjectReference: core.LocalObjectReference{
-												Name: k.Config.S3SecretName,
-											},
-											Key: "aws_access_key_id",
-										},
-									},
-								},
-								{
-									Name: "AWS_SECRET_ACCESS_KEY",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											LocalObjectReference: core.LocalObjectReference{
-												Name: k.Config.S3SecretName,
-											},
-											Key: "aws_secret_access_key",
-										},
-									},
-								},
-							},
-						},
-					},
-					RestartPolicy: "Never",
-				},
-			},
-		},
-	}
-}
-
-// creates iso
-func (k *kubeJob) GenerateISO(ctx context.Context, cluster common.Cluster, jobName string, imageName string, ignitionConfig string, eventsHandler events.Handler) error {
-	log := logutil.FromContext(ctx, k.log)
-	if cluster.ID != nil {
-		previousCreatedAt := time.Time(cluster.ImageInfo.CreatedAt)
-		// Kill the previous job in case it's still running
-		prevJobName := fmt.Sprintf("createimage-%s-%s", cluster.ID, previousCreatedAt.Format("20060102150405"))
-		log.Info("Attempting to delete job %s", prevJobName)
-		if err := k.Delete(ctx, prevJobName, k.Namespace, false); err != nil {
-			log.WithError(err).Errorf("failed to kill previous job in cluster %s", cluster.ID)
-			msg := "Failed to generate image: error stopping previous image generation"
-			eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
-			return err
-		}
-		log.Info("Finished attempting to delete job %s", prevJobName)
-	}
-
-	// This job name is exactly 63 characters which is the maximum for a job - be careful if modifying
-	log.Infof("Creating job %s", jobName)
-	performUpload := true
-	if ignitionConfig == Dummy {
-		performUpload = false
-	}
-	if err := k.Create(ctx, k.createImageJob(jobName, imageName, ignitionConfig, performUpload)); err != nil {
-		log.WithError(err).Error("failed to create image job")
-		msg := "Failed to generate image: error creating image generation job"
-		eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
-		return err
-	}
-
-	if err := k.Monitor(ctx, jobName, k.Namespace); err != nil {
-		log.WithError(err).Error("image creation failed")
-		msg := "Failed to generate image: error during image generation job"
-		eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError, msg, time.Now())
-		return err
-	}
-	return nil
-}
-
-func (k *kubeJob) createKubeconfigJob(cluster *common.Cluster, jobName string, cfg []byte, encodedDhcpFileContents string) *batch.Job {
+func (k *kubeJob) createKubeconfigJob(cluster *common.Cluster, jobName string, cfg []byte, releaseImage string,
+	encodedDhcpFileContents string) *batch.Job {
 	id := cluster.ID
 	ignitionGeneratorImage := k.Config.IgnitionGenerator
 	var pullPolicy core.PullPolicy = "Always"
@@ -403,7 +246,7 @@ func (k *kubeJob) createKubeconfigJob(cluster *common.Cluster, jobName string, c
 								},
 								{
 									Name:  "OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE",
-									Value: k.ReleaseImage, //TODO: change this to match the cluster openshift version
+									Value: releaseImage,
 								},
 								{
 									Name: "AWS_ACCESS_KEY_ID",
@@ -431,6 +274,10 @@ func (k *kubeJob) createKubeconfigJob(cluster *common.Cluster, jobName string, c
 									Name:  "SKIP_CERT_VERIFICATION",
 									Value: strconv.FormatBool(k.Config.SkipCertVerification),
 								},
+								{
+									Name:  "MANIFESTS_S3_PREFIX",
+									Value: manifests.GetPrefix(id.String()),
+								},
 							},
 							Resources: core.ResourceRequirements{
 								Limits: core.ResourceList{
@@ -460,7 +307,7 @@ func (k *kubeJob) createKubeconfigJob(cluster *common.Cluster, jobName string, c
 }
 
 // creates install config
-func (k *kubeJob) GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte) error {
+func (k *kubeJob) GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string) error {
 	log := logutil.FromContext(ctx, k.log)
 	ctime := time.Time(cluster.CreatedAt)
 	cTimestamp := strconv.FormatInt(ctime.Unix(), 10)
@@ -471,7 +318,7 @@ func (k *kubeJob) GenerateInstallConfig(ctx context.Context, cluster common.Clus
 		log.WithError(wrapped).Errorf("GenerateInstallConfig")
 		return wrapped
 	}
-	if err := k.Create(ctx, k.createKubeconfigJob(&cluster, jobName, cfg, encodedDhcpFileContents)); err != nil {
+	if err := k.Create(ctx, k.createKubeconfigJob(&cluster, jobName, cfg, releaseImage, encodedDhcpFileContents)); err != nil {
 		log.WithError(err).Errorf("Failed to create kubeconfig generation job %s for cluster %s", jobName, cluster.ID)
 		return errors.Wrapf(err, "Failed to create kubeconfig generation job %s for cluster %s", jobName, cluster.ID)
 	}
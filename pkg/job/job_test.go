@@ -6,10 +6,14 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/externalmocks"
 	"github.com/sirupsen/logrus"
 	batch "k8s.io/api/batch/v1"
@@ -151,3 +155,39 @@ var _ = Describe("job_test", func() {
 		ctrl.Finish()
 	})
 })
+
+var _ = Describe("per version images", func() {
+	var j *kubeJob
+
+	BeforeEach(func() {
+		j = New(logrus.New(), nil, Config{})
+	})
+
+	envValue := func(job *batch.Job, name string) (string, bool) {
+		for _, env := range job.Spec.Template.Spec.Containers[0].Env {
+			if env.Name == name {
+				return env.Value, true
+			}
+		}
+		return "", false
+	}
+
+	It("kubeconfig job uses the release image of the version", func() {
+		id := strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &id}}
+		value, ok := envValue(j.createKubeconfigJob(cluster, "job", []byte("cfg"), "release-image", ""),
+			"OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE")
+		Expect(ok).Should(BeTrue())
+		Expect(value).Should(Equal("release-image"))
+	})
+
+	It("kubeconfig job reads the manifests from the prefix of the cluster", func() {
+		id := strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &id}}
+		value, ok := envValue(j.createKubeconfigJob(cluster, "job", []byte("cfg"), "release-image", ""), "MANIFESTS_S3_PREFIX")
+		Expect(ok).Should(BeTrue())
+		Expect(value).Should(Equal(fmt.Sprintf("%s/manifests/", id)))
+		_, ok = envValue(j.createKubeconfigJob(cluster, "job", []byte("cfg"), "release-image", ""), "MANIFESTS")
+		Expect(ok).Should(BeFalse())
+	})
+})
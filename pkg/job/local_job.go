@@ -5,22 +5,27 @@ import (
 	"context"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 
+	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/pkg/errors"
 
 	"github.com/openshift/assisted-service/internal/common"
-	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/pkg/generator"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/sirupsen/logrus"
 )
 
+// localWorkDir is the directory of the files of the local job, the filesystem storage of the service stores its
+// objects in it too
+const localWorkDir = "/data"
+
 type LocalJob interface {
 	Execute(pythonCommand string, pythonFilePath string, envVars []string, log logrus.FieldLogger) error
-	generator.ISOInstallConfigGenerator
+	generator.InstallConfigGenerator
 }
 
 type localJob struct {
@@ -50,7 +55,7 @@ func (j *localJob) Execute(pythonCommand string, pythonFilePath string, envVars
 }
 
 // creates install config
-func (j *localJob) GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte) error {
+func (j *localJob) GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string) error {
 	log := logutil.FromContext(ctx, j.log)
 	encodedDhcpFileContents, err := network.GetEncodedDhcpParamFileContents(&cluster)
 	if err != nil {
@@ -63,9 +68,10 @@ func (j *localJob) GenerateInstallConfig(ctx context.Context, cluster common.Clu
 		"INVENTORY_ENDPOINT="+strings.TrimSpace(j.Config.ServiceBaseURL)+"/api/assisted-install/v1",
 		"IMAGE_NAME="+j.Config.IgnitionGenerator,
 		"CLUSTER_ID="+cluster.ID.String(),
-		"OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE="+j.Config.ReleaseImage,
-		"WORK_DIR=/data",
+		"OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE="+releaseImage,
+		"WORK_DIR="+localWorkDir,
 		"SKIP_CERT_VERIFICATION="+strconv.FormatBool(j.Config.SkipCertVerification),
+		"MANIFESTS_DIR="+filepath.Join(localWorkDir, manifests.GetPrefix(cluster.ID.String())),
 	)
 	if encodedDhcpFileContents != "" {
 		envVars = append(envVars, "DHCP_ALLOCATION_FILE="+encodedDhcpFileContents)
@@ -77,25 +83,3 @@ func (j *localJob) AbortInstallConfig(ctx context.Context, cluster common.Cluste
 	// no job to abort
 	return nil
 }
-
-func (j *localJob) GenerateISO(ctx context.Context, cluster common.Cluster, jobName string, imageName string, ignitionConfig string, eventsHandler events.Handler) error {
-	log := logutil.FromContext(ctx, j.log)
-	workDir := "/data"
-	cmd := exec.Command(workDir + "/assisted-iso-create")
-	cmd.Env = append(os.Environ(),
-		"IGNITION_CONFIG="+ignitionConfig,
-		"IMAGE_NAME="+imageName,
-		"COREOS_IMAGE="+workDir+"/livecd.iso",
-		"USE_S3=false",
-		"WORK_DIR="+workDir,
-	)
-
-	var out bytes.Buffer
-	cmd.Stdout = &out
-	cmd.Stderr = &out
-	if err := cmd.Run(); err != nil {
-		log.Errorf("assisted-iso-create failed: %s", out.String())
-		return err
-	}
-	return nil
-}
@@ -10,7 +10,6 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
-	events "github.com/openshift/assisted-service/internal/events"
 	logrus "github.com/sirupsen/logrus"
 )
 
@@ -51,32 +50,18 @@ func (mr *MockLocalJobMockRecorder) Execute(pythonCommand, pythonFilePath, envVa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLocalJob)(nil).Execute), pythonCommand, pythonFilePath, envVars, log)
 }
 
-// GenerateISO mocks base method
-func (m *MockLocalJob) GenerateISO(ctx context.Context, cluster common.Cluster, jobName, imageName, ignitionConfig string, eventsHandler events.Handler) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateISO", ctx, cluster, jobName, imageName, ignitionConfig, eventsHandler)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// GenerateISO indicates an expected call of GenerateISO
-func (mr *MockLocalJobMockRecorder) GenerateISO(ctx, cluster, jobName, imageName, ignitionConfig, eventsHandler interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateISO", reflect.TypeOf((*MockLocalJob)(nil).GenerateISO), ctx, cluster, jobName, imageName, ignitionConfig, eventsHandler)
-}
-
 // GenerateInstallConfig mocks base method
-func (m *MockLocalJob) GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte) error {
+func (m *MockLocalJob) GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateInstallConfig", ctx, cluster, cfg)
+	ret := m.ctrl.Call(m, "GenerateInstallConfig", ctx, cluster, cfg, releaseImage)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // GenerateInstallConfig indicates an expected call of GenerateInstallConfig
-func (mr *MockLocalJobMockRecorder) GenerateInstallConfig(ctx, cluster, cfg interface{}) *gomock.Call {
+func (mr *MockLocalJobMockRecorder) GenerateInstallConfig(ctx, cluster, cfg, releaseImage interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateInstallConfig", reflect.TypeOf((*MockLocalJob)(nil).GenerateInstallConfig), ctx, cluster, cfg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateInstallConfig", reflect.TypeOf((*MockLocalJob)(nil).GenerateInstallConfig), ctx, cluster, cfg, releaseImage)
 }
 
 // AbortInstallConfig mocks base method
@@ -120,4 +120,6 @@ type AuthPayload struct {
 	ClientID     string `json:"clientId"`
 	IsAdmin      bool   `json:"is_admin"`
 	IsUser       bool   `json:"is_user"`
+	// ClusterID limits the payload to a cluster, it is set by the URL tokens
+	ClusterID string `json:"cluster_id,omitempty"`
 }
@@ -43,6 +43,15 @@ type InstallerAPI interface {
 	/* CompleteInstallation Agent API to mark a finalizing installation as complete. */
 	CompleteInstallation(ctx context.Context, params installer.CompleteInstallationParams) middleware.Responder
 
+	/* CreateClusterManifest Creates a manifest that is added to the installation files of the cluster. */
+	CreateClusterManifest(ctx context.Context, params installer.CreateClusterManifestParams) middleware.Responder
+
+	/* DeleteClusterManifest Deletes a manifest of the cluster. */
+	DeleteClusterManifest(ctx context.Context, params installer.DeleteClusterManifestParams) middleware.Responder
+
+	/* DeleteHostBmcCredentials Deletes the BMC credentials of the OpenShift bare metal host. */
+	DeleteHostBmcCredentials(ctx context.Context, params installer.DeleteHostBmcCredentialsParams) middleware.Responder
+
 	/* DeregisterCluster Deletes an OpenShift bare metal cluster definition. */
 	DeregisterCluster(ctx context.Context, params installer.DeregisterClusterParams) middleware.Responder
 
@@ -52,6 +61,12 @@ type InstallerAPI interface {
 	/* DisableHost Disables a host for inclusion in the cluster. */
 	DisableHost(ctx context.Context, params installer.DisableHostParams) middleware.Responder
 
+	/* DownloadBaseBootArtifact Downloads a network boot artifact of the RHCOS live ISO of the OpenShift version. The kernel and the rootfs do not hold any data of a cluster, so they are served without auth and their URLs do not expire. */
+	DownloadBaseBootArtifact(ctx context.Context, params installer.DownloadBaseBootArtifactParams) middleware.Responder
+
+	/* DownloadClusterBootArtifact Downloads a network boot artifact of the discovery image of the cluster, the iPXE script boots the kernel and the rootfs of the OpenShift version and the initrd with the ignition config of the cluster. The minimal discovery ISO fetches the rootfs over HTTP. */
+	DownloadClusterBootArtifact(ctx context.Context, params installer.DownloadClusterBootArtifactParams) middleware.Responder
+
 	/* DownloadClusterFiles Downloads files relating to the installed/installing cluster. */
 	DownloadClusterFiles(ctx context.Context, params installer.DownloadClusterFilesParams) middleware.Responder
 
@@ -76,6 +91,9 @@ type InstallerAPI interface {
 	/* GetCluster Retrieves the details of the OpenShift bare metal cluster. */
 	GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder
 
+	/* GetClusterConnectivity Retrieves the L2 and L3 connectivity between every pair of hosts of the OpenShift bare metal cluster. */
+	GetClusterConnectivity(ctx context.Context, params installer.GetClusterConnectivityParams) middleware.Responder
+
 	/* GetClusterInstallConfig Get the cluster install config yaml */
 	GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder
 
@@ -88,6 +106,9 @@ type InstallerAPI interface {
 	/* GetHost Retrieves the details of the OpenShift bare metal host. */
 	GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder
 
+	/* GetHostBmcCredentials Retrieves the BMC credentials of the OpenShift bare metal host, without the password. */
+	GetHostBmcCredentials(ctx context.Context, params installer.GetHostBmcCredentialsParams) middleware.Responder
+
 	/* GetHostRequirements Get minimum host requirements */
 	GetHostRequirements(ctx context.Context, params installer.GetHostRequirementsParams) middleware.Responder
 
@@ -100,15 +121,36 @@ type InstallerAPI interface {
 	/* InstallCluster Installs the OpenShift bare metal cluster. */
 	InstallCluster(ctx context.Context, params installer.InstallClusterParams) middleware.Responder
 
+	/* InstallHosts Installs the hosts of an add-hosts cluster into the existing OpenShift cluster. */
+	InstallHosts(ctx context.Context, params installer.InstallHostsParams) middleware.Responder
+
+	/* ListClusterHistory Lists the state transitions of the OpenShift bare metal cluster. */
+	ListClusterHistory(ctx context.Context, params installer.ListClusterHistoryParams) middleware.Responder
+
+	/* ListClusterManifests Lists the manifests that are added to the installation files of the cluster. */
+	ListClusterManifests(ctx context.Context, params installer.ListClusterManifestsParams) middleware.Responder
+
+	/* ListClusterValidations Lists the validation results of the OpenShift bare metal cluster. */
+	ListClusterValidations(ctx context.Context, params installer.ListClusterValidationsParams) middleware.Responder
+
 	/* ListClusters Retrieves the list of OpenShift bare metal clusters. */
 	ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder
 
+	/* ListHostHistory Lists the state transitions of the OpenShift bare metal host. */
+	ListHostHistory(ctx context.Context, params installer.ListHostHistoryParams) middleware.Responder
+
+	/* ListHostValidations Lists the validation results of the OpenShift bare metal host. */
+	ListHostValidations(ctx context.Context, params installer.ListHostValidationsParams) middleware.Responder
+
 	/* ListHosts Retrieves the list of OpenShift bare metal hosts. */
 	ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder
 
 	/* PostStepReply Posts the result of the operations from the host agent. */
 	PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder
 
+	/* RegisterAddHostsCluster Creates a new OpenShift bare metal cluster definition for adding nodes to an existing OCP cluster. */
+	RegisterAddHostsCluster(ctx context.Context, params installer.RegisterAddHostsClusterParams) middleware.Responder
+
 	/* RegisterCluster Creates a new OpenShift bare metal cluster definition. */
 	RegisterCluster(ctx context.Context, params installer.RegisterClusterParams) middleware.Responder
 
@@ -118,12 +160,21 @@ type InstallerAPI interface {
 	/* ResetCluster Resets a failed installation. */
 	ResetCluster(ctx context.Context, params installer.ResetClusterParams) middleware.Responder
 
+	/* RetryInstallation Retries a failed installation by re-installing only the hosts that failed. */
+	RetryInstallation(ctx context.Context, params installer.RetryInstallationParams) middleware.Responder
+
+	/* SuggestVips Suggests an API VIP and an ingress VIP that all the hosts of the cluster report as free. */
+	SuggestVips(ctx context.Context, params installer.SuggestVipsParams) middleware.Responder
+
 	/* UpdateCluster Updates an OpenShift bare metal cluster definition. */
 	UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder
 
 	/* UpdateClusterInstallConfig Override values in the install config */
 	UpdateClusterInstallConfig(ctx context.Context, params installer.UpdateClusterInstallConfigParams) middleware.Responder
 
+	/* UpdateHostBmcCredentials Sets the BMC credentials that are used to manage the power and boot of the OpenShift bare metal host. */
+	UpdateHostBmcCredentials(ctx context.Context, params installer.UpdateHostBmcCredentialsParams) middleware.Responder
+
 	/* UpdateHostInstallProgress Update installation progress */
 	UpdateHostInstallProgress(ctx context.Context, params installer.UpdateHostInstallProgressParams) middleware.Responder
 
@@ -138,8 +189,20 @@ type InstallerAPI interface {
 
 /* ManagedDomainsAPI  */
 type ManagedDomainsAPI interface {
+	/* DeregisterManagedDomain Deletes a managed DNS domain, admin only. */
+	DeregisterManagedDomain(ctx context.Context, params managed_domains.DeregisterManagedDomainParams) middleware.Responder
+
+	/* GetManagedDomain Retrieves the details of a managed DNS domain, admin only. */
+	GetManagedDomain(ctx context.Context, params managed_domains.GetManagedDomainParams) middleware.Responder
+
 	/* ListManagedDomains List of managed DNS domains */
 	ListManagedDomains(ctx context.Context, params managed_domains.ListManagedDomainsParams) middleware.Responder
+
+	/* RegisterManagedDomain Adds a managed DNS domain, admin only. */
+	RegisterManagedDomain(ctx context.Context, params managed_domains.RegisterManagedDomainParams) middleware.Responder
+
+	/* UpdateManagedDomain Updates a managed DNS domain, admin only. */
+	UpdateManagedDomain(ctx context.Context, params managed_domains.UpdateManagedDomainParams) middleware.Responder
 }
 
 //go:generate mockery -name VersionsAPI -inpkg
@@ -148,6 +211,9 @@ type ManagedDomainsAPI interface {
 type VersionsAPI interface {
 	/* ListComponentVersions List of componenets versions */
 	ListComponentVersions(ctx context.Context, params versions.ListComponentVersionsParams) middleware.Responder
+
+	/* ListSupportedOpenshiftVersions Retrieves the list of OpenShift supported versions. */
+	ListSupportedOpenshiftVersions(ctx context.Context, params versions.ListSupportedOpenshiftVersionsParams) middleware.Responder
 }
 
 // Config is configuration for Handler
@@ -168,6 +234,9 @@ type Config struct {
 	// AuthAgentAuth Applies when the "X-Secret-Key" header is set
 	AuthAgentAuth func(token string) (interface{}, error)
 
+	// AuthURLAuth Applies when the "api_key" query is set
+	AuthURLAuth func(token string) (interface{}, error)
+
 	// AuthUserAuth Applies when the "Authorization" header is set
 	AuthUserAuth func(token string) (interface{}, error)
 
@@ -219,6 +288,13 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		return c.AuthAgentAuth(token)
 	}
 
+	api.URLAuthAuth = func(token string) (interface{}, error) {
+		if c.AuthURLAuth == nil {
+			return token, nil
+		}
+		return c.AuthURLAuth(token)
+	}
+
 	api.UserAuthAuth = func(token string) (interface{}, error) {
 		if c.AuthUserAuth == nil {
 			return token, nil
@@ -237,6 +313,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.CompleteInstallation(ctx, params)
 	})
+	api.InstallerCreateClusterManifestHandler = installer.CreateClusterManifestHandlerFunc(func(params installer.CreateClusterManifestParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.CreateClusterManifest(ctx, params)
+	})
+	api.InstallerDeleteClusterManifestHandler = installer.DeleteClusterManifestHandlerFunc(func(params installer.DeleteClusterManifestParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DeleteClusterManifest(ctx, params)
+	})
+	api.InstallerDeleteHostBmcCredentialsHandler = installer.DeleteHostBmcCredentialsHandlerFunc(func(params installer.DeleteHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DeleteHostBmcCredentials(ctx, params)
+	})
 	api.InstallerDeregisterClusterHandler = installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -247,11 +338,25 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DeregisterHost(ctx, params)
 	})
+	api.ManagedDomainsDeregisterManagedDomainHandler = managed_domains.DeregisterManagedDomainHandlerFunc(func(params managed_domains.DeregisterManagedDomainParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ManagedDomainsAPI.DeregisterManagedDomain(ctx, params)
+	})
 	api.InstallerDisableHostHandler = installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DisableHost(ctx, params)
 	})
+	api.InstallerDownloadBaseBootArtifactHandler = installer.DownloadBaseBootArtifactHandlerFunc(func(params installer.DownloadBaseBootArtifactParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.DownloadBaseBootArtifact(ctx, params)
+	})
+	api.InstallerDownloadClusterBootArtifactHandler = installer.DownloadClusterBootArtifactHandlerFunc(func(params installer.DownloadClusterBootArtifactParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DownloadClusterBootArtifact(ctx, params)
+	})
 	api.InstallerDownloadClusterFilesHandler = installer.DownloadClusterFilesHandlerFunc(func(params installer.DownloadClusterFilesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -292,6 +397,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetCluster(ctx, params)
 	})
+	api.InstallerGetClusterConnectivityHandler = installer.GetClusterConnectivityHandlerFunc(func(params installer.GetClusterConnectivityParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterConnectivity(ctx, params)
+	})
 	api.InstallerGetClusterInstallConfigHandler = installer.GetClusterInstallConfigHandlerFunc(func(params installer.GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -312,11 +422,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetHost(ctx, params)
 	})
+	api.InstallerGetHostBmcCredentialsHandler = installer.GetHostBmcCredentialsHandlerFunc(func(params installer.GetHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetHostBmcCredentials(ctx, params)
+	})
 	api.InstallerGetHostRequirementsHandler = installer.GetHostRequirementsHandlerFunc(func(params installer.GetHostRequirementsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetHostRequirements(ctx, params)
 	})
+	api.ManagedDomainsGetManagedDomainHandler = managed_domains.GetManagedDomainHandlerFunc(func(params managed_domains.GetManagedDomainParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ManagedDomainsAPI.GetManagedDomain(ctx, params)
+	})
 	api.InstallerGetNextStepsHandler = installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -332,6 +452,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.InstallCluster(ctx, params)
 	})
+	api.InstallerInstallHostsHandler = installer.InstallHostsHandlerFunc(func(params installer.InstallHostsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.InstallHosts(ctx, params)
+	})
+	api.InstallerListClusterHistoryHandler = installer.ListClusterHistoryHandlerFunc(func(params installer.ListClusterHistoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterHistory(ctx, params)
+	})
+	api.InstallerListClusterManifestsHandler = installer.ListClusterManifestsHandlerFunc(func(params installer.ListClusterManifestsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterManifests(ctx, params)
+	})
+	api.InstallerListClusterValidationsHandler = installer.ListClusterValidationsHandlerFunc(func(params installer.ListClusterValidationsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterValidations(ctx, params)
+	})
 	api.InstallerListClustersHandler = installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -347,6 +487,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.ListEvents(ctx, params)
 	})
+	api.InstallerListHostHistoryHandler = installer.ListHostHistoryHandlerFunc(func(params installer.ListHostHistoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListHostHistory(ctx, params)
+	})
+	api.InstallerListHostValidationsHandler = installer.ListHostValidationsHandlerFunc(func(params installer.ListHostValidationsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListHostValidations(ctx, params)
+	})
 	api.InstallerListHostsHandler = installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -357,11 +507,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManagedDomainsAPI.ListManagedDomains(ctx, params)
 	})
+	api.VersionsListSupportedOpenshiftVersionsHandler = versions.ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.VersionsAPI.ListSupportedOpenshiftVersions(ctx, params)
+	})
 	api.InstallerPostStepReplyHandler = installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.PostStepReply(ctx, params)
 	})
+	api.InstallerRegisterAddHostsClusterHandler = installer.RegisterAddHostsClusterHandlerFunc(func(params installer.RegisterAddHostsClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.RegisterAddHostsCluster(ctx, params)
+	})
 	api.InstallerRegisterClusterHandler = installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -372,11 +532,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.RegisterHost(ctx, params)
 	})
+	api.ManagedDomainsRegisterManagedDomainHandler = managed_domains.RegisterManagedDomainHandlerFunc(func(params managed_domains.RegisterManagedDomainParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ManagedDomainsAPI.RegisterManagedDomain(ctx, params)
+	})
 	api.InstallerResetClusterHandler = installer.ResetClusterHandlerFunc(func(params installer.ResetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ResetCluster(ctx, params)
 	})
+	api.InstallerRetryInstallationHandler = installer.RetryInstallationHandlerFunc(func(params installer.RetryInstallationParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.RetryInstallation(ctx, params)
+	})
+	api.InstallerSuggestVipsHandler = installer.SuggestVipsHandlerFunc(func(params installer.SuggestVipsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.SuggestVips(ctx, params)
+	})
 	api.InstallerUpdateClusterHandler = installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -387,11 +562,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateClusterInstallConfig(ctx, params)
 	})
+	api.InstallerUpdateHostBmcCredentialsHandler = installer.UpdateHostBmcCredentialsHandlerFunc(func(params installer.UpdateHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateHostBmcCredentials(ctx, params)
+	})
 	api.InstallerUpdateHostInstallProgressHandler = installer.UpdateHostInstallProgressHandlerFunc(func(params installer.UpdateHostInstallProgressParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateHostInstallProgress(ctx, params)
 	})
+	api.ManagedDomainsUpdateManagedDomainHandler = managed_domains.UpdateManagedDomainHandlerFunc(func(params managed_domains.UpdateManagedDomainParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ManagedDomainsAPI.UpdateManagedDomain(ctx, params)
+	})
 	api.InstallerUploadClusterIngressCertHandler = installer.UploadClusterIngressCertHandlerFunc(func(params installer.UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
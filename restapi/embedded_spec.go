@@ -37,6 +37,114 @@ func init() {
   "host": "api.openshift.com",
   "basePath": "/api/assisted-install/v1",
   "paths": {
+    "/add_hosts_clusters": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Creates a new OpenShift bare metal cluster definition for adding nodes to an existing OCP cluster.",
+        "operationId": "RegisterAddHostsCluster",
+        "parameters": [
+          {
+            "name": "new-add-hosts-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/add-hosts-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/boot-artifacts/{openshift_version}": {
+      "get": {
+        "security": [],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Downloads a network boot artifact of the RHCOS live ISO of the OpenShift version. The kernel and the rootfs do not hold any data of a cluster, so they are served without auth and their URLs do not expire.",
+        "operationId": "DownloadBaseBootArtifact",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "openshift_version",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kernel",
+              "rootfs"
+            ],
+            "type": "string",
+            "name": "artifact",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/clusters": {
       "get": {
         "tags": [
@@ -561,13 +669,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/reset": {
+    "/clusters/{cluster_id}/actions/install_hosts": {
       "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Resets a failed installation.",
-        "operationId": "ResetCluster",
+        "summary": "Installs the hosts of an add-hosts cluster into the existing OpenShift cluster.",
+        "operationId": "InstallHosts",
         "parameters": [
           {
             "type": "string",
@@ -602,12 +710,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -623,13 +725,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/credentials": {
-      "get": {
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Get the the cluster admin credentials.",
-        "operationId": "GetCredentials",
+        "summary": "Resets a failed installation.",
+        "operationId": "ResetCluster",
         "parameters": [
           {
             "type": "string",
@@ -640,10 +742,10 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -685,24 +787,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/actions/retry_install": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Downloads files relating to the installed/installing cluster.",
-        "operationId": "DownloadClusterFiles",
+        "summary": "Retries a failed installation by re-installing only the hosts that failed.",
+        "operationId": "RetryInstallation",
         "parameters": [
           {
             "type": "string",
@@ -710,34 +801,13 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml"
-            ],
-            "type": "string",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -775,28 +845,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
+    "/clusters/{cluster_id}/actions/suggest-vips": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves a presigned S3 URL for downloading cluster files.",
-        "operationId": "GetPresignedForClusterFiles",
+        "summary": "Suggests an API VIP and an ingress VIP that all the hosts of the cluster report as free.",
+        "operationId": "SuggestVips",
         "parameters": [
           {
             "type": "string",
@@ -804,36 +863,13 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs"
-            ],
-            "type": "string",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
+              "$ref": "#/definitions/vips-suggestion"
             }
           },
           "400": {
@@ -860,12 +896,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -881,16 +911,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
+    "/clusters/{cluster_id}/connectivity": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
           "installer"
         ],
-        "summary": "Downloads the OpenShift per-cluster discovery ISO.",
-        "operationId": "DownloadClusterISO",
+        "summary": "Retrieves the L2 and L3 connectivity between every pair of hosts of the OpenShift bare metal cluster.",
+        "operationId": "GetClusterConnectivity",
         "parameters": [
           {
             "type": "string",
@@ -904,14 +931,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/connectivity-matrix"
             }
           },
           "401": {
@@ -932,15 +952,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -948,13 +959,15 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/credentials": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Creates a new OpenShift per-cluster discovery ISO.",
-        "operationId": "GenerateClusterISO",
+        "summary": "Get the the cluster admin credentials.",
+        "operationId": "GetCredentials",
         "parameters": [
           {
             "type": "string",
@@ -962,27 +975,13 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "image-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/image-create-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -1024,16 +1023,27 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
+    "/clusters/{cluster_id}/downloads/boot-artifacts": {
       "get": {
-        "produces": [
+        "security": [
+          {
+            "userAuth": []
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "summary": "Downloads the kubeconfig file for this cluster.",
-        "operationId": "DownloadClusterKubeconfig",
+        "summary": "Downloads a network boot artifact of the discovery image of the cluster, the iPXE script boots the kernel and the rootfs of the OpenShift version and the initrd with the ignition config of the cluster. The minimal discovery ISO fetches the rootfs over HTTP.",
+        "operationId": "DownloadClusterBootArtifact",
         "parameters": [
           {
             "type": "string",
@@ -1041,14 +1051,24 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "ipxe-script",
+              "initrd",
+              "minimal-iso"
+            ],
+            "type": "string",
+            "name": "artifact",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "type": "file"
             }
           },
           "401": {
@@ -1090,13 +1110,24 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/events": {
+    "/clusters/{cluster_id}/downloads/files": {
       "get": {
+        "security": [
+          {
+            "userAuth": []
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "events"
+          "installer"
         ],
-        "summary": "Lists events for a cluster",
-        "operationId": "ListEvents",
+        "summary": "Downloads files relating to the installed/installing cluster.",
+        "operationId": "DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
@@ -1105,18 +1136,33 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml"
+            ],
+            "type": "string",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "query"
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "type": "file"
             }
           },
           "401": {
@@ -1143,22 +1189,39 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/free_addresses": {
+    "/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the free address list for a network.",
-        "operationId": "GetFreeAddresses",
+        "summary": "Retrieves a presigned S3 URL for downloading cluster files.",
+        "operationId": "GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
@@ -1168,31 +1231,40 @@ func init() {
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs"
+            ],
             "type": "string",
-            "name": "network",
+            "name": "file_name",
             "in": "query",
             "required": true
           },
-          {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "name": "limit",
-            "in": "query"
-          },
           {
             "type": "string",
-            "name": "prefix",
+            "format": "uuid",
+            "name": "host_id",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1219,6 +1291,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1228,21 +1306,16 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/downloads/image": {
       "get": {
-        "security": [
-          {
-            "userAuth": []
-          },
-          {
-            "agentAuth": []
-          }
+        "produces": [
+          "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the list of OpenShift bare metal hosts.",
-        "operationId": "ListHosts",
+        "summary": "Downloads the OpenShift per-cluster discovery ISO.",
+        "operationId": "DownloadClusterISO",
         "parameters": [
           {
             "type": "string",
@@ -1250,18 +1323,20 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1276,20 +1351,23 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1297,16 +1375,11 @@ func init() {
         }
       },
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
         "tags": [
           "installer"
         ],
-        "summary": "Registers a new OpenShift bare metal host.",
-        "operationId": "RegisterHost",
+        "summary": "Creates a new OpenShift per-cluster discovery ISO.",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
             "type": "string",
@@ -1316,24 +1389,19 @@ func init() {
             "required": true
           },
           {
-            "name": "new-host-params",
+            "name": "image-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/image-create-params"
             }
-          },
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
+          "202": {
+            "description": "Accepted, the build status of the image info of the cluster tracks the build of the image.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/cluster"
             }
           },
           "400": {
@@ -1366,14 +1434,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1381,13 +1449,16 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the details of the OpenShift bare metal host.",
-        "operationId": "GetHost",
+        "summary": "Downloads the kubeconfig file for this cluster.",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
@@ -1395,20 +1466,14 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -1435,6 +1500,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1442,13 +1513,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/clusters/{cluster_id}/events": {
+      "get": {
         "tags": [
-          "installer"
+          "events"
         ],
-        "summary": "Deregisters an OpenShift bare metal host.",
-        "operationId": "DeregisterHost",
+        "summary": "Lists events for a cluster",
+        "operationId": "ListEvents",
         "parameters": [
           {
             "type": "string",
@@ -1461,18 +1534,14 @@ func init() {
             "type": "string",
             "format": "uuid",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/event-list"
             }
           },
           "401": {
@@ -1508,13 +1577,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Enables a host for inclusion in the cluster.",
-        "operationId": "EnableHost",
+        "summary": "Retrieves the free address list for a network.",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
@@ -1524,18 +1593,31 @@ func init() {
             "required": true
           },
           {
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
             "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
+            "name": "network",
+            "in": "query",
             "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "Success",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -1562,12 +1644,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1575,13 +1651,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/clusters/{cluster_id}/history": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Disables a host for inclusion in the cluster.",
-        "operationId": "DisableHost",
+        "summary": "Lists the state transitions of the OpenShift bare metal cluster.",
+        "operationId": "ListClusterHistory",
         "parameters": [
           {
             "type": "string",
@@ -1589,20 +1667,13 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/state-transition-list"
             }
           },
           "401": {
@@ -1623,18 +1694,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1644,9 +1703,12 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+    "/clusters/{cluster_id}/hosts": {
       "get": {
         "security": [
+          {
+            "userAuth": []
+          },
           {
             "agentAuth": []
           }
@@ -1654,8 +1716,8 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the next operations that the host agent needs to perform.",
-        "operationId": "GetNextSteps",
+        "summary": "Retrieves the list of OpenShift bare metal hosts.",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
@@ -1664,13 +1726,6 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "name": "discovery_agent_version",
@@ -1681,7 +1736,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -1696,12 +1751,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -1731,14 +1780,9 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Posts the result of the operations from the host agent.",
-        "operationId": "PostStepReply",
+        "summary": "Registers a new OpenShift bare metal host.",
+        "operationId": "RegisterHost",
         "parameters": [
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -1747,23 +1791,25 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "reply",
+            "name": "new-host-params",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/step-reply"
+              "$ref": "#/definitions/host-create-params"
             }
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
             "description": "Error.",
@@ -1810,21 +1856,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
       "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
           "installer"
         ],
-        "summary": "Download host logs",
-        "operationId": "DownloadHostLogs",
+        "summary": "Retrieves the details of the OpenShift bare metal host.",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
@@ -1845,7 +1883,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -1872,12 +1910,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1886,20 +1918,12 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "consumes": [
-          "multipart/form-data"
-        ],
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Agent API to upload logs.",
-        "operationId": "UploadHostLogs",
+        "summary": "Deregisters an OpenShift bare metal host.",
+        "operationId": "DeregisterHost",
         "parameters": [
           {
             "type": "string",
@@ -1914,24 +1938,18 @@ func init() {
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "file",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "type": "string",
-            "x-mimetype": "application/zip",
-            "description": "The file to upload.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -1950,14 +1968,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1965,28 +1983,17 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Update installation progress",
-        "operationId": "UpdateHostInstallProgress",
+        "summary": "Enables a host for inclusion in the cluster.",
+        "operationId": "EnableHost",
         "parameters": [
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1994,24 +2001,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to retrieve",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress"
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2037,28 +2037,26 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/install-config": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Get the cluster install config yaml",
-        "operationId": "GetClusterInstallConfig",
+        "summary": "Disables a host for inclusion in the cluster.",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
@@ -2066,25 +2064,32 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
             "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "403": {
             "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "404": {
@@ -2099,6 +2104,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2106,13 +2117,15 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/bmc-credentials": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Override values in the install config",
-        "operationId": "UpdateClusterInstallConfig",
+        "summary": "Retrieves the BMC credentials of the OpenShift bare metal host, without the password.",
+        "operationId": "GetHostBmcCredentials",
         "parameters": [
           {
             "type": "string",
@@ -2122,17 +2135,82 @@ func init() {
             "required": true
           },
           {
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Sets the BMC credentials that are used to manage the power and boot of the OpenShift bare metal host.",
+        "operationId": "UpdateHostBmcCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "bmc-credentials-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-credentials-params"
+            }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-credentials"
+            }
           },
           "400": {
             "description": "Error.",
@@ -2143,14 +2221,66 @@ func init() {
           "401": {
             "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "403": {
             "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deletes the BMC credentials of the OpenShift bare metal host.",
+        "operationId": "DeleteHostBmcCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
           "404": {
             "description": "Error.",
@@ -2158,8 +2288,59 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/history": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Lists the state transitions of the OpenShift bare metal host.",
+        "operationId": "ListHostHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/state-transition-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2173,21 +2354,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
           "installer"
         ],
-        "summary": "Download cluster logs",
-        "operationId": "DownloadClusterLogs",
+        "summary": "Retrieves the next operations that the host agent needs to perform.",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
@@ -2195,13 +2373,25 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -2228,22 +2418,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      },
       "post": {
         "security": [
           {
@@ -2253,9 +2441,14 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Transfer the ingress certificate for the cluster.",
-        "operationId": "UploadClusterIngressCert",
+        "summary": "Posts the result of the operations from the host agent.",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2264,21 +2457,22 @@ func init() {
             "required": true
           },
           {
-            "name": "ingress-cert-params",
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/step-reply"
             }
-          },
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "Success."
           },
           "400": {
@@ -2326,35 +2520,144 @@ func init() {
         }
       }
     },
-    "/component_versions": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
-        "tags": [
-          "versions"
-        ],
-        "summary": "List of componenets versions",
-        "operationId": "ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Download host logs",
+        "operationId": "DownloadHostLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    },
-    "/domains": {
-      "get": {
-        "tags": [
-          "managed_domains"
         ],
-        "summary": "List of managed DNS domains",
-        "operationId": "ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Agent API to upload logs.",
+        "operationId": "UploadHostLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "file",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "x-mimetype": "application/zip",
+            "description": "The file to upload.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -2362,32 +2665,78 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/host_requirements": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Get minimum host requirements",
-        "operationId": "GetHostRequirements",
-        "responses": {
-          "200": {
-            "description": "Success.",
+        "summary": "Update installation progress",
+        "operationId": "UpdateHostInstallProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the cluster to retrieve",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/host-requirements"
+              "$ref": "#/definitions/host-progress"
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress"
           },
           "401": {
             "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "403": {
             "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2403,198 +2752,2290 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "boot": {
-      "type": "object",
-      "properties": {
-        "current_boot_mode": {
-          "type": "string"
-        },
-        "pxe_interface": {
-          "type": "string"
-        }
-      }
     },
-    "cluster": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
-      "properties": {
-        "api_vip": {
-          "description": "Virtual IP used to reach the OpenShift cluster API.",
-          "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
-        },
-        "created_at": {
-          "description": "The time that this cluster was created.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "hosts": {
+    "/clusters/{cluster_id}/hosts/{host_id}/validations": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Lists the validation results of the OpenShift bare metal host.",
+        "operationId": "ListHostValidations",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/validation-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/install-config": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Get the cluster install config yaml",
+        "operationId": "GetClusterInstallConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Override values in the install config",
+        "operationId": "UpdateClusterInstallConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/install-config-overrides-error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Download cluster logs",
+        "operationId": "DownloadClusterLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/manifests": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Lists the manifests that are added to the installation files of the cluster.",
+        "operationId": "ListClusterManifests",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-manifests"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Creates a manifest that is added to the installation files of the cluster.",
+        "operationId": "CreateClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "create-manifest-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deletes a manifest of the cluster.",
+        "operationId": "DeleteClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Transfer the ingress certificate for the cluster.",
+        "operationId": "UploadClusterIngressCert",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/validations": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Lists the validation results of the OpenShift bare metal cluster.",
+        "operationId": "ListClusterValidations",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/validation-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "tags": [
+          "versions"
+        ],
+        "summary": "List of componenets versions",
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "tags": [
+          "managed_domains"
+        ],
+        "summary": "List of managed DNS domains",
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "managed_domains"
+        ],
+        "summary": "Adds a managed DNS domain, admin only.",
+        "operationId": "RegisterManagedDomain",
+        "parameters": [
+          {
+            "name": "new-managed-domain-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/managed-domain-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/managed-domain"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/domains/{domain_id}": {
+      "get": {
+        "tags": [
+          "managed_domains"
+        ],
+        "summary": "Retrieves the details of a managed DNS domain, admin only.",
+        "operationId": "GetManagedDomain",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "domain_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/managed-domain"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "managed_domains"
+        ],
+        "summary": "Deletes a managed DNS domain, admin only.",
+        "operationId": "DeregisterManagedDomain",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "domain_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "managed_domains"
+        ],
+        "summary": "Updates a managed DNS domain, admin only.",
+        "operationId": "UpdateManagedDomain",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "domain_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "managed-domain-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/managed-domain-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/managed-domain"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/host_requirements": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Get minimum host requirements",
+        "operationId": "GetHostRequirements",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The OpenShift version of the cluster, the default requirements are returned when it is not set.",
+            "name": "openshift_version",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/openshift_versions": {
+      "get": {
+        "tags": [
+          "versions"
+        ],
+        "summary": "Retrieves the list of OpenShift supported versions.",
+        "operationId": "ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "add-hosts-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "id",
+        "api_vip_dnsname",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "api vip domain.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster, one of the versions that /openshift_versions lists.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-credentials": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Address of the BMC, the BMC address from the host inventory is used when not set.",
+          "type": "string"
+        },
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the BMC TLS certificate.",
+          "type": "boolean"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "last_action": {
+          "description": "The last power management action that was done using the BMC.",
+          "type": "string"
+        },
+        "last_action_at": {
+          "description": "The time of the last power management action.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "last_action_error": {
+          "description": "The error of the last power management action, empty when it succeeded.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "username": {
+          "description": "User name for the Redfish API of the BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-credentials-params": {
+      "type": "object",
+      "required": [
+        "username",
+        "password"
+      ],
+      "properties": {
+        "address": {
+          "description": "Address of the BMC, the BMC address from the host inventory is used when not set.",
+          "type": "string"
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the BMC TLS certificate.",
+          "type": "boolean"
+        },
+        "password": {
+          "description": "Password for the Redfish API of the BMC.",
+          "type": "string",
+          "format": "password"
+        },
+        "username": {
+          "description": "User name for the Redfish API of the BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle that is added to the trusted certificates of the hosts and of the cluster, for example the CA of the registry mirrors.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "api_vip": {
+          "description": "Virtual IP used to reach the OpenShift cluster API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
+        },
+        "api_vip_dnsname": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string"
+        },
+        "auto_assign_vips": {
+          "description": "Indicate if the service assigns free addresses of the machine network to the API VIP and the ingress VIP, and replaces them when they are not free anymore.",
+          "type": "boolean"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster over multiple master nodes whereas 'None' installs a full cluster over one node.",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'Full'\"",
+          "x-nullable": true
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "hosts": {
           "description": "Hosts that are associated with this cluster.",
           "type": "array",
           "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ignition_generator_version": {
+          "type": "string"
+        },
+        "image_content_sources": {
+          "description": "The mirrors of the image sources of the installation, for installations in disconnected networks. YAML formatted\nlist in the format of the imageContentSources of the install-config, for example:\n- mirrors:\n  - mirror.example.com:5000/ocp4/openshift4\n  source: quay.io/openshift-release-dev/ocp-release\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "image_info": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
+          "$ref": "#/definitions/image_info"
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "install_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the install-config.yaml file",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
+          "example": "{\"controlPlane\":{\"hyperthreading\": \"Disabled\"},\"fips\":true}"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster began installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object, 'AddHostsCluster' for cluster that add hosts to existing OCP cluster, or 'ClusterLink' if it is just a link.",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_network_dhcp_range": {
+          "description": "The range of addresses, in first-last format, that the DHCP server of the machine network leases. VIPs are not suggested from this range.",
+          "type": "string",
+          "pattern": "^(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}-(?:[0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The network plugin of the cluster. When it is not set, OVNKubernetes is used if one of the networks is an IPv6 network and OpenShiftSDN otherwise.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster, one of the versions that /openshift_versions lists.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull-secret has been added to the cluster",
+          "type": "boolean"
+        },
+        "secondary_cluster_network_cidr": {
+          "description": "The cluster network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node from the secondary cluster network.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "secondary_machine_network_cidr": {
+          "description": "The machine network of the second IP family of a dual-stack cluster. The primary networks of a dual-stack cluster are IPv4 and its secondary networks are IPv6.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "secondary_service_network_cidr": {
+          "description": "The service network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status has been updated",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the load balancers of the API and the ingress are managed by the user. The cluster has no VIPs in this mode, and the installation is of platform none.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "Json formatted string containing the validations results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if VIP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle that is added to the trusted certificates of the hosts and of the cluster, for example the CA of the registry mirrors.",
+          "type": "string"
+        },
+        "auto_assign_vips": {
+          "description": "Indicate if the service assigns free addresses of the machine network to the API VIP and the ingress VIP, and replaces them when they are not free anymore.",
+          "type": "boolean",
+          "default": false
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster over multiple master nodes whereas 'None' installs a full cluster over one node.",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ],
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "image_content_sources": {
+          "description": "The mirrors of the image sources of the installation, for installations in disconnected networks. YAML formatted\nlist in the format of the imageContentSources of the install-config, for example:\n- mirrors:\n  - mirror.example.com:5000/ocp4/openshift4\n  source: quay.io/openshift-release-dev/ocp-release\n",
+          "type": "string"
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
+        },
+        "machine_network_dhcp_range": {
+          "description": "The range of addresses, in first-last format, that the DHCP server of the machine network leases. VIPs are not suggested from this range.",
+          "type": "string",
+          "pattern": "^(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}-(?:[0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The network plugin of the cluster, OVNKubernetes is required for IPv6 and dual-stack clusters. Defaults to OVNKubernetes when one of the networks is an IPv6 network and to OpenShiftSDN otherwise.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster, one of the versions that /openshift_versions lists.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+          "type": "string"
+        },
+        "secondary_cluster_network_cidr": {
+          "description": "The cluster network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node from the secondary cluster network.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "secondary_machine_network_cidr": {
+          "description": "The machine network of the second IP family of a dual-stack cluster. The primary networks of a dual-stack cluster are IPv4 and its secondary networks are IPv6.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "secondary_service_network_cidr": {
+          "description": "The service network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the load balancers of the API and the ingress are managed by the user. The cluster has no VIPs in this mode, and the installation is of platform none.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if VIP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle that is added to the trusted certificates of the hosts and of the cluster, for example the CA of the registry mirrors.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "Virtual IP used to reach the OpenShift cluster API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "auto_assign_vips": {
+          "description": "Indicate if the service assigns free addresses of the machine network to the API VIP and the ingress VIP, and replaces them when they are not free anymore.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "hosts_installation_disks": {
+          "description": "The desired installation disk for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "installation_disk_id": {
+                "description": "The id of an eligible inventory disk of the host.",
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_names": {
+          "description": "The desired hostname for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "hostname": {
+                "type": "string"
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
+          "x-nullable": true
+        },
+        "hosts_roles": {
+          "description": "The desired role for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "role": {
+                "$ref": "#/definitions/host-role-update-params"
+              }
+            }
+          },
+          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "image_content_sources": {
+          "description": "The mirrors of the image sources of the installation, for installations in disconnected networks. YAML formatted\nlist in the format of the imageContentSources of the install-config, for example:\n- mirrors:\n  - mirror.example.com:5000/ocp4/openshift4\n  source: quay.io/openshift-release-dev/ocp-release\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "machine_network_dhcp_range": {
+          "description": "The range of addresses, in first-last format, that the DHCP server of the machine network leases. VIPs are not suggested from this range.",
+          "type": "string",
+          "pattern": "^(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}-(?:[0-9]{1,3}\\.){3}[0-9]{1,3})?$",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "OpenShift cluster name",
+          "type": "string",
+          "x-nullable": true
+        },
+        "network_type": {
+          "description": "The network plugin of the cluster, OVNKubernetes is required for IPv6 and dual-stack clusters.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "pull_secret": {
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "secondary_cluster_network_cidr": {
+          "description": "The cluster network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$",
+          "x-nullable": true
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node from the secondary cluster network.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "secondary_machine_network_cidr": {
+          "description": "The machine network of the second IP family of a dual-stack cluster. The primary networks of a dual-stack cluster are IPv4 and its secondary networks are IPv6.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$",
+          "x-nullable": true
+        },
+        "secondary_service_network_cidr": {
+          "description": "The service network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$",
+          "x-nullable": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the load balancers of the API and the ingress are managed by the user. The cluster has no VIPs in this mode, and the installation is of platform none.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if VIP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vip-defined",
+        "api-vip-valid",
+        "ingress-vip-defined",
+        "ingress-vip-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "all-hosts-are-connected",
+        "network-type-valid",
+        "api-domain-name-resolved",
+        "apps-domain-name-resolved"
+      ]
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-matrix": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-matrix-entry"
+      }
+    },
+    "connectivity-matrix-entry": {
+      "type": "object",
+      "required": [
+        "host_id",
+        "remote_host_id",
+        "l2_connected",
+        "l3_connected"
+      ],
+      "properties": {
+        "host_id": {
+          "description": "The host that checked the connectivity.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connected": {
+          "description": "Whether the host reached the remote host over L2 on the machine network.",
+          "type": "boolean"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connected": {
+          "description": "Whether the host reached the remote host over L3 on the machine network.",
+          "type": "boolean"
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        },
+        "remote_host_id": {
+          "description": "The host that the connectivity was checked to.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "The base64 encoded content of the manifest.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest, a YAML or JSON file.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The folder of the installation files that the manifest is placed in.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_mac": {
+          "description": "MAC address for API VIP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "ingress_vip_mac": {
+          "description": "MAC address for Ingress VIP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "interface": {
+          "description": "The interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for API VIP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for Ingress VIP.",
+          "type": "string",
+          "format": "ipv4"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "by_path": {
+          "type": "string"
+        },
+        "drive_type": {
+          "type": "string"
+        },
+        "has_partitions": {
+          "description": "Whether the disk has existing partitions.",
+          "type": "boolean"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "id": {
+          "description": "Stable identifier of the disk, its by-path link when available or its device path otherwise.",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "$ref": "#/definitions/disk-installation-eligibility"
+        },
+        "model": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "removable": {
+          "description": "Whether the disk is a removable device.",
+          "type": "boolean"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-installation-eligibility": {
+      "description": "Whether the host can be installed on the disk, set by the service according to its requirements.",
+      "type": "object",
+      "properties": {
+        "eligible": {
+          "description": "Whether the disk is eligible for installation or not.",
+          "type": "boolean"
+        },
+        "not_eligible_reasons": {
+          "description": "Reasons for why this disk is not eligible for installation.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "warnings": {
+          "description": "Attributes of the disk that don't prevent the installation on it, such as being removable or having existing partitions that the installation overwrites.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domain_resolution_request_domain"
+          }
+        }
+      }
+    },
+    "domain_resolution_request_domain": {
+      "type": "object",
+      "required": [
+        "domain_name"
+      ],
+      "properties": {
+        "domain_name": {
+          "description": "The domain name that should be resolved.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domain_resolution_response_domain"
+          }
+        }
+      }
+    },
+    "domain_resolution_response_domain": {
+      "type": "object",
+      "required": [
+        "domain_name"
+      ],
+      "properties": {
+        "domain_name": {
+          "description": "The domain name that was resolved.",
+          "type": "string"
+        },
+        "ipv4_addresses": {
+          "description": "The IPv4 addresses of the domain, empty if none.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
+        },
+        "ipv6_addresses": {
+          "description": "The IPv6 addresses of the domain, empty if none.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv6"
+          }
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, for if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
         },
         "href": {
           "description": "Self link.",
           "type": "string"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+          "enum": [
+            "Error"
+          ]
         },
-        "id": {
-          "description": "Unique identifier of the object.",
+        "reason": {
+          "description": "Human readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "ignition_generator_version": {
-          "type": "string"
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "image_info": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
-          "$ref": "#/definitions/image_info"
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid"
         },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
+        "message": {
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
+        "request_id": {
+          "description": "Unique identifier for the request that caused this event to occure",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+      }
+    },
+    "free_network_addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "network": {
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        }
+      }
+    },
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "host": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "install_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the install-config.yaml file",
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "install_started_at": {
-          "description": "The time that this cluster began installation.",
+        "connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object or 'ClusterLink' if it is just a link.",
+        "discovery_agent_version": {
+          "type": "string"
+        },
+        "domain_resolutions": {
+          "description": "Json formatted string containing the addresses that the host resolved for the domain names of the cluster.",
           "type": "string",
-          "enum": [
-            "Cluster"
-          ]
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
+        "installation_disk_id": {
+          "description": "The id of the inventory disk that the host will be installed on.",
           "type": "string"
         },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
+        "installation_disk_path": {
+          "description": "Host installation path",
           "type": "string"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "installer_version": {
+          "description": "Installer version",
+          "type": "string"
+        },
+        "inventory": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.",
           "type": "string",
           "enum": [
-            "4.5",
-            "4.6"
+            "Host"
           ]
         },
-        "org_id": {
+        "logs_collected_at": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "progress": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
+          "$ref": "#/definitions/host-progress-info"
+        },
+        "progress_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "requested_hostname": {
           "type": "string"
         },
-        "pull_secret_set": {
-          "description": "True if the pull-secret has been added to the cluster",
-          "type": "boolean"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "static_network_config": {
+          "description": "The static network configuration, in nmstate YAML format, of the discovery image that matched the host.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "status": {
-          "description": "Status of the OpenShift cluster.",
           "type": "string",
           "enum": [
+            "discovering",
+            "known",
+            "disconnected",
             "insufficient",
-            "ready",
-            "error",
+            "disabled",
             "preparing-for-installation",
             "pending-for-input",
             "installing",
-            "finalizing",
-            "installed"
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting",
+            "added-to-existing-cluster"
           ]
         },
         "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
         "status_updated_at": {
-          "description": "The last time that the cluster status has been updated",
+          "description": "The last time that the host status has been updated",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
         "updated_at": {
-          "description": "The last time that this cluster was updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
@@ -2603,1251 +5044,2280 @@ func init() {
           "type": "string"
         },
         "validations_info": {
-          "description": "Json formatted string containing the validations results for each validation id grouped by category (network, hosts-data, etc.)",
+          "description": "Json formatted string containing the validations results for each validation id grouped by category (network, hardware, etc.)",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if VIP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "cluster-create-params": {
+    "host-create-params": {
       "type": "object",
       "required": [
-        "name",
-        "openshift_version"
+        "host_id"
       ],
       "properties": {
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "discovery_agent_version": {
           "type": "string"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "host_id": {
           "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 32,
-          "minimum": 1
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "format": "uuid"
+        }
+      }
+    },
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "host-progress": {
+      "type": "object",
+      "required": [
+        "current_stage"
+      ],
+      "properties": {
+        "current_stage": {
           "type": "string",
-          "x-nullable": true
+          "$ref": "#/definitions/host-stage"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "progress_info": {
           "type": "string",
-          "x-nullable": true
-        },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        }
+      }
+    },
+    "host-progress-info": {
+      "type": "object",
+      "required": [
+        "current_stage"
+      ],
+      "properties": {
+        "current_stage": {
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
+          "$ref": "#/definitions/host-stage"
         },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
+        "progress_info": {
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started",
           "type": "string",
-          "enum": [
-            "4.5",
-            "4.6"
-          ]
-        },
-        "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
-          "type": "string"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated",
           "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if VIP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
       }
     },
-    "cluster-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster"
+    "host-requirements": {
+      "type": "object",
+      "properties": {
+        "master": {
+          "$ref": "#/definitions/host-requirements-role"
+        },
+        "worker": {
+          "$ref": "#/definitions/host-requirements-role"
+        }
       }
     },
-    "cluster-update-params": {
+    "host-requirements-role": {
       "type": "object",
       "properties": {
-        "api_vip": {
-          "description": "Virtual IP used to reach the OpenShift cluster API.",
-          "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$",
-          "x-nullable": true
+        "cpu_cores": {
+          "type": "integer"
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string",
-          "x-nullable": true
+        "disk_size_gb": {
+          "type": "integer"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "ram_gib": {
+          "type": "integer"
+        }
+      }
+    },
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Start Waiting for control plane",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-static-network-config": {
+      "type": "object",
+      "required": [
+        "mac_address",
+        "network_yaml"
+      ],
+      "properties": {
+        "mac_address": {
+          "description": "MAC address of one of the interfaces of the host, used to match the configuration to the host.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
-          "x-nullable": true
+          "pattern": "^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$"
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1,
-          "x-nullable": true
+        "network_yaml": {
+          "description": "Network configuration of the host in nmstate YAML format.",
+          "type": "string"
+        }
+      }
+    },
+    "host-validation-id": {
+      "type": "string",
+      "enum": [
+        "connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "role-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "belongs-to-majority-group"
+      ]
+    },
+    "host_network": {
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "type": "string"
         },
-        "hosts_names": {
-          "description": "The desired hostname for hosts associated with the cluster.",
+        "host_ids": {
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "hostname": {
-                "type": "string"
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
-          "x-nullable": true
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "image-create-params": {
+      "type": "object",
+      "properties": {
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
         },
-        "hosts_roles": {
-          "description": "The desired role for hosts associated with the cluster.",
+        "static_network_config": {
+          "description": "Static network configuration of the hosts that boot from the image, the hosts without a configuration use DHCP.",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "role": {
-                "$ref": "#/definitions/host-role-update-params"
-              }
-            }
-          },
-          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
-          "x-nullable": true
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+            "$ref": "#/definitions/host-static-network-config"
+          }
+        }
+      }
+    },
+    "image_info": {
+      "type": "object",
+      "properties": {
+        "build_status": {
+          "description": "Status of the build of the image, the image can be downloaded once it is uploaded.",
           "type": "string",
-          "x-nullable": true
+          "enum": [
+            "queued",
+            "running",
+            "uploaded",
+            "failed"
+          ]
         },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$",
-          "x-nullable": true
+        "build_status_info": {
+          "description": "Reason of the failure of the build of the image.",
+          "type": "string"
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "created_at": {
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "OpenShift cluster name",
-          "type": "string",
-          "x-nullable": true
+        "download_url": {
+          "type": "string"
         },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
+        "expires_at": {
           "type": "string",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
-          "type": "string",
-          "x-nullable": true
+        "generator_version": {
+          "description": "Image generator version",
+          "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
-          "x-nullable": true
+        "size_bytes": {
+          "type": "integer"
         },
         "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+          "description": "SSH public key for debugging the installation",
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if VIP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+        "static_network_config": {
+          "description": "JSON-formatted list of the static network configurations of the hosts that boot from the image.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vip-defined",
-        "api-vip-valid",
-        "ingress-vip-defined",
-        "ingress-vip-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set"
-      ]
-    },
-    "completion-params": {
+    "infra_error": {
       "type": "object",
       "required": [
-        "is_success"
+        "code",
+        "message"
       ],
       "properties": {
-        "error_info": {
-          "type": "string"
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
         },
-        "is_success": {
-          "type": "boolean"
+        "message": {
+          "description": "Human readable description of the error.",
+          "type": "string"
         }
       }
     },
-    "connectivity-check-host": {
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "install-config-field-error": {
       "type": "object",
+      "required": [
+        "field",
+        "message"
+      ],
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "field": {
+          "description": "The path of the field in the install config, for example networking.clusterNetwork[0].cidr.",
+          "type": "string"
         },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
+        "message": {
+          "description": "Human readable description of the error of the field.",
+          "type": "string"
         }
       }
     },
-    "connectivity-check-nic": {
+    "install-config-overrides-error": {
       "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
       "properties": {
-        "ip_addresses": {
+        "code": {
+          "description": "Globally unique code of the error.",
+          "type": "string"
+        },
+        "field_errors": {
+          "description": "The invalid fields of the install config overrides.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/install-config-field-error"
           }
         },
-        "mac": {
+        "href": {
+          "description": "Self link.",
           "type": "string"
         },
-        "name": {
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
+          "description": "Human readable description of the error.",
           "type": "string"
         }
       }
     },
-    "connectivity-check-params": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/connectivity-check-host"
-      }
-    },
-    "connectivity-remote-host": {
+    "interface": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "biosdevname": {
+          "type": "string"
         },
-        "l2_connectivity": {
+        "client_id": {
+          "type": "string"
+        },
+        "flags": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l2-connectivity"
+            "type": "string"
           }
         },
-        "l3_connectivity": {
+        "has_carrier": {
+          "type": "boolean"
+        },
+        "ipv4_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l3-connectivity"
+            "type": "string"
           }
-        }
-      }
-    },
-    "connectivity-report": {
-      "type": "object",
-      "properties": {
-        "remote_hosts": {
+        },
+        "ipv6_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
+            "type": "string"
           }
+        },
+        "mac_address": {
+          "type": "string"
+        },
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "product": {
+          "type": "string"
+        },
+        "speed_mbps": {
+          "type": "integer"
+        },
+        "vendor": {
+          "type": "string"
         }
       }
     },
-    "cpu": {
+    "inventory": {
       "type": "object",
       "properties": {
-        "architecture": {
+        "bmc_address": {
           "type": "string"
         },
-        "count": {
-          "type": "integer"
+        "bmc_v6address": {
+          "type": "string"
         },
-        "flags": {
+        "boot": {
+          "$ref": "#/definitions/boot"
+        },
+        "cpu": {
+          "$ref": "#/definitions/cpu"
+        },
+        "disks": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/disk"
           }
         },
-        "frequency": {
-          "type": "number"
-        },
-        "model_name": {
+        "hostname": {
           "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
+        },
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
         }
       }
     },
-    "credentials": {
+    "l2-connectivity": {
       "type": "object",
       "properties": {
-        "console_url": {
+        "outgoing_ip_address": {
           "type": "string"
         },
-        "password": {
+        "outgoing_nic": {
           "type": "string"
         },
-        "username": {
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
           "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "dhcp_allocation_request": {
+    "l3-connectivity": {
       "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
       "properties": {
-        "api_vip_mac": {
-          "description": "MAC address for API VIP.",
-          "type": "string",
-          "format": "mac"
-        },
-        "ingress_vip_mac": {
-          "description": "MAC address for Ingress VIP.",
-          "type": "string",
-          "format": "mac"
+        "outgoing_nic": {
+          "type": "string"
         },
-        "interface": {
-          "description": "The interface (NIC) to run the DHCP requests on.",
+        "remote_ip_address": {
           "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "dhcp_allocation_response": {
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain-summary"
+      }
+    },
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
+    },
+    "list-versions": {
       "type": "object",
-      "required": [
-        "api_vip_address",
-        "ingress_vip_address"
-      ],
       "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for API VIP.",
-          "type": "string",
-          "format": "ipv4"
+        "release_tag": {
+          "type": "string"
         },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for Ingress VIP.",
-          "type": "string",
-          "format": "ipv4"
+        "versions": {
+          "$ref": "#/definitions/versions"
         }
       }
     },
-    "disk": {
+    "managed-domain": {
       "type": "object",
       "properties": {
-        "by_path": {
-          "type": "string"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "drive_type": {
+        "credentials_ref": {
+          "description": "The credentials that manage the zone, an AWS shared credentials profile for route53 and a TSIG key name for rfc2136. The default credentials of the provider are used when it is empty.",
           "type": "string"
         },
-        "hctl": {
-          "type": "string"
+        "domain": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"unique_index\""
         },
-        "model": {
-          "type": "string"
+        "id": {
+          "description": "Unique identifier of the managed domain.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "name": {
+        "org_id": {
+          "description": "The organization that owns the domain, a domain without an organization is available to all the users.",
           "type": "string"
         },
-        "path": {
-          "type": "string"
+        "provider": {
+          "type": "string",
+          "enum": [
+            "route53",
+            "rfc2136"
+          ]
         },
-        "serial": {
+        "server": {
+          "description": "The address of the DNS server that accepts the dynamic updates of an rfc2136 zone, in host:port format.",
           "type": "string"
         },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "vendor": {
-          "type": "string"
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "wwn": {
+        "zone_id": {
+          "description": "The DNS zone of the domain, a hosted zone ID for route53 and a zone name for rfc2136.",
           "type": "string"
         }
       }
     },
-    "error": {
+    "managed-domain-create-params": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
+        "domain",
+        "provider",
+        "zone_id"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, for if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+        "credentials_ref": {
+          "description": "The credentials that manage the zone, an AWS shared credentials profile for route53 and a TSIG key name for rfc2136. The default credentials of the provider are used when it is empty.",
           "type": "string"
         },
-        "href": {
-          "description": "Self link.",
+        "domain": {
           "type": "string"
         },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+        "org_id": {
+          "description": "The organization that owns the domain, a domain without an organization is available to all the users.",
+          "type": "string"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+        "provider": {
           "type": "string",
           "enum": [
-            "Error"
+            "route53",
+            "rfc2136"
           ]
         },
-        "reason": {
-          "description": "Human readable description of the error.",
+        "server": {
+          "description": "The address of the DNS server that accepts the dynamic updates of an rfc2136 zone, in host:port format.",
+          "type": "string"
+        },
+        "zone_id": {
+          "description": "The DNS zone of the domain, a hosted zone ID for route53 and a zone name for rfc2136.",
           "type": "string"
         }
       }
     },
-    "event": {
+    "managed-domain-summary": {
+      "description": "The view of a managed domain that is available to the users who can use it.",
       "type": "object",
-      "required": [
-        "cluster_id",
-        "severity",
-        "message",
-        "event_time"
-      ],
       "properties": {
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
+        "domain": {
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the managed domain.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
+          "format": "uuid"
         },
-        "event_time": {
+        "provider": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "enum": [
+            "route53",
+            "rfc2136"
+          ]
+        }
+      }
+    },
+    "managed-domain-update-params": {
+      "type": "object",
+      "properties": {
+        "credentials_ref": {
+          "type": "string",
+          "x-nullable": true
         },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+        "org_id": {
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
         },
-        "message": {
+        "provider": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+          "enum": [
+            "route53",
+            "rfc2136"
+          ],
+          "x-nullable": true
         },
-        "request_id": {
-          "description": "Unique identifier for the request that caused this event to occure",
+        "server": {
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
         },
-        "severity": {
+        "zone_id": {
           "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+          "x-nullable": true
         }
       }
     },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
-      }
-    },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
-      }
-    },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-      }
-    },
-    "free_network_addresses": {
+    "manifest": {
       "type": "object",
       "properties": {
-        "free_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "ipv4"
-          }
+        "file_name": {
+          "description": "The name of the manifest.",
+          "type": "string"
         },
-        "network": {
+        "folder": {
+          "description": "The folder of the installation files that the manifest is placed in.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
         }
       }
     },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
+    "memory": {
+      "type": "object",
+      "properties": {
+        "physical_bytes": {
+          "type": "integer"
+        },
+        "usable_bytes": {
+          "type": "integer"
+        }
       }
     },
-    "host": {
+    "openshift-version": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
+        "display_name",
+        "release_image",
+        "support_level"
       ],
-      "properties": {
-        "bootstrap": {
-          "type": "boolean"
-        },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "cluster_id": {
-          "description": "The cluster that this host is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "discovery_agent_version": {
+      "properties": {
+        "display_name": {
+          "description": "Name of the version for display.",
           "type": "string"
         },
-        "free_addresses": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "host_requirements": {
+          "$ref": "#/definitions/host-requirements"
         },
-        "href": {
-          "description": "Self link.",
+        "release_image": {
+          "description": "The OpenShift release image that clusters of the version are installed from.",
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "installation_disk_path": {
-          "description": "Host installation path",
+        "rhcos_image": {
+          "description": "Location (URL or path of the service) of the RHCOS live ISO that the discovery image and the network boot artifacts of clusters of the version are based on, the default RHCOS live ISO of the service is used if empty.",
           "type": "string"
         },
-        "installer_version": {
-          "description": "Installer version",
+        "rhcos_minimal_image": {
+          "description": "Location (URL or path of the service) of the RHCOS minimal live ISO, which fetches the rootfs over HTTP, that the minimal discovery image of clusters of the version is based on, the default RHCOS minimal live ISO of the service is used if empty.",
           "type": "string"
         },
-        "inventory": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.",
+        "support_level": {
+          "description": "Level of support of the version.",
           "type": "string",
           "enum": [
-            "Host"
+            "beta",
+            "production"
           ]
         },
-        "logs_collected_at": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "progress": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
-          "$ref": "#/definitions/host-progress-info"
-        },
-        "progress_stages": {
+        "supported_network_types": {
+          "description": "The network types that clusters of the version can be installed with, all the network types are supported if empty.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "requested_hostname": {
+            "type": "string",
+            "enum": [
+              "OpenShiftSDN",
+              "OVNKubernetes"
+            ]
+          }
+        }
+      }
+    },
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
+    "presigned": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
           "type": "string"
-        },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started",
+        }
+      }
+    },
+    "state-transition": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "transition_type",
+        "from_state",
+        "to_state",
+        "transition_time"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this transition relates to.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "from_state": {
+          "description": "The state before the transition.",
+          "type": "string"
         },
-        "status": {
+        "host_id": {
+          "description": "Unique identifier of the host this transition relates to, empty for a cluster transition.",
           "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting"
-          ]
+          "format": "uuid"
         },
-        "status_info": {
+        "reason": {
+          "description": "Additional information about the new state.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "status_updated_at": {
-          "description": "The last time that the host status has been updated",
+        "request_id": {
+          "description": "Unique identifier for the request that caused this transition.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid"
         },
-        "updated_at": {
+        "to_state": {
+          "description": "The state after the transition.",
+          "type": "string"
+        },
+        "transition_time": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "user_name": {
+        "transition_type": {
+          "description": "The type of the state machine transition that changed the state.",
           "type": "string"
+        }
+      }
+    },
+    "state-transition-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/state-transition"
+      }
+    },
+    "step": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "validations_info": {
-          "description": "Json formatted string containing the validations results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "command": {
+          "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "host-create-params": {
+    "step-reply": {
       "type": "object",
-      "required": [
-        "host_id"
-      ],
       "properties": {
-        "discovery_agent_version": {
+        "error": {
           "type": "string"
         },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "exit_code": {
+          "type": "integer"
+        },
+        "output": {
+          "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "host-list": {
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "reset-installation",
+        "dhcp-lease-allocate",
+        "domain-resolution"
+      ]
+    },
+    "steps": {
+      "type": "object",
+      "properties": {
+        "instructions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/step"
+          }
+        },
+        "next_instruction_seconds": {
+          "type": "integer"
+        }
+      }
+    },
+    "steps-reply": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/host"
+        "$ref": "#/definitions/step-reply"
       }
     },
-    "host-progress": {
+    "system_vendor": {
       "type": "object",
-      "required": [
-        "current_stage"
-      ],
       "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
+        "manufacturer": {
+          "type": "string"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "product_name": {
+          "type": "string"
+        },
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not.",
+          "type": "boolean"
         }
       }
     },
-    "host-progress-info": {
+    "validation": {
       "type": "object",
-      "required": [
-        "current_stage"
-      ],
       "properties": {
-        "current_stage": {
+        "category": {
+          "description": "The category the validation belongs to (network, hardware, hosts-data, etc.)",
+          "type": "string"
+        },
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this validation relates to.",
           "type": "string",
-          "$ref": "#/definitions/host-stage"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "progress_info": {
+        "host_id": {
+          "description": "Unique identifier of the host this validation relates to, empty for a cluster validation.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started",
+        "id": {
+          "description": "The validation identifier, one of host-validation-id or cluster-validation-id.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated",
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending",
+            "error",
+            "warning"
+          ]
+        },
+        "status_updated_at": {
+          "description": "The last time the validation status changed.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
       }
     },
-    "host-requirements": {
+    "validation-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/validation"
+      }
+    },
+    "versions": {
       "type": "object",
-      "properties": {
-        "master": {
-          "$ref": "#/definitions/host-requirements-role"
-        },
-        "worker": {
-          "$ref": "#/definitions/host-requirements-role"
-        }
+      "additionalProperties": {
+        "type": "string"
       }
     },
-    "host-requirements-role": {
+    "vips-suggestion": {
       "type": "object",
       "properties": {
-        "cpu_cores": {
-          "type": "integer"
+        "api_vip": {
+          "description": "A free address for the API VIP.",
+          "type": "string"
         },
-        "disk_size_gb": {
-          "type": "integer"
+        "ingress_vip": {
+          "description": "A free address for the ingress VIP.",
+          "type": "string"
         },
-        "ram_gib": {
-          "type": "integer"
+        "machine_network_cidr": {
+          "description": "The machine network that the VIPs belong to.",
+          "type": "string"
         }
       }
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
     },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker",
-        "bootstrap"
-      ]
-    },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker"
-      ]
-    },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Start Waiting for control plane",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
     },
-    "host-validation-id": {
-      "type": "string",
-      "enum": [
-        "connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "role-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr"
-      ]
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": []
+    }
+  ],
+  "tags": [
+    {
+      "description": "Assisted bare metal installation",
+      "name": "Assisted installation"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install/v1",
+  "paths": {
+    "/add_hosts_clusters": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Creates a new OpenShift bare metal cluster definition for adding nodes to an existing OCP cluster.",
+        "operationId": "RegisterAddHostsCluster",
+        "parameters": [
+          {
+            "name": "new-add-hosts-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/add-hosts-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "host_network": {
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "type": "string"
-        },
-        "host_ids": {
-          "type": "array",
-          "items": {
+    "/boot-artifacts/{openshift_version}": {
+      "get": {
+        "security": [],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Downloads a network boot artifact of the RHCOS live ISO of the OpenShift version. The kernel and the rootfs do not hold any data of a cluster, so they are served without auth and their URLs do not expire.",
+        "operationId": "DownloadBaseBootArtifact",
+        "parameters": [
+          {
             "type": "string",
-            "format": "uuid"
+            "name": "openshift_version",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kernel",
+              "rootfs"
+            ],
+            "type": "string",
+            "name": "artifact",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of OpenShift bare metal clusters.",
+        "operationId": "ListClusters",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Creates a new OpenShift bare metal cluster definition.",
+        "operationId": "RegisterCluster",
+        "parameters": [
+          {
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "image-create-params": {
-      "type": "object",
-      "properties": {
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+    "/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the details of the OpenShift bare metal cluster.",
+        "operationId": "GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "image_info": {
-      "type": "object",
-      "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "download_url": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version",
-          "type": "string"
-        },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+      },
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deletes an OpenShift bare metal cluster definition.",
+        "operationId": "DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "infra_error": {
-      "type": "object",
-      "required": [
-        "code",
-        "message"
-      ],
-      "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
-        },
-        "message": {
-          "description": "Human readable description of the error.",
-          "type": "string"
+      },
+      "patch": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Updates an OpenShift bare metal cluster definition.",
+        "operationId": "UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "interface": {
-      "type": "object",
-      "properties": {
-        "biosdevname": {
-          "type": "string"
-        },
-        "client_id": {
-          "type": "string"
-        },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "has_carrier": {
-          "type": "boolean"
-        },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Cancels an ongoing installation.",
+        "operationId": "CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "ipv6_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "product": {
-          "type": "string"
-        },
-        "speed_mbps": {
-          "type": "integer"
-        },
-        "vendor": {
-          "type": "string"
         }
       }
     },
-    "inventory": {
-      "type": "object",
-      "properties": {
-        "bmc_address": {
-          "type": "string"
-        },
-        "bmc_v6address": {
-          "type": "string"
-        },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk"
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
           }
-        },
-        "hostname": {
-          "type": "string"
-        },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Agent API to mark a finalizing installation as complete.",
+        "operationId": "CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
         }
       }
     },
-    "l2-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "remote_mac": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Installs the OpenShift bare metal cluster.",
+        "operationId": "InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "l3-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
+    "/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Installs the hosts of an add-hosts cluster into the existing OpenShift cluster.",
+        "operationId": "InstallHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Resets a failed installation.",
+        "operationId": "ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "list-versions": {
-      "type": "object",
-      "properties": {
-        "release_tag": {
-          "type": "string"
-        },
-        "versions": {
-          "$ref": "#/definitions/versions"
+    "/clusters/{cluster_id}/actions/retry_install": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retries a failed installation by re-installing only the hosts that failed.",
+        "operationId": "RetryInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "managed-domain": {
-      "type": "object",
-      "properties": {
-        "domain": {
-          "type": "string"
-        },
-        "provider": {
-          "type": "string",
-          "enum": [
-            "route53"
-          ]
+    "/clusters/{cluster_id}/actions/suggest-vips": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Suggests an API VIP and an ingress VIP that all the hosts of the cluster report as free.",
+        "operationId": "SuggestVips",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/vips-suggestion"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "memory": {
-      "type": "object",
-      "properties": {
-        "physical_bytes": {
-          "type": "integer"
-        },
-        "usable_bytes": {
-          "type": "integer"
+    "/clusters/{cluster_id}/connectivity": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the L2 and L3 connectivity between every pair of hosts of the OpenShift bare metal cluster.",
+        "operationId": "GetClusterConnectivity",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/connectivity-matrix"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "presigned": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "url": {
-          "type": "string"
+    "/clusters/{cluster_id}/credentials": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Get the the cluster admin credentials.",
+        "operationId": "GetCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/downloads/boot-artifacts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Downloads a network boot artifact of the discovery image of the cluster, the iPXE script boots the kernel and the rootfs of the OpenShift version and the initrd with the ignition config of the cluster. The minimal discovery ISO fetches the rootfs over HTTP.",
+        "operationId": "DownloadClusterBootArtifact",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "ipxe-script",
+              "initrd",
+              "minimal-iso"
+            ],
+            "type": "string",
+            "name": "artifact",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "command": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+    "/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Downloads files relating to the installed/installing cluster.",
+        "operationId": "DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml"
+            ],
+            "type": "string",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "reset-installation",
-        "dhcp-lease-allocate"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+    "/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves a presigned S3 URL for downloading cluster files.",
+        "operationId": "GetPresignedForClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs"
+            ],
+            "type": "string",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        }
-      }
-    },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": []
-    }
-  ],
-  "tags": [
-    {
-      "description": "Assisted bare metal installation",
-      "name": "Assisted installation"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install/v1",
-  "paths": {
-    "/clusters": {
+    "/clusters/{cluster_id}/downloads/image": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the list of OpenShift bare metal clusters.",
-        "operationId": "ListClusters",
+        "summary": "Downloads the OpenShift per-cluster discovery ISO.",
+        "operationId": "DownloadClusterISO",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3862,20 +7332,23 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3886,21 +7359,28 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Creates a new OpenShift bare metal cluster definition.",
-        "operationId": "RegisterCluster",
+        "summary": "Creates a new OpenShift per-cluster discovery ISO.",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
-            "name": "new-cluster-params",
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "image-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/image-create-params"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
+          "202": {
+            "description": "Accepted, the build status of the image info of the cluster tracks the build of the image.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
@@ -3923,12 +7403,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3938,21 +7430,16 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}": {
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
       "get": {
-        "security": [
-          {
-            "userAuth": []
-          },
-          {
-            "agentAuth": []
-          }
+        "produces": [
+          "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the details of the OpenShift bare metal cluster.",
-        "operationId": "GetCluster",
+        "summary": "Downloads the kubeconfig file for this cluster.",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
@@ -3960,18 +7447,14 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -3998,26 +7481,90 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "tags": [
+          "events"
+        ],
+        "summary": "Lists events for a cluster",
+        "operationId": "ListEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "503": {
-            "description": "Unavailable.",
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Deletes an OpenShift bare metal cluster definition.",
-        "operationId": "DeregisterCluster",
+        "summary": "Retrieves the free address list for a network.",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
@@ -4025,11 +7572,34 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+            "type": "string",
+            "name": "network",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/free-addresses-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -4055,12 +7625,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4068,13 +7632,15 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
+      }
+    },
+    "/clusters/{cluster_id}/history": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Updates an OpenShift bare metal cluster definition.",
-        "operationId": "UpdateCluster",
+        "summary": "Lists the state transitions of the OpenShift bare metal cluster.",
+        "operationId": "ListClusterHistory",
         "parameters": [
           {
             "type": "string",
@@ -4082,27 +7648,13 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/state-transition-list"
             }
           },
           "401": {
@@ -4123,18 +7675,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4144,13 +7684,21 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/cancel": {
-      "post": {
+    "/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          },
+          {
+            "agentAuth": []
+          }
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Cancels an ongoing installation.",
-        "operationId": "CancelInstallation",
+        "summary": "Retrieves the list of OpenShift bare metal hosts.",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
@@ -4158,13 +7706,18 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -4179,34 +7732,26 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/complete_installation": {
+      },
       "post": {
         "security": [
           {
@@ -4216,8 +7761,8 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Agent API to mark a finalizing installation as complete.",
-        "operationId": "CompleteInstallation",
+        "summary": "Registers a new OpenShift bare metal host.",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
@@ -4227,11 +7772,11 @@ func init() {
             "required": true
           },
           {
-            "name": "completion-params",
+            "name": "new-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/completion-params"
+              "$ref": "#/definitions/host-create-params"
             }
           },
           {
@@ -4241,10 +7786,16 @@ func init() {
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4271,12 +7822,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4292,13 +7837,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Installs the OpenShift bare metal cluster.",
-        "operationId": "InstallCluster",
+        "summary": "Retrieves the details of the OpenShift bare metal host.",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
@@ -4306,19 +7851,20 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -4345,12 +7891,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4358,15 +7898,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/reset": {
-      "post": {
+      },
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Resets a failed installation.",
-        "operationId": "ResetCluster",
+        "summary": "Deregisters an OpenShift bare metal host.",
+        "operationId": "DeregisterHost",
         "parameters": [
           {
             "type": "string",
@@ -4374,13 +7912,23 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4407,12 +7955,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4422,13 +7964,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/credentials": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Get the the cluster admin credentials.",
-        "operationId": "GetCredentials",
+        "summary": "Enables a host for inclusion in the cluster.",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
@@ -4436,13 +7978,20 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -4482,26 +8031,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Downloads files relating to the installed/installing cluster.",
-        "operationId": "DownloadClusterFiles",
+        "summary": "Disables a host for inclusion in the cluster.",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
@@ -4510,33 +8046,19 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml"
-            ],
-            "type": "string",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -4574,28 +8096,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
+    "/clusters/{cluster_id}/hosts/{host_id}/bmc-credentials": {
       "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves a presigned S3 URL for downloading cluster files.",
-        "operationId": "GetPresignedForClusterFiles",
+        "summary": "Retrieves the BMC credentials of the OpenShift bare metal host, without the password.",
+        "operationId": "GetHostBmcCredentials",
         "parameters": [
           {
             "type": "string",
@@ -4604,41 +8115,19 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs"
-            ],
-            "type": "string",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/bmc-credentials"
             }
           },
           "401": {
@@ -4659,18 +8148,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4678,18 +8155,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/image": {
-      "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "put": {
         "tags": [
           "installer"
         ],
-        "summary": "Downloads the OpenShift per-cluster discovery ISO.",
-        "operationId": "DownloadClusterISO",
+        "summary": "Sets the BMC credentials that are used to manage the power and boot of the OpenShift bare metal host.",
+        "operationId": "UpdateHostBmcCredentials",
         "parameters": [
           {
             "type": "string",
@@ -4697,14 +8169,28 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "bmc-credentials-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-credentials-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/bmc-credentials"
             }
           },
           "400": {
@@ -4731,15 +8217,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4748,12 +8225,12 @@ func init() {
           }
         }
       },
-      "post": {
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Creates a new OpenShift per-cluster discovery ISO.",
-        "operationId": "GenerateClusterISO",
+        "summary": "Deletes the BMC credentials of the OpenShift bare metal host.",
+        "operationId": "DeleteHostBmcCredentials",
         "parameters": [
           {
             "type": "string",
@@ -4763,26 +8240,16 @@ func init() {
             "required": true
           },
           {
-            "name": "image-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/image-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4802,18 +8269,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4823,16 +8278,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
+    "/clusters/{cluster_id}/hosts/{host_id}/history": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
           "installer"
         ],
-        "summary": "Downloads the kubeconfig file for this cluster.",
-        "operationId": "DownloadClusterKubeconfig",
+        "summary": "Lists the state transitions of the OpenShift bare metal host.",
+        "operationId": "ListHostHistory",
         "parameters": [
           {
             "type": "string",
@@ -4840,14 +8292,20 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/state-transition-list"
             }
           },
           "401": {
@@ -4868,18 +8326,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4889,13 +8335,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/events": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
         "tags": [
-          "events"
+          "installer"
         ],
-        "summary": "Lists events for a cluster",
-        "operationId": "ListEvents",
+        "summary": "Retrieves the next operations that the host agent needs to perform.",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
@@ -4908,14 +8359,20 @@ func init() {
             "type": "string",
             "format": "uuid",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -4947,18 +8404,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/free_addresses": {
-      "get": {
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the free address list for a network.",
-        "operationId": "GetFreeAddresses",
+        "summary": "Posts the result of the operations from the host agent.",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -4967,31 +8438,28 @@ func init() {
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "name": "network",
-            "in": "query",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
             "required": true
           },
           {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5023,25 +8491,31 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
         "security": [
           {
             "userAuth": []
-          },
-          {
-            "agentAuth": []
           }
         ],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the list of OpenShift bare metal hosts.",
-        "operationId": "ListHosts",
+        "summary": "Download host logs",
+        "operationId": "DownloadHostLogs",
         "parameters": [
           {
             "type": "string",
@@ -5052,15 +8526,17 @@ func init() {
           },
           {
             "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "type": "file"
             }
           },
           "401": {
@@ -5075,20 +8551,26 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5101,11 +8583,14 @@ func init() {
             "agentAuth": []
           }
         ],
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Registers a new OpenShift bare metal host.",
-        "operationId": "RegisterHost",
+        "summary": "Agent API to upload logs.",
+        "operationId": "UploadHostLogs",
         "parameters": [
           {
             "type": "string",
@@ -5115,31 +8600,28 @@ func init() {
             "required": true
           },
           {
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "file",
+            "name": "upfile",
+            "in": "formData"
           },
           {
             "type": "string",
+            "x-mimetype": "application/zip",
+            "description": "The file to upload.",
             "name": "discovery_agent_version",
             "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -5159,12 +8641,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5180,17 +8656,28 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the details of the OpenShift bare metal host.",
-        "operationId": "GetHost",
+        "summary": "Update installation progress",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
+            "description": "The ID of the cluster to retrieve",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -5198,17 +8685,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
+            "description": "The ID of the host to retrieve",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "New progress value",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+            "description": "Update install progress"
           },
           "401": {
             "description": "Unauthorized.",
@@ -5239,15 +8733,23 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/validations": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Deregisters an OpenShift bare metal host.",
-        "operationId": "DeregisterHost",
+        "summary": "Lists the validation results of the OpenShift bare metal host.",
+        "operationId": "ListHostValidations",
         "parameters": [
           {
             "type": "string",
@@ -5265,13 +8767,10 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/validation-list"
             }
           },
           "401": {
@@ -5292,12 +8791,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5307,13 +8800,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
+    "/clusters/{cluster_id}/install-config": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Enables a host for inclusion in the cluster.",
-        "operationId": "EnableHost",
+        "summary": "Get the cluster install config yaml",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
@@ -5321,32 +8814,25 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string"
             }
           },
           "401": {
             "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "403": {
             "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -5361,12 +8847,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5375,12 +8855,12 @@ func init() {
           }
         }
       },
-      "delete": {
+      "patch": {
         "tags": [
           "installer"
         ],
-        "summary": "Disables a host for inclusion in the cluster.",
-        "operationId": "DisableHost",
+        "summary": "Override values in the install config",
+        "operationId": "UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
@@ -5390,30 +8870,34 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/install-config-overrides-error"
             }
           },
           "401": {
             "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "403": {
             "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -5428,12 +8912,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5443,18 +8921,21 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+    "/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the next operations that the host agent needs to perform.",
-        "operationId": "GetNextSteps",
+        "summary": "Download cluster logs",
+        "operationId": "DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
@@ -5462,25 +8943,13 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "type": "file"
             }
           },
           "401": {
@@ -5507,67 +8976,42 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
+      }
+    },
+    "/clusters/{cluster_id}/manifests": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Posts the result of the operations from the host agent.",
-        "operationId": "PostStepReply",
+        "summary": "Lists the manifests that are added to the installation files of the cluster.",
+        "operationId": "ListClusterManifests",
         "parameters": [
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
           }
         ],
-        "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -5588,42 +9032,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Download host logs",
-        "operationId": "DownloadHostLogs",
+        "summary": "Creates a manifest that is added to the installation files of the cluster.",
+        "operationId": "CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
@@ -5633,18 +9055,25 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "name": "create-manifest-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5665,12 +9094,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -5685,20 +9108,12 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "consumes": [
-          "multipart/form-data"
-        ],
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Agent API to upload logs.",
-        "operationId": "UploadHostLogs",
+        "summary": "Deletes a manifest of the cluster.",
+        "operationId": "DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
@@ -5708,29 +9123,32 @@ func init() {
             "required": true
           },
           {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
             "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "file",
-            "name": "upfile",
-            "in": "formData"
+            "default": "manifests",
+            "name": "folder",
+            "in": "query"
           },
           {
             "type": "string",
-            "x-mimetype": "application/zip",
-            "description": "The file to upload.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -5749,14 +9167,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5764,8 +9182,8 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
             "agentAuth": []
@@ -5774,43 +9192,39 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Update installation progress",
-        "operationId": "UpdateHostInstallProgress",
+        "summary": "Transfer the ingress certificate for the cluster.",
+        "operationId": "UploadClusterIngressCert",
         "parameters": [
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to retrieve",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "New progress value",
-            "name": "host-progress",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-progress"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress"
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5851,13 +9265,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/install-config": {
+    "/clusters/{cluster_id}/validations": {
       "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Get the cluster install config yaml",
-        "operationId": "GetClusterInstallConfig",
+        "summary": "Lists the validation results of the OpenShift bare metal cluster.",
+        "operationId": "ListClusterValidations",
         "parameters": [
           {
             "type": "string",
@@ -5871,19 +9285,19 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/validation-list"
             }
           },
           "401": {
             "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "403": {
             "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "404": {
@@ -5892,11 +9306,45 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "tags": [
+          "versions"
+        ],
+        "summary": "List of componenets versions",
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "tags": [
+          "managed_domains"
+        ],
+        "summary": "List of managed DNS domains",
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
           },
           "500": {
             "description": "Error.",
@@ -5906,32 +9354,28 @@ func init() {
           }
         }
       },
-      "patch": {
+      "post": {
         "tags": [
-          "installer"
+          "managed_domains"
         ],
-        "summary": "Override values in the install config",
-        "operationId": "UpdateClusterInstallConfig",
+        "summary": "Adds a managed DNS domain, admin only.",
+        "operationId": "RegisterManagedDomain",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "install-config-params",
+            "name": "new-managed-domain-params",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/managed-domain-create-params"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/managed-domain"
+            }
           },
           "400": {
             "description": "Error.",
@@ -5942,27 +9386,21 @@ func init() {
           "401": {
             "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "403": {
             "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5972,36 +9410,73 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
+    "/domains/{domain_id}": {
       "get": {
-        "security": [
+        "tags": [
+          "managed_domains"
+        ],
+        "summary": "Retrieves the details of a managed DNS domain, admin only.",
+        "operationId": "GetManagedDomain",
+        "parameters": [
           {
-            "userAuth": []
+            "type": "string",
+            "format": "uuid",
+            "name": "domain_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/managed-domain"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
+        }
+      },
+      "delete": {
         "tags": [
-          "installer"
+          "managed_domains"
         ],
-        "summary": "Download cluster logs",
-        "operationId": "DownloadClusterLogs",
+        "summary": "Deletes a managed DNS domain, admin only.",
+        "operationId": "DeregisterManagedDomain",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "name": "cluster_id",
+            "name": "domain_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -6021,18 +9496,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6040,45 +9503,36 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
+      },
+      "patch": {
         "tags": [
-          "installer"
+          "managed_domains"
         ],
-        "summary": "Transfer the ingress certificate for the cluster.",
-        "operationId": "UploadClusterIngressCert",
+        "summary": "Updates a managed DNS domain, admin only.",
+        "operationId": "UpdateManagedDomain",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "name": "cluster_id",
+            "name": "domain_id",
             "in": "path",
             "required": true
           },
           {
-            "name": "ingress-cert-params",
+            "name": "managed-domain-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/managed-domain-update-params"
             }
-          },
-          {
-            "type": "string",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/managed-domain"
+            }
           },
           "400": {
             "description": "Error.",
@@ -6104,58 +9558,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/component_versions": {
-      "get": {
-        "tags": [
-          "versions"
-        ],
-        "summary": "List of componenets versions",
-        "operationId": "ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/domains": {
-      "get": {
-        "tags": [
-          "managed_domains"
-        ],
-        "summary": "List of managed DNS domains",
-        "operationId": "ListManagedDomains",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-managed-domains"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6172,6 +9574,14 @@ func init() {
         ],
         "summary": "Get minimum host requirements",
         "operationId": "GetHostRequirements",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The OpenShift version of the cluster, the default requirements are returned when it is not set.",
+            "name": "openshift_version",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
@@ -6205,9 +9615,39 @@ func init() {
           }
         }
       }
+    },
+    "/openshift_versions": {
+      "get": {
+        "tags": [
+          "versions"
+        ],
+        "summary": "Retrieves the list of OpenShift supported versions.",
+        "operationId": "ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
+    "ClusterUpdateParamsHostsInstallationDisksItems0": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "installation_disk_id": {
+          "description": "The id of an eligible inventory disk of the host.",
+          "type": "string"
+        }
+      }
+    },
     "ClusterUpdateParamsHostsNamesItems0": {
       "type": "object",
       "properties": {
@@ -6232,6 +9672,102 @@ func init() {
         }
       }
     },
+    "add-hosts-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "id",
+        "api_vip_dnsname",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "api vip domain.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster, one of the versions that /openshift_versions lists.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-credentials": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Address of the BMC, the BMC address from the host inventory is used when not set.",
+          "type": "string"
+        },
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the BMC TLS certificate.",
+          "type": "boolean"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "last_action": {
+          "description": "The last power management action that was done using the BMC.",
+          "type": "string"
+        },
+        "last_action_at": {
+          "description": "The time of the last power management action.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "last_action_error": {
+          "description": "The error of the last power management action, empty when it succeeded.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "username": {
+          "description": "User name for the Redfish API of the BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-credentials-params": {
+      "type": "object",
+      "required": [
+        "username",
+        "password"
+      ],
+      "properties": {
+        "address": {
+          "description": "Address of the BMC, the BMC address from the host inventory is used when not set.",
+          "type": "string"
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the BMC TLS certificate.",
+          "type": "boolean"
+        },
+        "password": {
+          "description": "Password for the Redfish API of the BMC.",
+          "type": "string",
+          "format": "password"
+        },
+        "username": {
+          "description": "User name for the Redfish API of the BMC.",
+          "type": "string"
+        }
+      }
+    },
     "boot": {
       "type": "object",
       "properties": {
@@ -6254,10 +9790,23 @@ func init() {
         "status_info"
       ],
       "properties": {
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle that is added to the trusted certificates of the hosts and of the cluster, for example the CA of the registry mirrors.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "api_vip": {
           "description": "Virtual IP used to reach the OpenShift cluster API.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
+        },
+        "api_vip_dnsname": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string"
+        },
+        "auto_assign_vips": {
+          "description": "Indicate if the service assigns free addresses of the machine network to the API VIP and the ingress VIP, and replaces them when they are not free anymore.",
+          "type": "boolean"
         },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
@@ -6266,12 +9815,12 @@ func init() {
         "cluster_network_cidr": {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
           "type": "integer",
-          "maximum": 32,
+          "maximum": 128,
           "minimum": 1
         },
         "created_at": {
@@ -6280,6 +9829,17 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster over multiple master nodes whereas 'None' installs a full cluster over one node.",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'Full'\"",
+          "x-nullable": true
+        },
         "host_networks": {
           "description": "List of host networks to be filled during query.",
           "type": "array",
@@ -6319,6 +9879,11 @@ func init() {
         "ignition_generator_version": {
           "type": "string"
         },
+        "image_content_sources": {
+          "description": "The mirrors of the image sources of the installation, for installations in disconnected networks. YAML formatted\nlist in the format of the imageContentSources of the install-config, for example:\n- mirrors:\n  - mirror.example.com:5000/ocp4/openshift4\n  source: quay.io/openshift-release-dev/ocp-release\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "image_info": {
           "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
           "$ref": "#/definitions/image_info"
@@ -6326,7 +9891,7 @@ func init() {
         "ingress_vip": {
           "description": "Virtual IP used for cluster ingress traffic.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
         },
         "install_completed_at": {
           "description": "The time that this cluster completed installation.",
@@ -6338,7 +9903,7 @@ func init() {
           "description": "Json formatted string containing the user overrides for the install-config.yaml file",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+          "example": "{\"controlPlane\":{\"hyperthreading\": \"Disabled\"},\"fips\":true}"
         },
         "install_started_at": {
           "description": "The time that this cluster began installation.",
@@ -6347,32 +9912,42 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
         },
         "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object or 'ClusterLink' if it is just a link.",
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object, 'AddHostsCluster' for cluster that add hosts to existing OCP cluster, or 'ClusterLink' if it is just a link.",
           "type": "string",
           "enum": [
-            "Cluster"
+            "Cluster",
+            "AddHostsCluster"
           ]
         },
         "machine_network_cidr": {
           "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_network_dhcp_range": {
+          "description": "The range of addresses, in first-last format, that the DHCP server of the machine network leases. VIPs are not suggested from this range.",
+          "type": "string",
+          "pattern": "^(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}-(?:[0-9]{1,3}\\.){3}[0-9]{1,3})?$"
         },
         "name": {
           "description": "Name of the OpenShift cluster.",
           "type": "string"
         },
+        "network_type": {
+          "description": "The network plugin of the cluster. When it is not set, OVNKubernetes is used if one of the networks is an IPv6 network and OpenShiftSDN otherwise.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
+        },
         "no_proxy": {
           "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
           "type": "string"
         },
         "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "4.5",
-            "4.6"
-          ]
+          "description": "Version of the OpenShift cluster, one of the versions that /openshift_versions lists.",
+          "type": "string"
         },
         "org_id": {
           "type": "string"
@@ -6381,10 +9956,31 @@ func init() {
           "description": "True if the pull-secret has been added to the cluster",
           "type": "boolean"
         },
+        "secondary_cluster_network_cidr": {
+          "description": "The cluster network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node from the secondary cluster network.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "secondary_machine_network_cidr": {
+          "description": "The machine network of the second IP family of a dual-stack cluster. The primary networks of a dual-stack cluster are IPv4 and its secondary networks are IPv6.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "secondary_service_network_cidr": {
+          "description": "The service network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
@@ -6402,7 +9998,8 @@ func init() {
             "pending-for-input",
             "installing",
             "finalizing",
-            "installed"
+            "installed",
+            "adding-hosts"
           ]
         },
         "status_info": {
@@ -6422,13 +10019,18 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "user_managed_networking": {
+          "description": "Indicate if the load balancers of the API and the ingress are managed by the user. The cluster has no VIPs in this mode, and the installation is of platform none.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "user_name": {
           "type": "string"
         },
         "validations_info": {
           "description": "Json formatted string containing the validations results for each validation id grouped by category (network, hosts-data, etc.)",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "vip_dhcp_allocation": {
           "description": "Indicate if VIP DHCP allocation mode is enabled.",
@@ -6444,6 +10046,15 @@ func init() {
         "openshift_version"
       ],
       "properties": {
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle that is added to the trusted certificates of the hosts and of the cluster, for example the CA of the registry mirrors.",
+          "type": "string"
+        },
+        "auto_assign_vips": {
+          "description": "Indicate if the service assigns free addresses of the machine network to the API VIP and the ingress VIP, and replaces them when they are not free anymore.",
+          "type": "boolean",
+          "default": false
+        },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string"
@@ -6452,15 +10063,25 @@ func init() {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
           "default": "10.128.0.0/14",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
           "type": "integer",
           "default": 23,
-          "maximum": 32,
+          "maximum": 128,
           "minimum": 1
         },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster over multiple master nodes whereas 'None' installs a full cluster over one node.",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ],
+          "x-nullable": true
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
@@ -6471,42 +10092,82 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "image_content_sources": {
+          "description": "The mirrors of the image sources of the installation, for installations in disconnected networks. YAML formatted\nlist in the format of the imageContentSources of the install-config, for example:\n- mirrors:\n  - mirror.example.com:5000/ocp4/openshift4\n  source: quay.io/openshift-release-dev/ocp-release\n",
+          "type": "string"
+        },
         "ingress_vip": {
           "description": "Virtual IP used for cluster ingress traffic.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
+        },
+        "machine_network_dhcp_range": {
+          "description": "The range of addresses, in first-last format, that the DHCP server of the machine network leases. VIPs are not suggested from this range.",
+          "type": "string",
+          "pattern": "^(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}-(?:[0-9]{1,3}\\.){3}[0-9]{1,3})?$"
         },
         "name": {
           "description": "Name of the OpenShift cluster.",
           "type": "string"
         },
+        "network_type": {
+          "description": "The network plugin of the cluster, OVNKubernetes is required for IPv6 and dual-stack clusters. Defaults to OVNKubernetes when one of the networks is an IPv6 network and to OpenShiftSDN otherwise.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
+        },
         "no_proxy": {
           "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
           "type": "string",
           "x-nullable": true
         },
         "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "4.5",
-            "4.6"
-          ]
+          "description": "Version of the OpenShift cluster, one of the versions that /openshift_versions lists.",
+          "type": "string"
         },
         "pull_secret": {
           "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
           "type": "string"
         },
+        "secondary_cluster_network_cidr": {
+          "description": "The cluster network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node from the secondary cluster network.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "secondary_machine_network_cidr": {
+          "description": "The machine network of the second IP family of a dual-stack cluster. The primary networks of a dual-stack cluster are IPv4 and its secondary networks are IPv6.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
+        "secondary_service_network_cidr": {
+          "description": "The service network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$"
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
           "default": "172.30.0.0/16",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
         },
+        "user_managed_networking": {
+          "description": "Indicate if the load balancers of the API and the ingress are managed by the user. The cluster has no VIPs in this mode, and the installation is of platform none.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
         "vip_dhcp_allocation": {
           "description": "Indicate if VIP DHCP allocation mode is enabled.",
           "type": "boolean",
@@ -6524,10 +10185,20 @@ func init() {
     "cluster-update-params": {
       "type": "object",
       "properties": {
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle that is added to the trusted certificates of the hosts and of the cluster, for example the CA of the registry mirrors.",
+          "type": "string",
+          "x-nullable": true
+        },
         "api_vip": {
           "description": "Virtual IP used to reach the OpenShift cluster API.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "auto_assign_vips": {
+          "description": "Indicate if the service assigns free addresses of the machine network to the API VIP and the ingress VIP, and replaces them when they are not free anymore.",
+          "type": "boolean",
           "x-nullable": true
         },
         "base_dns_domain": {
@@ -6538,16 +10209,24 @@ func init() {
         "cluster_network_cidr": {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
           "x-nullable": true
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
           "type": "integer",
-          "maximum": 32,
+          "maximum": 128,
           "minimum": 1,
           "x-nullable": true
         },
+        "hosts_installation_disks": {
+          "description": "The desired installation disk for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusterUpdateParamsHostsInstallationDisksItems0"
+          },
+          "x-nullable": true
+        },
         "hosts_names": {
           "description": "The desired hostname for hosts associated with the cluster.",
           "type": "array",
@@ -6576,16 +10255,27 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "image_content_sources": {
+          "description": "The mirrors of the image sources of the installation, for installations in disconnected networks. YAML formatted\nlist in the format of the imageContentSources of the install-config, for example:\n- mirrors:\n  - mirror.example.com:5000/ocp4/openshift4\n  source: quay.io/openshift-release-dev/ocp-release\n",
+          "type": "string",
+          "x-nullable": true
+        },
         "ingress_vip": {
           "description": "Virtual IP used for cluster ingress traffic.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
           "x-nullable": true
         },
         "machine_network_cidr": {
           "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "machine_network_dhcp_range": {
+          "description": "The range of addresses, in first-last format, that the DHCP server of the machine network leases. VIPs are not suggested from this range.",
+          "type": "string",
+          "pattern": "^(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}-(?:[0-9]{1,3}\\.){3}[0-9]{1,3})?$",
           "x-nullable": true
         },
         "name": {
@@ -6593,6 +10283,15 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "network_type": {
+          "description": "The network plugin of the cluster, OVNKubernetes is required for IPv6 and dual-stack clusters.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
         "no_proxy": {
           "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude proxying.",
           "type": "string",
@@ -6603,10 +10302,35 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "secondary_cluster_network_cidr": {
+          "description": "The cluster network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$",
+          "x-nullable": true
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node from the secondary cluster network.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "secondary_machine_network_cidr": {
+          "description": "The machine network of the second IP family of a dual-stack cluster. The primary networks of a dual-stack cluster are IPv4 and its secondary networks are IPv6.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$",
+          "x-nullable": true
+        },
+        "secondary_service_network_cidr": {
+          "description": "The service network of the second IP family of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))?$",
+          "x-nullable": true
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
           "x-nullable": true
         },
         "ssh_public_key": {
@@ -6614,6 +10338,11 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "user_managed_networking": {
+          "description": "Indicate if the load balancers of the API and the ingress are managed by the user. The cluster has no VIPs in this mode, and the installation is of platform none.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "vip_dhcp_allocation": {
           "description": "Indicate if VIP DHCP allocation mode is enabled.",
           "type": "boolean",
@@ -6637,7 +10366,11 @@ func init() {
         "all-hosts-are-ready-to-install",
         "sufficient-masters-count",
         "dns-domain-defined",
-        "pull-secret-set"
+        "pull-secret-set",
+        "all-hosts-are-connected",
+        "network-type-valid",
+        "api-domain-name-resolved",
+        "apps-domain-name-resolved"
       ]
     },
     "completion-params": {
@@ -6692,6 +10425,53 @@ func init() {
         "$ref": "#/definitions/connectivity-check-host"
       }
     },
+    "connectivity-matrix": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-matrix-entry"
+      }
+    },
+    "connectivity-matrix-entry": {
+      "type": "object",
+      "required": [
+        "host_id",
+        "remote_host_id",
+        "l2_connected",
+        "l3_connected"
+      ],
+      "properties": {
+        "host_id": {
+          "description": "The host that checked the connectivity.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connected": {
+          "description": "Whether the host reached the remote host over L2 on the machine network.",
+          "type": "boolean"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connected": {
+          "description": "Whether the host reached the remote host over L3 on the machine network.",
+          "type": "boolean"
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        },
+        "remote_host_id": {
+          "description": "The host that the connectivity was checked to.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
     "connectivity-remote-host": {
       "type": "object",
       "properties": {
@@ -6747,6 +10527,32 @@ func init() {
         }
       }
     },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "The base64 encoded content of the manifest.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest, a YAML or JSON file.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The folder of the installation files that the manifest is placed in.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
     "credentials": {
       "type": "object",
       "properties": {
@@ -6813,9 +10619,20 @@ func init() {
         "drive_type": {
           "type": "string"
         },
+        "has_partitions": {
+          "description": "Whether the disk has existing partitions.",
+          "type": "boolean"
+        },
         "hctl": {
           "type": "string"
         },
+        "id": {
+          "description": "Stable identifier of the disk, its by-path link when available or its device path otherwise.",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "$ref": "#/definitions/disk-installation-eligibility"
+        },
         "model": {
           "type": "string"
         },
@@ -6825,6 +10642,10 @@ func init() {
         "path": {
           "type": "string"
         },
+        "removable": {
+          "description": "Whether the disk is a removable device.",
+          "type": "boolean"
+        },
         "serial": {
           "type": "string"
         },
@@ -6839,6 +10660,98 @@ func init() {
         }
       }
     },
+    "disk-installation-eligibility": {
+      "description": "Whether the host can be installed on the disk, set by the service according to its requirements.",
+      "type": "object",
+      "properties": {
+        "eligible": {
+          "description": "Whether the disk is eligible for installation or not.",
+          "type": "boolean"
+        },
+        "not_eligible_reasons": {
+          "description": "Reasons for why this disk is not eligible for installation.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "warnings": {
+          "description": "Attributes of the disk that don't prevent the installation on it, such as being removable or having existing partitions that the installation overwrites.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domain_resolution_request_domain"
+          }
+        }
+      }
+    },
+    "domain_resolution_request_domain": {
+      "type": "object",
+      "required": [
+        "domain_name"
+      ],
+      "properties": {
+        "domain_name": {
+          "description": "The domain name that should be resolved.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domain_resolution_response_domain"
+          }
+        }
+      }
+    },
+    "domain_resolution_response_domain": {
+      "type": "object",
+      "required": [
+        "domain_name"
+      ],
+      "properties": {
+        "domain_name": {
+          "description": "The domain name that was resolved.",
+          "type": "string"
+        },
+        "ipv4_addresses": {
+          "description": "The IPv4 addresses of the domain, empty if none.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
+        },
+        "ipv6_addresses": {
+          "description": "The IPv6 addresses of the domain, empty if none.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv6"
+          }
+        }
+      }
+    },
     "error": {
       "type": "object",
       "required": [
@@ -6932,14 +10845,14 @@ func init() {
       "type": "array",
       "items": {
         "type": "string",
-        "format": "ipv4"
+        "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
       }
     },
     "free_addresses_request": {
       "type": "array",
       "items": {
         "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
       }
     },
     "free_network_addresses": {
@@ -6949,12 +10862,12 @@ func init() {
           "type": "array",
           "items": {
             "type": "string",
-            "format": "ipv4"
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
           }
         },
         "network": {
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         }
       }
     },
@@ -7001,6 +10914,11 @@ func init() {
         "discovery_agent_version": {
           "type": "string"
         },
+        "domain_resolutions": {
+          "description": "Json formatted string containing the addresses that the host resolved for the domain names of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "free_addresses": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
@@ -7015,6 +10933,10 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
+        "installation_disk_id": {
+          "description": "The id of the inventory disk that the host will be installed on.",
+          "type": "string"
+        },
         "installation_disk_path": {
           "description": "Host installation path",
           "type": "string"
@@ -7068,6 +10990,11 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "static_network_config": {
+          "description": "The static network configuration, in nmstate YAML format, of the discovery image that matched the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "status": {
           "type": "string",
           "enum": [
@@ -7084,7 +11011,8 @@ func init() {
             "resetting-pending-user-action",
             "installed",
             "error",
-            "resetting"
+            "resetting",
+            "added-to-existing-cluster"
           ]
         },
         "status_info": {
@@ -7108,7 +11036,7 @@ func init() {
         "validations_info": {
           "description": "Json formatted string containing the validations results for each validation id grouped by category (network, hardware, etc.)",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
@@ -7235,6 +11163,24 @@ func init() {
         "Failed"
       ]
     },
+    "host-static-network-config": {
+      "type": "object",
+      "required": [
+        "mac_address",
+        "network_yaml"
+      ],
+      "properties": {
+        "mac_address": {
+          "description": "MAC address of one of the interfaces of the host, used to match the configuration to the host.",
+          "type": "string",
+          "pattern": "^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$"
+        },
+        "network_yaml": {
+          "description": "Network configuration of the host in nmstate YAML format.",
+          "type": "string"
+        }
+      }
+    },
     "host-validation-id": {
       "type": "string",
       "enum": [
@@ -7249,7 +11195,8 @@ func init() {
         "has-memory-for-role",
         "hostname-unique",
         "hostname-valid",
-        "belongs-to-machine-cidr"
+        "belongs-to-machine-cidr",
+        "belongs-to-majority-group"
       ]
     },
     "host_network": {
@@ -7273,12 +11220,33 @@ func init() {
         "ssh_public_key": {
           "description": "SSH public key for debugging the installation.",
           "type": "string"
+        },
+        "static_network_config": {
+          "description": "Static network configuration of the hosts that boot from the image, the hosts without a configuration use DHCP.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-static-network-config"
+          }
         }
       }
     },
     "image_info": {
       "type": "object",
       "properties": {
+        "build_status": {
+          "description": "Status of the build of the image, the image can be downloaded once it is uploaded.",
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "uploaded",
+            "failed"
+          ]
+        },
+        "build_status_info": {
+          "description": "Reason of the failure of the build of the image.",
+          "type": "string"
+        },
         "created_at": {
           "type": "string",
           "format": "date-time",
@@ -7304,32 +11272,99 @@ func init() {
           "description": "SSH public key for debugging the installation",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+        },
+        "static_network_config": {
+          "description": "JSON-formatted list of the static network configurations of the hosts that boot from the image.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "infra_error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
+        },
+        "message": {
+          "description": "Human readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "install-config-field-error": {
+      "type": "object",
+      "required": [
+        "field",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "description": "The path of the field in the install config, for example networking.clusterNetwork[0].cidr.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Human readable description of the error of the field.",
+          "type": "string"
         }
       }
     },
-    "infra_error": {
+    "install-config-overrides-error": {
       "type": "object",
       "required": [
+        "kind",
+        "id",
+        "href",
         "code",
-        "message"
+        "reason"
       ],
       "properties": {
         "code": {
+          "description": "Globally unique code of the error.",
+          "type": "string"
+        },
+        "field_errors": {
+          "description": "The invalid fields of the install config overrides.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/install-config-field-error"
+          }
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
           "description": "Numeric identifier of the error.",
           "type": "integer",
           "format": "int32",
-          "maximum": 403,
-          "minimum": 401
+          "maximum": 504,
+          "minimum": 400
         },
-        "message": {
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
           "description": "Human readable description of the error.",
           "type": "string"
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
-    },
     "interface": {
       "type": "object",
       "properties": {
@@ -7455,7 +11490,13 @@ func init() {
     "list-managed-domains": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/managed-domain"
+        "$ref": "#/definitions/managed-domain-summary"
+      }
+    },
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
       }
     },
     "list-versions": {
@@ -7470,15 +11511,152 @@ func init() {
       }
     },
     "managed-domain": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "credentials_ref": {
+          "description": "The credentials that manage the zone, an AWS shared credentials profile for route53 and a TSIG key name for rfc2136. The default credentials of the provider are used when it is empty.",
+          "type": "string"
+        },
+        "domain": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"unique_index\""
+        },
+        "id": {
+          "description": "Unique identifier of the managed domain.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "org_id": {
+          "description": "The organization that owns the domain, a domain without an organization is available to all the users.",
+          "type": "string"
+        },
+        "provider": {
+          "type": "string",
+          "enum": [
+            "route53",
+            "rfc2136"
+          ]
+        },
+        "server": {
+          "description": "The address of the DNS server that accepts the dynamic updates of an rfc2136 zone, in host:port format.",
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "zone_id": {
+          "description": "The DNS zone of the domain, a hosted zone ID for route53 and a zone name for rfc2136.",
+          "type": "string"
+        }
+      }
+    },
+    "managed-domain-create-params": {
+      "type": "object",
+      "required": [
+        "domain",
+        "provider",
+        "zone_id"
+      ],
+      "properties": {
+        "credentials_ref": {
+          "description": "The credentials that manage the zone, an AWS shared credentials profile for route53 and a TSIG key name for rfc2136. The default credentials of the provider are used when it is empty.",
+          "type": "string"
+        },
+        "domain": {
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization that owns the domain, a domain without an organization is available to all the users.",
+          "type": "string"
+        },
+        "provider": {
+          "type": "string",
+          "enum": [
+            "route53",
+            "rfc2136"
+          ]
+        },
+        "server": {
+          "description": "The address of the DNS server that accepts the dynamic updates of an rfc2136 zone, in host:port format.",
+          "type": "string"
+        },
+        "zone_id": {
+          "description": "The DNS zone of the domain, a hosted zone ID for route53 and a zone name for rfc2136.",
+          "type": "string"
+        }
+      }
+    },
+    "managed-domain-summary": {
+      "description": "The view of a managed domain that is available to the users who can use it.",
       "type": "object",
       "properties": {
         "domain": {
           "type": "string"
         },
+        "id": {
+          "description": "Unique identifier of the managed domain.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "provider": {
+          "type": "string",
+          "enum": [
+            "route53",
+            "rfc2136"
+          ]
+        }
+      }
+    },
+    "managed-domain-update-params": {
+      "type": "object",
+      "properties": {
+        "credentials_ref": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "org_id": {
+          "type": "string",
+          "x-nullable": true
+        },
         "provider": {
           "type": "string",
           "enum": [
-            "route53"
+            "route53",
+            "rfc2136"
+          ],
+          "x-nullable": true
+        },
+        "server": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "zone_id": {
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "manifest": {
+      "type": "object",
+      "properties": {
+        "file_name": {
+          "description": "The name of the manifest.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The folder of the installation files that the manifest is placed in.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
           ]
         }
       }
@@ -7494,6 +11672,60 @@ func init() {
         }
       }
     },
+    "openshift-version": {
+      "type": "object",
+      "required": [
+        "display_name",
+        "release_image",
+        "support_level"
+      ],
+      "properties": {
+        "display_name": {
+          "description": "Name of the version for display.",
+          "type": "string"
+        },
+        "host_requirements": {
+          "$ref": "#/definitions/host-requirements"
+        },
+        "release_image": {
+          "description": "The OpenShift release image that clusters of the version are installed from.",
+          "type": "string"
+        },
+        "rhcos_image": {
+          "description": "Location (URL or path of the service) of the RHCOS live ISO that the discovery image and the network boot artifacts of clusters of the version are based on, the default RHCOS live ISO of the service is used if empty.",
+          "type": "string"
+        },
+        "rhcos_minimal_image": {
+          "description": "Location (URL or path of the service) of the RHCOS minimal live ISO, which fetches the rootfs over HTTP, that the minimal discovery image of clusters of the version is based on, the default RHCOS minimal live ISO of the service is used if empty.",
+          "type": "string"
+        },
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production"
+          ]
+        },
+        "supported_network_types": {
+          "description": "The network types that clusters of the version can be installed with, all the network types are supported if empty.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "OpenShiftSDN",
+              "OVNKubernetes"
+            ]
+          }
+        }
+      }
+    },
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
     "presigned": {
       "type": "object",
       "required": [
@@ -7505,6 +11737,62 @@ func init() {
         }
       }
     },
+    "state-transition": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "transition_type",
+        "from_state",
+        "to_state",
+        "transition_time"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this transition relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "from_state": {
+          "description": "The state before the transition.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "Unique identifier of the host this transition relates to, empty for a cluster transition.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "reason": {
+          "description": "Additional information about the new state.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "request_id": {
+          "description": "Unique identifier for the request that caused this transition.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "to_state": {
+          "description": "The state after the transition.",
+          "type": "string"
+        },
+        "transition_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "transition_type": {
+          "description": "The type of the state machine transition that changed the state.",
+          "type": "string"
+        }
+      }
+    },
+    "state-transition-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/state-transition"
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -7554,7 +11842,8 @@ func init() {
         "install",
         "free-network-addresses",
         "reset-installation",
-        "dhcp-lease-allocate"
+        "dhcp-lease-allocate",
+        "domain-resolution"
       ]
     },
     "steps": {
@@ -7588,14 +11877,87 @@ func init() {
         },
         "serial_number": {
           "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not.",
+          "type": "boolean"
+        }
+      }
+    },
+    "validation": {
+      "type": "object",
+      "properties": {
+        "category": {
+          "description": "The category the validation belongs to (network, hardware, hosts-data, etc.)",
+          "type": "string"
+        },
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this validation relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host this validation relates to, empty for a cluster validation.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "id": {
+          "description": "The validation identifier, one of host-validation-id or cluster-validation-id.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending",
+            "error",
+            "warning"
+          ]
+        },
+        "status_updated_at": {
+          "description": "The last time the validation status changed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
       }
     },
+    "validation-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/validation"
+      }
+    },
     "versions": {
       "type": "object",
       "additionalProperties": {
         "type": "string"
       }
+    },
+    "vips-suggestion": {
+      "type": "object",
+      "properties": {
+        "api_vip": {
+          "description": "A free address for the API VIP.",
+          "type": "string"
+        },
+        "ingress_vip": {
+          "description": "A free address for the ingress VIP.",
+          "type": "string"
+        },
+        "machine_network_cidr": {
+          "description": "The machine network that the VIPs belong to.",
+          "type": "string"
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -7604,6 +11966,11 @@ func init() {
       "name": "X-Secret-Key",
       "in": "header"
     },
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
     "userAuth": {
       "type": "apiKey",
       "name": "Authorization",
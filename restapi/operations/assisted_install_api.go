@@ -55,15 +55,33 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerCompleteInstallationHandler: installer.CompleteInstallationHandlerFunc(func(params installer.CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.CompleteInstallation has not yet been implemented")
 		}),
+		InstallerCreateClusterManifestHandler: installer.CreateClusterManifestHandlerFunc(func(params installer.CreateClusterManifestParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.CreateClusterManifest has not yet been implemented")
+		}),
+		InstallerDeleteClusterManifestHandler: installer.DeleteClusterManifestHandlerFunc(func(params installer.DeleteClusterManifestParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DeleteClusterManifest has not yet been implemented")
+		}),
+		InstallerDeleteHostBmcCredentialsHandler: installer.DeleteHostBmcCredentialsHandlerFunc(func(params installer.DeleteHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DeleteHostBmcCredentials has not yet been implemented")
+		}),
 		InstallerDeregisterClusterHandler: installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterCluster has not yet been implemented")
 		}),
 		InstallerDeregisterHostHandler: installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterHost has not yet been implemented")
 		}),
+		ManagedDomainsDeregisterManagedDomainHandler: managed_domains.DeregisterManagedDomainHandlerFunc(func(params managed_domains.DeregisterManagedDomainParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation managed_domains.DeregisterManagedDomain has not yet been implemented")
+		}),
 		InstallerDisableHostHandler: installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DisableHost has not yet been implemented")
 		}),
+		InstallerDownloadBaseBootArtifactHandler: installer.DownloadBaseBootArtifactHandlerFunc(func(params installer.DownloadBaseBootArtifactParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadBaseBootArtifact has not yet been implemented")
+		}),
+		InstallerDownloadClusterBootArtifactHandler: installer.DownloadClusterBootArtifactHandlerFunc(func(params installer.DownloadClusterBootArtifactParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadClusterBootArtifact has not yet been implemented")
+		}),
 		InstallerDownloadClusterFilesHandler: installer.DownloadClusterFilesHandlerFunc(func(params installer.DownloadClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DownloadClusterFiles has not yet been implemented")
 		}),
@@ -88,6 +106,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetClusterHandler: installer.GetClusterHandlerFunc(func(params installer.GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCluster has not yet been implemented")
 		}),
+		InstallerGetClusterConnectivityHandler: installer.GetClusterConnectivityHandlerFunc(func(params installer.GetClusterConnectivityParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterConnectivity has not yet been implemented")
+		}),
 		InstallerGetClusterInstallConfigHandler: installer.GetClusterInstallConfigHandlerFunc(func(params installer.GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetClusterInstallConfig has not yet been implemented")
 		}),
@@ -100,9 +121,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetHostHandler: installer.GetHostHandlerFunc(func(params installer.GetHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHost has not yet been implemented")
 		}),
+		InstallerGetHostBmcCredentialsHandler: installer.GetHostBmcCredentialsHandlerFunc(func(params installer.GetHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetHostBmcCredentials has not yet been implemented")
+		}),
 		InstallerGetHostRequirementsHandler: installer.GetHostRequirementsHandlerFunc(func(params installer.GetHostRequirementsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHostRequirements has not yet been implemented")
 		}),
+		ManagedDomainsGetManagedDomainHandler: managed_domains.GetManagedDomainHandlerFunc(func(params managed_domains.GetManagedDomainParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation managed_domains.GetManagedDomain has not yet been implemented")
+		}),
 		InstallerGetNextStepsHandler: installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetNextSteps has not yet been implemented")
 		}),
@@ -112,6 +139,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerInstallClusterHandler: installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.InstallCluster has not yet been implemented")
 		}),
+		InstallerInstallHostsHandler: installer.InstallHostsHandlerFunc(func(params installer.InstallHostsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.InstallHosts has not yet been implemented")
+		}),
+		InstallerListClusterHistoryHandler: installer.ListClusterHistoryHandlerFunc(func(params installer.ListClusterHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterHistory has not yet been implemented")
+		}),
+		InstallerListClusterManifestsHandler: installer.ListClusterManifestsHandlerFunc(func(params installer.ListClusterManifestsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterManifests has not yet been implemented")
+		}),
+		InstallerListClusterValidationsHandler: installer.ListClusterValidationsHandlerFunc(func(params installer.ListClusterValidationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterValidations has not yet been implemented")
+		}),
 		InstallerListClustersHandler: installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListClusters has not yet been implemented")
 		}),
@@ -121,33 +160,60 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		EventsListEventsHandler: events.ListEventsHandlerFunc(func(params events.ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.ListEvents has not yet been implemented")
 		}),
+		InstallerListHostHistoryHandler: installer.ListHostHistoryHandlerFunc(func(params installer.ListHostHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListHostHistory has not yet been implemented")
+		}),
+		InstallerListHostValidationsHandler: installer.ListHostValidationsHandlerFunc(func(params installer.ListHostValidationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListHostValidations has not yet been implemented")
+		}),
 		InstallerListHostsHandler: installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListHosts has not yet been implemented")
 		}),
 		ManagedDomainsListManagedDomainsHandler: managed_domains.ListManagedDomainsHandlerFunc(func(params managed_domains.ListManagedDomainsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation managed_domains.ListManagedDomains has not yet been implemented")
 		}),
+		VersionsListSupportedOpenshiftVersionsHandler: versions.ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation versions.ListSupportedOpenshiftVersions has not yet been implemented")
+		}),
 		InstallerPostStepReplyHandler: installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.PostStepReply has not yet been implemented")
 		}),
+		InstallerRegisterAddHostsClusterHandler: installer.RegisterAddHostsClusterHandlerFunc(func(params installer.RegisterAddHostsClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.RegisterAddHostsCluster has not yet been implemented")
+		}),
 		InstallerRegisterClusterHandler: installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterCluster has not yet been implemented")
 		}),
 		InstallerRegisterHostHandler: installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterHost has not yet been implemented")
 		}),
+		ManagedDomainsRegisterManagedDomainHandler: managed_domains.RegisterManagedDomainHandlerFunc(func(params managed_domains.RegisterManagedDomainParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation managed_domains.RegisterManagedDomain has not yet been implemented")
+		}),
 		InstallerResetClusterHandler: installer.ResetClusterHandlerFunc(func(params installer.ResetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ResetCluster has not yet been implemented")
 		}),
+		InstallerRetryInstallationHandler: installer.RetryInstallationHandlerFunc(func(params installer.RetryInstallationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.RetryInstallation has not yet been implemented")
+		}),
+		InstallerSuggestVipsHandler: installer.SuggestVipsHandlerFunc(func(params installer.SuggestVipsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.SuggestVips has not yet been implemented")
+		}),
 		InstallerUpdateClusterHandler: installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateCluster has not yet been implemented")
 		}),
 		InstallerUpdateClusterInstallConfigHandler: installer.UpdateClusterInstallConfigHandlerFunc(func(params installer.UpdateClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerUpdateHostBmcCredentialsHandler: installer.UpdateHostBmcCredentialsHandlerFunc(func(params installer.UpdateHostBmcCredentialsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateHostBmcCredentials has not yet been implemented")
+		}),
 		InstallerUpdateHostInstallProgressHandler: installer.UpdateHostInstallProgressHandlerFunc(func(params installer.UpdateHostInstallProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateHostInstallProgress has not yet been implemented")
 		}),
+		ManagedDomainsUpdateManagedDomainHandler: managed_domains.UpdateManagedDomainHandlerFunc(func(params managed_domains.UpdateManagedDomainParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation managed_domains.UpdateManagedDomain has not yet been implemented")
+		}),
 		InstallerUploadClusterIngressCertHandler: installer.UploadClusterIngressCertHandlerFunc(func(params installer.UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UploadClusterIngressCert has not yet been implemented")
 		}),
@@ -163,6 +229,10 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		UserAuthAuth: func(token string) (interface{}, error) {
 			return nil, errors.NotImplemented("api key auth (userAuth) Authorization from header param [Authorization] has not yet been implemented")
 		},
+		// Applies when the "api_key" query is set
+		URLAuthAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (urlAuth) api_key from query param [api_key] has not yet been implemented")
+		},
 		// default authorizer is authorized meaning no requests are blocked
 		APIAuthorizer: security.Authorized(),
 	}
@@ -213,6 +283,10 @@ type AssistedInstallAPI struct {
 	// it performs authentication based on an api key Authorization provided in the header
 	UserAuthAuth func(string) (interface{}, error)
 
+	// URLAuthAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key api_key provided in the query
+	URLAuthAuth func(string) (interface{}, error)
+
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
@@ -220,12 +294,24 @@ type AssistedInstallAPI struct {
 	InstallerCancelInstallationHandler installer.CancelInstallationHandler
 	// InstallerCompleteInstallationHandler sets the operation handler for the complete installation operation
 	InstallerCompleteInstallationHandler installer.CompleteInstallationHandler
+	// InstallerCreateClusterManifestHandler sets the operation handler for the create cluster manifest operation
+	InstallerCreateClusterManifestHandler installer.CreateClusterManifestHandler
+	// InstallerDeleteClusterManifestHandler sets the operation handler for the delete cluster manifest operation
+	InstallerDeleteClusterManifestHandler installer.DeleteClusterManifestHandler
+	// InstallerDeleteHostBmcCredentialsHandler sets the operation handler for the delete host bmc credentials operation
+	InstallerDeleteHostBmcCredentialsHandler installer.DeleteHostBmcCredentialsHandler
 	// InstallerDeregisterClusterHandler sets the operation handler for the deregister cluster operation
 	InstallerDeregisterClusterHandler installer.DeregisterClusterHandler
 	// InstallerDeregisterHostHandler sets the operation handler for the deregister host operation
 	InstallerDeregisterHostHandler installer.DeregisterHostHandler
+	// ManagedDomainsDeregisterManagedDomainHandler sets the operation handler for the deregister managed domain operation
+	ManagedDomainsDeregisterManagedDomainHandler managed_domains.DeregisterManagedDomainHandler
 	// InstallerDisableHostHandler sets the operation handler for the disable host operation
 	InstallerDisableHostHandler installer.DisableHostHandler
+	// InstallerDownloadBaseBootArtifactHandler sets the operation handler for the download base boot artifact operation
+	InstallerDownloadBaseBootArtifactHandler installer.DownloadBaseBootArtifactHandler
+	// InstallerDownloadClusterBootArtifactHandler sets the operation handler for the download cluster boot artifact operation
+	InstallerDownloadClusterBootArtifactHandler installer.DownloadClusterBootArtifactHandler
 	// InstallerDownloadClusterFilesHandler sets the operation handler for the download cluster files operation
 	InstallerDownloadClusterFilesHandler installer.DownloadClusterFilesHandler
 	// InstallerDownloadClusterISOHandler sets the operation handler for the download cluster i s o operation
@@ -242,6 +328,8 @@ type AssistedInstallAPI struct {
 	InstallerGenerateClusterISOHandler installer.GenerateClusterISOHandler
 	// InstallerGetClusterHandler sets the operation handler for the get cluster operation
 	InstallerGetClusterHandler installer.GetClusterHandler
+	// InstallerGetClusterConnectivityHandler sets the operation handler for the get cluster connectivity operation
+	InstallerGetClusterConnectivityHandler installer.GetClusterConnectivityHandler
 	// InstallerGetClusterInstallConfigHandler sets the operation handler for the get cluster install config operation
 	InstallerGetClusterInstallConfigHandler installer.GetClusterInstallConfigHandler
 	// InstallerGetCredentialsHandler sets the operation handler for the get credentials operation
@@ -250,38 +338,68 @@ type AssistedInstallAPI struct {
 	InstallerGetFreeAddressesHandler installer.GetFreeAddressesHandler
 	// InstallerGetHostHandler sets the operation handler for the get host operation
 	InstallerGetHostHandler installer.GetHostHandler
+	// InstallerGetHostBmcCredentialsHandler sets the operation handler for the get host bmc credentials operation
+	InstallerGetHostBmcCredentialsHandler installer.GetHostBmcCredentialsHandler
 	// InstallerGetHostRequirementsHandler sets the operation handler for the get host requirements operation
 	InstallerGetHostRequirementsHandler installer.GetHostRequirementsHandler
+	// ManagedDomainsGetManagedDomainHandler sets the operation handler for the get managed domain operation
+	ManagedDomainsGetManagedDomainHandler managed_domains.GetManagedDomainHandler
 	// InstallerGetNextStepsHandler sets the operation handler for the get next steps operation
 	InstallerGetNextStepsHandler installer.GetNextStepsHandler
 	// InstallerGetPresignedForClusterFilesHandler sets the operation handler for the get presigned for cluster files operation
 	InstallerGetPresignedForClusterFilesHandler installer.GetPresignedForClusterFilesHandler
 	// InstallerInstallClusterHandler sets the operation handler for the install cluster operation
 	InstallerInstallClusterHandler installer.InstallClusterHandler
+	// InstallerInstallHostsHandler sets the operation handler for the install hosts operation
+	InstallerInstallHostsHandler installer.InstallHostsHandler
+	// InstallerListClusterHistoryHandler sets the operation handler for the list cluster history operation
+	InstallerListClusterHistoryHandler installer.ListClusterHistoryHandler
+	// InstallerListClusterManifestsHandler sets the operation handler for the list cluster manifests operation
+	InstallerListClusterManifestsHandler installer.ListClusterManifestsHandler
+	// InstallerListClusterValidationsHandler sets the operation handler for the list cluster validations operation
+	InstallerListClusterValidationsHandler installer.ListClusterValidationsHandler
 	// InstallerListClustersHandler sets the operation handler for the list clusters operation
 	InstallerListClustersHandler installer.ListClustersHandler
 	// VersionsListComponentVersionsHandler sets the operation handler for the list component versions operation
 	VersionsListComponentVersionsHandler versions.ListComponentVersionsHandler
 	// EventsListEventsHandler sets the operation handler for the list events operation
 	EventsListEventsHandler events.ListEventsHandler
+	// InstallerListHostHistoryHandler sets the operation handler for the list host history operation
+	InstallerListHostHistoryHandler installer.ListHostHistoryHandler
+	// InstallerListHostValidationsHandler sets the operation handler for the list host validations operation
+	InstallerListHostValidationsHandler installer.ListHostValidationsHandler
 	// InstallerListHostsHandler sets the operation handler for the list hosts operation
 	InstallerListHostsHandler installer.ListHostsHandler
 	// ManagedDomainsListManagedDomainsHandler sets the operation handler for the list managed domains operation
 	ManagedDomainsListManagedDomainsHandler managed_domains.ListManagedDomainsHandler
+	// VersionsListSupportedOpenshiftVersionsHandler sets the operation handler for the list supported openshift versions operation
+	VersionsListSupportedOpenshiftVersionsHandler versions.ListSupportedOpenshiftVersionsHandler
 	// InstallerPostStepReplyHandler sets the operation handler for the post step reply operation
 	InstallerPostStepReplyHandler installer.PostStepReplyHandler
+	// InstallerRegisterAddHostsClusterHandler sets the operation handler for the register add hosts cluster operation
+	InstallerRegisterAddHostsClusterHandler installer.RegisterAddHostsClusterHandler
 	// InstallerRegisterClusterHandler sets the operation handler for the register cluster operation
 	InstallerRegisterClusterHandler installer.RegisterClusterHandler
 	// InstallerRegisterHostHandler sets the operation handler for the register host operation
 	InstallerRegisterHostHandler installer.RegisterHostHandler
+	// ManagedDomainsRegisterManagedDomainHandler sets the operation handler for the register managed domain operation
+	ManagedDomainsRegisterManagedDomainHandler managed_domains.RegisterManagedDomainHandler
 	// InstallerResetClusterHandler sets the operation handler for the reset cluster operation
 	InstallerResetClusterHandler installer.ResetClusterHandler
+	// InstallerRetryInstallationHandler sets the operation handler for the retry installation operation
+	InstallerRetryInstallationHandler installer.RetryInstallationHandler
+	// InstallerSuggestVipsHandler sets the operation handler for the suggest vips operation
+	InstallerSuggestVipsHandler installer.SuggestVipsHandler
 	// InstallerUpdateClusterHandler sets the operation handler for the update cluster operation
 	InstallerUpdateClusterHandler installer.UpdateClusterHandler
 	// InstallerUpdateClusterInstallConfigHandler sets the operation handler for the update cluster install config operation
 	InstallerUpdateClusterInstallConfigHandler installer.UpdateClusterInstallConfigHandler
+	// InstallerUpdateHostBmcCredentialsHandler sets the operation handler for the update host bmc credentials operation
+	InstallerUpdateHostBmcCredentialsHandler installer.UpdateHostBmcCredentialsHandler
 	// InstallerUpdateHostInstallProgressHandler sets the operation handler for the update host install progress operation
 	InstallerUpdateHostInstallProgressHandler installer.UpdateHostInstallProgressHandler
+	// ManagedDomainsUpdateManagedDomainHandler sets the operation handler for the update managed domain operation
+	ManagedDomainsUpdateManagedDomainHandler managed_domains.UpdateManagedDomainHandler
 	// InstallerUploadClusterIngressCertHandler sets the operation handler for the upload cluster ingress cert operation
 	InstallerUploadClusterIngressCertHandler installer.UploadClusterIngressCertHandler
 	// InstallerUploadHostLogsHandler sets the operation handler for the upload host logs operation
@@ -374,6 +492,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.UserAuthAuth == nil {
 		unregistered = append(unregistered, "AuthorizationAuth")
 	}
+	if o.URLAuthAuth == nil {
+		unregistered = append(unregistered, "APIKeyAuth")
+	}
 
 	if o.InstallerCancelInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.CancelInstallationHandler")
@@ -381,15 +502,33 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerCompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.CompleteInstallationHandler")
 	}
+	if o.InstallerCreateClusterManifestHandler == nil {
+		unregistered = append(unregistered, "installer.CreateClusterManifestHandler")
+	}
+	if o.InstallerDeleteClusterManifestHandler == nil {
+		unregistered = append(unregistered, "installer.DeleteClusterManifestHandler")
+	}
+	if o.InstallerDeleteHostBmcCredentialsHandler == nil {
+		unregistered = append(unregistered, "installer.DeleteHostBmcCredentialsHandler")
+	}
 	if o.InstallerDeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterClusterHandler")
 	}
 	if o.InstallerDeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterHostHandler")
 	}
+	if o.ManagedDomainsDeregisterManagedDomainHandler == nil {
+		unregistered = append(unregistered, "managed_domains.DeregisterManagedDomainHandler")
+	}
 	if o.InstallerDisableHostHandler == nil {
 		unregistered = append(unregistered, "installer.DisableHostHandler")
 	}
+	if o.InstallerDownloadBaseBootArtifactHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadBaseBootArtifactHandler")
+	}
+	if o.InstallerDownloadClusterBootArtifactHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadClusterBootArtifactHandler")
+	}
 	if o.InstallerDownloadClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.DownloadClusterFilesHandler")
 	}
@@ -414,6 +553,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterHandler")
 	}
+	if o.InstallerGetClusterConnectivityHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterConnectivityHandler")
+	}
 	if o.InstallerGetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterInstallConfigHandler")
 	}
@@ -426,9 +568,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetHostHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostHandler")
 	}
+	if o.InstallerGetHostBmcCredentialsHandler == nil {
+		unregistered = append(unregistered, "installer.GetHostBmcCredentialsHandler")
+	}
 	if o.InstallerGetHostRequirementsHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostRequirementsHandler")
 	}
+	if o.ManagedDomainsGetManagedDomainHandler == nil {
+		unregistered = append(unregistered, "managed_domains.GetManagedDomainHandler")
+	}
 	if o.InstallerGetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.GetNextStepsHandler")
 	}
@@ -438,6 +586,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerInstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.InstallClusterHandler")
 	}
+	if o.InstallerInstallHostsHandler == nil {
+		unregistered = append(unregistered, "installer.InstallHostsHandler")
+	}
+	if o.InstallerListClusterHistoryHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterHistoryHandler")
+	}
+	if o.InstallerListClusterManifestsHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterManifestsHandler")
+	}
+	if o.InstallerListClusterValidationsHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterValidationsHandler")
+	}
 	if o.InstallerListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.ListClustersHandler")
 	}
@@ -447,33 +607,60 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.EventsListEventsHandler == nil {
 		unregistered = append(unregistered, "events.ListEventsHandler")
 	}
+	if o.InstallerListHostHistoryHandler == nil {
+		unregistered = append(unregistered, "installer.ListHostHistoryHandler")
+	}
+	if o.InstallerListHostValidationsHandler == nil {
+		unregistered = append(unregistered, "installer.ListHostValidationsHandler")
+	}
 	if o.InstallerListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.ListHostsHandler")
 	}
 	if o.ManagedDomainsListManagedDomainsHandler == nil {
 		unregistered = append(unregistered, "managed_domains.ListManagedDomainsHandler")
 	}
+	if o.VersionsListSupportedOpenshiftVersionsHandler == nil {
+		unregistered = append(unregistered, "versions.ListSupportedOpenshiftVersionsHandler")
+	}
 	if o.InstallerPostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.PostStepReplyHandler")
 	}
+	if o.InstallerRegisterAddHostsClusterHandler == nil {
+		unregistered = append(unregistered, "installer.RegisterAddHostsClusterHandler")
+	}
 	if o.InstallerRegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterClusterHandler")
 	}
 	if o.InstallerRegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterHostHandler")
 	}
+	if o.ManagedDomainsRegisterManagedDomainHandler == nil {
+		unregistered = append(unregistered, "managed_domains.RegisterManagedDomainHandler")
+	}
 	if o.InstallerResetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.ResetClusterHandler")
 	}
+	if o.InstallerRetryInstallationHandler == nil {
+		unregistered = append(unregistered, "installer.RetryInstallationHandler")
+	}
+	if o.InstallerSuggestVipsHandler == nil {
+		unregistered = append(unregistered, "installer.SuggestVipsHandler")
+	}
 	if o.InstallerUpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterHandler")
 	}
 	if o.InstallerUpdateClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterInstallConfigHandler")
 	}
+	if o.InstallerUpdateHostBmcCredentialsHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateHostBmcCredentialsHandler")
+	}
 	if o.InstallerUpdateHostInstallProgressHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateHostInstallProgressHandler")
 	}
+	if o.ManagedDomainsUpdateManagedDomainHandler == nil {
+		unregistered = append(unregistered, "managed_domains.UpdateManagedDomainHandler")
+	}
 	if o.InstallerUploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.UploadClusterIngressCertHandler")
 	}
@@ -502,6 +689,10 @@ func (o *AssistedInstallAPI) AuthenticatorsFor(schemes map[string]spec.SecurityS
 			scheme := schemes[name]
 			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.AgentAuthAuth)
 
+		case "urlAuth":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.URLAuthAuth)
+
 		case "userAuth":
 			scheme := schemes[name]
 			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.UserAuthAuth)
@@ -593,6 +784,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/actions/complete_installation"] = installer.NewCompleteInstallation(o.context, o.InstallerCompleteInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/manifests"] = installer.NewCreateClusterManifest(o.context, o.InstallerCreateClusterManifestHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/manifests"] = installer.NewDeleteClusterManifest(o.context, o.InstallerDeleteClusterManifestHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/hosts/{host_id}/bmc-credentials"] = installer.NewDeleteHostBmcCredentials(o.context, o.InstallerDeleteHostBmcCredentialsHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -604,10 +807,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/domains/{domain_id}"] = managed_domains.NewDeregisterManagedDomain(o.context, o.ManagedDomainsDeregisterManagedDomainHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}/hosts/{host_id}/actions/enable"] = installer.NewDisableHost(o.context, o.InstallerDisableHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/boot-artifacts/{openshift_version}"] = installer.NewDownloadBaseBootArtifact(o.context, o.InstallerDownloadBaseBootArtifactHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/downloads/boot-artifacts"] = installer.NewDownloadClusterBootArtifact(o.context, o.InstallerDownloadClusterBootArtifactHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/downloads/files"] = installer.NewDownloadClusterFiles(o.context, o.InstallerDownloadClusterFilesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -640,6 +855,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/connectivity"] = installer.NewGetClusterConnectivity(o.context, o.InstallerGetClusterConnectivityHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/install-config"] = installer.NewGetClusterInstallConfig(o.context, o.InstallerGetClusterInstallConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -656,10 +875,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/bmc-credentials"] = installer.NewGetHostBmcCredentials(o.context, o.InstallerGetHostBmcCredentialsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/host_requirements"] = installer.NewGetHostRequirements(o.context, o.InstallerGetHostRequirementsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/domains/{domain_id}"] = managed_domains.NewGetManagedDomain(o.context, o.ManagedDomainsGetManagedDomainHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/instructions"] = installer.NewGetNextSteps(o.context, o.InstallerGetNextStepsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -669,6 +896,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/actions/install"] = installer.NewInstallCluster(o.context, o.InstallerInstallClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/actions/install_hosts"] = installer.NewInstallHosts(o.context, o.InstallerInstallHostsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/history"] = installer.NewListClusterHistory(o.context, o.InstallerListClusterHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/manifests"] = installer.NewListClusterManifests(o.context, o.InstallerListClusterManifestsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/validations"] = installer.NewListClusterValidations(o.context, o.InstallerListClusterValidationsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -684,11 +927,23 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/history"] = installer.NewListHostHistory(o.context, o.InstallerListHostHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/validations"] = installer.NewListHostValidations(o.context, o.InstallerListHostValidationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts"] = installer.NewListHosts(o.context, o.InstallerListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/domains"] = managed_domains.NewListManagedDomains(o.context, o.ManagedDomainsListManagedDomainsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/openshift_versions"] = versions.NewListSupportedOpenshiftVersions(o.context, o.VersionsListSupportedOpenshiftVersionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -696,6 +951,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/add_hosts_clusters"] = installer.NewRegisterAddHostsCluster(o.context, o.InstallerRegisterAddHostsClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters"] = installer.NewRegisterCluster(o.context, o.InstallerRegisterClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -704,7 +963,19 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/domains"] = managed_domains.NewRegisterManagedDomain(o.context, o.ManagedDomainsRegisterManagedDomainHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/actions/reset"] = installer.NewResetCluster(o.context, o.InstallerResetClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/actions/retry_install"] = installer.NewRetryInstallation(o.context, o.InstallerRetryInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/actions/suggest-vips"] = installer.NewSuggestVips(o.context, o.InstallerSuggestVipsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -716,7 +987,15 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/hosts/{host_id}/bmc-credentials"] = installer.NewUpdateHostBmcCredentials(o.context, o.InstallerUpdateHostBmcCredentialsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/clusters/{cluster_id}/hosts/{host_id}/progress"] = installer.NewUpdateHostInstallProgress(o.context, o.InstallerUpdateHostInstallProgressHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/domains/{domain_id}"] = managed_domains.NewUpdateManagedDomain(o.context, o.ManagedDomainsUpdateManagedDomainHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
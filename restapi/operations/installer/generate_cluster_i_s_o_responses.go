@@ -13,14 +13,14 @@ import (
 	"github.com/openshift/assisted-service/models"
 )
 
-// GenerateClusterISOCreatedCode is the HTTP code returned for type GenerateClusterISOCreated
-const GenerateClusterISOCreatedCode int = 201
+// GenerateClusterISOAcceptedCode is the HTTP code returned for type GenerateClusterISOAccepted
+const GenerateClusterISOAcceptedCode int = 202
 
-/*GenerateClusterISOCreated Success.
+/*GenerateClusterISOAccepted Accepted, the build status of the image info of the cluster tracks the build of the image.
 
-swagger:response generateClusterISOCreated
+swagger:response generateClusterISOAccepted
 */
-type GenerateClusterISOCreated struct {
+type GenerateClusterISOAccepted struct {
 
 	/*
 	  In: Body
@@ -28,27 +28,27 @@ type GenerateClusterISOCreated struct {
 	Payload *models.Cluster `json:"body,omitempty"`
 }
 
-// NewGenerateClusterISOCreated creates GenerateClusterISOCreated with default headers values
-func NewGenerateClusterISOCreated() *GenerateClusterISOCreated {
+// NewGenerateClusterISOAccepted creates GenerateClusterISOAccepted with default headers values
+func NewGenerateClusterISOAccepted() *GenerateClusterISOAccepted {
 
-	return &GenerateClusterISOCreated{}
+	return &GenerateClusterISOAccepted{}
 }
 
-// WithPayload adds the payload to the generate cluster i s o created response
-func (o *GenerateClusterISOCreated) WithPayload(payload *models.Cluster) *GenerateClusterISOCreated {
+// WithPayload adds the payload to the generate cluster i s o accepted response
+func (o *GenerateClusterISOAccepted) WithPayload(payload *models.Cluster) *GenerateClusterISOAccepted {
 	o.Payload = payload
 	return o
 }
 
-// SetPayload sets the payload to the generate cluster i s o created response
-func (o *GenerateClusterISOCreated) SetPayload(payload *models.Cluster) {
+// SetPayload sets the payload to the generate cluster i s o accepted response
+func (o *GenerateClusterISOAccepted) SetPayload(payload *models.Cluster) {
 	o.Payload = payload
 }
 
 // WriteResponse to the client
-func (o *GenerateClusterISOCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+func (o *GenerateClusterISOAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.WriteHeader(201)
+	rw.WriteHeader(202)
 	if o.Payload != nil {
 		payload := o.Payload
 		if err := producer.Produce(rw, payload); err != nil {
@@ -49,7 +49,7 @@ type UpdateClusterInstallConfigBadRequest struct {
 	/*
 	  In: Body
 	*/
-	Payload *models.Error `json:"body,omitempty"`
+	Payload *models.InstallConfigOverridesError `json:"body,omitempty"`
 }
 
 // NewUpdateClusterInstallConfigBadRequest creates UpdateClusterInstallConfigBadRequest with default headers values
@@ -59,13 +59,13 @@ func NewUpdateClusterInstallConfigBadRequest() *UpdateClusterInstallConfigBadReq
 }
 
 // WithPayload adds the payload to the update cluster install config bad request response
-func (o *UpdateClusterInstallConfigBadRequest) WithPayload(payload *models.Error) *UpdateClusterInstallConfigBadRequest {
+func (o *UpdateClusterInstallConfigBadRequest) WithPayload(payload *models.InstallConfigOverridesError) *UpdateClusterInstallConfigBadRequest {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the update cluster install config bad request response
-func (o *UpdateClusterInstallConfigBadRequest) SetPayload(payload *models.Error) {
+func (o *UpdateClusterInstallConfigBadRequest) SetPayload(payload *models.InstallConfigOverridesError) {
 	o.Payload = payload
 }
 
@@ -83,7 +83,7 @@ var (
 	validFreeAddresses = models.FreeNetworksAddresses{
 		{
 			Network: "1.2.3.0/24",
-			FreeAddresses: []strfmt.IPv4{
+			FreeAddresses: []string{
 				"1.2.3.8",
 				"1.2.3.9",
 				"1.2.3.5",
@@ -488,6 +488,7 @@ var _ = Describe("cluster install", func() {
 			},
 		})
 		Expect(err).ShouldNot(HaveOccurred())
+		generateFullMeshConnectivity(ctx, h.ClusterID)
 	}
 
 	generateFAPostStepReply := func(h *models.Host, freeAddresses models.FreeNetworksAddresses) {
@@ -699,6 +700,7 @@ var _ = Describe("cluster install", func() {
 				Expect(*hostInDb.Status).Should(Equal(models.HostStatusInstallingInProgress))
 				Expect(*hostInDb.StatusInfo).Should(Equal(string(installProgress)))
 				Expect(hostInDb.InstallationDiskPath).ShouldNot(BeEmpty())
+				Expect(hostInDb.InstallationDiskID).Should(Equal(hostInDb.InstallationDiskPath))
 				Expect(hostInDb.Inventory).ShouldNot(BeEmpty())
 			})
 
@@ -2158,6 +2160,7 @@ func registerHostsAndSetRoles(clusterID strfmt.UUID, numHosts int) []*models.Hos
 			},
 		})
 		Expect(err).ShouldNot(HaveOccurred())
+		generateFullMeshConnectivity(ctx, h.ClusterID)
 	}
 	generateFAPostStepReply := func(h *models.Host, freeAddresses models.FreeNetworksAddresses) {
 		fa, err := json.Marshal(&freeAddresses)
@@ -2240,6 +2243,7 @@ func registerHostsAndSetRolesDHCP(clusterID strfmt.UUID, numHosts int) []*models
 			},
 		})
 		Expect(err).ShouldNot(HaveOccurred())
+		generateFullMeshConnectivity(ctx, h.ClusterID)
 	}
 	generateDhcpStepReply := func(h *models.Host, apiVip, ingressVip string) {
 		avip := strfmt.IPv4(apiVip)
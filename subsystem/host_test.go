@@ -342,8 +342,8 @@ var _ = Describe("Host tests", func() {
 		})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(freeAddressesReply.Payload).To(HaveLen(2))
-		Expect(freeAddressesReply.Payload[0]).To(Equal(strfmt.IPv4("10.0.0.0")))
-		Expect(freeAddressesReply.Payload[1]).To(Equal(strfmt.IPv4("10.0.0.1")))
+		Expect(freeAddressesReply.Payload[0]).To(Equal("10.0.0.0"))
+		Expect(freeAddressesReply.Payload[1]).To(Equal("10.0.0.1"))
 
 		freeAddressesReply, err = userBMClient.Installer.GetFreeAddresses(ctx, &installer.GetFreeAddressesParams{
 			ClusterID: clusterID,
@@ -351,7 +351,7 @@ var _ = Describe("Host tests", func() {
 		})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(freeAddressesReply.Payload).To(HaveLen(1))
-		Expect(freeAddressesReply.Payload[0]).To(Equal(strfmt.IPv4("10.0.1.0")))
+		Expect(freeAddressesReply.Payload[0]).To(Equal("10.0.1.0"))
 
 		freeAddressesReply, err = userBMClient.Installer.GetFreeAddresses(ctx, &installer.GetFreeAddressesParams{
 			ClusterID: clusterID,
@@ -54,6 +54,7 @@ var _ = Describe("system-test image tests", func() {
 			ImageCreateParams: &models.ImageCreateParams{},
 		})
 		Expect(err).NotTo(HaveOccurred())
+		waitForImageBuild(clusterID)
 		_, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{
 			ClusterID: clusterID,
 		}, file)
@@ -144,6 +145,7 @@ var _ = Describe("system-test proxy update tests", func() {
 			ImageCreateParams: &models.ImageCreateParams{},
 		})
 		Expect(err).NotTo(HaveOccurred())
+		waitForImageBuild(clusterID)
 
 		// fetch cluster proxy hash for generated image
 		msg := "Generated image (proxy URL is \"\", SSH public key is not set)"
@@ -164,15 +166,13 @@ var _ = Describe("system-test proxy update tests", func() {
 		// Verify proxy settings changed event emitted
 		verifyEventExistence(clusterID, "Proxy settings changed")
 
-		// at least 10s must elapse between requests to generate the same ISO
-		time.Sleep(time.Second * 10)
-
 		// Generate ISO of registered cluster with proxy configured
 		_, err = userBMClient.Installer.GenerateClusterISO(ctx, &installer.GenerateClusterISOParams{
 			ClusterID:         clusterID,
 			ImageCreateParams: &models.ImageCreateParams{},
 		})
 		Expect(err).NotTo(HaveOccurred())
+		waitForImageBuild(clusterID)
 
 		// fetch cluster proxy hash for generated image
 		msg = fmt.Sprintf("Generated image (proxy URL is \"%s\", SSH public key is not set)", httpProxy)
@@ -180,6 +180,17 @@ var _ = Describe("system-test proxy update tests", func() {
 	})
 })
 
+func waitForImageBuild(clusterID strfmt.UUID) {
+	Eventually(func() string {
+		reply, err := userBMClient.Installer.GetCluster(context.TODO(), &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		if reply.GetPayload().ImageInfo == nil {
+			return ""
+		}
+		return reply.GetPayload().ImageInfo.BuildStatus
+	}, 5*time.Minute, 2*time.Second).Should(Equal(models.ImageInfoBuildStatusUploaded))
+}
+
 func verifyEventExistence(ClusterID strfmt.UUID, message string) {
 	eventsReply, err := userBMClient.Events.ListEvents(context.TODO(), &events.ListEventsParams{
 		ClusterID: ClusterID,
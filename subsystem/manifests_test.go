@@ -0,0 +1,77 @@
+package subsystem
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("manifests tests", func() {
+	var (
+		ctx       = context.Background()
+		cluster   *models.Cluster
+		manifest  = "apiVersion: machineconfiguration.openshift.io/v1\nkind: MachineConfig\nmetadata:\n  name: 99-openshift-machineconfig-master-kargs\n"
+		fileName  = "99-openshift-machineconfig-master-kargs.yaml"
+		manifests *installer.ListClusterManifestsOK
+	)
+
+	BeforeEach(func() {
+		registerClusterReply, err := userBMClient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("test-cluster"),
+				OpenshiftVersion: swag.String("4.6"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		cluster = registerClusterReply.GetPayload()
+	})
+
+	AfterEach(func() {
+		clearDB()
+	})
+
+	It("create, list and delete a manifest", func() {
+		reply, err := userBMClient.Installer.CreateClusterManifest(ctx, &installer.CreateClusterManifestParams{
+			ClusterID: *cluster.ID,
+			CreateManifestParams: &models.CreateManifestParams{
+				Folder:   swag.String(models.ManifestFolderOpenshift),
+				FileName: swag.String(fileName),
+				Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(manifest))),
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*reply.GetPayload()).To(Equal(models.Manifest{Folder: models.ManifestFolderOpenshift, FileName: fileName}))
+
+		manifests, err = userBMClient.Installer.ListClusterManifests(ctx, &installer.ListClusterManifestsParams{ClusterID: *cluster.ID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(manifests.GetPayload()).To(HaveLen(1))
+		Expect(manifests.GetPayload()[0].FileName).To(Equal(fileName))
+
+		_, err = userBMClient.Installer.DeleteClusterManifest(ctx, &installer.DeleteClusterManifestParams{
+			ClusterID: *cluster.ID,
+			Folder:    swag.String(models.ManifestFolderOpenshift),
+			FileName:  fileName,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		manifests, err = userBMClient.Installer.ListClusterManifests(ctx, &installer.ListClusterManifestsParams{ClusterID: *cluster.ID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(manifests.GetPayload()).To(BeEmpty())
+	})
+
+	It("reject an invalid manifest", func() {
+		_, err := userBMClient.Installer.CreateClusterManifest(ctx, &installer.CreateClusterManifestParams{
+			ClusterID: *cluster.ID,
+			CreateManifestParams: &models.CreateManifestParams{
+				FileName: swag.String("99-manifest.txt"),
+				Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(manifest))),
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewCreateClusterManifestBadRequest()))
+	})
+})
@@ -2,6 +2,8 @@ package subsystem
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -83,3 +85,53 @@ func updateProgressWithInfo(hostID strfmt.UUID, clusterID strfmt.UUID, current_s
 	Expect(err).ShouldNot(HaveOccurred())
 	Expect(updateReply).Should(BeAssignableToTypeOf(installer.NewUpdateHostInstallProgressOK()))
 }
+
+// generateFullMeshConnectivity reports that every host of the cluster with an inventory reaches the addresses of all
+// the other such hosts
+func generateFullMeshConnectivity(ctx context.Context, clusterID strfmt.UUID) {
+	reply, err := userBMClient.Installer.ListHosts(ctx, &installer.ListHostsParams{ClusterID: clusterID})
+	Expect(err).NotTo(HaveOccurred())
+	addresses := make(map[strfmt.UUID][]string)
+	for _, h := range reply.Payload {
+		if h.Inventory == "" {
+			continue
+		}
+		var inventory models.Inventory
+		Expect(json.Unmarshal([]byte(h.Inventory), &inventory)).NotTo(HaveOccurred())
+		for _, intf := range inventory.Interfaces {
+			for _, ip := range intf.IPV4Addresses {
+				addresses[*h.ID] = append(addresses[*h.ID], strings.Split(ip, "/")[0])
+			}
+		}
+	}
+	for _, h := range reply.Payload {
+		if _, ok := addresses[*h.ID]; !ok {
+			continue
+		}
+		report := models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{}}
+		for remoteID, remoteAddresses := range addresses {
+			if remoteID == *h.ID {
+				continue
+			}
+			remote := &models.ConnectivityRemoteHost{HostID: remoteID}
+			for _, ip := range remoteAddresses {
+				remote.L2Connectivity = append(remote.L2Connectivity, &models.L2Connectivity{RemoteIPAddress: ip, Successful: true})
+				remote.L3Connectivity = append(remote.L3Connectivity, &models.L3Connectivity{RemoteIPAddress: ip, Successful: true})
+			}
+			report.RemoteHosts = append(report.RemoteHosts, remote)
+		}
+		b, err := json.Marshal(&report)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = agentBMClient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
+			ClusterID: clusterID,
+			HostID:    *h.ID,
+			Reply: &models.StepReply{
+				ExitCode: 0,
+				StepType: models.StepTypeConnectivityCheck,
+				Output:   string(b),
+				StepID:   string(models.StepTypeConnectivityCheck),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+}
@@ -3,9 +3,12 @@ package subsystem
 import (
 	"context"
 
+	"github.com/go-openapi/swag"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/versions"
+	"github.com/openshift/assisted-service/models"
 )
 
 var _ = Describe("test versions", func() {
@@ -15,4 +18,22 @@ var _ = Describe("test versions", func() {
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(len(reply.GetPayload().Versions)).To(Equal(6))
 	})
+
+	It("get openshift versions list", func() {
+		reply, err := userBMClient.Versions.ListSupportedOpenshiftVersions(context.Background(),
+			&versions.ListSupportedOpenshiftVersionsParams{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(reply.GetPayload()).To(HaveKey("4.5"))
+		Expect(reply.GetPayload()).To(HaveKey("4.6"))
+	})
+
+	It("reject a cluster of an unsupported openshift version", func() {
+		_, err := userBMClient.Installer.RegisterCluster(context.Background(), &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("test-cluster"),
+				OpenshiftVersion: swag.String("4.4"),
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+	})
 })
@@ -20,6 +20,7 @@ ENVS = [
     ("AGENT_DOCKER_IMAGE", ""),
     ("IGNITION_GENERATE_IMAGE", ""),
     ("BASE_DNS_DOMAINS", ""),
+    ("OPENSHIFT_VERSIONS", ""),
 ]
 
 